@@ -0,0 +1,227 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logs
+
+import (
+	"bufio"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/appengine/logdog/coordinator"
+	"github.com/luci/luci-go/common/api/logdog_coordinator/logs/v1"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	"github.com/luci/luci-go/common/grpcutil"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/parallel"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/server/logdog/archive"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// searchStreamLimit is the maximum number of log streams that will be
+	// searched in a single Search request.
+	searchStreamLimit = 50
+
+	// searchMatchLimit is the maximum number of matching lines that will be
+	// returned for a single log stream.
+	searchMatchLimit = 100
+
+	// searchWorkers is the maximum number of log stream search indexes that
+	// will be read concurrently.
+	searchWorkers = 8
+)
+
+// Search returns the lines of archived text log streams that contain the
+// requested text.
+func (s *server) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	log.Fields{
+		"project":    req.Project,
+		"path":       req.Path,
+		"text":       req.Text,
+		"ignoreCase": req.IgnoreCase,
+	}.Debugf(c, "Received search request.")
+
+	if req.Text == "" {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "missing search `text`")
+	}
+
+	limit := s.limit(int(req.MaxResults), searchStreamLimit)
+	matchLimit := int(req.MaxMatches)
+	if matchLimit <= 0 || matchLimit > searchMatchLimit {
+		matchLimit = searchMatchLimit
+	}
+
+	// Only text log streams are indexed. Purged log streams are never searched.
+	q := ds.NewQuery("LogStream").Order("-Created")
+	q = q.Eq("StreamType", logpb.StreamType_TEXT)
+	q = coordinator.AddLogStreamPurgedFilter(q, false)
+
+	di := ds.Get(c)
+	if req.Next != "" {
+		cursor, err := di.DecodeCursor(req.Next)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"cursor":     req.Next,
+			}.Errorf(c, "Failed to decode cursor.")
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid `next` value")
+		}
+		q = q.Start(cursor)
+	}
+
+	if req.Path != "" {
+		var err error
+		if q, err = coordinator.AddLogStreamPathFilter(q, req.Path); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       req.Path,
+			}.Errorf(c, "Invalid search path.")
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid search `path`")
+		}
+	}
+	if req.Newer != nil {
+		q = coordinator.AddNewerFilter(q, req.Newer.Time())
+	}
+	if req.Older != nil {
+		q = coordinator.AddOlderFilter(q, req.Older.Time())
+	}
+
+	q = q.Limit(int32(limit))
+	q = q.KeysOnly(true)
+
+	var cursor ds.Cursor
+	logStreams := make([]*coordinator.LogStream, 0, limit)
+	err := di.Run(q, func(sk *ds.Key, cb ds.CursorCB) error {
+		var ls coordinator.LogStream
+		ds.PopulateKey(&ls, sk)
+		logStreams = append(logStreams, &ls)
+
+		// If we hit our limit, add a cursor for the next iteration.
+		if len(logStreams) == limit {
+			var err error
+			if cursor, err = cb(); err != nil {
+				log.Fields{
+					log.ErrorKey: err,
+					"count":      len(logStreams),
+				}.Errorf(c, "Failed to get cursor value.")
+				return err
+			}
+			return ds.Stop
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to execute search query.")
+		return nil, grpcutil.Internal
+	}
+
+	resp := logdog.SearchResponse{
+		Project: string(coordinator.Project(c)),
+	}
+	if cursor != nil {
+		resp.Next = cursor.String()
+	}
+	if len(logStreams) == 0 {
+		return &resp, nil
+	}
+
+	entities := make([]interface{}, 0, 2*len(logStreams))
+	logStreamStates := make([]coordinator.LogStreamState, len(logStreams))
+	for i, ls := range logStreams {
+		ls.PopulateState(di, &logStreamStates[i])
+		entities = append(entities, ls, &logStreamStates[i])
+	}
+	if err := di.GetMulti(entities); err != nil {
+		log.WithError(err).Errorf(c, "Failed to load entry and state content.")
+		return nil, grpcutil.Internal
+	}
+
+	gsClient, err := coordinator.GetServices(c).GSClient(c)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to create Google Storage client.")
+		return nil, grpcutil.Internal
+	}
+	defer func() {
+		if err := gsClient.Close(); err != nil {
+			log.WithError(err).Warningf(c, "Failed to close Google Storage client.")
+		}
+	}()
+
+	// Search the log streams in parallel, retaining their query order. A log
+	// stream whose search index can't be read is skipped, so that it doesn't
+	// fail the search of all of the others.
+	results := make([]*logdog.SearchResponse_Stream, len(logStreams))
+	parallel.WorkPool(searchWorkers, func(workC chan<- func() error) {
+		for i, ls := range logStreams {
+			i, ls, lst := i, ls, &logStreamStates[i]
+
+			// Log streams that have not been archived have no search index.
+			if lst.ArchiveSearchURL == "" {
+				continue
+			}
+
+			workC <- func() error {
+				stream, err := searchStream(gsClient, lst, req, matchLimit)
+				if err != nil {
+					log.Fields{
+						log.ErrorKey: err,
+						"path":       ls.Path(),
+						"searchURL":  lst.ArchiveSearchURL,
+					}.Warningf(c, "Failed to search log stream; skipping.")
+					return nil
+				}
+				if stream != nil {
+					stream.Path = string(ls.Path())
+					results[i] = stream
+				}
+				return nil
+			}
+		}
+	})
+
+	for _, stream := range results {
+		if stream != nil {
+			resp.Streams = append(resp.Streams, stream)
+		}
+	}
+
+	log.Fields{
+		"searched": len(logStreams),
+		"matched":  len(resp.Streams),
+	}.Debugf(c, "Search request completed successfully.")
+	return &resp, nil
+}
+
+// searchStream searches a log stream's archived search index. It returns nil
+// if the log stream has no matching lines.
+func searchStream(client gs.Client, lst *coordinator.LogStreamState, req *logdog.SearchRequest,
+	matchLimit int) (*logdog.SearchResponse_Stream, error) {
+	r, err := client.NewReader(gs.Path(lst.ArchiveSearchURL), 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var stream logdog.SearchResponse_Stream
+	err = archive.Search(bufio.NewReader(r), req.Text, req.IgnoreCase, func(m *archive.SearchMatch) bool {
+		if len(stream.Matches) >= matchLimit {
+			stream.Truncated = true
+			return false
+		}
+		stream.Matches = append(stream.Matches, &logdog.SearchResponse_Match{
+			Line:        m.Line,
+			StreamIndex: m.StreamIndex,
+		})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(stream.Matches) == 0 {
+		return nil, nil
+	}
+	return &stream, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/luci/gae/filter/featureBreaker"
+	ds "github.com/luci/gae/service/datastore"
+	ct "github.com/luci/luci-go/appengine/logdog/coordinator/coordinatorTest"
+	"github.com/luci/luci-go/common/api/logdog_coordinator/logs/v1"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	"github.com/luci/luci-go/common/logdog/types"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/server/logdog/archive"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func shouldHaveSearchPaths(actual interface{}, expected ...interface{}) string {
+	resp := actual.(*logdog.SearchResponse)
+	var paths []string
+	for _, s := range resp.Streams {
+		paths = append(paths, s.Path)
+	}
+
+	var exp []string
+	for _, e := range expected {
+		exp = append(exp, e.(string))
+	}
+	return ShouldResemble(paths, exp)
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration, a Search request`, t, func() {
+		c, env := ct.Install()
+		c, fb := featureBreaker.FilterRDS(c, nil)
+
+		ds.Get(c).Testable().Consistent(true)
+
+		var svrBase server
+		svr := newService(&svrBase)
+
+		const project = config.ProjectName("proj-foo")
+
+		req := logdog.SearchRequest{
+			Project: string(project),
+			Text:    "ERROR",
+		}
+
+		// Install a set of log streams. Archived text streams have a search index
+		// built from their lines.
+		for i, s := range []struct {
+			path     types.StreamPath
+			lines    []string
+			archived bool
+			purged   bool
+			binary   bool
+		}{
+			{path: "testing/+/foo", lines: []string{"starting", "ERROR: failed", "retrying", "ERROR: failed again"}, archived: true},
+			{path: "testing/+/bar", lines: []string{"starting", "error: ignored", "done"}, archived: true},
+			{path: "testing/+/baz", lines: []string{"ERROR: not archived"}},
+			{path: "testing/+/purged", lines: []string{"ERROR: purged"}, archived: true, purged: true},
+			{path: "testing/+/binary", archived: true, binary: true},
+			{path: "other/+/foo", lines: []string{"ERROR: other"}, archived: true},
+		} {
+			tls := ct.MakeStream(c, project, s.path)
+			tls.Stream.Purged = s.purged
+			if s.binary {
+				tls.Desc.StreamType = logpb.StreamType_BINARY
+			}
+
+			if s.archived {
+				now := env.Clock.Now().UTC()
+				tls.State.ArchiveStreamURL = fmt.Sprintf("gs://testbucket/%d/stream", i)
+				tls.State.ArchivedTime = now
+				tls.State.TerminalIndex = int64(len(s.lines) - 1)
+				tls.State.TerminatedTime = now
+
+				if len(s.lines) > 0 {
+					var entries []*logpb.LogEntry
+					for j, line := range s.lines {
+						le := tls.LogEntry(c, j)
+						le.Sequence = uint64(j)
+						le.GetText().Lines[0].Value = line
+						entries = append(entries, le)
+					}
+
+					src := staticArchiveSource(entries)
+					var sbuf bytes.Buffer
+					m := archive.Manifest{
+						Desc:              tls.Desc,
+						Source:            &src,
+						SearchIndexWriter: &sbuf,
+					}
+					if err := archive.Archive(m); err != nil {
+						panic(err)
+					}
+
+					searchURL := gs.Path(fmt.Sprintf("gs://testbucket/%d/search", i))
+					env.GSClient.Put(searchURL, sbuf.Bytes())
+					tls.State.ArchiveSearchURL = string(searchURL)
+				}
+			}
+
+			tls.Reload(c)
+			if err := tls.Put(c); err != nil {
+				panic(fmt.Errorf("failed to put log stream %d: %v", i, err))
+			}
+			env.Clock.Add(time.Second)
+		}
+		ds.Get(c).Testable().CatchupIndexes()
+
+		Convey(`Will return the matching lines of archived text streams, newest first.`, func() {
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "other/+/foo", "testing/+/foo")
+			So(resp.Project, ShouldEqual, string(project))
+			So(resp.Next, ShouldEqual, "")
+
+			So(resp.Streams[1].Matches, ShouldResemble, []*logdog.SearchResponse_Match{
+				{Line: 1, StreamIndex: 1},
+				{Line: 3, StreamIndex: 3},
+			})
+			So(resp.Streams[1].Truncated, ShouldBeFalse)
+		})
+
+		Convey(`Can search case-insensitively.`, func() {
+			req.Text = "error"
+			req.IgnoreCase = true
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "other/+/foo", "testing/+/bar", "testing/+/foo")
+		})
+
+		Convey(`Can constrain the search to a path.`, func() {
+			req.Path = "testing/+/**"
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/foo")
+		})
+
+		Convey(`Will truncate matches at MaxMatches.`, func() {
+			req.Path = "testing/+/foo"
+			req.MaxMatches = 1
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/foo")
+			So(resp.Streams[0].Matches, ShouldResemble, []*logdog.SearchResponse_Match{{Line: 1, StreamIndex: 1}})
+			So(resp.Streams[0].Truncated, ShouldBeTrue)
+		})
+
+		Convey(`Will page through log streams with MaxResults.`, func() {
+			req.MaxResults = 1
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "other/+/foo")
+			So(resp.Next, ShouldNotEqual, "")
+
+			// Binary, purged, unarchived, and non-matching streams are skipped.
+			var paths []string
+			for resp.Next != "" {
+				req.Next = resp.Next
+				resp, err = svr.Search(c, &req)
+				So(err, ShouldBeRPCOK)
+				for _, s := range resp.Streams {
+					paths = append(paths, s.Path)
+				}
+			}
+			So(paths, ShouldResemble, []string{"testing/+/foo"})
+		})
+
+		Convey(`Will fail with InvalidArgument if no text is supplied.`, func() {
+			req.Text = ""
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "missing search `text`")
+		})
+
+		Convey(`Will fail with InvalidArgument if the path is invalid.`, func() {
+			req.Path = "***"
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid search `path`")
+		})
+
+		Convey(`Will fail with PermissionDenied if the user can't access the project.`, func() {
+			req.Project = "proj-exclusive"
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCPermissionDenied)
+		})
+
+		Convey(`Will fail with Internal if the query fails.`, func() {
+			fb.BreakFeatures(errors.New("testing error"), "Run")
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInternal)
+		})
+
+		Convey(`Will skip a log stream whose search index is corrupt.`, func() {
+			env.GSClient.Put("gs://testbucket/0/search", []byte{0xFF})
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "other/+/foo")
+		})
+	})
+}
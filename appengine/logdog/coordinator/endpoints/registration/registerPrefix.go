@@ -76,25 +76,37 @@ func (s *server) RegisterPrefix(c context.Context, req *logdog.RegisterPrefixReq
 		return nil, grpcutil.Errf(codes.InvalidArgument, "no prefix expiration defined")
 	}
 
-	// Determine our Pub/Sub topic.
+	// Determine where the Butler should send its log bundles.
 	cfgTransport := cfg.Transport
 	if cfgTransport == nil {
 		log.Errorf(c, "Missing transport configuration.")
 		return nil, grpcutil.Internal
 	}
 
-	cfgTransportPubSub := cfgTransport.GetPubsub()
-	if cfgTransportPubSub == nil {
-		log.Errorf(c, "Missing transport Pub/Sub configuration.")
-		return nil, grpcutil.Internal
-	}
+	var (
+		pubsubTopic pubsub.Topic
+		bundleURL   string
+	)
+	switch {
+	case cfgTransport.GetPubsub() != nil:
+		cfgTransportPubSub := cfgTransport.GetPubsub()
+		pubsubTopic = pubsub.NewTopic(cfgTransportPubSub.Project, cfgTransportPubSub.Topic)
+		if err := pubsubTopic.Validate(); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"topic":      pubsubTopic,
+			}.Errorf(c, "Invalid transport Pub/Sub topic.")
+			return nil, grpcutil.Internal
+		}
+
+	case cfgTransport.GetDirect() != nil:
+		if bundleURL = cfgTransport.GetDirect().Url; bundleURL == "" {
+			log.Errorf(c, "Missing transport direct URL.")
+			return nil, grpcutil.Internal
+		}
 
-	pubsubTopic := pubsub.NewTopic(cfgTransportPubSub.Project, cfgTransportPubSub.Topic)
-	if err := pubsubTopic.Validate(); err != nil {
-		log.Fields{
-			log.ErrorKey: err,
-			"topic":      pubsubTopic,
-		}.Errorf(c, "Invalid transport Pub/Sub topic.")
+	default:
+		log.Errorf(c, "Missing transport Pub/Sub or direct configuration.")
 		return nil, grpcutil.Internal
 	}
 
@@ -169,5 +181,6 @@ func (s *server) RegisterPrefix(c context.Context, req *logdog.RegisterPrefixReq
 	return &logdog.RegisterPrefixResponse{
 		Secret:         []byte(secret),
 		LogBundleTopic: string(pubsubTopic),
+		LogBundleUrl:   bundleURL,
 	}, nil
 }
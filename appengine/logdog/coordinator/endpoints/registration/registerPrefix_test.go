@@ -108,6 +108,34 @@ func TestRegisterPrefix(t *testing.T) {
 			})
 		})
 
+		Convey(`Will return a log bundle URL for a direct transport.`, func() {
+			env.ModServiceConfig(c, func(cfg *svcconfig.Config) {
+				cfg.Transport = &svcconfig.Transport{
+					Type: &svcconfig.Transport_Direct_{
+						Direct: &svcconfig.Transport_Direct{
+							Url: "http://collector.example.com/",
+						},
+					},
+				}
+			})
+
+			resp, err := svr.RegisterPrefix(c, &req)
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &logdog.RegisterPrefixResponse{
+				LogBundleUrl: "http://collector.example.com/",
+				Secret:       randSecret,
+			})
+		})
+
+		Convey(`Will fail with Internal if the transport is not configured.`, func() {
+			env.ModServiceConfig(c, func(cfg *svcconfig.Config) {
+				cfg.Transport = &svcconfig.Transport{}
+			})
+
+			_, err := svr.RegisterPrefix(c, &req)
+			So(err, ShouldBeRPCInternal)
+		})
+
 		Convey(`Uses the correct prefix expiration`, func() {
 
 			Convey(`When service, project, and request have expiration, chooses smallest.`, func() {
@@ -118,6 +118,12 @@ type LogStreamState struct {
 	// ArchiveDataSize is the size, in bytes, of the archived data. It will be
 	// zero if the file is not archived.
 	ArchiveDataSize int64 `gae:",noindex"`
+	// ArchiveSearchURL is the Google Storage URL where the log stream's
+	// full-text search index is archived. It is empty if the log stream has no
+	// search index.
+	ArchiveSearchURL string `gae:",noindex"`
+	// ArchiveSearchSize is the size, in bytes, of the archived search index.
+	ArchiveSearchSize int64 `gae:",noindex"`
 
 	// extra causes datastore to ignore unrecognized fields and strip them in
 	// future writes.
@@ -5,6 +5,7 @@
 package archiver
 
 import (
+	"crypto"
 	"errors"
 	"fmt"
 	"io"
@@ -70,6 +71,43 @@ type Future interface {
 	Digest() isolated.HexDigest
 }
 
+// EventType is the kind of progress reported by an Event.
+type EventType int
+
+const (
+	// EventFound is sent when an item is pushed to the Archiver.
+	EventFound EventType = iota
+	// EventHashed is sent once the digest of the item is calculated.
+	EventHashed
+	// EventCacheHit is sent when the item is already present on the server.
+	EventCacheHit
+	// EventUploading is sent periodically while the item is uploaded.
+	EventUploading
+	// EventUploaded is sent once the item is stored on the server.
+	EventUploaded
+	// EventFailed is sent when processing the item failed.
+	EventFailed
+)
+
+// uploadingInterval is the number of bytes between two EventUploading events
+// for an item.
+const uploadingInterval = 1024 * 1024
+
+// Event reports the progress of a single item.
+type Event struct {
+	Type        EventType
+	DisplayName string
+	Digest      isolated.HexDigest // Set starting with EventHashed.
+	Size        int64              // Set starting with EventHashed.
+	Uploaded    int64              // Bytes read for upload; set for EventUploading and EventUploaded.
+	Err         error              // Set for EventFailed.
+}
+
+// EventHandler receives progress events.
+//
+// It is called concurrently from multiple goroutines and must not block.
+type EventHandler func(e *Event)
+
 // Archiver is an high level interface to an isolatedclient.IsolateServer.
 type Archiver interface {
 	common.Canceler
@@ -79,7 +117,12 @@ type Archiver interface {
 	// PushFile schedules file upload to the isolate server.
 	// Smaller priority value means earlier processing.
 	PushFile(displayName, path string, priority int64) Future
+	// Hash returns the hashing algorithm used to calculate the digests.
+	Hash() crypto.Hash
 	Stats() *Stats
+	// SetEventHandler sets the function receiving the progress of each item.
+	// It must be called before pushing items to receive all their events.
+	SetEventHandler(h EventHandler)
 }
 
 // UploadStat is the statistic for a single upload.
@@ -269,7 +312,7 @@ func (i *archiverItem) calcDigest() error {
 	}
 	defer src.Close()
 
-	h := isolated.GetHash()
+	h := i.a.Hash().New()
 	size, err := io.Copy(h, src)
 	if err != nil {
 		i.setErr(err)
@@ -334,8 +377,10 @@ type archiver struct {
 	progress              progress.Progress
 
 	// Mutable.
-	statsLock sync.Mutex
-	stats     Stats
+	statsLock    sync.Mutex
+	stats        Stats
+	eventLock    sync.Mutex
+	eventHandler EventHandler
 }
 
 // Close waits for all pending files to be done.
@@ -386,12 +431,43 @@ func (a *archiver) PushFile(displayName, path string, priority int64) Future {
 	return a.push(newArchiverItem(a, displayName, path, source, priority))
 }
 
+func (a *archiver) Hash() crypto.Hash {
+	return a.is.Hash()
+}
+
 func (a *archiver) Stats() *Stats {
 	a.statsLock.Lock()
 	defer a.statsLock.Unlock()
 	return a.stats.deepCopy()
 }
 
+func (a *archiver) SetEventHandler(h EventHandler) {
+	a.eventLock.Lock()
+	defer a.eventLock.Unlock()
+	a.eventHandler = h
+}
+
+// emit sends an event about 'item' to the event handler, if any.
+func (a *archiver) emit(t EventType, item *archiverItem, uploaded int64, err error) {
+	a.eventLock.Lock()
+	h := a.eventHandler
+	a.eventLock.Unlock()
+	if h == nil {
+		return
+	}
+	item.lock.Lock()
+	e := &Event{
+		Type:        t,
+		DisplayName: item.displayName,
+		Digest:      isolated.HexDigest(item.digestItem.Digest),
+		Size:        item.digestItem.Size,
+		Uploaded:    uploaded,
+		Err:         err,
+	}
+	item.lock.Unlock()
+	h(e)
+}
+
 func (a *archiver) push(item *archiverItem) Future {
 	if a.pushLocked(item) {
 		tracer.Instant(a, "itemAdded", tracer.Thread, tracer.Args{"item": item.DisplayName()})
@@ -411,6 +487,7 @@ func (a *archiver) pushLocked(item *archiverItem) bool {
 		// Archiver was closed.
 		return false
 	}
+	a.emit(EventFound, item, 0, nil)
 	// stage1DedupeLoop must never block and must be as fast as it can because it
 	// is done while holding a.closeLock.
 	a.stage1DedupeChan <- item
@@ -501,6 +578,7 @@ func (a *archiver) stage2HashLoop() {
 			end := tracer.Span(a, "hash", tracer.Args{"name": item.DisplayName()})
 			if err := item.calcDigest(); err != nil {
 				end(tracer.Args{"err": err})
+				a.emit(EventFailed, item, 0, err)
 				a.Cancel(err)
 				item.Close()
 				return
@@ -510,6 +588,7 @@ func (a *archiver) stage2HashLoop() {
 			a.progress.Update(groupHash, groupHashDone, 1)
 			a.progress.Update(groupHash, groupHashDoneSize, item.digestItem.Size)
 			a.progress.Update(groupLookup, groupLookupTodo, 1)
+			a.emit(EventHashed, item, 0, nil)
 			a.stage3LookupChan <- item
 		}, func() {
 			item.setErr(a.CancelationReason())
@@ -593,6 +672,7 @@ func (a *archiver) doContains(items []*archiverItem) {
 		a.Cancel(err)
 		for _, item := range items {
 			item.setErr(err)
+			a.emit(EventFailed, item, 0, err)
 		}
 		return
 	}
@@ -603,6 +683,7 @@ func (a *archiver) doContains(items []*archiverItem) {
 			a.statsLock.Lock()
 			a.stats.Hits = append(a.stats.Hits, units.Size(size))
 			a.statsLock.Unlock()
+			a.emit(EventCacheHit, items[index], 0, nil)
 			items[index].Close()
 		} else {
 			items[index].state = state
@@ -617,19 +698,67 @@ func (a *archiver) doContains(items []*archiverItem) {
 // doUpload is called by stage 4.
 func (a *archiver) doUpload(item *archiverItem) {
 	start := time.Now()
-	if err := a.is.Push(item.state, item.source); err != nil {
+	u := &uploadProgress{a: a, item: item}
+	if err := a.is.Push(item.state, u.source); err != nil {
 		err = fmt.Errorf("push(%s) failed: %s\n", item.path, err)
 		a.Cancel(err)
 		item.setErr(err)
+		a.emit(EventFailed, item, 0, err)
 	} else {
 		a.progress.Update(groupUpload, groupUploadDone, 1)
 		a.progress.Update(groupUpload, groupUploadDoneSize, item.digestItem.Size)
+		a.emit(EventUploaded, item, item.digestItem.Size, nil)
 	}
 	item.Close()
 	size := units.Size(item.digestItem.Size)
-	u := &UploadStat{time.Since(start), size, item.DisplayName()}
+	stat := &UploadStat{time.Since(start), size, item.DisplayName()}
 	a.statsLock.Lock()
-	a.stats.Pushed = append(a.stats.Pushed, u)
+	a.stats.Pushed = append(a.stats.Pushed, stat)
 	a.statsLock.Unlock()
 	log.Printf("Uploaded %7s: %s\n", size, item.DisplayName())
 }
+
+// uploadProgress sends EventUploading while an item is read for upload.
+//
+// The source may be read multiple times when the upload is retried; only
+// progress past what was already reported is sent.
+type uploadProgress struct {
+	a        *archiver
+	item     *archiverItem
+	lock     sync.Mutex
+	reported int64
+}
+
+// source is the isolatedclient.Source to upload the item.
+func (u *uploadProgress) source() (io.ReadCloser, error) {
+	src, err := u.item.source()
+	if err != nil {
+		return nil, err
+	}
+	return &uploadReader{ReadCloser: src, u: u}, nil
+}
+
+func (u *uploadProgress) update(pos int64) {
+	u.lock.Lock()
+	send := pos >= u.reported+uploadingInterval
+	if send {
+		u.reported = pos
+	}
+	u.lock.Unlock()
+	if send {
+		u.a.emit(EventUploading, u.item, pos, nil)
+	}
+}
+
+type uploadReader struct {
+	io.ReadCloser
+	u   *uploadProgress
+	pos int64
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.pos += int64(n)
+	r.u.update(r.pos)
+	return n, err
+}
@@ -5,12 +5,15 @@
 package archiver
 
 import (
+	"bytes"
+	"crypto"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/luci/luci-go/client/internal/common"
@@ -64,9 +67,11 @@ func TestArchiverFile(t *testing.T) {
 	ut.AssertEqual(t, 2, stats.TotalMisses())
 	ut.AssertEqual(t, units.Size(0), stats.TotalBytesHits())
 	ut.AssertEqual(t, units.Size(3), stats.TotalBytesPushed())
-	expected := map[isolated.HexDigest][]byte{
-		"0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33": []byte("foo"),
-		"da39a3ee5e6b4b0d3255bfef95601890afd80709": {},
+	expected := map[string]map[isolated.HexDigest][]byte{
+		"default-gzip": {
+			"0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33": []byte("foo"),
+			"da39a3ee5e6b4b0d3255bfef95601890afd80709": {},
+		},
 	}
 	ut.AssertEqual(t, expected, server.Contents())
 	ut.AssertEqual(t, isolated.HexDigest("da39a3ee5e6b4b0d3255bfef95601890afd80709"), future1.Digest())
@@ -84,7 +89,7 @@ func TestArchiverFileHit(t *testing.T) {
 	ts := httptest.NewServer(server)
 	defer ts.Close()
 	a := New(isolatedclient.New(nil, ts.URL, "default-gzip"), nil)
-	server.Inject([]byte("foo"))
+	server.Inject("default-gzip", []byte("foo"))
 	future := a.Push("foo", isolatedclient.NewBytesSource([]byte("foo")), 0)
 	future.WaitForHashed()
 	ut.AssertEqual(t, isolated.HexDigest("0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"), future.Digest())
@@ -97,6 +102,42 @@ func TestArchiverFileHit(t *testing.T) {
 	ut.AssertEqual(t, units.Size(0), stats.TotalBytesPushed())
 }
 
+func TestArchiverEvents(t *testing.T) {
+	t.Parallel()
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	a := New(isolatedclient.New(nil, ts.URL, "default-gzip"), nil)
+	var lock sync.Mutex
+	events := map[string][]EventType{}
+	var lastUploading *Event
+	a.SetEventHandler(func(e *Event) {
+		lock.Lock()
+		defer lock.Unlock()
+		events[e.DisplayName] = append(events[e.DisplayName], e.Type)
+		if e.Type == EventUploading {
+			lastUploading = e
+		}
+	})
+	server.Inject("default-gzip", []byte("foo"))
+	large := bytes.Repeat([]byte("A"), 3*uploadingInterval)
+	future1 := a.Push("foo", isolatedclient.NewBytesSource([]byte("foo")), 0)
+	future2 := a.Push("large", isolatedclient.NewBytesSource(large), 0)
+	future1.WaitForHashed()
+	future2.WaitForHashed()
+	ut.AssertEqual(t, nil, a.Close())
+
+	expected := map[string][]EventType{
+		"foo":   {EventFound, EventHashed, EventCacheHit},
+		"large": {EventFound, EventHashed, EventUploading, EventUploading, EventUploading, EventUploaded},
+	}
+	ut.AssertEqual(t, expected, events)
+	ut.AssertEqual(t, isolated.HashBytes(crypto.SHA1, large), lastUploading.Digest)
+	ut.AssertEqual(t, int64(len(large)), lastUploading.Size)
+	ut.AssertEqual(t, int64(len(large)), lastUploading.Uploaded)
+	ut.AssertEqual(t, nil, server.Error())
+}
+
 func TestArchiverCancel(t *testing.T) {
 	t.Parallel()
 	server := isolatedfake.New()
@@ -196,7 +196,7 @@ func PushDirectory(a Archiver, root string, relDir string, blacklist []string) F
 
 	displayName := filepath.Base(root) + ".isolated"
 	i := isolated.Isolated{
-		Algo:    "sha-1",
+		Algo:    isolated.GetAlgo(a.Hash()),
 		Files:   map[string]isolated.File{},
 		Version: isolated.IsolatedFormatVersion,
 	}
@@ -5,6 +5,7 @@
 package archiver
 
 import (
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -113,14 +114,14 @@ func TestPushDirectory(t *testing.T) {
 	encoded, err := json.Marshal(isolatedData)
 	ut.AssertEqual(t, nil, err)
 	isolatedEncoded := string(encoded) + "\n"
-	isolatedHash := isolated.HashBytes([]byte(isolatedEncoded))
+	isolatedHash := isolated.HashBytes(crypto.SHA1, []byte(isolatedEncoded))
 
 	expected := map[string]string{
 		"0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33": "foo",
 		string(isolatedHash):                       isolatedEncoded,
 	}
 	actual := map[string]string{}
-	for k, v := range server.Contents() {
+	for k, v := range server.Contents()["default-gzip"] {
 		actual[string(k)] = string(v)
 	}
 	ut.AssertEqual(t, expected, actual)
@@ -31,12 +31,14 @@
 package cipd
 
 import (
-	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -48,6 +50,7 @@ import (
 	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/parallel"
 
 	"github.com/luci/luci-go/client/cipd/common"
 	"github.com/luci/luci-go/client/cipd/internal"
@@ -75,6 +78,10 @@ const (
 
 	// ServiceURL is URL of a backend to connect to by default.
 	ServiceURL = "https://chrome-infra-packages.appspot.com"
+
+	// DefaultMaxThreads is how many packages are resolved, fetched or deployed
+	// concurrently by default.
+	DefaultMaxThreads = 8
 )
 
 var (
@@ -206,6 +213,10 @@ type Actions struct {
 	ToUpdate  []UpdatedPin  `json:"to_update,omitempty"`  // pins to be replaced
 	ToRemove  []common.Pin  `json:"to_remove,omitempty"`  // pins to be removed
 	Errors    []ActionError `json:"errors,omitempty"`     // all individual errors
+
+	// Timings lists how long fetching and deploying each installed or updated
+	// pin took, in the same order as the packages were specified.
+	Timings []ActionTiming `json:"timings,omitempty"`
 }
 
 // Empty is true if there are no actions specified.
@@ -213,12 +224,58 @@ func (a *Actions) Empty() bool {
 	return len(a.ToInstall) == 0 && len(a.ToUpdate) == 0 && len(a.ToRemove) == 0
 }
 
+// ActionMap is returned by EnsurePackages. It maps a subdirectory of the site
+// root ("" for the root itself) to the actions performed in it. Subdirectories
+// without actions are omitted.
+type ActionMap map[string]*Actions
+
+// Empty is true if there are no actions specified in any subdirectory.
+func (m ActionMap) Empty() bool {
+	for _, a := range m {
+		if !a.Empty() {
+			return false
+		}
+	}
+	return true
+}
+
+// HasErrors is true if any action failed.
+func (m ActionMap) HasErrors() bool {
+	for _, a := range m {
+		if len(a.Errors) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdatedPin specifies a pair of pins: old and new version of a package.
 type UpdatedPin struct {
 	From common.Pin `json:"from"`
 	To   common.Pin `json:"to"`
 }
 
+// ActionTiming reports how long installing a pin took. Durations are
+// serialized to JSON as nanoseconds.
+type ActionTiming struct {
+	Pin    common.Pin    `json:"pin"`
+	Fetch  time.Duration `json:"fetch"`  // fetching and verifying the instance
+	Deploy time.Duration `json:"deploy"` // unzipping the instance into the site root
+}
+
+// PackageDrift is returned by VerifyDeployed. It describes a deployed package
+// whose files don't match its manifest.
+type PackageDrift struct {
+	Subdir string            `json:"subdir,omitempty"` // subdirectory of the site root
+	Pin    common.Pin        `json:"pin"`              // the deployed instance
+	Files  []local.FileDrift `json:"files,omitempty"`  // files that drifted
+	Error  string            `json:"error,omitempty"`  // if the package can't be verified at all
+
+	// Repaired is true if the package was redeployed from the instance cache.
+	Repaired    bool   `json:"repaired,omitempty"`
+	RepairError string `json:"repair_error,omitempty"`
+}
+
 // ActionError holds an error that happened when installing or removing the pin.
 type ActionError struct {
 	Action string     `json:"action"`
@@ -297,23 +354,41 @@ type Client interface {
 
 	// ProcessEnsureFile parses text file that describes what should be installed.
 	//
-	// It is a text file where each line has a form "<package name> <version>".
-	// Whitespaces are ignored. Lines that start with '#' are ignored. A version
-	// can be specified as instance ID, tag or ref. Will resolve tags and refs to
-	// concrete instance IDs by calling the backend.
-	ProcessEnsureFile(ctx context.Context, r io.Reader) ([]common.Pin, error)
+	// See ParseEnsureFile for the format. Template variables are expanded with
+	// HostTemplateVars(). Will resolve tags and refs to concrete instance IDs by
+	// calling the backend.
+	ProcessEnsureFile(ctx context.Context, r io.Reader) (common.PinSliceBySubdir, error)
+
+	// ResolveEnsureFile resolves all the versions listed in an ensure file.
+	//
+	// If 'locked' is not nil, the result is verified against it: all the
+	// packages must be listed in 'locked' and resolve to the recorded instance
+	// IDs, otherwise an error is returned. It happens when a ref moved or a tag
+	// was attached to another instance since 'locked' was generated.
+	ResolveEnsureFile(ctx context.Context, f *EnsureFile, locked *ResolvedVersions) (*ResolvedVersions, error)
 
 	// EnsurePackages installs, removes and updates packages in the site root.
 	//
-	// Given a description of what packages (and versions) should be installed it
-	// will do all necessary actions to bring the state of the site root to the
-	// desired one.
+	// Given a description of what packages (and versions) should be installed
+	// in each subdirectory of the site root, it will do all necessary actions to
+	// bring the state of the site root to the desired one. Packages installed in
+	// a subdirectory that isn't listed in 'pins' anymore are removed.
 	//
-	// If dryRun is true, will just check for changes and return them in Actions
-	// struct, but won't actually perform them.
+	// If dryRun is true, will just check for changes and return them in
+	// ActionMap, but won't actually perform them.
+	//
+	// If the update was only partially applied, returns both ActionMap and
+	// error.
+	EnsurePackages(ctx context.Context, pins common.PinSliceBySubdir, dryRun bool) (ActionMap, error)
+
+	// VerifyDeployed checks files of all packages deployed to the site root and
+	// its subdirectories against their manifests.
 	//
-	// If the update was only partially applied, returns both Actions and error.
-	EnsurePackages(ctx context.Context, pins []common.Pin, dryRun bool) (Actions, error)
+	// Returns only the packages that drifted. If 'repair' is true, they are
+	// deployed again from the instance cache (see ClientOptions.CacheDir). The
+	// backend is never called: packages that are not in the cache are reported
+	// as not repaired.
+	VerifyDeployed(ctx context.Context, repair bool) ([]PackageDrift, error)
 }
 
 // ClientOptions is passed to NewClient factory function.
@@ -357,6 +432,36 @@ type ClientOptions struct {
 	//
 	// Default is UserAgent const.
 	UserAgent string
+
+	// MaxThreads is how many packages EnsurePackages and ResolveEnsureFile
+	// process concurrently.
+	//
+	// Default is DefaultMaxThreads const.
+	MaxThreads int
+
+	// TrustedKeys, if not empty, are the keys allowed to sign packages.
+	//
+	// Packages not signed by one of them are not deployed.
+	TrustedKeys local.TrustedKeys
+
+	// KeepInstances is how many previously deployed instances of each package
+	// are kept around, so they can be rolled back to.
+	//
+	// Default is 0, previous instances are deleted.
+	KeepInstances int
+
+	// DeltaFetch makes the client fetch package instances file by file, reusing
+	// files with the same content from already deployed instances instead of
+	// downloading them.
+	//
+	// Files are verified against their hashes served by the backend, not against
+	// the instance ID, so the backend is trusted to list the right files.
+	//
+	// Requires the 'repo/v1/instance/files' endpoint, which only localserver
+	// implements, so it does nothing against the real backend: the whole
+	// instance is fetched if the endpoint is missing. Not used with TrustedKeys,
+	// since the endpoint doesn't serve the signatures stored in the package.
+	DeltaFetch bool
 }
 
 // NewClient initializes CIPD client object.
@@ -373,6 +478,9 @@ func NewClient(opts ClientOptions) Client {
 	if opts.UserAgent == "" {
 		opts.UserAgent = UserAgent
 	}
+	if opts.MaxThreads <= 0 {
+		opts.MaxThreads = DefaultMaxThreads
+	}
 	return &clientImpl{
 		ClientOptions: opts,
 		remote: &remoteImpl{
@@ -385,7 +493,7 @@ func NewClient(opts ClientOptions) Client {
 			userAgent: opts.UserAgent,
 			client:    opts.AnonymousClient,
 		},
-		deployer: local.NewDeployer(opts.Root),
+		deployer: local.NewDeployerWithOptions(opts.Root, opts.deployerOptions()),
 	}
 }
 
@@ -833,142 +941,212 @@ func (client *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pi
 }
 
 func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, pin common.Pin) error {
-	err := common.ValidatePin(pin)
+	return client.fetchAndDeploy(ctx, client.deployer, pin)
+}
+
+// fetchAndDeploy fetches the package instance and deploys it with 'deployer'.
+func (client *clientImpl) fetchAndDeploy(ctx context.Context, deployer local.Deployer, pin common.Pin) error {
+	instance, tmp, err := client.fetchAndOpen(ctx, deployer, pin)
 	if err != nil {
 		return err
 	}
+	defer os.RemoveAll(tmp)
+	defer instance.Close()
+	_, err = deployer.DeployInstance(ctx, instance)
+	return err
+}
 
-	// Use temp file for storing package file. Delete it when done.
-	var instance local.PackageInstance
-	f, err := client.deployer.TempFile(ctx, pin.InstanceID)
+// fetchAndOpen fetches the package instance into a temp file of 'deployer' and
+// opens it, verifying the instance ID. With DeltaFetch, the instance may be
+// fetched file by file into a temp directory instead, see fetchDelta.
+//
+// On success, the caller must close the instance and then delete the temp file
+// or directory at the returned path.
+func (client *clientImpl) fetchAndOpen(ctx context.Context, deployer local.Deployer, pin common.Pin) (local.PackageInstance, string, error) {
+	err := common.ValidatePin(pin)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	defer func() {
-		// Instance takes ownership of the file, no need to close it separately.
-		if instance == nil {
-			f.Close()
+
+	if client.DeltaFetch && len(client.TrustedKeys) == 0 {
+		instance, dir, err := client.fetchDelta(ctx, deployer, pin)
+		if err == nil {
+			return instance, dir, nil
 		}
-		os.Remove(f.Name())
-	}()
+		logging.Warningf(ctx, "cipd: can't fetch %s file by file, fetching the whole instance - %s", pin, err)
+	}
 
-	// Fetch the package data to the provided storage.
-	err = client.FetchInstance(ctx, pin, f)
+	// Use temp file for storing package file.
+	f, err := deployer.TempFile(ctx, pin.InstanceID)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
-	// Open the instance, verify the instance ID.
-	instance, err = local.OpenInstance(ctx, f, pin.InstanceID)
+	// Fetch the package data to the provided storage, then open the instance
+	// and verify the instance ID. The instance takes ownership of the file.
+	var instance local.PackageInstance
+	if err = client.FetchInstance(ctx, pin, f); err == nil {
+		instance, err = local.OpenInstance(ctx, f, pin.InstanceID)
+	}
 	if err != nil {
-		return err
+		f.Close()
+		os.Remove(f.Name())
+		return nil, "", err
 	}
-	defer instance.Close()
-
-	// Deploy it. 'defer' will take care of removing the temp file if needed.
-	_, err = client.deployer.DeployInstance(ctx, instance)
-	return err
+	return instance, f.Name(), nil
 }
 
-func (client *clientImpl) ProcessEnsureFile(ctx context.Context, r io.Reader) ([]common.Pin, error) {
-	lineNo := 0
-	makeError := func(msg string) error {
-		return fmt.Errorf("failed to parse desired state (line %d): %s", lineNo, msg)
+func (client *clientImpl) ProcessEnsureFile(ctx context.Context, r io.Reader) (common.PinSliceBySubdir, error) {
+	f, err := ParseEnsureFile(r, HostTemplateVars())
+	if err != nil {
+		return nil, err
 	}
+	resolved, err := client.ResolveEnsureFile(ctx, f, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.Pins(), nil
+}
 
-	out := []common.Pin{}
-	scanner := bufio.NewScanner(r)
-	for scanner.Scan() {
-		lineNo++
-
-		// Split each line into words, ignore white space.
-		tokens := []string{}
-		for _, chunk := range strings.Split(scanner.Text(), " ") {
-			chunk = strings.TrimSpace(chunk)
-			if chunk != "" {
-				tokens = append(tokens, chunk)
+func (client *clientImpl) ResolveEnsureFile(ctx context.Context, f *EnsureFile, locked *ResolvedVersions) (*ResolvedVersions, error) {
+	// Resolve all versions concurrently. Each result goes to its own slot to keep
+	// the output and the errors in the order of the ensure file.
+	out := &ResolvedVersions{Packages: make([]ResolvedPackage, len(f.Packages))}
+	errs := make(errors.MultiError, len(f.Packages))
+	parallel.WorkPool(client.MaxThreads, func(work chan<- func() error) {
+		for i, p := range f.Packages {
+			i, p := i, p
+			work <- func() error {
+				pin, err := client.ResolveVersion(ctx, p.PackageName, p.Version)
+				errs[i] = err
+				out.Packages[i] = ResolvedPackage{
+					Subdir:      p.Subdir,
+					PackageName: p.PackageName,
+					Version:     p.Version,
+					InstanceID:  pin.InstanceID,
+				}
+				return nil
 			}
 		}
+	})
+	if err := aggregateErrors(errs); err != nil {
+		return nil, err
+	}
 
-		// Skip empty lines or lines starting with '#'.
-		if len(tokens) == 0 || tokens[0][0] == '#' {
-			continue
-		}
-
-		// Each line has a format "<package name> <version>".
-		if len(tokens) != 2 {
-			return nil, makeError("expecting '<package name> <version>' line")
-		}
-		err := common.ValidatePackageName(tokens[0])
-		if err != nil {
-			return nil, makeError(err.Error())
-		}
-		err = common.ValidateInstanceVersion(tokens[1])
-		if err != nil {
-			return nil, makeError(err.Error())
+	if locked == nil {
+		return out, nil
+	}
+	mismatches := []string{}
+	for _, p := range out.Packages {
+		switch expected := locked.lookup(p.Subdir, p.PackageName, p.Version); expected {
+		case "":
+			mismatches = append(mismatches, fmt.Sprintf("%s %s is not in the resolved versions", p.PackageName, p.Version))
+		case p.InstanceID:
+		default:
+			mismatches = append(mismatches, fmt.Sprintf("%s %s resolves to %s, expected %s", p.PackageName, p.Version, p.InstanceID, expected))
 		}
-
-		// Good enough.
-		pin, err := client.ResolveVersion(ctx, tokens[0], tokens[1])
-		if err != nil {
-			return nil, err
+	}
+	if len(mismatches) != 0 {
+		for _, m := range mismatches {
+			logging.Errorf(ctx, "cipd: %s", m)
 		}
-		out = append(out, pin)
+		return out, fmt.Errorf("versions do not match the resolved versions: %s", strings.Join(mismatches, "; "))
 	}
-
 	return out, nil
 }
 
-func (client *clientImpl) EnsurePackages(ctx context.Context, pins []common.Pin, dryRun bool) (actions Actions, err error) {
-	// Make sure a package is specified only once.
-	seen := make(map[string]bool, len(pins))
-	for _, p := range pins {
-		if seen[p.PackageName] {
-			return actions, fmt.Errorf("package %s is specified twice", p.PackageName)
+func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSliceBySubdir, dryRun bool) (ActionMap, error) {
+	// Make sure a package is specified only once per subdirectory.
+	for subdir, pins := range allPins {
+		if err := common.ValidateSubdir(subdir); err != nil {
+			return nil, err
+		}
+		seen := make(map[string]bool, len(pins))
+		for _, p := range pins {
+			if seen[p.PackageName] {
+				return nil, fmt.Errorf("package %s is specified twice", p.PackageName)
+			}
+			seen[p.PackageName] = true
 		}
-		seen[p.PackageName] = true
 	}
 
-	// Enumerate existing packages.
-	existing, err := client.deployer.FindDeployed(ctx)
+	// Subdirectories that had packages installed by a previous call must be
+	// visited too, to remove packages that are not needed anymore.
+	previous, err := client.readSubdirs()
 	if err != nil {
-		return actions, err
+		return nil, err
+	}
+	subdirs := common.PinSliceBySubdir{"": allPins[""]}
+	for _, subdir := range previous {
+		subdirs[subdir] = allPins[subdir]
+	}
+	for subdir, pins := range allPins {
+		subdirs[subdir] = pins
 	}
 
 	// Figure out what needs to be updated and deleted, log it.
-	actions = buildActionPlan(pins, existing)
+	actions := ActionMap{}
+	for _, subdir := range subdirs.Subdirs() {
+		deployed, err := client.subdirDeployer(subdir).FindDeployed(ctx)
+		if err != nil {
+			return nil, err
+		}
+		existing := make([]common.Pin, len(deployed))
+		for i, p := range deployed {
+			existing[i] = p.Pin
+		}
+		if a := buildActionPlan(subdirs[subdir], existing); !a.Empty() {
+			actions[subdir] = &a
+		}
+	}
 	if actions.Empty() {
 		logging.Debugf(ctx, "Everything is up-to-date.")
 		return actions, nil
 	}
-	if len(actions.ToInstall) != 0 {
-		logging.Infof(ctx, "Packages to be installed:")
-		for _, pin := range actions.ToInstall {
-			logging.Infof(ctx, "  %s", pin)
-		}
+	for _, subdir := range sortedSubdirs(actions) {
+		logActions(ctx, subdir, actions[subdir])
 	}
-	if len(actions.ToUpdate) != 0 {
-		logging.Infof(ctx, "Packages to be updated:")
-		for _, pair := range actions.ToUpdate {
-			logging.Infof(ctx, "  %s (%s -> %s)",
-				pair.From.PackageName, pair.From.InstanceID, pair.To.InstanceID)
+
+	if dryRun {
+		logging.Infof(ctx, "Dry run, not actually doing anything.")
+		return actions, nil
+	}
+
+	// Remove all unneeded stuff first, then fetch and deploy all new and updated
+	// packages of all subdirectories at once.
+	tasks := []*deployTask{}
+	for _, subdir := range subdirs.Subdirs() {
+		if a := actions[subdir]; a != nil {
+			deployer := client.subdirDeployer(subdir)
+			removePackages(ctx, deployer, a)
+			tasks = append(tasks, makeDeployTasks(deployer, subdir, subdirs[subdir], a)...)
 		}
 	}
-	if len(actions.ToRemove) != 0 {
-		logging.Infof(ctx, "Packages to be removed:")
-		for _, pin := range actions.ToRemove {
-			logging.Infof(ctx, "  %s", pin)
+	client.deployAll(ctx, tasks)
+
+	remaining := []string{}
+	for _, subdir := range subdirs.Subdirs() {
+		a := actions[subdir]
+		if subdir != "" && (len(subdirs[subdir]) != 0 || (a != nil && len(a.Errors) != 0)) {
+			remaining = append(remaining, subdir)
 		}
 	}
+	if err := client.writeSubdirs(ctx, remaining); err != nil {
+		return actions, err
+	}
 
-	if dryRun {
-		logging.Infof(ctx, "Dry run, not actually doing anything.")
+	if !actions.HasErrors() {
+		logging.Infof(ctx, "All changes applied.")
 		return actions, nil
 	}
+	return actions, ErrEnsurePackagesFailed
+}
 
-	// Remove all unneeded stuff.
+// removePackages removes the packages listed in actions.ToRemove. Errors are
+// appended to actions.Errors.
+func removePackages(ctx context.Context, deployer local.Deployer, actions *Actions) {
 	for _, pin := range actions.ToRemove {
-		err = client.deployer.RemoveDeployed(ctx, pin.PackageName)
+		err := deployer.RemoveDeployed(ctx, pin.PackageName)
 		if err != nil {
 			logging.Errorf(ctx, "Failed to remove %s - %s", pin.PackageName, err)
 			actions.Errors = append(actions.Errors, ActionError{
@@ -978,9 +1156,24 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, pins []common.Pin,
 			})
 		}
 	}
+}
+
+// deployTask is a package instance to fetch and deploy by deployAll.
+type deployTask struct {
+	deployer local.Deployer
+	subdir   string
+	pin      common.Pin
+	actions  *Actions // where to report the result
+
+	instance local.PackageInstance // set once fetched
+	path     string                // temp file backing 'instance'
+	after    []int                 // tasks to deploy before this one
+	timing   ActionTiming
+}
 
-	// Install all new and updated stuff. Install in the order specified by
-	// 'pins'. Order matters if multiple packages install same file.
+// makeDeployTasks returns a task for each new or updated package of a
+// subdirectory, in the order specified by 'pins'.
+func makeDeployTasks(deployer local.Deployer, subdir string, pins []common.Pin, actions *Actions) []*deployTask {
 	toDeploy := make(map[string]bool, len(actions.ToInstall)+len(actions.ToUpdate))
 	for _, p := range actions.ToInstall {
 		toDeploy[p.PackageName] = true
@@ -988,26 +1181,258 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, pins []common.Pin,
 	for _, pair := range actions.ToUpdate {
 		toDeploy[pair.To.PackageName] = true
 	}
+	out := []*deployTask{}
 	for _, pin := range pins {
-		if !toDeploy[pin.PackageName] {
+		if toDeploy[pin.PackageName] {
+			out = append(out, &deployTask{
+				deployer: deployer,
+				subdir:   subdir,
+				pin:      pin,
+				actions:  actions,
+				timing:   ActionTiming{Pin: pin},
+			})
+		}
+	}
+	return out
+}
+
+// deployAll fetches and deploys package instances, at most client.MaxThreads
+// at a time. Errors and timings are appended to the tasks' actions, in the
+// order of 'tasks'.
+//
+// Order matters if multiple packages install same file, so a package is
+// deployed only after all packages preceding it in 'tasks' that install any
+// of the same files.
+func (client *clientImpl) deployAll(ctx context.Context, tasks []*deployTask) {
+	// Each task reports its error in its own slot, to make the aggregated errors
+	// independent of the order in which the tasks complete.
+	errs := make(errors.MultiError, len(tasks))
+
+	// Fetch and verify all instances.
+	parallel.WorkPool(client.MaxThreads, func(work chan<- func() error) {
+		for i, t := range tasks {
+			i, t := i, t
+			work <- func() error {
+				start := clock.Now(ctx)
+				t.instance, t.path, errs[i] = client.fetchAndOpen(ctx, t.deployer, t.pin)
+				t.timing.Fetch = clock.Now(ctx).Sub(start)
+				return nil
+			}
+		}
+	})
+	defer func() {
+		for _, t := range tasks {
+			if t.instance != nil {
+				t.instance.Close()
+				os.RemoveAll(t.path)
+			}
+		}
+	}()
+
+	// Find conflicting files. Paths are relative to the site root.
+	owners := map[string][]int{}
+	for i, t := range tasks {
+		if t.instance == nil {
 			continue
 		}
-		err = client.FetchAndDeployInstance(ctx, pin)
-		if err != nil {
-			logging.Errorf(ctx, "Failed to install %s - %s", pin, err)
-			actions.Errors = append(actions.Errors, ActionError{
+		deps := map[int]bool{}
+		for _, f := range t.instance.Files() {
+			name := path.Join(t.subdir, f.Name())
+			for _, j := range owners[name] {
+				if !deps[j] {
+					deps[j] = true
+					t.after = append(t.after, j)
+				}
+			}
+			owners[name] = append(owners[name], i)
+		}
+	}
+
+	// Deploy them. Tasks are dispatched in order, so tasks being waited for are
+	// already running.
+	done := make([]chan struct{}, len(tasks))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	parallel.WorkPool(client.MaxThreads, func(work chan<- func() error) {
+		for i, t := range tasks {
+			i, t := i, t
+			if t.instance == nil {
+				close(done[i])
+				continue
+			}
+			work <- func() error {
+				defer close(done[i])
+				for _, j := range t.after {
+					<-done[j]
+				}
+				start := clock.Now(ctx)
+				_, errs[i] = t.deployer.DeployInstance(ctx, t.instance)
+				t.timing.Deploy = clock.Now(ctx).Sub(start)
+				return nil
+			}
+		}
+	})
+
+	for i, t := range tasks {
+		t.actions.Timings = append(t.actions.Timings, t.timing)
+		if err := errs[i]; err != nil {
+			logging.Errorf(ctx, "Failed to install %s - %s", t.pin, err)
+			t.actions.Errors = append(t.actions.Errors, ActionError{
 				Action: "install",
-				Pin:    pin,
+				Pin:    t.pin,
 				Error:  JSONError{err},
 			})
 		}
 	}
+}
 
-	if len(actions.Errors) == 0 {
-		logging.Infof(ctx, "All changes applied.")
-		return actions, nil
+func (client *clientImpl) VerifyDeployed(ctx context.Context, repair bool) ([]PackageDrift, error) {
+	subdirs, err := client.readSubdirs()
+	if err != nil {
+		return nil, err
 	}
-	return actions, ErrEnsurePackagesFailed
+	out := []PackageDrift{}
+	for _, subdir := range append([]string{""}, subdirs...) {
+		deployer := client.subdirDeployer(subdir)
+		deployed, err := deployer.FindDeployed(ctx)
+		if err != nil {
+			return out, err
+		}
+		for _, p := range deployed {
+			_, files, err := deployer.VerifyDeployed(ctx, p.PackageName)
+			if err == nil && len(files) == 0 {
+				continue
+			}
+			drift := PackageDrift{Subdir: subdir, Pin: p.Pin, Files: files}
+			if err != nil {
+				logging.Errorf(ctx, "Failed to verify %s: %s", p.Pin, err)
+				drift.Error = err.Error()
+			} else {
+				logging.Warningf(ctx, "%d files of %s don't match the manifest", len(files), p.Pin)
+			}
+			if repair {
+				if err := client.deployFromCache(ctx, deployer, p.Pin); err != nil {
+					logging.Errorf(ctx, "Failed to repair %s: %s", p.Pin, err)
+					drift.RepairError = err.Error()
+				} else {
+					drift.Repaired = true
+				}
+			}
+			out = append(out, drift)
+		}
+	}
+	return out, nil
+}
+
+// deployFromCache deploys the package instance taken from the instance cache,
+// without calling the backend.
+func (client *clientImpl) deployFromCache(ctx context.Context, deployer local.Deployer, pin common.Pin) error {
+	cache := client.getInstanceCache()
+	if cache == nil {
+		return fmt.Errorf("the instance cache is not configured")
+	}
+	f, err := deployer.TempFile(ctx, pin.InstanceID)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if err = cache.Get(ctx, pin, f, clock.Now(ctx)); err != nil {
+		f.Close()
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%s is not in the instance cache", pin)
+		}
+		return err
+	}
+	// The instance takes ownership of the file.
+	instance, err := local.OpenInstance(ctx, f, pin.InstanceID)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	defer instance.Close()
+	_, err = deployer.DeployInstance(ctx, instance)
+	return err
+}
+
+// subdirDeployer returns the deployer for a subdirectory of the site root.
+//
+// Each subdirectory is handled as a site root of its own, with its own .cipd
+// directory.
+func (client *clientImpl) subdirDeployer(subdir string) local.Deployer {
+	if subdir == "" {
+		return client.deployer
+	}
+	if client.Root == "" {
+		return local.NewDeployer("")
+	}
+	return local.NewDeployerWithOptions(filepath.Join(client.Root, filepath.FromSlash(subdir)), client.deployerOptions())
+}
+
+// deployerOptions returns options of deployers that install packages.
+func (opts *ClientOptions) deployerOptions() local.DeployerOptions {
+	return local.DeployerOptions{
+		TrustedKeys:   opts.TrustedKeys,
+		KeepInstances: opts.KeepInstances,
+	}
+}
+
+// subdirsPath returns the path of the file that lists the subdirectories with
+// packages installed, or "" if there's no site root.
+func (client *clientImpl) subdirsPath() string {
+	if client.Root == "" {
+		return ""
+	}
+	return filepath.Join(client.Root, local.SiteServiceDir, subdirsFile)
+}
+
+// readSubdirs returns the subdirectories that had packages installed by the
+// last EnsurePackages call.
+func (client *clientImpl) readSubdirs() ([]string, error) {
+	path := client.subdirsPath()
+	if path == "" {
+		return nil, nil
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var subdirs []string
+	if err := json.Unmarshal(b, &subdirs); err != nil {
+		return nil, fmt.Errorf("corrupted %s: %s", path, err)
+	}
+	for _, subdir := range subdirs {
+		if err := common.ValidateSubdir(subdir); err != nil {
+			return nil, fmt.Errorf("corrupted %s: %s", path, err)
+		}
+	}
+	return subdirs, nil
+}
+
+// writeSubdirs records the subdirectories with packages installed.
+func (client *clientImpl) writeSubdirs(ctx context.Context, subdirs []string) error {
+	path := client.subdirsPath()
+	if path == "" {
+		return nil
+	}
+	if len(subdirs) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	b, err := json.Marshal(subdirs)
+	if err != nil {
+		return err
+	}
+	fs := local.NewFileSystem(client.Root)
+	if _, err := fs.EnsureDirectory(ctx, filepath.Dir(path)); err != nil {
+		return err
+	}
+	return local.EnsureFile(ctx, fs, path, bytes.NewReader(b))
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -1028,6 +1453,7 @@ type remote interface {
 	fetchTags(ctx context.Context, pin common.Pin, tags []string) ([]TagInfo, error)
 	fetchRefs(ctx context.Context, pin common.Pin, refs []string) ([]RefInfo, error)
 	fetchInstance(ctx context.Context, pin common.Pin) (*fetchInstanceResponse, error)
+	fetchInstanceFiles(ctx context.Context, pin common.Pin) ([]instanceFile, error)
 
 	listPackages(ctx context.Context, path string, recursive bool) ([]string, []string, error)
 	searchInstances(ctx context.Context, tag, packageName string) ([]common.Pin, error)
@@ -1051,8 +1477,76 @@ type fetchInstanceResponse struct {
 	registeredTs time.Time
 }
 
+// instanceFile is a file of a package instance that can be fetched alone.
+type instanceFile struct {
+	local.FileInfo
+
+	fetchURL string // empty for symlinks
+}
+
 // Private stuff.
 
+// subdirsFile is the name of the file in the site service directory that lists
+// the subdirectories of the site root with packages installed.
+const subdirsFile = "subdirs.json"
+
+// aggregateErrors returns nil if all errors in 'errs' are nil, the only
+// non-nil error if there's one, or a MultiError with all non-nil errors,
+// preserving their order.
+func aggregateErrors(errs errors.MultiError) error {
+	var out errors.MultiError
+	for _, err := range errs {
+		if err != nil {
+			out = append(out, err)
+		}
+	}
+	switch len(out) {
+	case 0:
+		return nil
+	case 1:
+		return out[0]
+	default:
+		return out
+	}
+}
+
+// sortedSubdirs returns the subdirectories of an ActionMap, sorted.
+func sortedSubdirs(m ActionMap) []string {
+	out := make([]string, 0, len(m))
+	for subdir := range m {
+		out = append(out, subdir)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// logActions logs the actions planned in a subdirectory of the site root.
+func logActions(ctx context.Context, subdir string, a *Actions) {
+	where := ""
+	if subdir != "" {
+		where = fmt.Sprintf(" in %s", subdir)
+	}
+	if len(a.ToInstall) != 0 {
+		logging.Infof(ctx, "Packages to be installed%s:", where)
+		for _, pin := range a.ToInstall {
+			logging.Infof(ctx, "  %s", pin)
+		}
+	}
+	if len(a.ToUpdate) != 0 {
+		logging.Infof(ctx, "Packages to be updated%s:", where)
+		for _, pair := range a.ToUpdate {
+			logging.Infof(ctx, "  %s (%s -> %s)",
+				pair.From.PackageName, pair.From.InstanceID, pair.To.InstanceID)
+		}
+	}
+	if len(a.ToRemove) != 0 {
+		logging.Infof(ctx, "Packages to be removed%s:", where)
+		for _, pin := range a.ToRemove {
+			logging.Infof(ctx, "  %s", pin)
+		}
+	}
+}
+
 // buildActionPlan is used by EnsurePackages to figure out what to install or remove.
 func buildActionPlan(desired, existing []common.Pin) (a Actions) {
 	// Figure out what needs to be installed or updated.
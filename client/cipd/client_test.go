@@ -529,7 +529,7 @@ func TestFetch(t *testing.T) {
 func TestProcessEnsureFile(t *testing.T) {
 	ctx := makeTestContext()
 
-	call := func(c C, data string, calls []expectedHTTPCall) ([]common.Pin, error) {
+	call := func(c C, data string, calls []expectedHTTPCall) (common.PinSliceBySubdir, error) {
 		client := mockClient(c, "", calls)
 		return client.ProcessEnsureFile(ctx, bytes.NewBufferString(data))
 	}
@@ -542,9 +542,35 @@ func TestProcessEnsureFile(t *testing.T) {
 			pkg/b  1000000000000000000000000000000000000000
 		`, nil)
 		So(err, ShouldBeNil)
-		So(out, ShouldResemble, []common.Pin{
-			{"pkg/a", "0000000000000000000000000000000000000000"},
-			{"pkg/b", "1000000000000000000000000000000000000000"},
+		So(out, ShouldResemble, common.PinSliceBySubdir{
+			"": {
+				{"pkg/a", "0000000000000000000000000000000000000000"},
+				{"pkg/b", "1000000000000000000000000000000000000000"},
+			},
+		})
+	})
+
+	Convey("ProcessEnsureFile with subdirs", t, func(c C) {
+		out, err := call(c, `
+			pkg/a  0000000000000000000000000000000000000000
+
+			@Subdir tools/sub
+			pkg/a  1000000000000000000000000000000000000000
+			pkg/b  2000000000000000000000000000000000000000
+
+			@Subdir
+			pkg/c  3000000000000000000000000000000000000000
+		`, nil)
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, common.PinSliceBySubdir{
+			"": {
+				{"pkg/a", "0000000000000000000000000000000000000000"},
+				{"pkg/c", "3000000000000000000000000000000000000000"},
+			},
+			"tools/sub": {
+				{"pkg/a", "1000000000000000000000000000000000000000"},
+				{"pkg/b", "2000000000000000000000000000000000000000"},
+			},
 		})
 	})
 
@@ -561,15 +587,15 @@ func TestProcessEnsureFile(t *testing.T) {
 			},
 		})
 		So(err, ShouldBeNil)
-		So(out, ShouldResemble, []common.Pin{
-			{"pkg/a", "0000000000000000000000000000000000000000"},
+		So(out, ShouldResemble, common.PinSliceBySubdir{
+			"": {{"pkg/a", "0000000000000000000000000000000000000000"}},
 		})
 	})
 
 	Convey("ProcessEnsureFile empty", t, func(c C) {
 		out, err := call(c, "", nil)
 		So(err, ShouldBeNil)
-		So(out, ShouldResemble, []common.Pin{})
+		So(out, ShouldResemble, common.PinSliceBySubdir{})
 	})
 
 	Convey("ProcessEnsureFile bad package name", t, func(c C) {
@@ -586,6 +612,123 @@ func TestProcessEnsureFile(t *testing.T) {
 		_, err := call(c, "pkg/a", nil)
 		So(err, ShouldNotBeNil)
 	})
+
+	Convey("ProcessEnsureFile bad subdir", t, func(c C) {
+		_, err := call(c, "@Subdir ../a", nil)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestParseEnsureFile(t *testing.T) {
+	vars := map[string]string{"os": "linux", "arch": "amd64", "platform": "linux-amd64"}
+
+	call := func(data string) (*EnsureFile, error) {
+		return ParseEnsureFile(bytes.NewBufferString(data), vars)
+	}
+
+	Convey("ParseEnsureFile expands variables", t, func() {
+		out, err := call(`
+			pkg/tool/${platform}  latest
+			@Subdir ${os}
+			pkg/other/${arch}     tag_key:value
+		`)
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, &EnsureFile{
+			Packages: []PackageDef{
+				{Subdir: "", PackageName: "pkg/tool/linux-amd64", Version: "latest"},
+				{Subdir: "linux", PackageName: "pkg/other/amd64", Version: "tag_key:value"},
+			},
+		})
+	})
+
+	Convey("ParseEnsureFile unknown variable", t, func() {
+		_, err := call("pkg/tool/${unknown} latest")
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("ParseEnsureFile unknown directive", t, func() {
+		_, err := call("@Unknown abc")
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("ParseEnsureFile bad @Subdir line", t, func() {
+		_, err := call("@Subdir a b")
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("ParseEnsureFile same package in different subdirs", t, func() {
+		_, err := call("pkg/a latest\n@Subdir a\npkg/a latest")
+		So(err, ShouldBeNil)
+	})
+
+	Convey("ParseEnsureFile same package twice", t, func() {
+		_, err := call("@Subdir a\npkg/a latest\npkg/a stable")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestResolveEnsureFile(t *testing.T) {
+	ctx := makeTestContext()
+
+	resolveCall := func(instanceID string) expectedHTTPCall {
+		return expectedHTTPCall{
+			Method: "GET",
+			Path:   "/_ah/api/repo/v1/instance/resolve",
+			Query: url.Values{
+				"package_name": []string{"pkg/a"},
+				"version":      []string{"latest"},
+			},
+			Reply: fmt.Sprintf(`{"status":"SUCCESS","instance_id":"%s"}`, instanceID),
+		}
+	}
+	ensureFile := &EnsureFile{
+		Packages: []PackageDef{{Subdir: "sub", PackageName: "pkg/a", Version: "latest"}},
+	}
+	locked := &ResolvedVersions{
+		Packages: []ResolvedPackage{{
+			Subdir:      "sub",
+			PackageName: "pkg/a",
+			Version:     "latest",
+			InstanceID:  "0000000000000000000000000000000000000000",
+		}},
+	}
+
+	Convey("ResolveEnsureFile without resolved versions", t, func(c C) {
+		client := mockClient(c, "", []expectedHTTPCall{resolveCall("0000000000000000000000000000000000000000")})
+		out, err := client.ResolveEnsureFile(ctx, ensureFile, nil)
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, locked)
+	})
+
+	Convey("ResolveEnsureFile matching resolved versions", t, func(c C) {
+		client := mockClient(c, "", []expectedHTTPCall{resolveCall("0000000000000000000000000000000000000000")})
+		out, err := client.ResolveEnsureFile(ctx, ensureFile, locked)
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, locked)
+	})
+
+	Convey("ResolveEnsureFile moved ref", t, func(c C) {
+		client := mockClient(c, "", []expectedHTTPCall{resolveCall("1000000000000000000000000000000000000000")})
+		_, err := client.ResolveEnsureFile(ctx, ensureFile, locked)
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("ResolveEnsureFile missing from resolved versions", t, func(c C) {
+		client := mockClient(c, "", []expectedHTTPCall{resolveCall("0000000000000000000000000000000000000000")})
+		_, err := client.ResolveEnsureFile(ctx, ensureFile, &ResolvedVersions{})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Resolved versions round trip", t, func() {
+		tempDir, err := ioutil.TempDir("", "cipd_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+		path := filepath.Join(tempDir, "versions.json")
+		So(SaveResolvedVersions(path, locked), ShouldBeNil)
+		out, err := LoadResolvedVersions(path)
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, locked)
+	})
 }
 
 func TestListPackages(t *testing.T) {
@@ -638,37 +781,46 @@ func TestEnsurePackages(t *testing.T) {
 
 			// Calls EnsurePackages, mocking fetch backend first. Backend will be mocked
 			// to serve only 'fetched' packages.
-			callEnsure := func(instances []local.PackageInstance, fetched []local.PackageInstance) (Actions, error) {
+			callEnsure := func(instances []local.PackageInstance, fetched []local.PackageInstance) (ActionMap, error) {
 				client := mockClientForFetch(c, tempDir, fetched)
 				pins := []common.Pin{}
 				for _, i := range instances {
 					pins = append(pins, i.Pin())
 				}
-				return client.EnsurePackages(ctx, pins, false)
+				return withoutTimings(client.EnsurePackages(ctx, common.PinSliceBySubdir{"": pins}, false))
 			}
 
 			findDeployed := func(root string) []common.Pin {
 				deployer := local.NewDeployer(root)
-				pins, err := deployer.FindDeployed(ctx)
+				deployed, err := deployer.FindDeployed(ctx)
 				So(err, ShouldBeNil)
+				if deployed == nil {
+					return nil
+				}
+				pins := make([]common.Pin, len(deployed))
+				for i, p := range deployed {
+					pins[i] = p.Pin
+				}
 				return pins
 			}
 
 			// Noop run on top of empty directory.
 			actions, err := callEnsure(nil, nil)
 			So(err, ShouldBeNil)
-			So(actions, ShouldResemble, Actions{})
+			So(actions, ShouldResemble, ActionMap{})
 
 			// Specify same package twice. Fails.
 			actions, err = callEnsure([]local.PackageInstance{a1, a2}, nil)
 			So(err, ShouldNotBeNil)
-			So(actions, ShouldResemble, Actions{})
+			So(actions, ShouldBeNil)
 
 			// Install a1 into a site root.
 			actions, err = callEnsure([]local.PackageInstance{a1}, []local.PackageInstance{a1})
 			So(err, ShouldBeNil)
-			So(actions, ShouldResemble, Actions{
-				ToInstall: []common.Pin{a1.Pin()},
+			So(actions, ShouldResemble, ActionMap{
+				"": {
+					ToInstall: []common.Pin{a1.Pin()},
+				},
 			})
 			assertFile("file a 1", "test data")
 			So(findDeployed(tempDir), ShouldResemble, []common.Pin{a1.Pin()})
@@ -676,18 +828,20 @@ func TestEnsurePackages(t *testing.T) {
 			// Noop run. Nothing is fetched.
 			actions, err = callEnsure([]local.PackageInstance{a1}, nil)
 			So(err, ShouldBeNil)
-			So(actions, ShouldResemble, Actions{})
+			So(actions, ShouldResemble, ActionMap{})
 			assertFile("file a 1", "test data")
 			So(findDeployed(tempDir), ShouldResemble, []common.Pin{a1.Pin()})
 
 			// Upgrade a1 to a2.
 			actions, err = callEnsure([]local.PackageInstance{a2}, []local.PackageInstance{a2})
 			So(err, ShouldBeNil)
-			So(actions, ShouldResemble, Actions{
-				ToUpdate: []UpdatedPin{
-					{
-						From: a1.Pin(),
-						To:   a2.Pin(),
+			So(actions, ShouldResemble, ActionMap{
+				"": {
+					ToUpdate: []UpdatedPin{
+						{
+							From: a1.Pin(),
+							To:   a2.Pin(),
+						},
 					},
 				},
 			})
@@ -697,9 +851,11 @@ func TestEnsurePackages(t *testing.T) {
 			// Remove a2 and install b.
 			actions, err = callEnsure([]local.PackageInstance{b}, []local.PackageInstance{b})
 			So(err, ShouldBeNil)
-			So(actions, ShouldResemble, Actions{
-				ToInstall: []common.Pin{b.Pin()},
-				ToRemove:  []common.Pin{a2.Pin()},
+			So(actions, ShouldResemble, ActionMap{
+				"": {
+					ToInstall: []common.Pin{b.Pin()},
+					ToRemove:  []common.Pin{a2.Pin()},
+				},
 			})
 			assertFile("file b", "test data")
 			So(findDeployed(tempDir), ShouldResemble, []common.Pin{b.Pin()})
@@ -707,26 +863,197 @@ func TestEnsurePackages(t *testing.T) {
 			// Remove b.
 			actions, err = callEnsure(nil, nil)
 			So(err, ShouldBeNil)
-			So(actions, ShouldResemble, Actions{
-				ToRemove: []common.Pin{b.Pin()},
+			So(actions, ShouldResemble, ActionMap{
+				"": {
+					ToRemove: []common.Pin{b.Pin()},
+				},
 			})
 			So(findDeployed(tempDir), ShouldResemble, []common.Pin{})
 
 			// Install a1 and b.
 			actions, err = callEnsure([]local.PackageInstance{a1, b}, []local.PackageInstance{a1, b})
 			So(err, ShouldBeNil)
-			So(actions, ShouldResemble, Actions{
-				ToInstall: []common.Pin{a1.Pin(), b.Pin()},
+			So(actions, ShouldResemble, ActionMap{
+				"": {
+					ToInstall: []common.Pin{a1.Pin(), b.Pin()},
+				},
 			})
 			assertFile("file a 1", "test data")
 			assertFile("file b", "test data")
 			So(findDeployed(tempDir), ShouldResemble, []common.Pin{a1.Pin(), b.Pin()})
 		})
+
+		Convey("EnsurePackages with subdirs", func(c C) {
+			a := buildInstanceInMemory(ctx, "pkg/a", []local.File{local.NewTestFile("file a", "test data", false)})
+			defer a.Close()
+			b := buildInstanceInMemory(ctx, "pkg/b", []local.File{local.NewTestFile("file b", "test data", false)})
+			defer b.Close()
+
+			callEnsure := func(pins common.PinSliceBySubdir, fetched []local.PackageInstance) (ActionMap, error) {
+				client := mockClientForFetch(c, tempDir, fetched)
+				return withoutTimings(client.EnsurePackages(ctx, pins, false))
+			}
+
+			// Bad subdir.
+			_, err := callEnsure(common.PinSliceBySubdir{"../a": {a.Pin()}}, nil)
+			So(err, ShouldNotBeNil)
+
+			// Install a in the root and in a subdir, b only in the subdir.
+			actions, err := callEnsure(common.PinSliceBySubdir{
+				"":    {a.Pin()},
+				"sub": {a.Pin(), b.Pin()},
+			}, []local.PackageInstance{a, a, b})
+			So(err, ShouldBeNil)
+			So(actions, ShouldResemble, ActionMap{
+				"":    {ToInstall: []common.Pin{a.Pin()}},
+				"sub": {ToInstall: []common.Pin{a.Pin(), b.Pin()}},
+			})
+			assertFile("file a", "test data")
+			assertFile("sub/file a", "test data")
+			assertFile("sub/file b", "test data")
+
+			// Dropping the subdir removes its packages.
+			actions, err = callEnsure(common.PinSliceBySubdir{"": {a.Pin()}}, nil)
+			So(err, ShouldBeNil)
+			So(actions, ShouldResemble, ActionMap{
+				"sub": {ToRemove: []common.Pin{a.Pin(), b.Pin()}},
+			})
+			assertFile("file a", "test data")
+			_, err = os.Stat(filepath.Join(tempDir, "sub", "file b"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			// Nothing left to do.
+			actions, err = callEnsure(common.PinSliceBySubdir{"": {a.Pin()}}, nil)
+			So(err, ShouldBeNil)
+			So(actions, ShouldResemble, ActionMap{})
+		})
+
+		Convey("EnsurePackages in parallel", func(c C) {
+			a := buildInstanceInMemory(ctx, "pkg/a", []local.File{local.NewTestFile("shared", "a", false)})
+			defer a.Close()
+			b := buildInstanceInMemory(ctx, "pkg/b", []local.File{local.NewTestFile("other", "b", false)})
+			defer b.Close()
+			c1 := buildInstanceInMemory(ctx, "pkg/c", []local.File{local.NewTestFile("shared", "c", false)})
+			defer c1.Close()
+			broken := buildInstanceInMemory(ctx, "pkg/d", []local.File{local.NewTestFile("file d", "d", false)})
+			defer broken.Close()
+
+			// Instances are served from the cache, no backend calls are made.
+			client := mockClient(c, tempDir, nil)
+			client.CacheDir = filepath.Join(tempDir, ".cache")
+			client.MaxThreads = 4
+			put := func(pin common.Pin, inst local.PackageInstance) {
+				r := inst.DataReader()
+				_, err := r.Seek(0, os.SEEK_SET)
+				So(err, ShouldBeNil)
+				err = client.getInstanceCache().Put(ctx, pin, clock.Now(ctx), func(f *os.File) error {
+					_, err := io.Copy(f, r)
+					return err
+				})
+				So(err, ShouldBeNil)
+			}
+			put(a.Pin(), a)
+			put(b.Pin(), b)
+			put(c1.Pin(), c1)
+			// The cached data doesn't match the instance ID.
+			put(broken.Pin(), a)
+
+			pins := []common.Pin{a.Pin(), broken.Pin(), b.Pin(), c1.Pin()}
+			actions, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": pins}, false)
+			So(err, ShouldEqual, ErrEnsurePackagesFailed)
+			So(actions[""].ToInstall, ShouldResemble, pins)
+
+			// Results are reported in the order of the pins.
+			timings := []common.Pin{}
+			for _, t := range actions[""].Timings {
+				timings = append(timings, t.Pin)
+			}
+			So(timings, ShouldResemble, pins)
+			So(len(actions[""].Errors), ShouldEqual, 1)
+			So(actions[""].Errors[0].Pin, ShouldResemble, broken.Pin())
+
+			// The package listed last wins if several install the same file.
+			assertFile("shared", "c")
+			assertFile("other", "b")
+		})
+
+		Convey("VerifyDeployed repairs from the cache", func(c C) {
+			a := buildInstanceInMemory(ctx, "pkg/a", []local.File{local.NewTestFile("file a", "a", false)})
+			defer a.Close()
+			b := buildInstanceInMemory(ctx, "pkg/b", []local.File{local.NewTestFile("file b", "b", false)})
+			defer b.Close()
+
+			// Instances are served from the cache, no backend calls are made.
+			client := mockClient(c, tempDir, nil)
+			client.CacheDir = filepath.Join(tempDir, ".cache")
+			for _, inst := range []local.PackageInstance{a, b} {
+				r := inst.DataReader()
+				_, err := r.Seek(0, os.SEEK_SET)
+				So(err, ShouldBeNil)
+				err = client.getInstanceCache().Put(ctx, inst.Pin(), clock.Now(ctx), func(f *os.File) error {
+					_, err := io.Copy(f, r)
+					return err
+				})
+				So(err, ShouldBeNil)
+			}
+			_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{
+				"":    {a.Pin()},
+				"sub": {b.Pin()},
+			}, false)
+			So(err, ShouldBeNil)
+
+			drift, err := client.VerifyDeployed(ctx, false)
+			So(err, ShouldBeNil)
+			So(drift, ShouldResemble, []PackageDrift{})
+
+			So(os.Remove(filepath.Join(tempDir, "file a")), ShouldBeNil)
+			So(os.Remove(filepath.Join(tempDir, "sub", "file b")), ShouldBeNil)
+			expected := []PackageDrift{
+				{
+					Pin:   a.Pin(),
+					Files: []local.FileDrift{{Name: "file a", Kind: local.DriftMissing}},
+				},
+				{
+					Subdir: "sub",
+					Pin:    b.Pin(),
+					Files:  []local.FileDrift{{Name: "file b", Kind: local.DriftMissing}},
+				},
+			}
+
+			// Without the cache, nothing can be repaired.
+			noCache := mockClient(c, tempDir, nil)
+			drift, err = noCache.VerifyDeployed(ctx, true)
+			So(err, ShouldBeNil)
+			So(len(drift), ShouldEqual, 2)
+			So(drift[0].RepairError, ShouldEqual, "the instance cache is not configured")
+			So(drift[1].Repaired, ShouldBeFalse)
+
+			drift, err = client.VerifyDeployed(ctx, true)
+			So(err, ShouldBeNil)
+			expected[0].Repaired = true
+			expected[1].Repaired = true
+			So(drift, ShouldResemble, expected)
+			assertFile("file a", "a")
+			assertFile("sub/file b", "b")
+
+			drift, err = client.VerifyDeployed(ctx, false)
+			So(err, ShouldBeNil)
+			So(drift, ShouldResemble, []PackageDrift{})
+		})
 	})
 }
 
 ////////////////////////////////////////////////////////////////////////////////
 
+// withoutTimings clears the timings of EnsurePackages results, since they
+// depend on the clock.
+func withoutTimings(actions ActionMap, err error) (ActionMap, error) {
+	for _, a := range actions {
+		a.Timings = nil
+	}
+	return actions, err
+}
+
 // buildInstanceInMemory makes fully functional PackageInstance object that uses
 // memory buffer as a backing store.
 func buildInstanceInMemory(ctx context.Context, pkgName string, files []local.File) local.PackageInstance {
@@ -852,6 +1179,8 @@ func mockClient(c C, root string, expectations []expectedHTTPCall) *clientImpl {
 		Root:                root,
 		AnonymousClient:     &http.Client{Transport: transport},
 		AuthenticatedClient: &http.Client{Transport: transport},
+		// Expected calls are served in order.
+		MaxThreads: 1,
 	})
 	return client.(*clientImpl)
 }
@@ -8,6 +8,7 @@ package common
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -32,6 +33,20 @@ func (pin Pin) String() string {
 	return fmt.Sprintf("%s:%s", pin.PackageName, pin.InstanceID)
 }
 
+// PinSliceBySubdir maps a subdirectory of a site root to the pins of the
+// packages installed into it. The site root itself is "".
+type PinSliceBySubdir map[string][]Pin
+
+// Subdirs returns the sorted list of subdirectories.
+func (p PinSliceBySubdir) Subdirs() []string {
+	out := make([]string, 0, len(p))
+	for subdir := range p {
+		out = append(out, subdir)
+	}
+	sort.Strings(out)
+	return out
+}
+
 // ValidatePackageName returns error if a string isn't a valid package name.
 func ValidatePackageName(name string) error {
 	if !packageNameRe.MatchString(name) {
@@ -65,6 +80,30 @@ func ValidatePin(pin Pin) error {
 	return nil
 }
 
+// ValidateSubdir returns error if a string isn't a valid subdirectory of a site
+// root.
+//
+// It must be a relative slash-separated path that stays within the site root
+// and doesn't point inside the .cipd service directory. "" means the site root
+// itself.
+func ValidateSubdir(subdir string) error {
+	if subdir == "" {
+		return nil
+	}
+	if strings.Contains(subdir, "\\") || strings.Contains(subdir, ":") {
+		return fmt.Errorf("invalid subdir %q: must be a slash-separated relative path", subdir)
+	}
+	for i, chunk := range strings.Split(subdir, "/") {
+		if chunk == "" || chunk == "." || chunk == ".." {
+			return fmt.Errorf("invalid subdir %q: must be a clean relative path", subdir)
+		}
+		if i == 0 && chunk == ".cipd" {
+			return fmt.Errorf("invalid subdir %q: the .cipd directory is reserved", subdir)
+		}
+	}
+	return nil
+}
+
 // ValidatePackageRef returns error if a string doesn't look like a valid ref.
 func ValidatePackageRef(r string) error {
 	if ValidateInstanceID(r) == nil {
@@ -62,6 +62,30 @@ func TestValidatePin(t *testing.T) {
 	})
 }
 
+func TestValidateSubdir(t *testing.T) {
+	Convey("ValidateSubdir works", t, func() {
+		So(ValidateSubdir(""), ShouldBeNil)
+		So(ValidateSubdir("a"), ShouldBeNil)
+		So(ValidateSubdir("a/b.c/d"), ShouldBeNil)
+		So(ValidateSubdir("a/.cipd"), ShouldBeNil)
+		So(ValidateSubdir("/a"), ShouldNotBeNil)
+		So(ValidateSubdir("a/"), ShouldNotBeNil)
+		So(ValidateSubdir("a//b"), ShouldNotBeNil)
+		So(ValidateSubdir("a/../b"), ShouldNotBeNil)
+		So(ValidateSubdir("./a"), ShouldNotBeNil)
+		So(ValidateSubdir("a\\b"), ShouldNotBeNil)
+		So(ValidateSubdir("c:/a"), ShouldNotBeNil)
+		So(ValidateSubdir(".cipd/a"), ShouldNotBeNil)
+	})
+}
+
+func TestPinSliceBySubdir(t *testing.T) {
+	Convey("Subdirs are sorted", t, func() {
+		p := PinSliceBySubdir{"b": nil, "": nil, "a/b": nil}
+		So(p.Subdirs(), ShouldResemble, []string{"", "a/b", "b"})
+	})
+}
+
 func TestValidatePackageRef(t *testing.T) {
 	Convey("ValidatePackageRef works", t, func() {
 		So(ValidatePackageRef("some-ref"), ShouldBeNil)
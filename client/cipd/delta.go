@@ -0,0 +1,195 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cipd
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging"
+
+	"github.com/luci/luci-go/client/cipd/common"
+	"github.com/luci/luci-go/client/cipd/local"
+)
+
+// fetchDelta fetches the package instance file by file into a temp directory of
+// 'deployer', copying files with known content from already deployed instances
+// and downloading only the rest.
+//
+// Each file is verified against its hash served by the backend, and the
+// instance is deployed from the verified files directly. Unlike a whole
+// package file, the files can't be checked against the instance ID, so the
+// backend is trusted to list the files of the requested instance. There's no
+// package file, so nothing is put into the instance cache.
+//
+// On success, the caller must close the instance and then delete the temp
+// directory at the returned path.
+func (client *clientImpl) fetchDelta(ctx context.Context, deployer local.Deployer, pin common.Pin) (local.PackageInstance, string, error) {
+	files, err := client.remote.fetchInstanceFiles(ctx, pin)
+	if err != nil {
+		return nil, "", err
+	}
+	deployed, err := deployer.FindDeployedFiles(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	dir, err := deployer.TempDir(ctx, pin.InstanceID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	instance := &deltaInstance{pin: pin, files: make([]local.File, len(files))}
+	var downloaded, reused uint64
+	for i, f := range files {
+		if !isCleanSlashPath(f.Name) {
+			err = fmt.Errorf("invalid file name %q in the server response", f.Name)
+			break
+		}
+		staged := &stagedFile{info: f.FileInfo}
+		instance.files[i] = staged
+		if f.Symlink != "" {
+			continue
+		}
+		staged.path = filepath.Join(dir, fmt.Sprintf("%d", i))
+		if src := deployed[f.Hash]; src != "" {
+			copyErr := copyVerified(src, staged.path, f.Hash)
+			if copyErr == nil {
+				reused += f.Size
+				continue
+			}
+			logging.Warningf(ctx, "cipd: can't reuse %s - %s", src, copyErr)
+		}
+		if err = client.downloadVerified(ctx, f, staged.path); err != nil {
+			break
+		}
+		downloaded += f.Size
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, "", err
+	}
+
+	logging.Infof(ctx, "cipd: fetched %s file by file, downloaded %d bytes, reused %d bytes", pin, downloaded, reused)
+	return instance, dir, nil
+}
+
+// downloadVerified downloads the instance file into a new file at 'dst' and
+// verifies its hash.
+func (client *clientImpl) downloadVerified(ctx context.Context, f instanceFile, dst string) error {
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if err = client.storage.download(ctx, f.fetchURL, out); err != nil {
+		return err
+	}
+	if _, err = out.Seek(0, os.SEEK_SET); err != nil {
+		return err
+	}
+	hash := sha1.New()
+	if _, err = io.Copy(hash, out); err != nil {
+		return err
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != f.Hash {
+		return fmt.Errorf("%s has wrong hash", f.Name)
+	}
+	return nil
+}
+
+// copyVerified copies the file at 'src' to a new file at 'dst', checking that
+// the copied body has the given hash.
+func copyVerified(src, dst, expectedHash string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	hash := sha1.New()
+	_, err = io.Copy(io.MultiWriter(out, hash), in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && hex.EncodeToString(hash.Sum(nil)) != expectedHash {
+		err = fmt.Errorf("the file was modified")
+	}
+	if err != nil {
+		os.Remove(dst)
+	}
+	return err
+}
+
+// isCleanSlashPath returns true if 'p' is a clean relative slash separated path
+// that doesn't go outside of the package root.
+func isCleanSlashPath(p string) bool {
+	if p == "" || path.IsAbs(p) || strings.ContainsRune(p, '\\') {
+		return false
+	}
+	return path.Clean(p) == p && p != "." && p != ".." && !strings.HasPrefix(p, "../")
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// local.PackageInstance implementation for instances fetched file by file.
+
+// deltaInstance is a package instance assembled from separately fetched files.
+//
+// It has no package file, only the files themselves.
+type deltaInstance struct {
+	pin   common.Pin
+	files []local.File
+}
+
+func (inst *deltaInstance) Close() error        { return nil }
+func (inst *deltaInstance) Pin() common.Pin     { return inst.pin }
+func (inst *deltaInstance) Files() []local.File { return inst.files }
+
+func (inst *deltaInstance) DataReader() io.ReadSeeker {
+	return errReadSeeker{fmt.Errorf("%s was fetched file by file, there's no package file", inst.pin)}
+}
+
+// errReadSeeker is io.ReadSeeker that fails all calls with 'err'.
+type errReadSeeker struct {
+	err error
+}
+
+func (r errReadSeeker) Read([]byte) (int, error)       { return 0, r.err }
+func (r errReadSeeker) Seek(int64, int) (int64, error) { return 0, r.err }
+
+// stagedFile implements local.File for a file fetched into a temp directory.
+type stagedFile struct {
+	info local.FileInfo
+	path string // empty for symlinks
+}
+
+func (f *stagedFile) Name() string     { return f.info.Name }
+func (f *stagedFile) Size() uint64     { return f.info.Size }
+func (f *stagedFile) Executable() bool { return f.info.Executable }
+func (f *stagedFile) Symlink() bool    { return f.info.Symlink != "" }
+
+func (f *stagedFile) SymlinkTarget() (string, error) {
+	if f.info.Symlink == "" {
+		return "", fmt.Errorf("%s is not a symlink", f.info.Name)
+	}
+	return f.info.Symlink, nil
+}
+
+func (f *stagedFile) Open() (io.ReadCloser, error) {
+	if f.info.Symlink != "" {
+		return nil, fmt.Errorf("opening a symlink %s is not allowed", f.info.Name)
+	}
+	return os.Open(f.path)
+}
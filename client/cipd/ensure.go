@@ -0,0 +1,239 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cipd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/luci/luci-go/client/cipd/common"
+)
+
+// EnsureFile is a parsed ensure file, see ParseEnsureFile.
+type EnsureFile struct {
+	// Packages lists the packages in the order they appear in the file.
+	Packages []PackageDef
+}
+
+// PackageDef is a package line of an ensure file, with its template variables
+// expanded.
+type PackageDef struct {
+	// Subdir is the subdirectory of the site root to install the package into.
+	Subdir string
+	// PackageName is the name of the package.
+	PackageName string
+	// Version is an instance ID, a ref or a tag.
+	Version string
+}
+
+// ResolvedVersions records what the versions listed in an ensure file resolved
+// to. Serialized as JSON, it is the lock file used to make sure all the hosts
+// that process the same ensure file install the same instances.
+type ResolvedVersions struct {
+	Packages []ResolvedPackage `json:"packages"`
+}
+
+// ResolvedPackage is a package of an ensure file with its resolved instance ID.
+type ResolvedPackage struct {
+	Subdir      string `json:"subdir,omitempty"`
+	PackageName string `json:"package"`
+	Version     string `json:"version"`
+	InstanceID  string `json:"instance_id"`
+}
+
+// HostTemplateVars returns the values of the variables that can be used in
+// ensure files for the current host:
+//	${os}       - "linux", "mac" or "windows".
+//	${arch}     - "amd64", "386", "armv6l", ...
+//	${platform} - ${os}-${arch}, e.g. "linux-amd64".
+func HostTemplateVars() map[string]string {
+	goos := runtime.GOOS
+	if goos == "darwin" {
+		goos = "mac"
+	}
+	arch := runtime.GOARCH
+	if arch == "arm" {
+		arch = "armv6l"
+	}
+	return map[string]string{
+		"os":       goos,
+		"arch":     arch,
+		"platform": goos + "-" + arch,
+	}
+}
+
+// ParseEnsureFile parses an ensure file.
+//
+// Each line of an ensure file is either:
+//	- empty or starting with '#', ignored.
+//	- "<package name> <version>", a package to install. A version can be an
+//	  instance ID, a tag or a ref.
+//	- "@Subdir <path>", to install the packages on the following lines into
+//	  a subdirectory of the site root. "@Subdir" alone switches back to the
+//	  site root itself.
+//
+// Package names and subdirectories can contain ${var} variables, expanded
+// using 'vars'. Use HostTemplateVars() to install packages for the current
+// host. A package can be listed only once per subdirectory.
+func ParseEnsureFile(r io.Reader, vars map[string]string) (*EnsureFile, error) {
+	lineNo := 0
+	makeError := func(msg string, args ...interface{}) error {
+		return fmt.Errorf("failed to parse desired state (line %d): %s", lineNo, fmt.Sprintf(msg, args...))
+	}
+
+	out := &EnsureFile{Packages: []PackageDef{}}
+	seen := map[string]map[string]bool{}
+	subdir := ""
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+
+		// Split each line into words, ignore white space. Skip empty lines or
+		// lines starting with '#'.
+		tokens := strings.Fields(scanner.Text())
+		if len(tokens) == 0 || tokens[0][0] == '#' {
+			continue
+		}
+
+		// Directives.
+		if tokens[0][0] == '@' {
+			if tokens[0] != "@Subdir" {
+				return nil, makeError("unknown directive %q", tokens[0])
+			}
+			if len(tokens) > 2 {
+				return nil, makeError("expecting '@Subdir <path>' line")
+			}
+			subdir = ""
+			if len(tokens) == 2 {
+				var err error
+				if subdir, err = expandTemplate(tokens[1], vars); err != nil {
+					return nil, makeError("%s", err)
+				}
+				if err := common.ValidateSubdir(subdir); err != nil {
+					return nil, makeError("%s", err)
+				}
+			}
+			continue
+		}
+
+		// Each other line has a format "<package name> <version>".
+		if len(tokens) != 2 {
+			return nil, makeError("expecting '<package name> <version>' line")
+		}
+		name, err := expandTemplate(tokens[0], vars)
+		if err != nil {
+			return nil, makeError("%s", err)
+		}
+		if err := common.ValidatePackageName(name); err != nil {
+			return nil, makeError("%s", err)
+		}
+		if err := common.ValidateInstanceVersion(tokens[1]); err != nil {
+			return nil, makeError("%s", err)
+		}
+		if seen[subdir] == nil {
+			seen[subdir] = map[string]bool{}
+		}
+		if seen[subdir][name] {
+			return nil, makeError("package %s is specified twice", name)
+		}
+		seen[subdir][name] = true
+		out.Packages = append(out.Packages, PackageDef{
+			Subdir:      subdir,
+			PackageName: name,
+			Version:     tokens[1],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Pins returns the resolved pins, grouped by subdirectory.
+func (v *ResolvedVersions) Pins() common.PinSliceBySubdir {
+	out := common.PinSliceBySubdir{}
+	for _, p := range v.Packages {
+		out[p.Subdir] = append(out[p.Subdir], common.Pin{PackageName: p.PackageName, InstanceID: p.InstanceID})
+	}
+	return out
+}
+
+// lookup returns the instance ID that 'version' of the package resolved to,
+// or "" if it is not recorded.
+func (v *ResolvedVersions) lookup(subdir, packageName, version string) string {
+	for _, p := range v.Packages {
+		if p.Subdir == subdir && p.PackageName == packageName && p.Version == version {
+			return p.InstanceID
+		}
+	}
+	return ""
+}
+
+// LoadResolvedVersions reads a lock file written by SaveResolvedVersions.
+func LoadResolvedVersions(path string) (*ResolvedVersions, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	v := &ResolvedVersions{}
+	if err := json.NewDecoder(f).Decode(v); err != nil {
+		return nil, fmt.Errorf("failed to read resolved versions file %s: %s", path, err)
+	}
+	for _, p := range v.Packages {
+		if err := common.ValidateSubdir(p.Subdir); err != nil {
+			return nil, fmt.Errorf("bad resolved versions file %s: %s", path, err)
+		}
+		if err := common.ValidatePin(common.Pin{PackageName: p.PackageName, InstanceID: p.InstanceID}); err != nil {
+			return nil, fmt.Errorf("bad resolved versions file %s: %s", path, err)
+		}
+	}
+	return v, nil
+}
+
+// SaveResolvedVersions writes a lock file.
+func SaveResolvedVersions(path string, v *ResolvedVersions) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(b, '\n'))
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Private stuff.
+
+// templateVarRe matches ${var} in ensure files.
+var templateVarRe = regexp.MustCompile(`\$\{[^}]*\}`)
+
+// expandTemplate replaces ${var} in 's' with values from 'vars'. Returns error
+// if a variable is not defined.
+func expandTemplate(s string, vars map[string]string) (string, error) {
+	var err error
+	out := templateVarRe.ReplaceAllStringFunc(s, func(match string) string {
+		// Strip '${' and '}'.
+		key := match[2 : len(match)-1]
+		value, ok := vars[key]
+		if !ok && err == nil {
+			err = fmt.Errorf("unknown variable %q in %q", match, s)
+		}
+		return value
+	})
+	return out, err
+}
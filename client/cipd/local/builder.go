@@ -7,10 +7,12 @@ package local
 import (
 	"archive/zip"
 	"bytes"
+	"compress/flate"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -36,8 +38,21 @@ type BuildInstanceOptions struct {
 
 	// InstallMode defines how to install the package: "copy" or "symlink".
 	InstallMode InstallMode
+
+	// Reproducible makes the output depend only on names, bodies and executable
+	// bits of the input files, so that the same files produce the same instance
+	// ID no matter where and how they were collected.
+	//
+	// Files are sorted by name and compressed with fixed settings that can't be
+	// changed by zip.RegisterCompressor. Timestamps and permissions are never
+	// stored, regardless of this option.
+	Reproducible bool
 }
 
+// reproducibleCompressionLevel is the Deflate level used in reproducible builds.
+// Changing it changes instance IDs of all reproducible packages.
+const reproducibleCompressionLevel = flate.DefaultCompression
+
 // BuildInstance builds a new package instance.
 //
 // If build an instance of package named opts.PackageName by archiving input
@@ -58,12 +73,18 @@ func BuildInstance(ctx context.Context, opts BuildInstanceOptions) error {
 		}
 	}
 
-	// Generate the manifest file, add to the list of input files.
+	// Generate the manifest file, add to the list of input files. It always goes
+	// last, even in reproducible builds.
 	manifestFile, err := makeManifestFile(opts)
 	if err != nil {
 		return err
 	}
-	files := append(opts.Input, manifestFile)
+	files := make([]File, 0, len(opts.Input)+1)
+	files = append(files, opts.Input...)
+	if opts.Reproducible {
+		sort.Sort(filesByName(files))
+	}
+	files = append(files, manifestFile)
 
 	// Make sure filenames are unique.
 	seenNames := make(map[string]struct{}, len(files))
@@ -76,14 +97,22 @@ func BuildInstance(ctx context.Context, opts BuildInstanceOptions) error {
 	}
 
 	// Write the final zip file.
-	return zipInputFiles(ctx, files, opts.Output)
+	return zipInputFiles(ctx, files, opts.Output, opts.Reproducible)
 }
 
 // zipInputFiles deterministically builds a zip archive out of input files and
 // writes it to the writer. Files are written in the order given.
-func zipInputFiles(ctx context.Context, files []File, w io.Writer) error {
+//
+// If 'reproducible' is true, the Deflate compressor is pinned to fixed settings
+// instead of using whatever is registered in the zip package.
+func zipInputFiles(ctx context.Context, files []File, w io.Writer, reproducible bool) error {
 	writer := zip.NewWriter(w)
 	defer writer.Close()
+	if reproducible {
+		writer.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+			return flate.NewWriter(out, reproducibleCompressionLevel)
+		})
+	}
 
 	// Reports zipping progress to the log each second.
 	lastReport := time.Time{}
@@ -162,6 +191,13 @@ func zipSymlinkFile(dst io.Writer, f File) error {
 	return err
 }
 
+// filesByName implements sort.Interface to sort files by name.
+type filesByName []File
+
+func (l filesByName) Len() int           { return len(l) }
+func (l filesByName) Less(i, j int) bool { return l[i].Name() < l[j].Name() }
+func (l filesByName) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
 ////////////////////////////////////////////////////////////////////////////////
 
 type manifestFile []byte
@@ -11,7 +11,9 @@ import (
 	"encoding/hex"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -152,6 +154,105 @@ func TestBuildInstance(t *testing.T) {
 	})
 }
 
+func TestReproducibleBuild(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given two checkouts of the same files", t, func() {
+		tempDir, err := ioutil.TempDir("", "cipd_test")
+		So(err, ShouldBeNil)
+		Reset(func() { os.RemoveAll(tempDir) })
+
+		pkgDef := `{
+			"package": "test/package",
+			"data": [
+				{"dir": "lib", "exclude": [".*\\.pyc"]},
+				{"file": "tool"},
+				{"dir": "docs"},
+				{"version_file": "VERSION.json"}
+			]
+		}`
+		files := []struct {
+			name string
+			body string
+			exec bool
+		}{
+			{"docs/README", "readme", false},
+			{"lib/a.txt", "a", false},
+			{"lib/a/b.txt", "b", false},
+			{"tool", "#!/bin/sh", true},
+		}
+
+		// The second checkout writes files in reverse order, has different
+		// permissions and timestamps and some junk to exclude.
+		checkout := func(name string, reverse bool, perm os.FileMode, mtime time.Time) string {
+			root := filepath.Join(tempDir, name)
+			for i := range files {
+				f := files[i]
+				if reverse {
+					f = files[len(files)-i-1]
+				}
+				abs := filepath.Join(root, filepath.FromSlash(f.name))
+				So(os.MkdirAll(filepath.Dir(abs), 0777), ShouldBeNil)
+				mode := perm
+				if f.exec {
+					mode |= 0111
+				}
+				So(ioutil.WriteFile(abs, []byte(f.body), mode), ShouldBeNil)
+				So(os.Chmod(abs, mode), ShouldBeNil)
+				So(os.Chtimes(abs, mtime, mtime), ShouldBeNil)
+			}
+			if reverse {
+				So(ioutil.WriteFile(filepath.Join(root, "lib", "junk.pyc"), []byte("junk"), perm), ShouldBeNil)
+			}
+			So(ioutil.WriteFile(filepath.Join(root, "cipd.yaml"), []byte(pkgDef), 0666), ShouldBeNil)
+			return root
+		}
+
+		build := func(input []File, def PackageDef) string {
+			out := bytes.Buffer{}
+			err := BuildInstance(ctx, BuildInstanceOptions{
+				Input:        input,
+				Output:       &out,
+				PackageName:  def.Package,
+				VersionFile:  def.VersionFile(),
+				InstallMode:  def.InstallMode,
+				Reproducible: true,
+			})
+			So(err, ShouldBeNil)
+			return getSHA1(&out)
+		}
+
+		buildCheckout := func(root string) string {
+			f, err := os.Open(filepath.Join(root, "cipd.yaml"))
+			So(err, ShouldBeNil)
+			defer f.Close()
+			def, err := LoadPackageDef(f, nil)
+			So(err, ShouldBeNil)
+			input, err := def.FindFiles(root)
+			So(err, ShouldBeNil)
+			return build(input, def)
+		}
+
+		first := checkout("first", false, 0644, time.Unix(1000000000, 0))
+		second := checkout("second", true, 0664, time.Now())
+
+		Convey("They produce the same instance ID", func() {
+			So(buildCheckout(first), ShouldEqual, buildCheckout(second))
+		})
+
+		Convey("The order of input files doesn't matter", func() {
+			input, err := ScanFileSystem(first, first, nil)
+			So(err, ShouldBeNil)
+			reversed := make([]File, len(input))
+			for i, f := range input {
+				reversed[len(input)-i-1] = f
+			}
+			def := PackageDef{Package: "test/package"}
+			So(build(input, def), ShouldEqual, build(reversed, def))
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 // getSHA1 returns SHA1 hex digest of a byte buffer.
@@ -51,6 +51,14 @@ import (
 // copied to the site root directory and .cipd/pkgs/* contains only metadata,
 // such as manifest file with a list of extracted files (to know what to
 // uninstall).
+//
+// If the deployer is configured to keep previously deployed instances (see
+// DeployerOptions.KeepInstances), their directories stay in .cipd/pkgs/<name>/
+// and their IDs are listed, most recent first, in
+// .cipd/pkgs/<name>/_retained.txt. For "copy" install method, files of the
+// previous instance are moved back from the site root into its directory
+// before the new instance is installed, and returned to the site root if the
+// new instance fails to install.
 
 // Deployer knows how to unzip and place packages into site root directory.
 type Deployer interface {
@@ -66,18 +74,71 @@ type Deployer interface {
 	// It returns information about installed version (or error if not installed).
 	CheckDeployed(ctx context.Context, packageName string) (common.Pin, error)
 
-	// FindDeployed returns a list of packages deployed to a site root.
-	FindDeployed(ctx context.Context) (out []common.Pin, err error)
+	// FindDeployed returns a list of packages deployed to a site root, along
+	// with the previously deployed instances retained for rollback.
+	FindDeployed(ctx context.Context) (out []DeployedPackage, err error)
 
-	// RemoveDeployed deletes a package given its name.
+	// RemoveDeployed deletes a package given its name, including all retained
+	// instances.
 	RemoveDeployed(ctx context.Context, packageName string) error
 
+	// VerifyDeployed checks that files of the deployed instance of a package
+	// match its manifest.
+	//
+	// Returns the deployed pin and the list of discrepancies, empty if the
+	// package is intact. Use DeployInstance with the same instance to repair it.
+	VerifyDeployed(ctx context.Context, packageName string) (common.Pin, []FileDrift, error)
+
+	// Rollback switches a package back to the most recently retained instance,
+	// without fetching anything. The current instance is retained in its place,
+	// so calling Rollback twice goes back to where it started.
+	//
+	// Returns information about the deployed instance.
+	Rollback(ctx context.Context, packageName string) (common.Pin, error)
+
+	// FindDeployedFiles returns a map from a hash of a file (see FileInfo.Hash)
+	// to an absolute path of a deployed file with such content, among the files
+	// of all deployed and retained instances.
+	//
+	// The files may have been modified after they were deployed, callers must
+	// check the hash before using them.
+	FindDeployedFiles(ctx context.Context) (map[string]string, error)
+
 	// TempFile returns os.File located in <root>/tmp/*.
 	TempFile(ctx context.Context, prefix string) (*os.File, error)
+
+	// TempDir creates a new directory in <root>/tmp/* and returns its path.
+	TempDir(ctx context.Context, prefix string) (string, error)
+}
+
+// DeployedPackage is a package deployed to a site root.
+type DeployedPackage struct {
+	common.Pin
+
+	// Retained lists IDs of previously deployed instances kept for rollback,
+	// most recently deployed first.
+	Retained []string `json:"retained,omitempty"`
+}
+
+// DeployerOptions configures a Deployer created by NewDeployerWithOptions.
+type DeployerOptions struct {
+	// TrustedKeys, if not empty, makes the deployer refuse to deploy packages
+	// that are not signed by one of them, see VerifyInstance.
+	TrustedKeys TrustedKeys
+
+	// KeepInstances is how many previously deployed instances of each package to
+	// keep for Rollback. Zero keeps none.
+	KeepInstances int
 }
 
 // NewDeployer return default Deployer implementation.
 func NewDeployer(root string) Deployer {
+	return NewDeployerWithOptions(root, DeployerOptions{})
+}
+
+// NewDeployerWithOptions returns default Deployer implementation configured
+// with 'opts'.
+func NewDeployerWithOptions(root string, opts DeployerOptions) Deployer {
 	var err error
 	if root == "" {
 		err = fmt.Errorf("site root path is not provided")
@@ -87,7 +148,7 @@ func NewDeployer(root string) Deployer {
 	if err != nil {
 		return errDeployer{err}
 	}
-	return &deployerImpl{NewFileSystem(root)}
+	return &deployerImpl{NewFileSystem(root), opts}
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -103,9 +164,25 @@ func (d errDeployer) CheckDeployed(context.Context, string) (common.Pin, error)
 	return common.Pin{}, d.err
 }
 
-func (d errDeployer) FindDeployed(context.Context) (out []common.Pin, err error) { return nil, d.err }
-func (d errDeployer) RemoveDeployed(context.Context, string) error               { return d.err }
-func (d errDeployer) TempFile(context.Context, string) (*os.File, error)         { return nil, d.err }
+func (d errDeployer) FindDeployed(context.Context) (out []DeployedPackage, err error) {
+	return nil, d.err
+}
+
+func (d errDeployer) VerifyDeployed(context.Context, string) (common.Pin, []FileDrift, error) {
+	return common.Pin{}, nil, d.err
+}
+
+func (d errDeployer) Rollback(context.Context, string) (common.Pin, error) {
+	return common.Pin{}, d.err
+}
+
+func (d errDeployer) FindDeployedFiles(context.Context) (map[string]string, error) {
+	return nil, d.err
+}
+
+func (d errDeployer) RemoveDeployed(context.Context, string) error       { return d.err }
+func (d errDeployer) TempFile(context.Context, string) (*os.File, error) { return nil, d.err }
+func (d errDeployer) TempDir(context.Context, string) (string, error)    { return "", d.err }
 
 ////////////////////////////////////////////////////////////////////////////////
 // Real deployer implementation.
@@ -121,9 +198,14 @@ const currentSymlink = "_current"
 // version. Used on Windows.
 const currentTxt = "_current.txt"
 
+// retainedTxt is a name of a text file with instance IDs of previously deployed
+// versions kept for rollback, one per line, most recent first.
+const retainedTxt = "_retained.txt"
+
 // deployerImpl implements Deployer interface.
 type deployerImpl struct {
-	fs FileSystem
+	fs   FileSystem
+	opts DeployerOptions
 }
 
 func (d *deployerImpl) DeployInstance(ctx context.Context, inst PackageInstance) (common.Pin, error) {
@@ -134,6 +216,11 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, inst PackageInstance)
 	if err := common.ValidatePin(pin); err != nil {
 		return common.Pin{}, err
 	}
+	if len(d.opts.TrustedKeys) != 0 {
+		if err := VerifyInstance(ctx, inst, d.opts.TrustedKeys); err != nil {
+			return common.Pin{}, fmt.Errorf("refusing to deploy %s: %s", pin, err)
+		}
+	}
 	if _, err := d.fs.EnsureDirectory(ctx, d.fs.Root()); err != nil {
 		return common.Pin{}, err
 	}
@@ -148,6 +235,56 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, inst PackageInstance)
 	if err := ExtractInstance(ctx, inst, NewFileSystemDestination(destPath, d.fs)); err != nil {
 		return common.Pin{}, err
 	}
+	newPin, err := d.activate(ctx, pkgPath, pin, d.opts.KeepInstances, func() {
+		d.fs.EnsureDirectoryGone(ctx, destPath)
+	})
+	if err == nil {
+		logging.Infof(ctx, "Successfully deployed %s", pin)
+	} else {
+		logging.Errorf(ctx, "Failed to deploy %s: %s", pin, err)
+	}
+	return newPin, err
+}
+
+func (d *deployerImpl) Rollback(ctx context.Context, packageName string) (common.Pin, error) {
+	logging.Infof(ctx, "Rolling back %s in %s", packageName, d.fs.Root())
+	if err := common.ValidatePackageName(packageName); err != nil {
+		return common.Pin{}, err
+	}
+	pkgPath := d.packagePath(ctx, packageName)
+	retained, err := d.readRetained(pkgPath)
+	if err != nil {
+		return common.Pin{}, err
+	}
+	if len(retained) == 0 {
+		return common.Pin{}, fmt.Errorf("no previous instance of %s is retained", packageName)
+	}
+	pin := common.Pin{PackageName: packageName, InstanceID: retained[0]}
+	// The current instance takes the place of the one being rolled back to, the
+	// history doesn't shrink even if the deployer isn't configured to keep it.
+	keep := d.opts.KeepInstances
+	if keep < len(retained) {
+		keep = len(retained)
+	}
+	// Leave the retained instance alone if it can't be installed, it is not
+	// worse than it was.
+	newPin, err := d.activate(ctx, pkgPath, pin, keep, func() {})
+	if err == nil {
+		logging.Infof(ctx, "Successfully rolled back to %s", pin)
+	} else {
+		logging.Errorf(ctx, "Failed to roll back to %s: %s", pin, err)
+	}
+	return newPin, err
+}
+
+// activate makes an instance extracted to .cipd/pkgs/<name>/<instance id> the
+// current one: installs its files into the site root, removes files of the
+// previous instance that are no longer needed, and updates the list of
+// retained instances, keeping at most 'keep' of them.
+//
+// 'cleanup' is called if the instance couldn't be installed.
+func (d *deployerImpl) activate(ctx context.Context, pkgPath string, pin common.Pin, keep int, cleanup func()) (common.Pin, error) {
+	destPath := filepath.Join(pkgPath, pin.InstanceID)
 	newManifest, err := d.readManifest(ctx, destPath)
 	if err != nil {
 		return common.Pin{}, err
@@ -162,35 +299,83 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, inst PackageInstance)
 	}
 	if err != nil {
 		logging.Warningf(ctx, "Previous version of the package is broken: %s", err)
+		prevInstanceID = ""
 		prevManifest = Manifest{} // to make sure prevManifest.Files == nil.
 	}
+	if prevInstanceID == pin.InstanceID {
+		prevInstanceID = ""
+	}
+	retained, err := d.readRetained(pkgPath)
+	if err != nil {
+		logging.Warningf(ctx, "Forgetting retained instances: %s", err)
+		retained = nil
+	}
+
+	// Keep the previous instance for rollback, if asked to. Files of a "copy"
+	// mode instance live in the site root, move them back first. They are put
+	// back into the site root if the new instance can't be installed.
+	keepPrev := prevInstanceID != "" && keep > 0
+	movedPrev := false
+	if keepPrev && effectiveInstallMode(prevManifest.InstallMode) == InstallModeCopy {
+		prevPath := filepath.Join(pkgPath, prevInstanceID)
+		if err := d.moveFromSiteRoot(ctx, prevManifest.Files, prevPath); err != nil {
+			logging.Warningf(ctx, "Not retaining %s: %s", prevInstanceID, err)
+			d.restoreToSiteRoot(ctx, prevManifest.Files, prevPath)
+			keepPrev = false
+		} else {
+			movedPrev = true
+		}
+	}
 
-	// Install all new files to the site root.
+	// Install all new files to the site root and mark installed instance as a
+	// current one. After this the package is considered installed and the
+	// function must not fail. All cleanup below is best effort.
 	err = d.addToSiteRoot(ctx, newManifest.Files, newManifest.InstallMode, pkgPath, destPath)
+	if err == nil {
+		err = d.setCurrentInstanceID(ctx, pkgPath, pin.InstanceID)
+	}
 	if err != nil {
-		d.fs.EnsureDirectoryGone(ctx, destPath)
+		d.undoAddToSiteRoot(ctx, newManifest.Files, newManifest.InstallMode, destPath, prevManifest.Files)
+		if movedPrev {
+			d.restoreToSiteRoot(ctx, prevManifest.Files, filepath.Join(pkgPath, prevInstanceID))
+		}
+		cleanup()
 		return common.Pin{}, err
 	}
 
-	// Mark installed instance as a current one. After this call the package is
-	// considered installed and the function must not fail. All cleanup below is
-	// best effort.
-	if err = d.setCurrentInstanceID(ctx, pkgPath, pin.InstanceID); err != nil {
-		d.fs.EnsureDirectoryGone(ctx, destPath)
-		return common.Pin{}, err
+	// Figure out what instances to keep.
+	toKeep := []string{}
+	if keepPrev {
+		toKeep = append(toKeep, prevInstanceID)
+	}
+	for _, id := range retained {
+		if id != pin.InstanceID && id != prevInstanceID {
+			toKeep = append(toKeep, id)
+		}
+	}
+	toDelete := []string{}
+	if prevInstanceID != "" && !keepPrev {
+		toDelete = append(toDelete, prevInstanceID)
+	}
+	if len(toKeep) > keep {
+		toDelete = append(toDelete, toKeep[keep:]...)
+		toKeep = toKeep[:keep]
+	}
+	if err := d.writeRetained(ctx, pkgPath, toKeep); err != nil {
+		logging.Warningf(ctx, "Failed to update the list of retained instances: %s", err)
 	}
 
 	// Wait for async cleanup to finish.
 	wg := sync.WaitGroup{}
 	defer wg.Wait()
 
-	// Remove old instance directory completely.
-	if prevInstanceID != "" && prevInstanceID != pin.InstanceID {
+	// Remove old instance directories completely.
+	for _, id := range toDelete {
 		wg.Add(1)
-		go func() {
+		go func(id string) {
 			defer wg.Done()
-			d.fs.EnsureDirectoryGone(ctx, filepath.Join(pkgPath, prevInstanceID))
-		}()
+			d.fs.EnsureDirectoryGone(ctx, filepath.Join(pkgPath, id))
+		}(id)
 	}
 
 	// Remove no longer present files from the site root directory.
@@ -217,11 +402,6 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, inst PackageInstance)
 	if err == nil && newPin.InstanceID != pin.InstanceID {
 		err = fmt.Errorf("other instance (%s) was deployed concurrently", newPin.InstanceID)
 	}
-	if err == nil {
-		logging.Infof(ctx, "Successfully deployed %s", pin)
-	} else {
-		logging.Errorf(ctx, "Failed to deploy %s: %s", pin, err)
-	}
 	return newPin, err
 }
 
@@ -239,7 +419,7 @@ func (d *deployerImpl) CheckDeployed(ctx context.Context, pkg string) (common.Pi
 	}, nil
 }
 
-func (d *deployerImpl) FindDeployed(ctx context.Context) ([]common.Pin, error) {
+func (d *deployerImpl) FindDeployed(ctx context.Context) ([]DeployedPackage, error) {
 	// Directories with packages are direct children of .cipd/pkgs/.
 	pkgs := filepath.Join(d.fs.Root(), filepath.FromSlash(packagesDir))
 	infos, err := ioutil.ReadDir(pkgs)
@@ -250,7 +430,7 @@ func (d *deployerImpl) FindDeployed(ctx context.Context) ([]common.Pin, error) {
 		return nil, err
 	}
 
-	found := map[string]common.Pin{}
+	found := map[string]DeployedPackage{}
 	keys := []string{}
 	for _, info := range infos {
 		if !info.IsDir() {
@@ -270,22 +450,79 @@ func (d *deployerImpl) FindDeployed(ctx context.Context) ([]common.Pin, error) {
 		// structure manually.
 		if _, ok := found[manifest.PackageName]; !ok {
 			keys = append(keys, manifest.PackageName)
-			found[manifest.PackageName] = common.Pin{
-				PackageName: manifest.PackageName,
-				InstanceID:  currentID,
+			retained, err := d.readRetained(pkgPath)
+			if err != nil {
+				logging.Warningf(ctx, "Ignoring retained instances of %s: %s", manifest.PackageName, err)
+			}
+			found[manifest.PackageName] = DeployedPackage{
+				Pin: common.Pin{
+					PackageName: manifest.PackageName,
+					InstanceID:  currentID,
+				},
+				Retained: retained,
 			}
 		}
 	}
 
 	// Sort by package name.
 	sort.Strings(keys)
-	out := make([]common.Pin, len(found))
+	out := make([]DeployedPackage, len(found))
 	for i, k := range keys {
 		out[i] = found[k]
 	}
 	return out, nil
 }
 
+func (d *deployerImpl) FindDeployedFiles(ctx context.Context) (map[string]string, error) {
+	pkgs := filepath.Join(d.fs.Root(), filepath.FromSlash(packagesDir))
+	infos, err := ioutil.ReadDir(pkgs)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	out := map[string]string{}
+	for _, info := range infos {
+		if !info.IsDir() {
+			continue
+		}
+		pkgPath := filepath.Join(pkgs, info.Name())
+		currentID, err := d.getCurrentInstanceID(pkgPath)
+		if err != nil || currentID == "" {
+			continue
+		}
+		retained, err := d.readRetained(pkgPath)
+		if err != nil {
+			logging.Warningf(ctx, "Ignoring retained instances in %s: %s", pkgPath, err)
+		}
+		for _, id := range append([]string{currentID}, retained...) {
+			instanceDir := filepath.Join(pkgPath, id)
+			manifest, err := d.readManifest(ctx, instanceDir)
+			if err != nil {
+				logging.Warningf(ctx, "Ignoring broken instance in %s: %s", instanceDir, err)
+				continue
+			}
+			// Files of the current "copy" mode instance are in the site root, in
+			// all other cases they are in the instance directory.
+			inSiteRoot := id == currentID && effectiveInstallMode(manifest.InstallMode) == InstallModeCopy
+			for _, f := range manifest.Files {
+				if f.Hash == "" || f.Symlink != "" || out[f.Hash] != "" {
+					continue
+				}
+				relPath := filepath.FromSlash(f.Name)
+				if !inSiteRoot {
+					out[f.Hash] = filepath.Join(instanceDir, relPath)
+				} else if abs, err := d.fs.RootRelToAbs(relPath); err == nil {
+					out[f.Hash] = abs
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
 func (d *deployerImpl) RemoveDeployed(ctx context.Context, packageName string) error {
 	logging.Infof(ctx, "Removing %s from %s", packageName, d.fs.Root())
 	if err := common.ValidatePackageName(packageName); err != nil {
@@ -318,6 +555,14 @@ func (d *deployerImpl) TempFile(ctx context.Context, prefix string) (*os.File, e
 	return ioutil.TempFile(dir, prefix)
 }
 
+func (d *deployerImpl) TempDir(ctx context.Context, prefix string) (string, error) {
+	dir, err := d.fs.EnsureDirectory(ctx, filepath.Join(d.fs.Root(), SiteServiceDir, "tmp"))
+	if err != nil {
+		return "", err
+	}
+	return ioutil.TempDir(dir, prefix)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Utility methods.
 
@@ -377,6 +622,38 @@ func (d *deployerImpl) setCurrentInstanceID(ctx context.Context, packageDir, ins
 	return d.fs.EnsureSymlink(ctx, filepath.Join(packageDir, currentSymlink), instanceID)
 }
 
+// readRetained returns IDs of retained instances given a path to a package
+// directory (.cipd/pkgs/<name>), most recent first.
+//
+// It returns (nil, nil) if no instances are retained.
+func (d *deployerImpl) readRetained(packageDir string) ([]string, error) {
+	blob, err := ioutil.ReadFile(filepath.Join(packageDir, retainedTxt))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []string
+	for _, id := range strings.Fields(string(blob)) {
+		if err := common.ValidateInstanceID(id); err != nil {
+			return nil, fmt.Errorf("bad retained instance ID: %s", err)
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// writeRetained replaces the list of retained instances given a path to a
+// package directory (.cipd/pkgs/<name>).
+func (d *deployerImpl) writeRetained(ctx context.Context, packageDir string, ids []string) error {
+	path := filepath.Join(packageDir, retainedTxt)
+	if len(ids) == 0 {
+		return d.fs.EnsureFileGone(ctx, path)
+	}
+	return EnsureFile(ctx, d.fs, path, strings.NewReader(strings.Join(ids, "\n")+"\n"))
+}
+
 // readManifest reads package manifest given a path to a package instance
 // (.cipd/pkgs/<name>/<instance id>).
 func (d *deployerImpl) readManifest(ctx context.Context, instanceDir string) (Manifest, error) {
@@ -403,12 +680,7 @@ func (d *deployerImpl) readManifest(ctx context.Context, instanceDir string) (Ma
 // addToSiteRoot moves or symlinks files into the site root directory (depending
 // on passed installMode).
 func (d *deployerImpl) addToSiteRoot(ctx context.Context, files []FileInfo, installMode InstallMode, pkgDir, srcDir string) error {
-	// On Windows only InstallModeCopy is supported.
-	if runtime.GOOS == "windows" {
-		installMode = InstallModeCopy
-	} else if installMode == "" {
-		installMode = InstallModeSymlink // default on non-Windows
-	}
+	installMode = effectiveInstallMode(installMode)
 	if err := ValidateInstallMode(installMode); err != nil {
 		return err
 	}
@@ -455,6 +727,82 @@ func (d *deployerImpl) addToSiteRoot(ctx context.Context, files []FileInfo, inst
 	return nil
 }
 
+// moveFromSiteRoot moves files installed in "copy" mode from the site root
+// directory back into the package instance directory 'srcDir', so the instance
+// can be installed again later.
+func (d *deployerImpl) moveFromSiteRoot(ctx context.Context, files []FileInfo, srcDir string) error {
+	for _, f := range files {
+		relPath := filepath.FromSlash(f.Name)
+		destAbs, err := d.fs.RootRelToAbs(relPath)
+		if err != nil {
+			return err
+		}
+		if err := d.fs.Replace(ctx, destAbs, filepath.Join(srcDir, relPath)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreToSiteRoot moves files of a "copy" mode instance from the package
+// instance directory 'srcDir' back into the site root directory, undoing
+// moveFromSiteRoot. Files missing in 'srcDir' are assumed to be still in the
+// site root.
+//
+// Best effort. Logs errors and carries on.
+func (d *deployerImpl) restoreToSiteRoot(ctx context.Context, files []FileInfo, srcDir string) {
+	for _, f := range files {
+		relPath := filepath.FromSlash(f.Name)
+		srcAbs := filepath.Join(srcDir, relPath)
+		if _, err := os.Lstat(srcAbs); err != nil {
+			continue
+		}
+		destAbs, err := d.fs.RootRelToAbs(relPath)
+		if err != nil {
+			logging.Warningf(ctx, "Refusing to restore %q: %s", f.Name, err)
+			continue
+		}
+		if err := d.fs.Replace(ctx, srcAbs, destAbs); err != nil {
+			logging.Warningf(ctx, "Failed to restore %s: %s", destAbs, err)
+		}
+	}
+}
+
+// undoAddToSiteRoot reverts a partially successful addToSiteRoot call: moves
+// files installed in "copy" mode back into the package instance directory
+// 'srcDir' and removes the rest of them from the site root directory, except
+// ones listed in 'keep'.
+//
+// Best effort. Logs errors and carries on.
+func (d *deployerImpl) undoAddToSiteRoot(ctx context.Context, files []FileInfo, installMode InstallMode, srcDir string, keep []FileInfo) {
+	keepSet := map[string]bool{}
+	for _, f := range keep {
+		keepSet[f.Name] = true
+	}
+	toKill := []FileInfo{}
+	for _, f := range files {
+		relPath := filepath.FromSlash(f.Name)
+		if effectiveInstallMode(installMode) == InstallModeCopy {
+			srcAbs := filepath.Join(srcDir, relPath)
+			if _, err := os.Lstat(srcAbs); err == nil {
+				continue // was not moved
+			}
+			destAbs, err := d.fs.RootRelToAbs(relPath)
+			if err != nil {
+				continue
+			}
+			if err := d.fs.Replace(ctx, destAbs, srcAbs); err == nil {
+				continue
+			}
+			logging.Warningf(ctx, "Failed to move %s back to %s", destAbs, srcAbs)
+		}
+		if !keepSet[f.Name] {
+			toKill = append(toKill, f)
+		}
+	}
+	d.removeFromSiteRoot(ctx, toKill)
+}
+
 // removeFromSiteRoot deletes files from the site root directory.
 //
 // Best effort. Logs errors and carries on.
@@ -483,6 +831,19 @@ func (d *deployerImpl) removeEmptyDirs(ctx context.Context, root string) {
 ////////////////////////////////////////////////////////////////////////////////
 // Utility functions.
 
+// effectiveInstallMode returns the install mode actually used for a package
+// with the given install mode in its manifest.
+func effectiveInstallMode(installMode InstallMode) InstallMode {
+	// On Windows only InstallModeCopy is supported.
+	if runtime.GOOS == "windows" {
+		return InstallModeCopy
+	}
+	if installMode == "" {
+		return InstallModeSymlink // default on non-Windows
+	}
+	return installMode
+}
+
 // packageNameDigest returns a filename to use for naming a package directory in
 // the file system. Using package names as is can introduce problems on file
 // systems with path length limits (on Windows in particular). Returns stripped
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strings"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -641,11 +642,11 @@ func TestFindDeployed(t *testing.T) {
 			// Verify it is discoverable.
 			out, err := d.FindDeployed(ctx)
 			So(err, ShouldBeNil)
-			So(out, ShouldResemble, []Pin{
-				{"test", "0123456789abcdef00000123456789abcdef0000"},
-				{"test/pkg", "0123456789abcdef00000123456789abcdef0000"},
-				{"test/pkg/123", "0123456789abcdef00000123456789abcdef0000"},
-				{"test/pkg/456", "0123456789abcdef00000123456789abcdef0000"},
+			So(out, ShouldResemble, []DeployedPackage{
+				{Pin: Pin{"test", "0123456789abcdef00000123456789abcdef0000"}},
+				{Pin: Pin{"test/pkg", "0123456789abcdef00000123456789abcdef0000"}},
+				{Pin: Pin{"test/pkg/123", "0123456789abcdef00000123456789abcdef0000"}},
+				{Pin: Pin{"test/pkg/456", "0123456789abcdef00000123456789abcdef0000"}},
 			})
 		})
 	})
@@ -759,8 +760,197 @@ func TestRemoveDeployedWindows(t *testing.T) {
 	})
 }
 
+func TestRollback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	makeInstances := func(installMode InstallMode) []*testPackageInstance {
+		out := []*testPackageInstance{}
+		for i, id := range []string{"0", "1", "2"} {
+			files := []File{NewTestFile("file", "data "+id, false)}
+			if i == 0 {
+				files = append(files, NewTestFile("first only", "data", false))
+			}
+			inst := makeTestInstance("test/package", files, installMode)
+			inst.instanceID = strings.Repeat(id, 40)
+			out = append(out, inst)
+		}
+		return out
+	}
+
+	for _, mode := range []InstallMode{InstallModeSymlink, InstallModeCopy} {
+		mode := mode
+
+		Convey(fmt.Sprintf("Given a temp directory and %q mode", mode), t, func() {
+			tempDir, err := ioutil.TempDir("", "cipd_test")
+			So(err, ShouldBeNil)
+			Reset(func() { os.RemoveAll(tempDir) })
+
+			insts := makeInstances(mode)
+			deployAll := func(d Deployer) {
+				for _, inst := range insts {
+					_, err := d.DeployInstance(ctx, inst)
+					So(err, ShouldBeNil)
+				}
+			}
+			findDeployed := func(d Deployer) DeployedPackage {
+				out, err := d.FindDeployed(ctx)
+				So(err, ShouldBeNil)
+				So(len(out), ShouldEqual, 1)
+				return out[0]
+			}
+			pkgDirs := func() []string {
+				infos, err := ioutil.ReadDir(filepath.Join(tempDir, ".cipd/pkgs/test_package_B6R4ErK5ko"))
+				So(err, ShouldBeNil)
+				out := []string{}
+				for _, info := range infos {
+					if info.IsDir() {
+						out = append(out, info.Name())
+					}
+				}
+				return out
+			}
+
+			Convey("Nothing is retained by default", func() {
+				d := NewDeployer(tempDir)
+				deployAll(d)
+				So(findDeployed(d), ShouldResemble, DeployedPackage{Pin: insts[2].Pin()})
+				So(pkgDirs(), ShouldResemble, []string{insts[2].instanceID})
+				_, err := d.Rollback(ctx, "test/package")
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Retains up to KeepInstances instances", func() {
+				d := NewDeployerWithOptions(tempDir, DeployerOptions{KeepInstances: 1})
+				deployAll(d)
+				So(findDeployed(d), ShouldResemble, DeployedPackage{
+					Pin:      insts[2].Pin(),
+					Retained: []string{insts[1].instanceID},
+				})
+				So(pkgDirs(), ShouldResemble, []string{insts[1].instanceID, insts[2].instanceID})
+				So(readFile(tempDir, "file"), ShouldEqual, "data 2")
+
+				Convey("Rollback flips between instances", func() {
+					pin, err := d.Rollback(ctx, "test/package")
+					So(err, ShouldBeNil)
+					So(pin, ShouldResemble, insts[1].Pin())
+					So(readFile(tempDir, "file"), ShouldEqual, "data 1")
+					So(findDeployed(d), ShouldResemble, DeployedPackage{
+						Pin:      insts[1].Pin(),
+						Retained: []string{insts[2].instanceID},
+					})
+
+					pin, err = d.Rollback(ctx, "test/package")
+					So(err, ShouldBeNil)
+					So(pin, ShouldResemble, insts[2].Pin())
+					So(readFile(tempDir, "file"), ShouldEqual, "data 2")
+				})
+
+				Convey("Rollback with default options keeps the history", func() {
+					_, err := NewDeployer(tempDir).Rollback(ctx, "test/package")
+					So(err, ShouldBeNil)
+					So(findDeployed(d).Retained, ShouldResemble, []string{insts[2].instanceID})
+				})
+
+				Convey("RemoveDeployed removes retained instances", func() {
+					So(d.RemoveDeployed(ctx, "test/package"), ShouldBeNil)
+					So(scanDir(tempDir), ShouldResemble, []string(nil))
+				})
+
+				Convey("Failed rollback leaves both instances intact", func() {
+					broken := &deployerImpl{
+						fs: &failingFS{
+							FileSystem: NewFileSystem(tempDir),
+							failOn:     filepath.Join(tempDir, "file"),
+							failFrom:   insts[1].instanceID,
+						},
+						opts: DeployerOptions{KeepInstances: 1},
+					}
+					_, err := broken.Rollback(ctx, "test/package")
+					So(err, ShouldNotBeNil)
+					So(readFile(tempDir, "file"), ShouldEqual, "data 2")
+					So(findDeployed(d), ShouldResemble, DeployedPackage{
+						Pin:      insts[2].Pin(),
+						Retained: []string{insts[1].instanceID},
+					})
+
+					_, err = d.Rollback(ctx, "test/package")
+					So(err, ShouldBeNil)
+					So(readFile(tempDir, "file"), ShouldEqual, "data 1")
+				})
+
+				Convey("Failed deployment restores the previous instance", func() {
+					broken := &deployerImpl{
+						fs: &failingFS{
+							FileSystem: NewFileSystem(tempDir),
+							failOn:     filepath.Join(tempDir, "first only"),
+							failFrom:   insts[0].instanceID,
+						},
+						opts: DeployerOptions{KeepInstances: 1},
+					}
+					_, err := broken.DeployInstance(ctx, insts[0])
+					So(err, ShouldNotBeNil)
+					So(readFile(tempDir, "file"), ShouldEqual, "data 2")
+					_, err = os.Lstat(filepath.Join(tempDir, "first only"))
+					So(os.IsNotExist(err), ShouldBeTrue)
+					So(findDeployed(d), ShouldResemble, DeployedPackage{
+						Pin:      insts[2].Pin(),
+						Retained: []string{insts[1].instanceID},
+					})
+					So(pkgDirs(), ShouldResemble, []string{insts[1].instanceID, insts[2].instanceID})
+				})
+			})
+
+			Convey("Rollback removes files not in the previous instance", func() {
+				d := NewDeployerWithOptions(tempDir, DeployerOptions{KeepInstances: 2})
+				deployAll(d)
+				So(findDeployed(d).Retained, ShouldResemble, []string{insts[1].instanceID, insts[0].instanceID})
+
+				// Deploying a retained instance again takes it out of the list.
+				_, err := d.DeployInstance(ctx, insts[0])
+				So(err, ShouldBeNil)
+				So(readFile(tempDir, "first only"), ShouldEqual, "data")
+				So(findDeployed(d).Retained, ShouldResemble, []string{insts[2].instanceID, insts[1].instanceID})
+
+				_, err = d.Rollback(ctx, "test/package")
+				So(err, ShouldBeNil)
+				So(readFile(tempDir, "file"), ShouldEqual, "data 2")
+				_, err = os.Lstat(filepath.Join(tempDir, "first only"))
+				So(os.IsNotExist(err), ShouldBeTrue)
+				So(findDeployed(d).Retained, ShouldResemble, []string{insts[0].instanceID, insts[1].instanceID})
+			})
+		})
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
+// failingFS is a FileSystem that fails to put files of an instance 'failFrom'
+// to 'failOn' path.
+type failingFS struct {
+	FileSystem
+
+	failOn   string
+	failFrom string
+}
+
+func (f *failingFS) EnsureSymlink(ctx context.Context, path string, target string) error {
+	if path == f.failOn {
+		return fmt.Errorf("refusing to create %s", path)
+	}
+	return f.FileSystem.EnsureSymlink(ctx, path, target)
+}
+
+func (f *failingFS) Replace(ctx context.Context, oldpath, newpath string) error {
+	if newpath == f.failOn && strings.Contains(oldpath, f.failFrom) {
+		return fmt.Errorf("refusing to replace %s", newpath)
+	}
+	return f.FileSystem.Replace(ctx, oldpath, newpath)
+}
+
 type testPackageInstance struct {
 	packageName string
 	instanceID  string
@@ -69,6 +69,10 @@ type FileInfo struct {
 
 	// Symlink is a path the symlink points to or "" if the file is not a symlink.
 	Symlink string `json:"symlink,omitempty"`
+
+	// Hash is hex encoded SHA1 of the file body, used to verify the deployed
+	// file. Empty for symlinks and in manifests written by older clients.
+	Hash string `json:"hash,omitempty"`
 }
 
 // VersionFile describes JSON file with package version information that's
@@ -81,6 +81,10 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 
 	files := inst.Files()
 
+	// Hex SHA1 of extracted regular files, recorded in the manifest to be able
+	// to verify the deployed package later.
+	hashes := make(map[string]string, len(files))
+
 	extractManifestFile := func(f File) (err error) {
 		manifest, err := readManifestFile(f)
 		if err != nil {
@@ -98,6 +102,7 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 				Name:       file.Name(),
 				Size:       file.Size(),
 				Executable: file.Executable(),
+				Hash:       hashes[file.Name()],
 			}
 			if file.Symlink() {
 				target, err := file.SymlinkTarget()
@@ -143,18 +148,23 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 			return err
 		}
 		defer in.Close()
-		_, err = io.Copy(out, in)
+		hash := sha1.New()
+		if _, err = io.Copy(io.MultiWriter(out, hash), in); err == nil {
+			hashes[f.Name()] = hex.EncodeToString(hash.Sum(nil))
+		}
 		return err
 	}
 
-	// Use nested functions in a loop to be able to utilize defers.
+	// Use nested functions in a loop to be able to utilize defers. The manifest
+	// is extracted last, when hashes of all other files are known.
 	var err error
+	var manifestFile File
 	for _, f := range files {
 		if err = ctx.Err(); err != nil {
 			break
 		}
 		if f.Name() == manifestName {
-			err = extractManifestFile(f)
+			manifestFile = f
 		} else if f.Symlink() {
 			err = extractSymlinkFile(f)
 		} else {
@@ -164,6 +174,9 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 			break
 		}
 	}
+	if err == nil && manifestFile != nil {
+		err = extractManifestFile(manifestFile)
+	}
 
 	needToEnd = false
 	if err == nil {
@@ -216,11 +229,16 @@ func (inst *packageInstance) open(instanceID string) error {
 	if err != nil {
 		return err
 	}
-	inst.files = make([]File, len(inst.zip.File))
-	for i, zf := range inst.zip.File {
-		inst.files[i] = &fileInZip{z: zf}
-		if inst.files[i].Name() == manifestName {
-			inst.manifest, err = readManifestFile(inst.files[i])
+	// Signatures are not a part of the package content, see signing.go.
+	inst.files = make([]File, 0, len(inst.zip.File))
+	for _, zf := range inst.zip.File {
+		if isSignature(zf.Name) {
+			continue
+		}
+		f := &fileInZip{z: zf}
+		inst.files = append(inst.files, f)
+		if f.Name() == manifestName {
+			inst.manifest, err = readManifestFile(f)
 			if err != nil {
 				return err
 			}
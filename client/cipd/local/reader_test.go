@@ -171,8 +171,8 @@ func TestPackageReading(t *testing.T) {
 			"abc",
 			"rel_symlink",
 			"abs_symlink",
-			".cipdpkg/manifest.json",
 			"subpath/version.json",
+			".cipdpkg/manifest.json",
 		})
 		So(string(dest.files[0].Bytes()), ShouldEqual, "12345")
 		So(dest.files[1].executable, ShouldBeTrue)
@@ -187,12 +187,14 @@ func TestPackageReading(t *testing.T) {
 			"files": [
 				{
 					"name": "testing/qwerty",
-					"size": 5
+					"size": 5,
+					"hash": "8cb2237d0679ca88db6464eac60da96345513964"
 				},
 				{
 					"name": "abc",
 					"size": 3,
-					"executable": true
+					"executable": true,
+					"hash": "1107c34522e2db80f1bc9713b7326bf2855d740a"
 				},
 				{
 					"name": "rel_symlink",
@@ -206,20 +208,21 @@ func TestPackageReading(t *testing.T) {
 				},
 				{
 					"name": "subpath/version.json",
-					"size": 92
+					"size": 92,
+					"hash": "441c9e46b752dc3624dd630fe5bc7bd0c04153d3"
 				}
 			]
 		}`
-		So(dest.files[4].name, ShouldEqual, ".cipdpkg/manifest.json")
-		So(string(dest.files[4].Bytes()), shouldBeSameJSONDict, goodManifest)
+		So(dest.files[5].name, ShouldEqual, ".cipdpkg/manifest.json")
+		So(string(dest.files[5].Bytes()), shouldBeSameJSONDict, goodManifest)
 
 		// Verify version file is correct.
 		goodVersionFile := `{
 			"instance_id": "e01c6837705d21418a61bc17c794746daa32379f",
 			"package_name": "testing"
 		}`
-		So(dest.files[5].name, ShouldEqual, "subpath/version.json")
-		So(string(dest.files[5].Bytes()), shouldBeSameJSONDict, goodVersionFile)
+		So(dest.files[4].name, ShouldEqual, "subpath/version.json")
+		So(string(dest.files[4].Bytes()), shouldBeSameJSONDict, goodVersionFile)
 	})
 }
 
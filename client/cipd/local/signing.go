@@ -0,0 +1,336 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"archive/zip"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging"
+)
+
+// Package signatures.
+//
+// A signature is a JSON file stored inside the package under
+// .cipdpkg/signatures/<key ID>.json. It is an ECDSA signature of the content
+// digest of the package: a SHA256 hash of the names, types and contents of all
+// files in the package, except the signatures themselves. That way signatures
+// can be added to an existing package without invalidating the ones already
+// there.
+//
+// Since a signature is a part of the package file, signing a package produces
+// a new package instance, with its own instance ID.
+
+// signaturesDir is a directory inside the package with signatures.
+const signaturesDir = packageServiceDir + "/signatures"
+
+// ErrNotSigned is returned by VerifyInstance if a package has no signature
+// made with a trusted key.
+var ErrNotSigned = errors.New("the package is not signed by a trusted key")
+
+// Signature is a signature of a package, as stored inside the package.
+type Signature struct {
+	// KeyID identifies the public key to use to verify the signature.
+	KeyID string `json:"key_id"`
+	// Signature is ASN.1 encoded ECDSA signature of the content digest.
+	Signature []byte `json:"signature"`
+}
+
+// TrustedKeys maps a key ID to a public key that is allowed to sign packages.
+type TrustedKeys map[string]*ecdsa.PublicKey
+
+// KeyID returns an identifier of a public key: first 16 bytes of SHA256 of its
+// DER encoding, hex encoded.
+func KeyID(pub *ecdsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(der)
+	return hex.EncodeToString(digest[:16]), nil
+}
+
+// ParseTrustedKeys parses PEM encoded "PUBLIC KEY" blocks, as produced by
+// 'openssl ec -pubout'.
+func ParseTrustedKeys(data []byte) (TrustedKeys, error) {
+	out := TrustedKeys{}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("not an ECDSA public key")
+		}
+		id, err := KeyID(pub)
+		if err != nil {
+			return nil, err
+		}
+		out[id] = pub
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no public keys found")
+	}
+	return out, nil
+}
+
+// LoadTrustedKeys reads a file with PEM encoded public keys.
+func LoadTrustedKeys(path string) (TrustedKeys, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := ParseTrustedKeys(data)
+	if err != nil {
+		return nil, fmt.Errorf("bad trusted keys file %s: %s", path, err)
+	}
+	return keys, nil
+}
+
+// ParsePrivateKey parses a PEM encoded "EC PRIVATE KEY" block, as produced by
+// 'openssl ecparam -genkey'.
+func ParsePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no EC private key found")
+		}
+		if block.Type == "EC PRIVATE KEY" {
+			return x509.ParseECPrivateKey(block.Bytes)
+		}
+	}
+}
+
+// SignInstance writes a copy of the package to 'output' with an additional
+// signature made with 'key'. An existing signature by the same key is
+// replaced.
+func SignInstance(ctx context.Context, inst PackageInstance, key *ecdsa.PrivateKey, output io.Writer) error {
+	z, err := openZip(inst)
+	if err != nil {
+		return err
+	}
+	digest, err := contentDigest(z)
+	if err != nil {
+		return err
+	}
+	keyID, err := KeyID(&key.PublicKey)
+	if err != nil {
+		return err
+	}
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+	if err != nil {
+		return err
+	}
+	sig, err := asn1.Marshal(ecdsaSignature{r, s})
+	if err != nil {
+		return err
+	}
+	blob, err := json.MarshalIndent(Signature{KeyID: keyID, Signature: sig}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	sigName := signaturesDir + "/" + keyID + ".json"
+	files := []File{}
+	for _, zf := range z.File {
+		if zf.Name != sigName {
+			files = append(files, &fileInZip{z: zf})
+		}
+	}
+	files = append(files, &blobFile{name: sigName, blob: blob})
+	logging.Infof(ctx, "Signing %s with key %s", inst.Pin(), keyID)
+	return zipInputFiles(ctx, files, output, false)
+}
+
+// Signatures returns all signatures stored in the package.
+func Signatures(inst PackageInstance) ([]Signature, error) {
+	z, err := openZip(inst)
+	if err != nil {
+		return nil, err
+	}
+	return readSignatures(z)
+}
+
+// VerifyInstance checks that the package has a valid signature made by one of
+// the trusted keys. Returns ErrNotSigned if there's none.
+//
+// Signatures made by unknown keys are ignored. Invalid signatures made by
+// trusted keys are errors.
+func VerifyInstance(ctx context.Context, inst PackageInstance, keys TrustedKeys) error {
+	z, err := openZip(inst)
+	if err != nil {
+		return err
+	}
+	sigs, err := readSignatures(z)
+	if err != nil {
+		return err
+	}
+	var digest []byte
+	for _, sig := range sigs {
+		pub := keys[sig.KeyID]
+		if pub == nil {
+			logging.Debugf(ctx, "Ignoring signature of %s by unknown key %s", inst.Pin(), sig.KeyID)
+			continue
+		}
+		if digest == nil {
+			if digest, err = contentDigest(z); err != nil {
+				return err
+			}
+		}
+		parsed := ecdsaSignature{}
+		if rest, err := asn1.Unmarshal(sig.Signature, &parsed); err != nil || len(rest) != 0 {
+			return fmt.Errorf("malformed signature by key %s", sig.KeyID)
+		}
+		if !ecdsa.Verify(pub, digest, parsed.R, parsed.S) {
+			return fmt.Errorf("bad signature by key %s", sig.KeyID)
+		}
+		logging.Infof(ctx, "%s is signed by trusted key %s", inst.Pin(), sig.KeyID)
+		return nil
+	}
+	return ErrNotSigned
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Utilities.
+
+// ecdsaSignature is ASN.1 structure of an ECDSA signature.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// fileList implements sort.Interface to sort files by name.
+type fileList []File
+
+func (l fileList) Len() int           { return len(l) }
+func (l fileList) Less(i, j int) bool { return l[i].Name() < l[j].Name() }
+func (l fileList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+// openZip reads the zip directory of the package data.
+func openZip(inst PackageInstance) (*zip.Reader, error) {
+	r := inst.DataReader()
+	size, err := r.Seek(0, os.SEEK_END)
+	if err != nil {
+		return nil, err
+	}
+	return zip.NewReader(&readerAt{r: r}, size)
+}
+
+// isSignature is true if the zip entry is a signature.
+func isSignature(name string) bool {
+	return strings.HasPrefix(name, signaturesDir+"/")
+}
+
+// readSignatures reads all signatures from the package, sorted by key ID.
+func readSignatures(z *zip.Reader) ([]Signature, error) {
+	out := []Signature{}
+	for _, zf := range z.File {
+		if !isSignature(zf.Name) {
+			continue
+		}
+		f := &fileInZip{z: zf}
+		r, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		sig := Signature{}
+		err = json.NewDecoder(r).Decode(&sig)
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("malformed signature file %s: %s", zf.Name, err)
+		}
+		out = append(out, sig)
+	}
+	sort.Sort(signatureList(out))
+	return out, nil
+}
+
+// signatureList implements sort.Interface to sort signatures by key ID.
+type signatureList []Signature
+
+func (l signatureList) Len() int           { return len(l) }
+func (l signatureList) Less(i, j int) bool { return l[i].KeyID < l[j].KeyID }
+func (l signatureList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+// contentDigest returns SHA256 of names, types and contents of all files in
+// the package, except signatures. It doesn't depend on how the files are
+// compressed or ordered in the zip archive.
+func contentDigest(z *zip.Reader) ([]byte, error) {
+	files := fileList{}
+	for _, zf := range z.File {
+		if !isSignature(zf.Name) {
+			files = append(files, &fileInZip{z: zf})
+		}
+	}
+	sort.Sort(files)
+
+	digest := sha256.New()
+	for _, f := range files {
+		kind := "f"
+		h := sha256.New()
+		switch {
+		case f.Symlink():
+			kind = "l"
+			target, err := f.SymlinkTarget()
+			if err != nil {
+				return nil, err
+			}
+			io.WriteString(h, target)
+		default:
+			if f.Executable() {
+				kind = "x"
+			}
+			r, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			_, err = io.Copy(h, r)
+			r.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+		writeField(digest, f.Name())
+		writeField(digest, kind)
+		writeField(digest, hex.EncodeToString(h.Sum(nil)))
+	}
+	return digest.Sum(nil), nil
+}
+
+// writeField writes 'field' prefixed by its length, so that any sequence of
+// fields is encoded unambiguously, whatever bytes file names contain.
+func writeField(w io.Writer, field string) {
+	var size [8]byte
+	binary.BigEndian.PutUint64(size[:], uint64(len(field)))
+	w.Write(size[:])
+	io.WriteString(w, field)
+}
@@ -0,0 +1,199 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSigning(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Given a package and keys", t, func() {
+		build := func() PackageInstance {
+			out := bytes.Buffer{}
+			err := BuildInstance(ctx, BuildInstanceOptions{
+				Input: []File{
+					NewTestFile("testing/qwerty", "12345", false),
+					NewTestFile("abc", "data", true),
+					NewTestSymlink("link", "abc"),
+				},
+				Output:      &out,
+				PackageName: "testing",
+			})
+			So(err, ShouldBeNil)
+			inst, err := OpenInstance(ctx, bytes.NewReader(out.Bytes()), "")
+			So(err, ShouldBeNil)
+			return inst
+		}
+		sign := func(inst PackageInstance, key *ecdsa.PrivateKey) PackageInstance {
+			out := bytes.Buffer{}
+			So(SignInstance(ctx, inst, key, &out), ShouldBeNil)
+			signed, err := OpenInstance(ctx, bytes.NewReader(out.Bytes()), "")
+			So(err, ShouldBeNil)
+			return signed
+		}
+		genKey := func() *ecdsa.PrivateKey {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			So(err, ShouldBeNil)
+			return key
+		}
+		trust := func(keys ...*ecdsa.PrivateKey) TrustedKeys {
+			out := TrustedKeys{}
+			for _, k := range keys {
+				id, err := KeyID(&k.PublicKey)
+				So(err, ShouldBeNil)
+				out[id] = &k.PublicKey
+			}
+			return out
+		}
+
+		inst := build()
+		defer inst.Close()
+		key1 := genKey()
+		key2 := genKey()
+
+		Convey("Unsigned package", func() {
+			sigs, err := Signatures(inst)
+			So(err, ShouldBeNil)
+			So(sigs, ShouldResemble, []Signature{})
+			So(VerifyInstance(ctx, inst, trust(key1)), ShouldEqual, ErrNotSigned)
+		})
+
+		Convey("Signed package", func() {
+			signed := sign(inst, key1)
+			defer signed.Close()
+			So(signed.Pin().PackageName, ShouldEqual, "testing")
+			So(signed.Pin().InstanceID, ShouldNotEqual, inst.Pin().InstanceID)
+
+			sigs, err := Signatures(signed)
+			So(err, ShouldBeNil)
+			So(len(sigs), ShouldEqual, 1)
+			So(VerifyInstance(ctx, signed, trust(key1)), ShouldBeNil)
+			So(VerifyInstance(ctx, signed, trust(key2)), ShouldEqual, ErrNotSigned)
+
+			// The signature is not one of the package files.
+			for _, f := range signed.Files() {
+				So(isSignature(f.Name()), ShouldBeFalse)
+			}
+
+			Convey("Signed twice", func() {
+				twice := sign(signed, key2)
+				defer twice.Close()
+				sigs, err := Signatures(twice)
+				So(err, ShouldBeNil)
+				So(len(sigs), ShouldEqual, 2)
+				So(VerifyInstance(ctx, twice, trust(key1)), ShouldBeNil)
+				So(VerifyInstance(ctx, twice, trust(key2)), ShouldBeNil)
+			})
+
+			Convey("Signature doesn't apply to other content", func() {
+				// Graft the signature onto a different package.
+				other := bytes.Buffer{}
+				err := BuildInstance(ctx, BuildInstanceOptions{
+					Input:       []File{NewTestFile("abc", "other data", true)},
+					Output:      &other,
+					PackageName: "testing",
+				})
+				So(err, ShouldBeNil)
+				otherInst, err := OpenInstance(ctx, bytes.NewReader(other.Bytes()), "")
+				So(err, ShouldBeNil)
+				defer otherInst.Close()
+				files := []File{}
+				for _, f := range otherInst.Files() {
+					files = append(files, f)
+				}
+				z, err := openZip(signed)
+				So(err, ShouldBeNil)
+				for _, zf := range z.File {
+					if isSignature(zf.Name) {
+						files = append(files, &fileInZip{z: zf})
+					}
+				}
+				forged := bytes.Buffer{}
+				So(zipInputFiles(ctx, files, &forged, false), ShouldBeNil)
+				forgedInst, err := OpenInstance(ctx, bytes.NewReader(forged.Bytes()), "")
+				So(err, ShouldBeNil)
+				defer forgedInst.Close()
+				So(VerifyInstance(ctx, forgedInst, trust(key1)), ShouldNotBeNil)
+			})
+		})
+
+		Convey("Content digest framing is unambiguous", func() {
+			digest := func(files ...File) []byte {
+				out := bytes.Buffer{}
+				So(zipInputFiles(ctx, files, &out, false), ShouldBeNil)
+				z, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+				So(err, ShouldBeNil)
+				d, err := contentDigest(z)
+				So(err, ShouldBeNil)
+				return d
+			}
+			// A file name that embeds the entry of another file.
+			h := sha256.Sum256([]byte("a data"))
+			name := "a\x00f\x00" + hex.EncodeToString(h[:]) + "\nb"
+			So(digest(NewTestFile(name, "b data", false)), ShouldNotResemble,
+				digest(NewTestFile("a", "a data", false), NewTestFile("b", "b data", false)))
+		})
+
+		Convey("Deployer checks signatures", func() {
+			tempDir, err := ioutil.TempDir("", "cipd_test")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(tempDir)
+
+			_, err = NewDeployerWithOptions(tempDir, DeployerOptions{TrustedKeys: trust(key1)}).DeployInstance(ctx, inst)
+			So(err, ShouldNotBeNil)
+			_, err = os.Stat(filepath.Join(tempDir, "abc"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			signed := sign(inst, key1)
+			defer signed.Close()
+			pin, err := NewDeployerWithOptions(tempDir, DeployerOptions{TrustedKeys: trust(key1)}).DeployInstance(ctx, signed)
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, signed.Pin())
+			So(readFile(tempDir, "abc"), ShouldEqual, "data")
+		})
+
+		Convey("Keys are parsed from PEM", func() {
+			privDER, err := x509.MarshalECPrivateKey(key1)
+			So(err, ShouldBeNil)
+			parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privDER}))
+			So(err, ShouldBeNil)
+			So(parsed.D, ShouldResemble, key1.D)
+
+			pems := []byte{}
+			for _, k := range []*ecdsa.PrivateKey{key1, key2} {
+				der, err := x509.MarshalPKIXPublicKey(&k.PublicKey)
+				So(err, ShouldBeNil)
+				pems = append(pems, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})...)
+			}
+			keys, err := ParseTrustedKeys(pems)
+			So(err, ShouldBeNil)
+			So(len(keys), ShouldEqual, 2)
+			for id := range trust(key1, key2) {
+				So(keys[id], ShouldNotBeNil)
+			}
+
+			_, err = ParseTrustedKeys([]byte("garbage"))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,233 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/client/cipd/common"
+)
+
+// DriftKind describes how a deployed file differs from the package manifest.
+type DriftKind string
+
+const (
+	// DriftModified is used when a file has different content or type.
+	DriftModified DriftKind = "modified"
+	// DriftMissing is used when a file is gone.
+	DriftMissing DriftKind = "missing"
+	// DriftExtra is used when a file not listed in the manifest is found in the
+	// package instance directory.
+	DriftExtra DriftKind = "extra"
+	// DriftWrongMode is used when the executable bit of a file is wrong.
+	DriftWrongMode DriftKind = "wrong_mode"
+	// DriftBrokenSymlink is used when a symlink points to a wrong place or to
+	// a file that doesn't exist.
+	DriftBrokenSymlink DriftKind = "broken_symlink"
+)
+
+// FileDrift is a deployed file that doesn't match the package manifest.
+type FileDrift struct {
+	// Name is slash separated file path relative to a package root.
+	Name string `json:"name"`
+	// Kind is what is wrong with the file.
+	Kind DriftKind `json:"kind"`
+}
+
+func (f FileDrift) String() string {
+	return fmt.Sprintf("%s (%s)", f.Name, f.Kind)
+}
+
+func (d *deployerImpl) VerifyDeployed(ctx context.Context, packageName string) (common.Pin, []FileDrift, error) {
+	if err := common.ValidatePackageName(packageName); err != nil {
+		return common.Pin{}, nil, err
+	}
+	pin, err := d.CheckDeployed(ctx, packageName)
+	if err != nil {
+		return common.Pin{}, nil, err
+	}
+	pkgPath := d.packagePath(ctx, packageName)
+	instanceDir := filepath.Join(pkgPath, pin.InstanceID)
+	manifest, err := d.readManifest(ctx, instanceDir)
+	if err != nil {
+		return pin, nil, err
+	}
+	installMode := effectiveInstallMode(manifest.InstallMode)
+
+	drift := []FileDrift{}
+	known := map[string]bool{}
+	for _, f := range manifest.Files {
+		relPath := filepath.FromSlash(f.Name)
+		siteAbs, err := d.fs.RootRelToAbs(relPath)
+		if err != nil {
+			return pin, nil, err
+		}
+
+		var kind DriftKind
+		if installMode == InstallModeSymlink {
+			// The site root has a symlink to the file in the instance directory,
+			// see addToSiteRoot.
+			known[f.Name] = true
+			kind, err = checkSiteSymlink(siteAbs, filepath.Join(pkgPath, currentSymlink, relPath))
+			if err == nil && kind == "" {
+				kind, err = checkDeployedFile(filepath.Join(instanceDir, relPath), f)
+				if kind == DriftMissing {
+					kind = DriftBrokenSymlink
+				}
+			}
+		} else {
+			kind, err = checkDeployedFile(siteAbs, f)
+		}
+		if err != nil {
+			return pin, nil, err
+		}
+		if kind != "" {
+			drift = append(drift, FileDrift{Name: f.Name, Kind: kind})
+		}
+	}
+
+	// In "copy" mode all the files are moved out of the instance directory, in
+	// "symlink" mode only the files from the manifest should be there.
+	extra, err := findExtraFiles(instanceDir, known)
+	if err != nil {
+		return pin, nil, err
+	}
+	for _, name := range extra {
+		drift = append(drift, FileDrift{Name: name, Kind: DriftExtra})
+	}
+
+	sort.Sort(fileDriftList(drift))
+	return pin, drift, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Utility functions.
+
+// fileDriftList implements sort.Interface to sort drift by file name.
+type fileDriftList []FileDrift
+
+func (l fileDriftList) Len() int           { return len(l) }
+func (l fileDriftList) Less(i, j int) bool { return l[i].Name < l[j].Name }
+func (l fileDriftList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+// checkSiteSymlink checks that 'path' is a symlink to 'targetAbs', as created
+// by addToSiteRoot in "symlink" mode. Returns "" if it is.
+func checkSiteSymlink(path, targetAbs string) (DriftKind, error) {
+	fi, err := os.Lstat(path)
+	switch {
+	case os.IsNotExist(err):
+		return DriftMissing, nil
+	case err != nil:
+		return "", err
+	case fi.Mode()&os.ModeSymlink == 0:
+		return DriftModified, nil
+	}
+	target, err := os.Readlink(path)
+	if err != nil {
+		return "", err
+	}
+	expected, err := filepath.Rel(filepath.Dir(path), targetAbs)
+	if err != nil {
+		return "", err
+	}
+	if target != expected {
+		return DriftBrokenSymlink, nil
+	}
+	return "", nil
+}
+
+// checkDeployedFile compares a file at 'path' to its manifest entry. Returns ""
+// if they match.
+func checkDeployedFile(path string, f FileInfo) (DriftKind, error) {
+	fi, err := os.Lstat(path)
+	switch {
+	case os.IsNotExist(err):
+		return DriftMissing, nil
+	case err != nil:
+		return "", err
+	}
+
+	if f.Symlink != "" {
+		if fi.Mode()&os.ModeSymlink == 0 {
+			return DriftModified, nil
+		}
+		target, err := os.Readlink(path)
+		if err != nil {
+			return "", err
+		}
+		if target != filepath.FromSlash(f.Symlink) {
+			return DriftBrokenSymlink, nil
+		}
+		return "", nil
+	}
+
+	if !fi.Mode().IsRegular() || uint64(fi.Size()) != f.Size {
+		return DriftModified, nil
+	}
+	if f.Hash != "" {
+		hash, err := hashFile(path)
+		if err != nil {
+			return "", err
+		}
+		if hash != f.Hash {
+			return DriftModified, nil
+		}
+	}
+	// Windows doesn't have the executable bit.
+	if runtime.GOOS != "windows" && (fi.Mode().Perm()&0100 != 0) != f.Executable {
+		return DriftWrongMode, nil
+	}
+	return "", nil
+}
+
+// hashFile returns hex encoded SHA1 of the file body.
+func hashFile(path string) (string, error) {
+	r, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	hash := sha1.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// findExtraFiles returns slash separated paths of files in the package instance
+// directory that are not in 'known', skipping the .cipdpkg directory.
+func findExtraFiles(instanceDir string, known map[string]bool) ([]string, error) {
+	out := []string{}
+	err := filepath.Walk(instanceDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(instanceDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if info.IsDir() {
+			if rel == packageServiceDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !known[rel] {
+			out = append(out, rel)
+		}
+		return nil
+	})
+	return out, err
+}
@@ -0,0 +1,141 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVerifyDeployed(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	for _, mode := range []InstallMode{InstallModeSymlink, InstallModeCopy} {
+		mode := mode
+
+		Convey(fmt.Sprintf("Given a package deployed in %q mode", mode), t, func() {
+			tempDir, err := ioutil.TempDir("", "cipd_test")
+			So(err, ShouldBeNil)
+			Reset(func() { os.RemoveAll(tempDir) })
+
+			// Use a real package, to have file hashes in the manifest.
+			out := bytes.Buffer{}
+			err = BuildInstance(ctx, BuildInstanceOptions{
+				Input: []File{
+					NewTestFile("some/file", "data", false),
+					NewTestFile("some/tool", "tool", true),
+					NewTestSymlink("link", "some/file"),
+				},
+				Output:      &out,
+				PackageName: "test/package",
+				InstallMode: mode,
+			})
+			So(err, ShouldBeNil)
+			inst, err := OpenInstance(ctx, bytes.NewReader(out.Bytes()), "")
+			So(err, ShouldBeNil)
+			defer inst.Close()
+
+			d := NewDeployer(tempDir)
+			_, err = d.DeployInstance(ctx, inst)
+			So(err, ShouldBeNil)
+
+			verify := func() []FileDrift {
+				pin, drift, err := d.VerifyDeployed(ctx, "test/package")
+				So(err, ShouldBeNil)
+				So(pin, ShouldResemble, inst.Pin())
+				return drift
+			}
+			site := func(rel string) string {
+				return filepath.Join(tempDir, filepath.FromSlash(rel))
+			}
+			// Where the file content lives.
+			content := func(rel string) string {
+				if mode == InstallModeCopy {
+					return site(rel)
+				}
+				return filepath.Join(tempDir, ".cipd", "pkgs", packageNameDigest("test/package"),
+					inst.Pin().InstanceID, filepath.FromSlash(rel))
+			}
+
+			Convey("Intact package", func() {
+				So(verify(), ShouldResemble, []FileDrift{})
+			})
+
+			Convey("Not installed package", func() {
+				_, _, err := d.VerifyDeployed(ctx, "test/another")
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Drift is detected and repaired", func() {
+				So(os.Chmod(content("some/file"), 0600), ShouldBeNil)
+				So(ioutil.WriteFile(content("some/file"), []byte("evil"), 0600), ShouldBeNil)
+				So(os.Chmod(content("some/tool"), 0600), ShouldBeNil)
+				So(os.Remove(content("link")), ShouldBeNil)
+				So(os.Symlink("elsewhere", content("link")), ShouldBeNil)
+
+				drift := verify()
+				So(drift, ShouldResemble, []FileDrift{
+					{Name: "link", Kind: DriftBrokenSymlink},
+					{Name: "some/file", Kind: DriftModified},
+					{Name: "some/tool", Kind: DriftWrongMode},
+				})
+				So(drift[0].String(), ShouldEqual, "link (broken_symlink)")
+
+				_, err := d.DeployInstance(ctx, inst)
+				So(err, ShouldBeNil)
+				So(verify(), ShouldResemble, []FileDrift{})
+				So(readFile(tempDir, "some/file"), ShouldEqual, "data")
+			})
+
+			Convey("Missing files are detected", func() {
+				So(os.Remove(site("some/file")), ShouldBeNil)
+				So(verify(), ShouldResemble, []FileDrift{
+					{Name: "some/file", Kind: DriftMissing},
+				})
+			})
+
+			if mode == InstallModeSymlink {
+				Convey("Symlinks to missing files are detected", func() {
+					So(os.Remove(content("some/file")), ShouldBeNil)
+					So(verify(), ShouldResemble, []FileDrift{
+						{Name: "some/file", Kind: DriftBrokenSymlink},
+					})
+				})
+
+				Convey("Replaced symlinks are detected", func() {
+					So(os.Remove(site("some/file")), ShouldBeNil)
+					So(ioutil.WriteFile(site("some/file"), []byte("data"), 0666), ShouldBeNil)
+					So(verify(), ShouldResemble, []FileDrift{
+						{Name: "some/file", Kind: DriftModified},
+					})
+				})
+			}
+
+			Convey("Extra files are detected", func() {
+				So(ioutil.WriteFile(content("extra"), []byte("boo"), 0666), ShouldBeNil)
+				drift := verify()
+				if mode == InstallModeSymlink {
+					So(drift, ShouldResemble, []FileDrift{{Name: "extra", Kind: DriftExtra}})
+				} else {
+					// Files in the site root are not tracked.
+					So(drift, ShouldResemble, []FileDrift{})
+				}
+			})
+		})
+	}
+}
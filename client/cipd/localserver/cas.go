@@ -0,0 +1,295 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package localserver
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/luci/luci-go/common/logging"
+
+	"github.com/luci/luci-go/client/cipd/common"
+	"github.com/luci/luci-go/client/cipd/local"
+)
+
+// uploadSession is an upload to the CAS in progress.
+//
+// The data is uploaded with the same resumable protocol as Google Storage
+// signed URLs, see storageImpl in the cipd package.
+type uploadSession struct {
+	sha1     string
+	path     string // Temporary file holding the data received so far.
+	size     int64  // Number of bytes received so far.
+	complete bool   // true once the last byte was received.
+	busy     bool   // true while a chunk is being written to 'path'.
+}
+
+// casPath returns the path of the file holding the CAS item 'sha1'.
+func (s *Server) casPath(sha1 string) string {
+	return filepath.Join(s.casDir(), sha1)
+}
+
+func (s *Server) casExists(sha1 string) bool {
+	_, err := os.Stat(s.casPath(sha1))
+	return err == nil
+}
+
+// casStoreFile puts the body of a package file into the CAS, unless it's
+// already there, and returns its SHA1.
+func (s *Server) casStoreFile(f local.File) (string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	tmp, err := ioutil.TempFile(s.tmpDir(), "file")
+	if err != nil {
+		return "", err
+	}
+	h := sha1.New()
+	_, err = io.Copy(io.MultiWriter(tmp, h), r)
+	if err2 := tmp.Close(); err == nil {
+		err = err2
+	}
+	hash := hex.EncodeToString(h.Sum(nil))
+	if err == nil && !s.casExists(hash) {
+		err = os.Rename(tmp.Name(), s.casPath(hash))
+	}
+	os.Remove(tmp.Name())
+	if err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// newSession creates an upload session for 'sha1' and returns its ID.
+func (s *Server) newSession(sha1 string) string {
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	s.sessions[id] = &uploadSession{sha1: sha1, path: filepath.Join(s.tmpDir(), "upload"+id)}
+	return id
+}
+
+func (s *Server) initiateUpload(r *http.Request) (reply, bool) {
+	sha1 := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	if err := common.ValidateInstanceID(sha1); err != nil {
+		return errorReply("%s", err), false
+	}
+	if s.casExists(sha1) {
+		return reply{"status": "ALREADY_UPLOADED"}, false
+	}
+	id := s.newSession(sha1)
+	return reply{
+		"status":            "SUCCESS",
+		"upload_session_id": id,
+		"upload_url":        baseURL(r) + casUploadPath + id,
+	}, false
+}
+
+// handleFinalizeUpload moves the data of a complete upload session to the CAS.
+//
+// The lock is held only to take the session out of the map, the data is
+// verified outside of it.
+func (s *Server) handleFinalizeUpload(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	s.lock.Lock()
+	session := s.sessions[id]
+	var resp reply
+	switch {
+	case session == nil:
+		resp = reply{"status": "MISSING"}
+	case session.busy || !session.complete:
+		resp = errorReply("upload session %s is incomplete, %d bytes received", id, session.size)
+	default:
+		delete(s.sessions, id)
+	}
+	s.lock.Unlock()
+	if resp == nil {
+		resp = s.finalizeUpload(session)
+	}
+	s.writeReply(w, resp)
+}
+
+func (s *Server) finalizeUpload(session *uploadSession) reply {
+	err := verifySHA1(session.path, session.sha1)
+	if err == nil {
+		err = os.Rename(session.path, s.casPath(session.sha1))
+	}
+	if err != nil {
+		os.Remove(session.path)
+		return errorReply("%s", err)
+	}
+	logging.Infof(s.ctx, "cipd: uploaded %s", session.sha1)
+	return reply{"status": "PUBLISHED"}
+}
+
+// handleUploadData receives a chunk of data for an upload session.
+//
+// The lock is held only to look up and update the session, the data is written
+// outside of it. Concurrent writes to the same session are rejected.
+func (s *Server) handleUploadData(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != "PUT" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	first, total, err := parseContentRange(r.Header.Get("Content-Range"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.lock.Lock()
+	session := s.sessions[id]
+	if session == nil {
+		s.lock.Unlock()
+		http.NotFound(w, r)
+		return
+	}
+	if session.busy {
+		s.lock.Unlock()
+		http.Error(w, "another chunk is being uploaded", http.StatusConflict)
+		return
+	}
+	session.busy = true
+	size, complete := session.size, session.complete
+	s.lock.Unlock()
+
+	code, err := writeChunk(session.path, r.Body, first, total, &size, complete)
+
+	s.lock.Lock()
+	session.busy = false
+	session.size = size
+	if err == nil && total != -1 && size >= total {
+		session.complete = true
+	}
+	complete = session.complete
+	s.lock.Unlock()
+
+	switch {
+	case err != nil:
+		http.Error(w, err.Error(), code)
+	case complete:
+		w.WriteHeader(http.StatusOK)
+	default:
+		if size != 0 {
+			w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", size-1))
+		}
+		w.WriteHeader(308)
+	}
+}
+
+// writeChunk appends the part of 'body' starting at offset 'first' that wasn't
+// received yet to the file at 'path', updating 'size'. It returns the HTTP
+// status code to reply with on error.
+func writeChunk(path string, body io.Reader, first, total int64, size *int64, complete bool) (int, error) {
+	if first != -1 && !complete {
+		if first > *size {
+			return http.StatusBadRequest, fmt.Errorf("expected offset %d, got %d", *size, first)
+		}
+		// Skip the bytes that were already received.
+		if _, err := io.CopyN(ioutil.Discard, body, *size-first); err != nil {
+			return http.StatusBadRequest, err
+		}
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		n, err := io.Copy(f, body)
+		*size += n
+		if err2 := f.Close(); err == nil {
+			err = err2
+		}
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+	if total != -1 && *size >= total && *size == 0 {
+		// Make sure the file exists for empty uploads.
+		if err := ioutil.WriteFile(path, nil, 0600); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+	return 0, nil
+}
+
+// handleFetchData serves the content of a CAS item.
+func (s *Server) handleFetchData(w http.ResponseWriter, r *http.Request, sha1 string) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if common.ValidateInstanceID(sha1) != nil {
+		http.NotFound(w, r)
+		return
+	}
+	f, err := os.Open(s.casPath(sha1))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(w, r, "", fi.ModTime(), f)
+}
+
+// parseContentRange parses a Content-Range header in the form
+// "bytes <first>-<last>/<total>" or "bytes */<total>". The total may be "*"
+// when unknown. Missing values are returned as -1.
+func parseContentRange(value string) (first, total int64, err error) {
+	if !strings.HasPrefix(value, "bytes ") {
+		return 0, 0, fmt.Errorf("unexpected Content-Range %q", value)
+	}
+	chunks := strings.SplitN(value[len("bytes "):], "/", 2)
+	if len(chunks) != 2 {
+		return 0, 0, fmt.Errorf("unexpected Content-Range %q", value)
+	}
+	first, total = -1, -1
+	if chunks[0] != "*" {
+		i := strings.Index(chunks[0], "-")
+		if i <= 0 {
+			return 0, 0, fmt.Errorf("unexpected Content-Range %q", value)
+		}
+		if first, err = strconv.ParseInt(chunks[0][:i], 10, 64); err != nil || first < 0 {
+			return 0, 0, fmt.Errorf("unexpected Content-Range %q", value)
+		}
+	}
+	if chunks[1] != "*" {
+		if total, err = strconv.ParseInt(chunks[1], 10, 64); err != nil || total < 0 {
+			return 0, 0, fmt.Errorf("unexpected Content-Range %q", value)
+		}
+	}
+	return first, total, nil
+}
+
+// verifySHA1 returns an error if the content of the file at 'path' doesn't
+// match 'expected'.
+func verifySHA1(path, expected string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha1.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return fmt.Errorf("hash mismatch: expected %s, got %s", expected, actual)
+	}
+	return nil
+}
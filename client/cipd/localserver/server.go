@@ -0,0 +1,679 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package localserver implements the CIPD backend REST protocol on top of a
+// local directory.
+//
+// It can be used as a package repository mirror on machines that can't reach
+// the real backend, or by tests that need a working backend without mocking
+// individual HTTP calls. Point the client to it with
+// cipd.ClientOptions.ServiceURL (or -service-url on the command line).
+//
+// There's no authentication: all callers are treated as anonymous and ACLs are
+// stored and reported, but not enforced.
+//
+// Layout of the directory:
+//
+//	state.json     - registered packages, instances, refs, tags and ACLs.
+//	cas/SHA1/<id>  - uploaded package instance files and bodies of the files
+//	                 inside them, extracted on the first file by file fetch.
+//	tmp/           - in-flight uploads, purged on startup.
+package localserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+
+	"github.com/luci/luci-go/client/cipd/common"
+	"github.com/luci/luci-go/client/cipd/local"
+)
+
+// Anonymous is the identity recorded as the author of all modifications.
+const Anonymous = "anonymous:anonymous"
+
+// Server is an http.Handler that implements the CIPD backend protocol.
+//
+// It is safe for concurrent use.
+type Server struct {
+	ctx  context.Context
+	root string
+
+	lock     sync.Mutex
+	state    repoState
+	sessions map[string]*uploadSession
+	nextID   int
+}
+
+// New returns a Server that stores its data in the directory 'root', creating
+// it if necessary. The state of a previous Server that used the same directory
+// is loaded.
+//
+// 'ctx' is used for logging and timestamps.
+func New(ctx context.Context, root string) (*Server, error) {
+	s := &Server{
+		ctx:      ctx,
+		root:     root,
+		sessions: map[string]*uploadSession{},
+	}
+	if err := os.RemoveAll(s.tmpDir()); err != nil {
+		return nil, err
+	}
+	for _, dir := range []string{s.casDir(), s.tmpDir()} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+	}
+	b, err := ioutil.ReadFile(s.statePath())
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(b, &s.state); err != nil {
+			return nil, fmt.Errorf("corrupted state file %s: %s", s.statePath(), err)
+		}
+	}
+	if s.state.Packages == nil {
+		s.state.Packages = map[string]*packageState{}
+	}
+	if s.state.ACLs == nil {
+		s.state.ACLs = map[string]map[string]*aclState{}
+	}
+	return s, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logging.Debugf(s.ctx, "cipd: %s %s", r.Method, r.URL)
+	path := r.URL.Path
+	switch {
+	case strings.HasPrefix(path, casUploadPath):
+		s.handleUploadData(w, r, path[len(casUploadPath):])
+	case strings.HasPrefix(path, casFetchPath):
+		s.handleFetchData(w, r, path[len(casFetchPath):])
+	case strings.HasPrefix(path, apiPath+"cas/v1/upload/SHA1/") && r.Method == "POST":
+		s.handleAPI(w, r, s.initiateUpload)
+	case strings.HasPrefix(path, apiPath+"cas/v1/finalize/") && r.Method == "POST":
+		s.handleFinalizeUpload(w, r)
+	case path == apiPath+"repo/v1/instance/resolve" && r.Method == "GET":
+		s.handleAPI(w, r, s.resolveVersion)
+	case path == apiPath+"repo/v1/instance/search" && r.Method == "GET":
+		s.handleAPI(w, r, s.searchInstances)
+	case path == apiPath+"repo/v1/instance/files" && r.Method == "GET":
+		s.handleFetchInstanceFiles(w, r)
+	case path == apiPath+"repo/v1/instance" && r.Method == "GET":
+		s.handleAPI(w, r, s.fetchInstance)
+	case path == apiPath+"repo/v1/instance" && r.Method == "POST":
+		s.handleAPI(w, r, s.registerInstance)
+	case path == apiPath+"repo/v1/tags" && r.Method == "GET":
+		s.handleAPI(w, r, s.fetchTags)
+	case path == apiPath+"repo/v1/tags" && r.Method == "POST":
+		s.handleAPI(w, r, s.attachTags)
+	case path == apiPath+"repo/v1/ref" && r.Method == "GET":
+		s.handleAPI(w, r, s.fetchRefs)
+	case path == apiPath+"repo/v1/ref" && r.Method == "POST":
+		s.handleAPI(w, r, s.setRef)
+	case path == apiPath+"repo/v1/acl" && r.Method == "GET":
+		s.handleAPI(w, r, s.fetchACL)
+	case path == apiPath+"repo/v1/acl" && r.Method == "POST":
+		s.handleAPI(w, r, s.modifyACL)
+	case path == apiPath+"repo/v1/package/search" && r.Method == "GET":
+		s.handleAPI(w, r, s.listPackages)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Private stuff.
+
+const (
+	apiPath       = "/_ah/api/"
+	casUploadPath = "/_local/cas/upload/"
+	casFetchPath  = "/_local/cas/fetch/"
+)
+
+// repoState is the persisted state of the repository.
+type repoState struct {
+	// Packages is keyed by package name.
+	Packages map[string]*packageState `json:"packages"`
+	// ACLs is keyed by package path, then by role.
+	ACLs map[string]map[string]*aclState `json:"acls"`
+}
+
+type packageState struct {
+	// Instances is keyed by instance ID.
+	Instances map[string]*instanceState `json:"instances"`
+	// Refs is keyed by ref name.
+	Refs map[string]*refState `json:"refs"`
+}
+
+type instanceState struct {
+	RegisteredBy string `json:"registered_by"`
+	RegisteredTs int64  `json:"registered_ts"`
+	// Tags is keyed by the tag, e.g. "key:value".
+	Tags map[string]*tagState `json:"tags"`
+}
+
+type refState struct {
+	InstanceID string `json:"instance_id"`
+	ModifiedBy string `json:"modified_by"`
+	ModifiedTs int64  `json:"modified_ts"`
+}
+
+type tagState struct {
+	RegisteredBy string `json:"registered_by"`
+	RegisteredTs int64  `json:"registered_ts"`
+}
+
+type aclState struct {
+	Principals []string `json:"principals"`
+	ModifiedBy string   `json:"modified_by"`
+	ModifiedTs int64    `json:"modified_ts"`
+}
+
+// reply is the body of an API response. It always has a "status" key.
+type reply map[string]interface{}
+
+// apiHandler handles one API call while the lock is held. It returns the
+// response and true if the state was modified and must be saved.
+type apiHandler func(r *http.Request) (reply, bool)
+
+func (s *Server) casDir() string    { return filepath.Join(s.root, "cas", "SHA1") }
+func (s *Server) tmpDir() string    { return filepath.Join(s.root, "tmp") }
+func (s *Server) statePath() string { return filepath.Join(s.root, "state.json") }
+
+// handleAPI calls 'h' and writes its reply as JSON.
+func (s *Server) handleAPI(w http.ResponseWriter, r *http.Request, h apiHandler) {
+	s.lock.Lock()
+	resp, modified := h(r)
+	var err error
+	if modified {
+		err = s.saveState()
+	}
+	s.lock.Unlock()
+	if err != nil {
+		logging.Errorf(s.ctx, "cipd: failed to save the state: %s", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.writeReply(w, resp)
+}
+
+// writeReply writes 'resp' as JSON.
+func (s *Server) writeReply(w http.ResponseWriter, resp reply) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
+
+// saveState atomically writes the state to disk.
+func (s *Server) saveState() error {
+	b, err := json.MarshalIndent(&s.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(s.tmpDir(), "state")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.statePath())
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// now returns the current time in microseconds since the epoch, the unit used
+// by the protocol.
+func (s *Server) now() int64 {
+	return clock.Now(s.ctx).UnixNano() / int64(time.Microsecond)
+}
+
+// baseURL returns the root URL the client used to reach the server.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+func errorReply(format string, args ...interface{}) reply {
+	return reply{"status": "ERROR", "error_message": fmt.Sprintf(format, args...)}
+}
+
+func formatTs(ts int64) string {
+	return strconv.FormatInt(ts, 10)
+}
+
+// lookupPin returns the package and instance of the pin in the request query.
+//
+// On failure, it returns a reply to send back instead.
+func (s *Server) lookupPin(r *http.Request) (common.Pin, *packageState, *instanceState, reply) {
+	pin := common.Pin{
+		PackageName: r.FormValue("package_name"),
+		InstanceID:  r.FormValue("instance_id"),
+	}
+	if err := common.ValidatePin(pin); err != nil {
+		return pin, nil, nil, errorReply("%s", err)
+	}
+	pkg := s.state.Packages[pin.PackageName]
+	if pkg == nil {
+		return pin, nil, nil, reply{"status": "PACKAGE_NOT_FOUND"}
+	}
+	inst := pkg.Instances[pin.InstanceID]
+	if inst == nil {
+		return pin, pkg, nil, reply{"status": "INSTANCE_NOT_FOUND"}
+	}
+	return pin, pkg, inst, nil
+}
+
+func instanceMsg(pin common.Pin, inst *instanceState) reply {
+	return reply{
+		"package_name":  pin.PackageName,
+		"instance_id":   pin.InstanceID,
+		"registered_by": inst.RegisteredBy,
+		"registered_ts": formatTs(inst.RegisteredTs),
+	}
+}
+
+func (s *Server) resolveVersion(r *http.Request) (reply, bool) {
+	name := r.FormValue("package_name")
+	version := r.FormValue("version")
+	if err := common.ValidatePackageName(name); err != nil {
+		return errorReply("%s", err), false
+	}
+	if err := common.ValidateInstanceVersion(version); err != nil {
+		return errorReply("%s", err), false
+	}
+	pkg := s.state.Packages[name]
+	if pkg == nil {
+		return reply{"status": "PACKAGE_NOT_FOUND"}, false
+	}
+	var ids []string
+	switch {
+	case common.ValidateInstanceID(version) == nil:
+		if pkg.Instances[version] != nil {
+			ids = append(ids, version)
+		}
+	case common.ValidatePackageRef(version) == nil:
+		if ref := pkg.Refs[version]; ref != nil {
+			ids = append(ids, ref.InstanceID)
+		}
+	default:
+		for id, inst := range pkg.Instances {
+			if inst.Tags[version] != nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return reply{"status": "INSTANCE_NOT_FOUND"}, false
+	case 1:
+		return reply{"status": "SUCCESS", "instance_id": ids[0]}, false
+	default:
+		return reply{"status": "AMBIGUOUS_VERSION"}, false
+	}
+}
+
+func (s *Server) searchInstances(r *http.Request) (reply, bool) {
+	tag := r.FormValue("tag")
+	name := r.FormValue("package_name")
+	if err := common.ValidateInstanceTag(tag); err != nil {
+		return errorReply("%s", err), false
+	}
+	instances := []reply{}
+	for _, pkgName := range s.packageNames() {
+		if name != "" && pkgName != name {
+			continue
+		}
+		pkg := s.state.Packages[pkgName]
+		for _, id := range instanceIDs(pkg) {
+			inst := pkg.Instances[id]
+			if inst.Tags[tag] != nil {
+				instances = append(instances, instanceMsg(common.Pin{PackageName: pkgName, InstanceID: id}, inst))
+			}
+		}
+	}
+	return reply{"status": "SUCCESS", "instances": instances}, false
+}
+
+func (s *Server) fetchInstance(r *http.Request) (reply, bool) {
+	pin, _, inst, errReply := s.lookupPin(r)
+	if errReply != nil {
+		return errReply, false
+	}
+	return reply{
+		"status":    "SUCCESS",
+		"instance":  instanceMsg(pin, inst),
+		"fetch_url": baseURL(r) + casFetchPath + pin.InstanceID,
+	}, false
+}
+
+// handleFetchInstanceFiles lists files of an instance, putting their bodies
+// into the CAS.
+//
+// The lock is held only to look up the instance, it is unpacked outside of it.
+// The CAS items never change once stored, so this is safe.
+func (s *Server) handleFetchInstanceFiles(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	pin, _, _, resp := s.lookupPin(r)
+	s.lock.Unlock()
+	if resp == nil {
+		resp = s.fetchInstanceFiles(r, pin)
+	}
+	s.writeReply(w, resp)
+}
+
+func (s *Server) fetchInstanceFiles(r *http.Request, pin common.Pin) reply {
+	inst, err := local.OpenInstanceFile(s.ctx, s.casPath(pin.InstanceID), pin.InstanceID)
+	if err != nil {
+		return errorReply("%s", err)
+	}
+	defer inst.Close()
+	files := []reply{}
+	for _, f := range inst.Files() {
+		msg := reply{
+			"name":       f.Name(),
+			"size":       strconv.FormatUint(f.Size(), 10),
+			"executable": f.Executable(),
+		}
+		if f.Symlink() {
+			target, err := f.SymlinkTarget()
+			if err != nil {
+				return errorReply("%s", err)
+			}
+			msg["symlink"] = target
+		} else {
+			hash, err := s.casStoreFile(f)
+			if err != nil {
+				return errorReply("%s", err)
+			}
+			msg["hash"] = hash
+			msg["fetch_url"] = baseURL(r) + casFetchPath + hash
+		}
+		files = append(files, msg)
+	}
+	return reply{"status": "SUCCESS", "files": files}
+}
+
+func (s *Server) registerInstance(r *http.Request) (reply, bool) {
+	pin, pkg, inst, errReply := s.lookupPin(r)
+	if inst != nil {
+		return reply{"status": "ALREADY_REGISTERED", "instance": instanceMsg(pin, inst)}, false
+	}
+	if errReply != nil && errReply["status"] == "ERROR" {
+		return errReply, false
+	}
+	// The package file must be uploaded first.
+	if !s.casExists(pin.InstanceID) {
+		id := s.newSession(pin.InstanceID)
+		return reply{
+			"status":            "UPLOAD_FIRST",
+			"upload_session_id": id,
+			"upload_url":        baseURL(r) + casUploadPath + id,
+		}, false
+	}
+	if pkg == nil {
+		pkg = &packageState{Instances: map[string]*instanceState{}, Refs: map[string]*refState{}}
+		s.state.Packages[pin.PackageName] = pkg
+	}
+	inst = &instanceState{RegisteredBy: Anonymous, RegisteredTs: s.now(), Tags: map[string]*tagState{}}
+	pkg.Instances[pin.InstanceID] = inst
+	logging.Infof(s.ctx, "cipd: registered %s", pin)
+	return reply{"status": "REGISTERED", "instance": instanceMsg(pin, inst)}, true
+}
+
+func (s *Server) fetchTags(r *http.Request) (reply, bool) {
+	_, _, inst, errReply := s.lookupPin(r)
+	if errReply != nil {
+		return errReply, false
+	}
+	filter := r.Form["tag"]
+	tags := []reply{}
+	for _, tag := range sortedKeys(inst.Tags) {
+		if len(filter) != 0 && !contains(filter, tag) {
+			continue
+		}
+		t := inst.Tags[tag]
+		tags = append(tags, reply{
+			"tag":           tag,
+			"registered_by": t.RegisteredBy,
+			"registered_ts": formatTs(t.RegisteredTs),
+		})
+	}
+	return reply{"status": "SUCCESS", "tags": tags}, false
+}
+
+func (s *Server) attachTags(r *http.Request) (reply, bool) {
+	_, _, inst, errReply := s.lookupPin(r)
+	if errReply != nil {
+		return errReply, false
+	}
+	var request struct {
+		Tags []string `json:"tags"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		return errorReply("bad request: %s", err), false
+	}
+	for _, tag := range request.Tags {
+		if err := common.ValidateInstanceTag(tag); err != nil {
+			return errorReply("%s", err), false
+		}
+	}
+	modified := false
+	for _, tag := range request.Tags {
+		if inst.Tags[tag] == nil {
+			inst.Tags[tag] = &tagState{RegisteredBy: Anonymous, RegisteredTs: s.now()}
+			modified = true
+		}
+	}
+	return reply{"status": "SUCCESS"}, modified
+}
+
+func (s *Server) fetchRefs(r *http.Request) (reply, bool) {
+	pin, pkg, _, errReply := s.lookupPin(r)
+	if errReply != nil {
+		return errReply, false
+	}
+	filter := r.Form["ref"]
+	refs := []reply{}
+	for _, name := range sortedKeys(pkg.Refs) {
+		ref := pkg.Refs[name]
+		if ref.InstanceID != pin.InstanceID || (len(filter) != 0 && !contains(filter, name)) {
+			continue
+		}
+		refs = append(refs, reply{
+			"ref":         name,
+			"modified_by": ref.ModifiedBy,
+			"modified_ts": formatTs(ref.ModifiedTs),
+		})
+	}
+	return reply{"status": "SUCCESS", "refs": refs}, false
+}
+
+func (s *Server) setRef(r *http.Request) (reply, bool) {
+	name := r.FormValue("package_name")
+	ref := r.FormValue("ref")
+	if err := common.ValidatePackageName(name); err != nil {
+		return errorReply("%s", err), false
+	}
+	if err := common.ValidatePackageRef(ref); err != nil {
+		return errorReply("%s", err), false
+	}
+	var request struct {
+		InstanceID string `json:"instance_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		return errorReply("bad request: %s", err), false
+	}
+	pkg := s.state.Packages[name]
+	if pkg == nil || pkg.Instances[request.InstanceID] == nil {
+		return errorReply("instance %s:%s is not registered", name, request.InstanceID), false
+	}
+	pkg.Refs[ref] = &refState{InstanceID: request.InstanceID, ModifiedBy: Anonymous, ModifiedTs: s.now()}
+	return reply{"status": "SUCCESS"}, true
+}
+
+func (s *Server) fetchACL(r *http.Request) (reply, bool) {
+	path := r.FormValue("package_path")
+	if err := common.ValidatePackageName(path); err != nil {
+		return errorReply("%s", err), false
+	}
+	// The ACL of a path is the union of the ACLs of all its parents.
+	acls := []reply{}
+	chunks := strings.Split(path, "/")
+	for i := range chunks {
+		prefix := strings.Join(chunks[:i+1], "/")
+		roles := s.state.ACLs[prefix]
+		for _, role := range sortedKeys(roles) {
+			acl := roles[role]
+			acls = append(acls, reply{
+				"package_path": prefix,
+				"role":         role,
+				"principals":   acl.Principals,
+				"modified_by":  acl.ModifiedBy,
+				"modified_ts":  formatTs(acl.ModifiedTs),
+			})
+		}
+	}
+	return reply{"status": "SUCCESS", "acls": reply{"acls": acls}}, false
+}
+
+func (s *Server) modifyACL(r *http.Request) (reply, bool) {
+	path := r.FormValue("package_path")
+	if err := common.ValidatePackageName(path); err != nil {
+		return errorReply("%s", err), false
+	}
+	var request struct {
+		Changes []struct {
+			Action    string `json:"action"`
+			Role      string `json:"role"`
+			Principal string `json:"principal"`
+		} `json:"changes"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		return errorReply("bad request: %s", err), false
+	}
+	for _, c := range request.Changes {
+		if c.Action != "GRANT" && c.Action != "REVOKE" {
+			return errorReply("unexpected action %q", c.Action), false
+		}
+		if c.Role == "" || c.Principal == "" {
+			return errorReply("role and principal are required"), false
+		}
+	}
+	roles := s.state.ACLs[path]
+	if roles == nil {
+		roles = map[string]*aclState{}
+		s.state.ACLs[path] = roles
+	}
+	now := s.now()
+	for _, c := range request.Changes {
+		acl := roles[c.Role]
+		if acl == nil {
+			acl = &aclState{Principals: []string{}}
+			roles[c.Role] = acl
+		}
+		if c.Action == "GRANT" {
+			if !contains(acl.Principals, c.Principal) {
+				acl.Principals = append(acl.Principals, c.Principal)
+			}
+		} else {
+			acl.Principals = remove(acl.Principals, c.Principal)
+		}
+		acl.ModifiedBy = Anonymous
+		acl.ModifiedTs = now
+	}
+	return reply{"status": "SUCCESS"}, true
+}
+
+func (s *Server) listPackages(r *http.Request) (reply, bool) {
+	path := strings.Trim(r.FormValue("path"), "/")
+	recursive := r.FormValue("recursive") == "true"
+	prefix := ""
+	if path != "" {
+		prefix = path + "/"
+	}
+	packages := []string{}
+	dirs := map[string]bool{}
+	for _, name := range s.packageNames() {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		chunks := strings.Split(name[len(prefix):], "/")
+		if !recursive && len(chunks) > 1 {
+			dirs[prefix+chunks[0]] = true
+			continue
+		}
+		packages = append(packages, name)
+		for i := 1; i < len(chunks); i++ {
+			dirs[prefix+strings.Join(chunks[:i], "/")] = true
+		}
+	}
+	return reply{"status": "SUCCESS", "packages": packages, "directories": sortedKeys(dirs)}, false
+}
+
+func (s *Server) packageNames() []string {
+	return sortedKeys(s.state.Packages)
+}
+
+func instanceIDs(pkg *packageState) []string {
+	return sortedKeys(pkg.Instances)
+}
+
+// sortedKeys returns the sorted keys of a map keyed by strings.
+func sortedKeys(m interface{}) []string {
+	keys := []string{}
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(l []string, s string) bool {
+	for _, i := range l {
+		if i == s {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(l []string, s string) []string {
+	out := []string{}
+	for _, i := range l {
+		if i != s {
+			out = append(out, i)
+		}
+	}
+	return out
+}
@@ -0,0 +1,352 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package localserver
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging/gologger"
+
+	"github.com/luci/luci-go/client/cipd"
+	"github.com/luci/luci-go/client/cipd/common"
+	"github.com/luci/luci-go/client/cipd/local"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestServer(t *testing.T) {
+	ctx := gologger.StdConfig.Use(context.Background())
+
+	Convey("Given a local server", t, func() {
+		tempDir, err := ioutil.TempDir("", "cipd_localserver")
+		So(err, ShouldBeNil)
+		Reset(func() { os.RemoveAll(tempDir) })
+
+		s, err := New(ctx, filepath.Join(tempDir, "repo"))
+		So(err, ShouldBeNil)
+		ts := httptest.NewServer(s)
+		Reset(ts.Close)
+		client := cipd.NewClient(cipd.ClientOptions{
+			ServiceURL: ts.URL,
+			Root:       filepath.Join(tempDir, "site"),
+		})
+
+		inst := buildInstanceInMemory(ctx, "a/b/pkg", []local.File{
+			local.NewTestFile("file", "test data", false),
+		})
+		defer inst.Close()
+		So(client.RegisterInstance(ctx, inst, time.Minute), ShouldBeNil)
+		pin := inst.Pin()
+
+		Convey("Registering twice is fine", func() {
+			So(client.RegisterInstance(ctx, inst, time.Minute), ShouldBeNil)
+		})
+
+		Convey("Resolves refs, tags and instance IDs", func() {
+			So(client.SetRefWhenReady(ctx, "latest", pin), ShouldBeNil)
+			So(client.AttachTagsWhenReady(ctx, pin, []string{"k:v1", "k:v2"}), ShouldBeNil)
+
+			for _, v := range []string{"latest", "k:v1", pin.InstanceID} {
+				resolved, err := client.ResolveVersion(ctx, "a/b/pkg", v)
+				So(err, ShouldBeNil)
+				So(resolved, ShouldResemble, pin)
+			}
+			_, err := client.ResolveVersion(ctx, "a/b/pkg", "k:missing")
+			So(err, ShouldNotBeNil)
+			_, err = client.ResolveVersion(ctx, "a/b/missing", "latest")
+			So(err, ShouldNotBeNil)
+
+			tags, err := client.FetchInstanceTags(ctx, pin, nil)
+			So(err, ShouldBeNil)
+			So(len(tags), ShouldEqual, 2)
+			So([]string{tags[0].Tag, tags[1].Tag}, ShouldContain, "k:v1")
+			So([]string{tags[0].Tag, tags[1].Tag}, ShouldContain, "k:v2")
+			So(tags[0].RegisteredBy, ShouldEqual, Anonymous)
+
+			refs, err := client.FetchInstanceRefs(ctx, pin, nil)
+			So(err, ShouldBeNil)
+			So(len(refs), ShouldEqual, 1)
+			So(refs[0].Ref, ShouldEqual, "latest")
+
+			pins, err := client.SearchInstances(ctx, "k:v2", "")
+			So(err, ShouldBeNil)
+			So(pins, ShouldResemble, []common.Pin{pin})
+		})
+
+		Convey("Second instance makes the tag ambiguous", func() {
+			So(client.AttachTagsWhenReady(ctx, pin, []string{"k:v"}), ShouldBeNil)
+			inst2 := buildInstanceInMemory(ctx, "a/b/pkg", []local.File{
+				local.NewTestFile("file", "other data", false),
+			})
+			defer inst2.Close()
+			So(client.RegisterInstance(ctx, inst2, time.Minute), ShouldBeNil)
+			So(client.AttachTagsWhenReady(ctx, inst2.Pin(), []string{"k:v"}), ShouldBeNil)
+			_, err := client.ResolveVersion(ctx, "a/b/pkg", "k:v")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Fetches and deploys instances", func() {
+			_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, false)
+			So(err, ShouldBeNil)
+			data, err := ioutil.ReadFile(filepath.Join(tempDir, "site", "file"))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "test data")
+
+			info, err := client.FetchInstanceInfo(ctx, pin)
+			So(err, ShouldBeNil)
+			So(info.RegisteredBy, ShouldEqual, Anonymous)
+		})
+
+		Convey("Fetches instances file by file", func() {
+			inst2 := buildInstanceInMemory(ctx, "a/b/pkg", []local.File{
+				local.NewTestFile("file", "test data", false),
+				local.NewTestFile("dir/new", "new data", true),
+				local.NewTestSymlink("link", "file"),
+			})
+			defer inst2.Close()
+			So(client.RegisterInstance(ctx, inst2, time.Minute), ShouldBeNil)
+			_, err := client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, false)
+			So(err, ShouldBeNil)
+
+			// Record which CAS items are fetched, and let tests change the list of
+			// files of the instance.
+			fetched := []string{}
+			var tamper func(files []map[string]interface{}) []map[string]interface{}
+			lock := sync.Mutex{}
+			ts2 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasPrefix(r.URL.Path, casFetchPath):
+					lock.Lock()
+					fetched = append(fetched, r.URL.Path[len(casFetchPath):])
+					lock.Unlock()
+				case strings.HasSuffix(r.URL.Path, "/repo/v1/instance/files") && tamper != nil:
+					rec := httptest.NewRecorder()
+					s.ServeHTTP(rec, r)
+					var reply struct {
+						Status string                   `json:"status"`
+						Files  []map[string]interface{} `json:"files"`
+					}
+					if err := json.Unmarshal(rec.Body.Bytes(), &reply); err != nil {
+						http.Error(w, err.Error(), 500)
+						return
+					}
+					reply.Files = tamper(reply.Files)
+					w.Header().Set("Content-Type", "application/json; charset=utf-8")
+					json.NewEncoder(w).Encode(&reply)
+					return
+				}
+				s.ServeHTTP(w, r)
+			}))
+			defer ts2.Close()
+			deltaClient := cipd.NewClient(cipd.ClientOptions{
+				ServiceURL: ts2.URL,
+				Root:       filepath.Join(tempDir, "site"),
+				CacheDir:   filepath.Join(tempDir, "cache"),
+				DeltaFetch: true,
+			})
+
+			checkDeployed := func() {
+				for name, expected := range map[string]string{"file": "test data", "dir/new": "new data", "link": "test data"} {
+					data, err := ioutil.ReadFile(filepath.Join(tempDir, "site", filepath.FromSlash(name)))
+					So(err, ShouldBeNil)
+					So(string(data), ShouldEqual, expected)
+				}
+				drift, err := deltaClient.VerifyDeployed(ctx, false)
+				So(err, ShouldBeNil)
+				So(drift, ShouldResemble, []cipd.PackageDrift{})
+			}
+
+			Convey("Reusing deployed files", func() {
+				_, err = deltaClient.EnsurePackages(ctx, common.PinSliceBySubdir{"": {inst2.Pin()}}, false)
+				So(err, ShouldBeNil)
+
+				// The whole instance is not fetched, nor is "file" that is deployed.
+				So(fetched, ShouldNotContain, inst2.Pin().InstanceID)
+				So(fetched, ShouldNotContain, sha1Hex("test data"))
+				So(fetched, ShouldContain, sha1Hex("new data"))
+				checkDeployed()
+			})
+
+			Convey("Fetching the whole instance if a file doesn't match its hash", func() {
+				tamper = func(files []map[string]interface{}) []map[string]interface{} {
+					for _, f := range files {
+						if f["name"] == "dir/new" {
+							url := f["fetch_url"].(string)
+							f["fetch_url"] = strings.Replace(url, f["hash"].(string), sha1Hex("test data"), 1)
+						}
+					}
+					return files
+				}
+				_, err = deltaClient.EnsurePackages(ctx, common.PinSliceBySubdir{"": {inst2.Pin()}}, false)
+				So(err, ShouldBeNil)
+
+				So(fetched, ShouldContain, inst2.Pin().InstanceID)
+				checkDeployed()
+			})
+		})
+
+		Convey("Lists packages", func() {
+			inst2 := buildInstanceInMemory(ctx, "a/c", nil)
+			defer inst2.Close()
+			So(client.RegisterInstance(ctx, inst2, time.Minute), ShouldBeNil)
+
+			pkgs, err := client.ListPackages(ctx, "a", false)
+			So(err, ShouldBeNil)
+			So(pkgs, ShouldResemble, []string{"a/b/", "a/c"})
+			pkgs, err = client.ListPackages(ctx, "", true)
+			So(err, ShouldBeNil)
+			So(pkgs, ShouldResemble, []string{"a/", "a/b/", "a/b/pkg", "a/c"})
+		})
+
+		Convey("Modifies ACLs", func() {
+			So(client.ModifyACL(ctx, "a", []cipd.PackageACLChange{
+				{Action: cipd.GrantRole, Role: "READER", Principal: "group:all"},
+				{Action: cipd.GrantRole, Role: "OWNER", Principal: "user:a@example.com"},
+			}), ShouldBeNil)
+			So(client.ModifyACL(ctx, "a/b", []cipd.PackageACLChange{
+				{Action: cipd.GrantRole, Role: "WRITER", Principal: "user:b@example.com"},
+			}), ShouldBeNil)
+			So(client.ModifyACL(ctx, "a", []cipd.PackageACLChange{
+				{Action: cipd.RevokeRole, Role: "OWNER", Principal: "user:a@example.com"},
+			}), ShouldBeNil)
+			acls, err := client.FetchACL(ctx, "a/b/pkg")
+			So(err, ShouldBeNil)
+			So(len(acls), ShouldEqual, 3)
+			So(acls[0].PackagePath, ShouldEqual, "a")
+			So(acls[0].Role, ShouldEqual, "OWNER")
+			So(acls[0].Principals, ShouldResemble, []string{})
+			So(acls[1].Role, ShouldEqual, "READER")
+			So(acls[1].Principals, ShouldResemble, []string{"group:all"})
+			So(acls[2].PackagePath, ShouldEqual, "a/b")
+			So(acls[2].Principals, ShouldResemble, []string{"user:b@example.com"})
+		})
+
+		Convey("Serves other requests while a chunk is being uploaded", func() {
+			data := "chunked data"
+			resp, err := http.Post(ts.URL+apiPath+"cas/v1/upload/SHA1/"+sha1Hex(data), "application/json", nil)
+			So(err, ShouldBeNil)
+			var initiated struct {
+				Status    string `json:"status"`
+				UploadURL string `json:"upload_url"`
+			}
+			err = json.NewDecoder(resp.Body).Decode(&initiated)
+			resp.Body.Close()
+			So(err, ShouldBeNil)
+			So(initiated.Status, ShouldEqual, "SUCCESS")
+
+			put := func(body io.Reader, contentRange string) int {
+				req, err := http.NewRequest("PUT", initiated.UploadURL, body)
+				So(err, ShouldBeNil)
+				req.Header.Set("Content-Range", contentRange)
+				resp, err := http.DefaultClient.Do(req)
+				So(err, ShouldBeNil)
+				resp.Body.Close()
+				return resp.StatusCode
+			}
+
+			pr, pw := io.Pipe()
+			done := make(chan int)
+			go func() {
+				req, _ := http.NewRequest("PUT", initiated.UploadURL, pr)
+				req.Header.Set("Content-Range", fmt.Sprintf("bytes 0-%d/%d", len(data)-1, len(data)))
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					done <- 0
+					return
+				}
+				resp.Body.Close()
+				done <- resp.StatusCode
+			}()
+			_, err = pw.Write([]byte(data[:5]))
+			So(err, ShouldBeNil)
+
+			// Wait for the chunk to start being written.
+			code := 0
+			for i := 0; i < 100 && code != http.StatusConflict; i++ {
+				time.Sleep(10 * time.Millisecond)
+				code = put(nil, fmt.Sprintf("bytes */%d", len(data)))
+			}
+			So(code, ShouldEqual, http.StatusConflict)
+
+			resolved, err := client.ResolveVersion(ctx, "a/b/pkg", pin.InstanceID)
+			So(err, ShouldBeNil)
+			So(resolved, ShouldResemble, pin)
+
+			_, err = pw.Write([]byte(data[5:]))
+			So(err, ShouldBeNil)
+			So(pw.Close(), ShouldBeNil)
+			So(<-done, ShouldEqual, http.StatusOK)
+		})
+
+		Convey("State survives a restart", func() {
+			So(client.SetRefWhenReady(ctx, "latest", pin), ShouldBeNil)
+			s2, err := New(ctx, filepath.Join(tempDir, "repo"))
+			So(err, ShouldBeNil)
+			ts2 := httptest.NewServer(s2)
+			defer ts2.Close()
+			client2 := cipd.NewClient(cipd.ClientOptions{ServiceURL: ts2.URL})
+			resolved, err := client2.ResolveVersion(ctx, "a/b/pkg", "latest")
+			So(err, ShouldBeNil)
+			So(resolved, ShouldResemble, pin)
+		})
+	})
+}
+
+func TestParseContentRange(t *testing.T) {
+	Convey("parseContentRange works", t, func() {
+		first, total, err := parseContentRange("bytes 0-9/10")
+		So(err, ShouldBeNil)
+		So(first, ShouldEqual, 0)
+		So(total, ShouldEqual, 10)
+		first, total, err = parseContentRange("bytes */10")
+		So(err, ShouldBeNil)
+		So(first, ShouldEqual, -1)
+		So(total, ShouldEqual, 10)
+		first, total, err = parseContentRange("bytes 5-9/*")
+		So(err, ShouldBeNil)
+		So(first, ShouldEqual, 5)
+		So(total, ShouldEqual, -1)
+		_, _, err = parseContentRange("5-9/10")
+		So(err, ShouldNotBeNil)
+		_, _, err = parseContentRange("bytes -5/10")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func sha1Hex(data string) string {
+	h := sha1.Sum([]byte(data))
+	return hex.EncodeToString(h[:])
+}
+
+// buildInstanceInMemory makes fully functional PackageInstance object that uses
+// memory buffer as a backing store.
+func buildInstanceInMemory(ctx context.Context, pkgName string, files []local.File) local.PackageInstance {
+	out := bytes.Buffer{}
+	err := local.BuildInstance(ctx, local.BuildInstanceOptions{
+		Input:       files,
+		Output:      &out,
+		PackageName: pkgName,
+	})
+	So(err, ShouldBeNil)
+	inst, err := local.OpenInstance(ctx, bytes.NewReader(out.Bytes()), "")
+	So(err, ShouldBeNil)
+	return inst
+}
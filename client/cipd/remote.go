@@ -23,6 +23,7 @@ import (
 	"github.com/luci/luci-go/common/logging"
 
 	"github.com/luci/luci-go/client/cipd/common"
+	"github.com/luci/luci-go/client/cipd/local"
 )
 
 // remoteMaxRetries is how many times to retry transient HTTP errors.
@@ -324,6 +325,62 @@ func (r *remoteImpl) fetchInstance(ctx context.Context, pin common.Pin) (*fetchI
 	return nil, fmt.Errorf("unexpected reply status: %s", reply.Status)
 }
 
+func (r *remoteImpl) fetchInstanceFiles(ctx context.Context, pin common.Pin) ([]instanceFile, error) {
+	endpoint, err := instanceFilesEndpoint(pin)
+	if err != nil {
+		return nil, err
+	}
+	var reply struct {
+		Status       string `json:"status"`
+		ErrorMessage string `json:"error_message"`
+		Files        []struct {
+			Name       string `json:"name"`
+			Size       string `json:"size"`
+			Executable bool   `json:"executable"`
+			Symlink    string `json:"symlink"`
+			Hash       string `json:"hash"`
+			FetchURL   string `json:"fetch_url"`
+		} `json:"files"`
+	}
+	err = r.makeRequest(ctx, endpoint, "GET", nil, &reply)
+	if err != nil {
+		return nil, err
+	}
+	switch reply.Status {
+	case "SUCCESS":
+		out := make([]instanceFile, len(reply.Files))
+		for i, f := range reply.Files {
+			size, err := strconv.ParseUint(f.Size, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected size value %q in the server response", f.Size)
+			}
+			if f.Symlink == "" {
+				if err := common.ValidateInstanceID(f.Hash); err != nil {
+					return nil, fmt.Errorf("bad hash of %q in the server response: %s", f.Name, err)
+				}
+			}
+			out[i] = instanceFile{
+				FileInfo: local.FileInfo{
+					Name:       f.Name,
+					Size:       size,
+					Executable: f.Executable,
+					Symlink:    f.Symlink,
+					Hash:       f.Hash,
+				},
+				fetchURL: f.FetchURL,
+			}
+		}
+		return out, nil
+	case "PACKAGE_NOT_FOUND":
+		return nil, fmt.Errorf("package %q is not registered", pin.PackageName)
+	case "INSTANCE_NOT_FOUND":
+		return nil, fmt.Errorf("package %q doesn't have instance %q", pin.PackageName, pin.InstanceID)
+	case "ERROR":
+		return nil, errors.New(reply.ErrorMessage)
+	}
+	return nil, fmt.Errorf("unexpected reply status: %s", reply.Status)
+}
+
 func (r *remoteImpl) fetchTags(ctx context.Context, pin common.Pin, tags []string) ([]TagInfo, error) {
 	endpoint, err := tagsEndpoint(pin, tags)
 	if err != nil {
@@ -629,6 +686,16 @@ func instanceEndpoint(pin common.Pin) (string, error) {
 	return "repo/v1/instance?" + params.Encode(), nil
 }
 
+func instanceFilesEndpoint(pin common.Pin) (string, error) {
+	if err := common.ValidatePin(pin); err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	params.Add("package_name", pin.PackageName)
+	params.Add("instance_id", pin.InstanceID)
+	return "repo/v1/instance/files?" + params.Encode(), nil
+}
+
 func aclEndpoint(packagePath string) (string, error) {
 	if err := common.ValidatePackageName(packagePath); err != nil {
 		return "", err
@@ -93,6 +93,9 @@ type installationSiteConfig struct {
 	TrackedVersions map[string]string
 	// CacheDir contains shared cache.
 	CacheDir string
+	// KeepInstances is how many previously installed instances of each package
+	// to keep for 'rollback'.
+	KeepInstances int `json:",omitempty"`
 }
 
 // read loads JSON from given path.
@@ -207,9 +210,10 @@ func (site *installationSite) initClient(ctx context.Context, authFlags authcli.
 		return errors.New("client is already initialized")
 	}
 	clientOpts := ClientOptions{
-		authFlags:  authFlags,
-		serviceURL: site.cfg.ServiceURL,
-		cacheDir:   site.cfg.CacheDir,
+		authFlags:     authFlags,
+		serviceURL:    site.cfg.ServiceURL,
+		cacheDir:      site.cfg.CacheDir,
+		keepInstances: site.cfg.KeepInstances,
 	}
 	site.client, err = clientOpts.makeCipdClient(ctx, site.siteRoot)
 	return err
@@ -243,11 +247,12 @@ func (site *installationSite) installedPackages(ctx context.Context, pkgs []stri
 		}
 		output := make([]pinInfo, len(pins))
 		for i, pin := range pins {
-			cpy := pin
+			cpy := pin.Pin
 			output[i] = pinInfo{
 				Pkg:      pin.PackageName,
 				Pin:      &cpy,
 				Tracking: site.cfg.TrackedVersions[pin.PackageName],
+				Retained: pin.Retained,
 			}
 		}
 		return output, nil
@@ -350,6 +355,30 @@ func (site *installationSite) installPackage(ctx context.Context, pkgName, versi
 	}, nil
 }
 
+// rollbackPackage reinstalls the most recent previously installed instance of
+// a package and pins the package to it. On errors returns (nil, error).
+func (site *installationSite) rollbackPackage(ctx context.Context, pkgName string) (*pinInfo, error) {
+	d := local.NewDeployerWithOptions(site.siteRoot, local.DeployerOptions{
+		KeepInstances: site.cfg.KeepInstances,
+	})
+	pin, err := d.Rollback(ctx, pkgName)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("Package %s is rolled back to %q.\n", pkgName, pin.InstanceID)
+
+	// Stop tracking a version, otherwise the next update would undo the
+	// rollback.
+	err = site.modifyConfig(func(cfg *installationSiteConfig) error {
+		delete(cfg.TrackedVersions, pkgName)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pinInfo{Pkg: pkgName, Pin: &pin}, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Common command line flags.
 
@@ -385,6 +414,7 @@ var cmdInit = &subcommands.Command{
 		c.Flags.BoolVar(&c.force, "force", false, "Create the site root even if the directory is not empty or already under another site root directory.")
 		c.Flags.StringVar(&c.serviceURL, "service-url", "", "URL of a backend to use instead of the default one.")
 		c.Flags.StringVar(&c.cacheDir, "cache-dir", "", "Directory for shared cache")
+		c.Flags.IntVar(&c.keepInstances, "keep-instances", 0, "Number of previously installed instances of each package to keep for 'rollback'.")
 		return c
 	},
 }
@@ -392,9 +422,10 @@ var cmdInit = &subcommands.Command{
 type initRun struct {
 	Subcommand
 
-	force      bool
-	serviceURL string
-	cacheDir   string
+	force         bool
+	serviceURL    string
+	cacheDir      string
+	keepInstances int
 }
 
 func (c *initRun) Run(a subcommands.Application, args []string) int {
@@ -412,6 +443,7 @@ func (c *initRun) Run(a subcommands.Application, args []string) int {
 	err = site.modifyConfig(func(cfg *installationSiteConfig) error {
 		cfg.ServiceURL = c.serviceURL
 		cfg.CacheDir = c.cacheDir
+		cfg.KeepInstances = c.keepInstances
 		return nil
 	})
 	return c.done(site.siteRoot, err)
@@ -509,3 +541,38 @@ func (c *installedRun) Run(a subcommands.Application, args []string) int {
 	ctx := cli.GetContext(a, c)
 	return c.doneWithPins(site.installedPackages(ctx, args))
 }
+
+////////////////////////////////////////////////////////////////////////////////
+// 'rollback' subcommand.
+
+var cmdRollback = &subcommands.Command{
+	UsageLine: "rollback <package> [options]",
+	ShortDesc: "reinstalls the previously installed instance of a package",
+	LongDesc: "Reinstalls the previously installed instance of a package.\n\n" +
+		"Works only if the site root keeps previous instances around, see " +
+		"-keep-instances flag of 'init', 'ensure' and 'pkg-deploy'. Nothing is " +
+		"fetched. The package is pinned to the instance it is rolled back to.",
+	CommandRun: func() subcommands.CommandRun {
+		c := &rollbackRun{}
+		c.registerBaseFlags()
+		c.siteRootOptions.registerFlags(&c.Flags)
+		return c
+	},
+}
+
+type rollbackRun struct {
+	Subcommand
+	siteRootOptions
+}
+
+func (c *rollbackRun) Run(a subcommands.Application, args []string) int {
+	if !c.checkArgs(args, 1, 1) {
+		return 1
+	}
+	site, err := getInstallationSite(c.rootDir)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	ctx := cli.GetContext(a, c)
+	return c.done(site.rollbackPackage(ctx, args[0]))
+}
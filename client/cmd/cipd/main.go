@@ -36,6 +36,7 @@ import (
 	"github.com/luci/luci-go/client/cipd"
 	"github.com/luci/luci-go/client/cipd/common"
 	"github.com/luci/luci-go/client/cipd/local"
+	"github.com/luci/luci-go/client/cipd/localserver"
 	"github.com/luci/luci-go/client/cipd/version"
 )
 
@@ -54,6 +55,9 @@ type pinInfo struct {
 	Tracking string `json:"tracking,omitempty"`
 	// Err is not empty if pin related operation failed. Pin is nil in that case.
 	Err string `json:"error,omitempty"`
+	// Retained is instance IDs of previously installed instances that can be
+	// rolled back to, most recent first.
+	Retained []string `json:"retained,omitempty"`
 }
 
 // describeOutput defines JSON format for 'cipd describe' output.
@@ -227,14 +231,22 @@ func makeCLIError(msg string, args ...interface{}) error {
 // ClientOptions defines command line arguments related to CIPD client creation.
 // Subcommands that need a CIPD client embed it.
 type ClientOptions struct {
-	authFlags  authcli.Flags
-	serviceURL string
-	cacheDir   string
+	authFlags     authcli.Flags
+	serviceURL    string
+	cacheDir      string
+	maxThreads    int
+	keysFile      string
+	keepInstances int
+	deltaFetch    bool
 }
 
 func (opts *ClientOptions) registerFlags(f *flag.FlagSet) {
 	f.StringVar(&opts.serviceURL, "service-url", "", "URL of a backend to use instead of the default one.")
 	f.StringVar(&opts.cacheDir, "cache-dir", "", "Directory for shared cache")
+	f.IntVar(&opts.maxThreads, "max-threads", cipd.DefaultMaxThreads, "Number of packages to fetch and install concurrently.")
+	f.StringVar(&opts.keysFile, "trusted-keys", "", "File with PEM encoded public keys. If set, only packages signed by one of them are installed.")
+	f.IntVar(&opts.keepInstances, "keep-instances", 0, "Number of previously installed instances of each package to keep for 'cipd rollback'.")
+	f.BoolVar(&opts.deltaFetch, "delta-fetch", false, "Fetch packages file by file, reusing files already present in the site root. Only supported by a local CIPD server, ignored with -trusted-keys.")
 	opts.authFlags.Register(f, auth.Options{})
 }
 
@@ -247,12 +259,22 @@ func (opts *ClientOptions) makeCipdClient(ctx context.Context, root string) (cip
 	if err != nil {
 		return nil, err
 	}
+	var keys local.TrustedKeys
+	if opts.keysFile != "" {
+		if keys, err = local.LoadTrustedKeys(opts.keysFile); err != nil {
+			return nil, err
+		}
+	}
 	return cipd.NewClient(cipd.ClientOptions{
 		ServiceURL:          opts.serviceURL,
 		Root:                root,
 		CacheDir:            opts.cacheDir,
 		AuthenticatedClient: client,
 		AnonymousClient:     http.DefaultClient,
+		MaxThreads:          opts.maxThreads,
+		TrustedKeys:         keys,
+		KeepInstances:       opts.keepInstances,
+		DeltaFetch:          opts.deltaFetch,
 	}), nil
 }
 
@@ -296,6 +318,8 @@ type InputOptions struct {
 	packageName string
 	inputDir    string
 	installMode local.InstallMode
+
+	reproducible bool
 }
 
 func (opts *InputOptions) registerFlags(f *flag.FlagSet) {
@@ -310,6 +334,9 @@ func (opts *InputOptions) registerFlags(f *flag.FlagSet) {
 	f.StringVar(&opts.inputDir, "in", "", "Path to a directory with files to package (unused with -pkg-def).")
 	f.Var(&opts.installMode, "install-mode",
 		"How the package should be installed: \"copy\" or \"symlink\" (unused with -pkg-def).")
+
+	f.BoolVar(&opts.reproducible, "reproducible", false,
+		"Build the package so that the same files always produce the same instance ID.")
 }
 
 // prepareInput processes InputOptions by collecting all files to be added to
@@ -337,9 +364,10 @@ func (opts *InputOptions) prepareInput() (local.BuildInstanceOptions, error) {
 			return empty, err
 		}
 		return local.BuildInstanceOptions{
-			Input:       files,
-			PackageName: opts.packageName,
-			InstallMode: opts.installMode,
+			Input:        files,
+			PackageName:  opts.packageName,
+			InstallMode:  opts.installMode,
+			Reproducible: opts.reproducible,
 		}, nil
 	}
 
@@ -371,10 +399,11 @@ func (opts *InputOptions) prepareInput() (local.BuildInstanceOptions, error) {
 			return empty, err
 		}
 		return local.BuildInstanceOptions{
-			Input:       files,
-			PackageName: pkgDef.Package,
-			VersionFile: pkgDef.VersionFile(),
-			InstallMode: pkgDef.InstallMode,
+			Input:        files,
+			PackageName:  pkgDef.Package,
+			VersionFile:  pkgDef.VersionFile(),
+			InstallMode:  pkgDef.InstallMode,
+			Reproducible: opts.reproducible,
 		}, nil
 	}
 
@@ -515,9 +544,9 @@ func performBatchOperation(ctx context.Context, op batchOperation) ([]pinInfo, e
 	return callConcurrently(pkgs, func(pkg string) pinInfo {
 		pin, err := op.callback(pkg)
 		if err != nil {
-			return pinInfo{pkg, nil, "", err.Error()}
+			return pinInfo{Pkg: pkg, Err: err.Error()}
 		}
-		return pinInfo{pkg, &pin, "", ""}
+		return pinInfo{Pkg: pkg, Pin: &pin}
 	}), nil
 }
 
@@ -564,6 +593,9 @@ func printPinsAndError(pins []pinInfo) {
 			} else {
 				fmt.Printf("  %s (tracking %q)\n", p.Pin, p.Tracking)
 			}
+			for _, id := range p.Retained {
+				fmt.Printf("    retained %s\n", id)
+			}
 		}
 	}
 	if hasErrors {
@@ -656,13 +688,21 @@ var cmdEnsure = &subcommands.Command{
 	LongDesc: "Installs, removes and updates packages in one go.\n\n" +
 		"Supposed to be used from scripts and automation. Alternative to 'init', " +
 		"'install' and 'remove'. As such, it doesn't try to discover site root " +
-		"directory on its own.",
+		"directory on its own.\n\n" +
+		"Each line of the list file is either '<package name> <version>' or " +
+		"'@Subdir <path>' to install the following packages into a subdirectory " +
+		"of the site root. Package names can use ${platform}, ${os} and ${arch}.\n\n" +
+		"With -versions-file, the versions are verified against the instance IDs " +
+		"recorded in that file and the command fails if they differ, e.g. because " +
+		"a ref moved. The file is created if it doesn't exist yet.",
 	CommandRun: func() subcommands.CommandRun {
 		c := &ensureRun{}
 		c.registerBaseFlags()
 		c.ClientOptions.registerFlags(&c.Flags)
 		c.Flags.StringVar(&c.rootDir, "root", "<path>", "Path to an installation site root directory.")
 		c.Flags.StringVar(&c.listFile, "list", "<path>", "A file with a list of '<package name> <version>' pairs.")
+		c.Flags.StringVar(&c.versionsFile, "versions-file", "", "A file with the resolved versions to verify against.")
+		c.Flags.BoolVar(&c.updateVersions, "update-versions-file", false, "Overwrite -versions-file with the current versions instead of verifying them.")
 		return c
 	},
 }
@@ -671,33 +711,72 @@ type ensureRun struct {
 	Subcommand
 	ClientOptions
 
-	rootDir  string
-	listFile string
+	rootDir        string
+	listFile       string
+	versionsFile   string
+	updateVersions bool
 }
 
 func (c *ensureRun) Run(a subcommands.Application, args []string) int {
 	if !c.checkArgs(args, 0, 0) {
 		return 1
 	}
+	if c.updateVersions && c.versionsFile == "" {
+		c.printError(makeCLIError("-update-versions-file requires -versions-file"))
+		return 1
+	}
 	ctx := cli.GetContext(a, c)
-	currentPins, _, err := ensurePackages(ctx, c.rootDir, c.listFile, false, c.ClientOptions)
+	opts := ensureOptions{
+		versionsFile:   c.versionsFile,
+		updateVersions: c.updateVersions,
+	}
+	currentPins, _, err := ensurePackages(ctx, c.rootDir, c.listFile, false, c.ClientOptions, opts)
 	return c.done(currentPins, err)
 }
 
-func ensurePackages(ctx context.Context, root string, desiredStateFile string, dryRun bool, clientOpts ClientOptions) ([]common.Pin, cipd.Actions, error) {
+// ensureOptions controls how the versions of an ensure file are verified.
+type ensureOptions struct {
+	// versionsFile is the path to the resolved versions file, if any.
+	versionsFile string
+	// updateVersions is true to overwrite versionsFile instead of verifying it.
+	updateVersions bool
+}
+
+func ensurePackages(ctx context.Context, root string, desiredStateFile string, dryRun bool, clientOpts ClientOptions, opts ensureOptions) (common.PinSliceBySubdir, cipd.ActionMap, error) {
 	f, err := os.Open(desiredStateFile)
 	if err != nil {
-		return nil, cipd.Actions{}, err
+		return nil, nil, err
 	}
 	defer f.Close()
+	ensureFile, err := cipd.ParseEnsureFile(f, cipd.HostTemplateVars())
+	if err != nil {
+		return nil, nil, err
+	}
 	client, err := clientOpts.makeCipdClient(ctx, root)
 	if err != nil {
-		return nil, cipd.Actions{}, err
+		return nil, nil, err
+	}
+	var locked *cipd.ResolvedVersions
+	if opts.versionsFile != "" && !opts.updateVersions {
+		locked, err = cipd.LoadResolvedVersions(opts.versionsFile)
+		if os.IsNotExist(err) {
+			logging.Infof(ctx, "cipd: %s doesn't exist, it will be created", opts.versionsFile)
+			locked, err = nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
 	}
-	desiredState, err := client.ProcessEnsureFile(ctx, f)
+	resolved, err := client.ResolveEnsureFile(ctx, ensureFile, locked)
 	if err != nil {
-		return nil, cipd.Actions{}, err
+		return nil, nil, err
 	}
+	if opts.versionsFile != "" && locked == nil && !dryRun {
+		if err := cipd.SaveResolvedVersions(opts.versionsFile, resolved); err != nil {
+			return nil, nil, err
+		}
+	}
+	desiredState := resolved.Pins()
 	actions, err := client.EnsurePackages(ctx, desiredState, dryRun)
 	if err != nil {
 		return nil, actions, err
@@ -705,6 +784,99 @@ func ensurePackages(ctx context.Context, root string, desiredStateFile string, d
 	return desiredState, actions, nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'verify' and 'repair' subcommands.
+
+var cmdVerify = &subcommands.Command{
+	UsageLine: "verify [options]",
+	ShortDesc: "checks that files in a site root match the deployed packages",
+	LongDesc: "Checks that files in a site root match the deployed packages.\n\n" +
+		"Every file of every package installed in the site root (and its " +
+		"subdirectories, see 'ensure') is compared to the package manifest. " +
+		"Modified, missing, extra files, files with a wrong mode and broken " +
+		"symlinks are reported. Exits with non-zero code if there are any.",
+	CommandRun: func() subcommands.CommandRun {
+		c := &verifyRun{}
+		c.registerBaseFlags()
+		c.ClientOptions.registerFlags(&c.Flags)
+		c.Flags.StringVar(&c.rootDir, "root", "<path>", "Path to an installation site root directory.")
+		return c
+	},
+}
+
+var cmdRepair = &subcommands.Command{
+	UsageLine: "repair [options]",
+	ShortDesc: "redeploys packages whose files in a site root were modified",
+	LongDesc: "Redeploys packages whose files in a site root were modified.\n\n" +
+		"Same as 'verify', but packages that don't match are deployed again " +
+		"using instances from the cache (see -cache-dir). Nothing is fetched " +
+		"from the backend. Exits with non-zero code if some packages can't " +
+		"be repaired.",
+	CommandRun: func() subcommands.CommandRun {
+		c := &verifyRun{repair: true}
+		c.registerBaseFlags()
+		c.ClientOptions.registerFlags(&c.Flags)
+		c.Flags.StringVar(&c.rootDir, "root", "<path>", "Path to an installation site root directory.")
+		return c
+	},
+}
+
+type verifyRun struct {
+	Subcommand
+	ClientOptions
+
+	rootDir string
+	repair  bool
+}
+
+func (c *verifyRun) Run(a subcommands.Application, args []string) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c)
+	drift, err := verifyDeployed(ctx, c.rootDir, c.repair, c.ClientOptions)
+	ret := c.done(drift, err)
+	if ret == 0 {
+		for _, d := range drift {
+			if !d.Repaired {
+				return 1
+			}
+		}
+	}
+	return ret
+}
+
+func verifyDeployed(ctx context.Context, root string, repair bool, clientOpts ClientOptions) ([]cipd.PackageDrift, error) {
+	client, err := clientOpts.makeCipdClient(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	drift, err := client.VerifyDeployed(ctx, repair)
+	if len(drift) == 0 && err == nil {
+		fmt.Println("All packages are intact.")
+	}
+	for _, d := range drift {
+		where := ""
+		if d.Subdir != "" {
+			where = fmt.Sprintf(" in %s", d.Subdir)
+		}
+		fmt.Printf("%s%s:\n", d.Pin, where)
+		if d.Error != "" {
+			fmt.Printf("  can't verify: %s\n", d.Error)
+		}
+		for _, f := range d.Files {
+			fmt.Printf("  %s\n", f)
+		}
+		switch {
+		case d.Repaired:
+			fmt.Println("  repaired")
+		case d.RepairError != "":
+			fmt.Printf("  can't repair: %s\n", d.RepairError)
+		}
+	}
+	return drift, err
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'puppet-check-updates' subcommand.
 
@@ -741,7 +913,7 @@ func (c *checkUpdatesRun) Run(a subcommands.Application, args []string) int {
 		return 1
 	}
 	ctx := cli.GetContext(a, c)
-	_, actions, err := ensurePackages(ctx, c.rootDir, c.listFile, true, c.ClientOptions)
+	_, actions, err := ensurePackages(ctx, c.rootDir, c.listFile, true, c.ClientOptions, ensureOptions{})
 	if err != nil {
 		ret := c.done(actions, err)
 		if errors.IsTransient(err) {
@@ -1396,6 +1568,8 @@ var cmdDeploy = &subcommands.Command{
 		c := &deployRun{}
 		c.registerBaseFlags()
 		c.Flags.StringVar(&c.rootDir, "root", "<path>", "Path to an installation site root directory.")
+		c.Flags.StringVar(&c.keysFile, "trusted-keys", "", "File with PEM encoded public keys. If set, the package must be signed by one of them.")
+		c.Flags.IntVar(&c.keepInstances, "keep-instances", 0, "Number of previously deployed instances of the package to keep for 'cipd rollback'.")
 		return c
 	},
 }
@@ -1403,7 +1577,9 @@ var cmdDeploy = &subcommands.Command{
 type deployRun struct {
 	Subcommand
 
-	rootDir string
+	rootDir       string
+	keysFile      string
+	keepInstances int
 }
 
 func (c *deployRun) Run(a subcommands.Application, args []string) int {
@@ -1411,17 +1587,24 @@ func (c *deployRun) Run(a subcommands.Application, args []string) int {
 		return 1
 	}
 	ctx := cli.GetContext(a, c)
-	return c.done(deployInstanceFile(ctx, c.rootDir, args[0]))
+	return c.done(deployInstanceFile(ctx, c.rootDir, args[0], c.keysFile, c.keepInstances))
 }
 
-func deployInstanceFile(ctx context.Context, root string, instanceFile string) (common.Pin, error) {
+func deployInstanceFile(ctx context.Context, root string, instanceFile string, keysFile string, keepInstances int) (common.Pin, error) {
+	opts := local.DeployerOptions{KeepInstances: keepInstances}
+	if keysFile != "" {
+		var err error
+		if opts.TrustedKeys, err = local.LoadTrustedKeys(keysFile); err != nil {
+			return common.Pin{}, err
+		}
+	}
 	inst, err := local.OpenInstanceFile(ctx, instanceFile, "")
 	if err != nil {
 		return common.Pin{}, err
 	}
 	defer inst.Close()
 	inspectInstance(ctx, inst, false)
-	return local.NewDeployer(root).DeployInstance(ctx, inst)
+	return local.NewDeployerWithOptions(root, opts).DeployInstance(ctx, inst)
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -1535,6 +1718,13 @@ func inspectInstanceFile(ctx context.Context, instanceFile string, listFiles boo
 
 func inspectInstance(ctx context.Context, inst local.PackageInstance, listFiles bool) {
 	fmt.Printf("Instance: %s\n", inst.Pin())
+	if sigs, err := local.Signatures(inst); err != nil {
+		fmt.Printf("Signatures: %s\n", err)
+	} else {
+		for _, sig := range sigs {
+			fmt.Printf("Signed by: %s\n", sig.KeyID)
+		}
+	}
 	if listFiles {
 		fmt.Println("Package files:")
 		for _, f := range inst.Files() {
@@ -1552,6 +1742,74 @@ func inspectInstance(ctx context.Context, inst local.PackageInstance, listFiles
 	}
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'pkg-sign' subcommand.
+
+var cmdSign = &subcommands.Command{
+	UsageLine: "pkg-sign <package instance file> [options]",
+	ShortDesc: "signs a package instance file",
+	LongDesc: "Writes a copy of a *.cipd file with an additional signature.\n\n" +
+		"The key is an ECDSA private key in PEM format, e.g. generated with " +
+		"'openssl ecparam -name prime256v1 -genkey -noout'. Clients that pass the " +
+		"matching public key ('openssl ec -pubout') with -trusted-keys refuse to " +
+		"install packages that are not signed. Signing produces a new package " +
+		"instance, with a different instance ID.",
+	CommandRun: func() subcommands.CommandRun {
+		c := &signRun{}
+		c.registerBaseFlags()
+		c.Flags.StringVar(&c.keyFile, "key", "<path>", "Path to a PEM encoded EC private key.")
+		c.Flags.StringVar(&c.outputFile, "out", "<path>", "Path to a file to write the signed package to.")
+		return c
+	},
+}
+
+type signRun struct {
+	Subcommand
+
+	keyFile    string
+	outputFile string
+}
+
+func (c *signRun) Run(a subcommands.Application, args []string) int {
+	if !c.checkArgs(args, 1, 1) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c)
+	if err := signInstanceFile(ctx, args[0], c.keyFile, c.outputFile); err != nil {
+		return c.done(nil, err)
+	}
+	return c.done(inspectInstanceFile(ctx, c.outputFile, false))
+}
+
+func signInstanceFile(ctx context.Context, instanceFile, keyFile, outputFile string) error {
+	keyData, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return err
+	}
+	key, err := local.ParsePrivateKey(keyData)
+	if err != nil {
+		return err
+	}
+	inst, err := local.OpenInstanceFile(ctx, instanceFile, "")
+	if err != nil {
+		return err
+	}
+	defer inst.Close()
+
+	out, err := os.OpenFile(outputFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	err = local.SignInstance(ctx, inst, key, out)
+	if err2 := out.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		os.Remove(outputFile)
+	}
+	return err
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'pkg-register' subcommand.
 
@@ -1619,6 +1877,51 @@ func registerInstanceFile(ctx context.Context, instanceFile string, opts *regist
 	return inst.Pin(), nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'serve' subcommand.
+
+var cmdServe = &subcommands.Command{
+	UsageLine: "serve [options]",
+	ShortDesc: "runs a local package repository",
+	LongDesc: "Runs a package repository backed by a local directory.\n\n" +
+		"It implements the same protocol as the real backend, so other cipd " +
+		"commands can use it by passing -service-url=http://<address>. Packages " +
+		"can be mirrored into it with 'pkg-fetch' from the real backend followed " +
+		"by 'pkg-register' against the local one. There's no authentication, " +
+		"ACLs are stored but not enforced.",
+	CommandRun: func() subcommands.CommandRun {
+		c := &serveRun{}
+		c.registerBaseFlags()
+		c.Flags.StringVar(&c.rootDir, "root", "<path>", "Path to a directory to store the repository in.")
+		c.Flags.StringVar(&c.address, "address", "localhost:8080", "Address to listen on.")
+		return c
+	},
+}
+
+type serveRun struct {
+	Subcommand
+
+	rootDir string
+	address string
+}
+
+func (c *serveRun) Run(a subcommands.Application, args []string) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c)
+	return c.done(nil, serveRepository(ctx, c.rootDir, c.address))
+}
+
+func serveRepository(ctx context.Context, root, address string) error {
+	s, err := localserver.New(ctx, root)
+	if err != nil {
+		return err
+	}
+	logging.Infof(ctx, "cipd: serving %s on http://%s", root, address)
+	return http.ListenAndServe(address, s)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Main.
 
@@ -1643,6 +1946,7 @@ var application = &cli.Application{
 		cmdInit,
 		cmdInstall,
 		cmdInstalled,
+		cmdRollback,
 
 		// Authentication related commands.
 		authcli.SubcommandInfo(auth.Options{}, "auth-info"),
@@ -1654,6 +1958,8 @@ var application = &cli.Application{
 		cmdSearch,
 		cmdCreate,
 		cmdEnsure,
+		cmdVerify,
+		cmdRepair,
 		cmdResolve,
 		cmdDescribe,
 		cmdSetRef,
@@ -1669,9 +1975,11 @@ var application = &cli.Application{
 		cmdFetch,
 		cmdInspect,
 		cmdRegister,
+		cmdSign,
 
 		// Low level misc commands.
 		cmdPuppetCheckUpdates,
+		cmdServe,
 	},
 }
 
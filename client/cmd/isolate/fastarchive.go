@@ -6,6 +6,7 @@ package main
 
 import (
 	"bytes"
+	"crypto"
 	"errors"
 	"fmt"
 	"hash"
@@ -48,7 +49,7 @@ type ToPush struct {
 func HashFile(is isolatedclient.IsolateServer, _ common.Canceler, src <-chan *ToHash, dst chan<- *ToCheck) {
 	for tohash := range src {
 		fmt.Printf("hashing %s\n", tohash.path)
-		d, _ := isolated.HashFile(tohash.path)
+		d, _ := isolated.HashFile(is.Hash(), tohash.path)
 		f, _ := os.Open(tohash.path)
 		dst <- &ToCheck{
 			digest: d,
@@ -152,11 +153,11 @@ type SmallFilesCollection struct {
 	ar     *ar.Writer
 }
 
-func NewSmallFilesCollection(index int) *SmallFilesCollection {
+func NewSmallFilesCollection(index int, h crypto.Hash) *SmallFilesCollection {
 	var o SmallFilesCollection
 	o.index = index
 	o.buffer = new(bytes.Buffer)
-	o.hash = isolated.GetHash()
+	o.hash = h.New()
 
 	var w io.Writer = o.buffer
 	w = io.MultiWriter(w, o.hash)
@@ -184,16 +185,18 @@ const SMALLFILES_AR_MAXSIZE = 1024 * 1024 * 100 // 100MBytes
 
 type SmallFilesWalkObserver struct {
 	trim              string
+	h                 crypto.Hash
 	chck_chan         chan<- *ToCheck
 	smallfiles_buffer *SmallFilesCollection
 	largefiles_queue  []string
 }
 
-func NewSmallFilesWalkObserver(trim string, chck_chan chan<- *ToCheck) *SmallFilesWalkObserver {
+func NewSmallFilesWalkObserver(trim string, chck_chan chan<- *ToCheck, h crypto.Hash) *SmallFilesWalkObserver {
 	return &SmallFilesWalkObserver{
 		trim:              trim,
+		h:                 h,
 		chck_chan:         chck_chan,
-		smallfiles_buffer: NewSmallFilesCollection(0),
+		smallfiles_buffer: NewSmallFilesCollection(0, h),
 		largefiles_queue:  make([]string, 0),
 	}
 }
@@ -202,7 +205,7 @@ func (s *SmallFilesWalkObserver) SmallFile(name string, alldata []byte) {
 	s.smallfiles_buffer.ar.Add(name[len(s.trim)+1:], alldata)
 	if s.smallfiles_buffer.buffer.Len() > SMALLFILES_AR_MAXSIZE {
 		s.smallfiles_buffer.RequestCheck(s.chck_chan)
-		s.smallfiles_buffer = NewSmallFilesCollection(s.smallfiles_buffer.index + 1)
+		s.smallfiles_buffer = NewSmallFilesCollection(s.smallfiles_buffer.index+1, s.h)
 		if s.smallfiles_buffer.buffer.Len() > 100 {
 			panic("Ahh!")
 		}
@@ -229,7 +232,7 @@ func upload(is isolatedclient.IsolateServer, path string) {
 	go ChckFile(is, canceler, chck_chan, push_chan)
 	go PushFile(is, canceler, push_chan, done_chan)
 
-	obs := NewSmallFilesWalkObserver(path, chck_chan)
+	obs := NewSmallFilesWalkObserver(path, chck_chan, is.Hash())
 	dirtools.WalkNoStat(path, SMALLFILES_MAXSIZE, obs)
 	obs.smallfiles_buffer.RequestCheck(obs.chck_chan)
 
@@ -16,7 +16,7 @@ import (
 
 // version must be updated whenever functional change (behavior, arguments,
 // supported commands) is done.
-const version = "0.3"
+const version = "0.4"
 
 var application = &subcommands.DefaultApplication{
 	Name:  "isolate",
@@ -27,6 +27,7 @@ var application = &subcommands.DefaultApplication{
 		cmdFastArchive,
 		cmdBatchArchive,
 		cmdCheck,
+		cmdRun,
 		subcommands.CmdHelp,
 		authcli.SubcommandInfo(auth.Options{}, "whoami"),
 		authcli.SubcommandLogin(auth.Options{}, "login"),
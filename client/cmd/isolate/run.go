@@ -0,0 +1,154 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/luci/luci-go/client/isolate"
+	"github.com/luci/luci-go/common/cache"
+	"github.com/luci/luci-go/common/units"
+	"github.com/maruel/subcommands"
+)
+
+var cmdRun = &subcommands.Command{
+	UsageLine: "run <options> -- <extra args>",
+	ShortDesc: "maps the tree of a .isolate file in a temporary directory and runs its command.",
+	LongDesc: `Maps all the files listed in the .isolate file in a temporary directory and
+runs the command in it, the same way a Swarming bot would.
+
+The files are hardlinked from a local cache to avoid copying them. Arguments
+after -- are appended to the command. The temporary directory is deleted
+afterward unless -leak-temp-dir is specified.`,
+	CommandRun: func() subcommands.CommandRun {
+		c := runRun{}
+		c.commonFlags.Init()
+		c.isolateFlags.Init(&c.Flags)
+		c.Flags.StringVar(&c.cacheDir, "cache-dir", "", "Directory to use as a local cache; a temporary one is used if not specified")
+		c.Flags.Int64Var(&c.maxCacheSize, "max-cache-size", 20*1024*1024*1024, "Trim the cache if it gets larger than this value, in bytes")
+		c.Flags.IntVar(&c.maxItems, "max-items", 100000, "Maximum number of items to keep in the cache")
+		c.Flags.BoolVar(&c.leakTempDir, "leak-temp-dir", false, "Do not delete the temporary directory after the run, useful for debugging")
+		return &c
+	},
+}
+
+type runRun struct {
+	commonFlags
+	isolateFlags
+	cacheDir     string
+	maxCacheSize int64
+	maxItems     int
+	leakTempDir  bool
+}
+
+func (c *runRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err := c.isolateFlags.Parse(cwd, RequireIsolateFile); err != nil {
+		return err
+	}
+	if c.cacheDir != "" {
+		if c.cacheDir, err = filepath.Abs(c.cacheDir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// main maps the tree and runs the command. It returns the exit code of the
+// command.
+func (c *runRun) main(a subcommands.Application, args []string) (int, error) {
+	tmpDir, err := ioutil.TempDir("", "isolate_run")
+	if err != nil {
+		return 1, err
+	}
+	if c.leakTempDir {
+		fmt.Fprintf(os.Stderr, "Temporary directory: %s\n", tmpDir)
+	} else {
+		defer os.RemoveAll(tmpDir)
+	}
+	cacheDir := c.cacheDir
+	if cacheDir == "" {
+		cacheDir = filepath.Join(tmpDir, "cache")
+	}
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		return 1, err
+	}
+	// The cache is local only, so the hashing algorithm doesn't need to match
+	// any isolate server namespace.
+	h := crypto.SHA1
+	// An error loading the previous cache state is not fatal, the cache starts
+	// empty in that case.
+	diskCache, err := cache.NewDisk(cache.Policies{MaxSize: units.Size(c.maxCacheSize), MaxItems: c.maxItems}, cacheDir, h)
+	if diskCache == nil {
+		return 1, fmt.Errorf("failed to open the cache: %s", err)
+	}
+	outDir := filepath.Join(tmpDir, "out")
+	i, err := isolate.MapTree(diskCache, h, &c.ArchiveOptions, outDir)
+	if err2 := diskCache.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		return 1, err
+	}
+
+	command := append(i.Command, args...)
+	if len(command) == 0 {
+		return 1, errors.New("no command to run")
+	}
+	cwd := filepath.Join(outDir, i.RelativeCwd)
+	if !filepath.IsAbs(command[0]) && filepath.Base(command[0]) != command[0] {
+		command[0] = filepath.Join(cwd, command[0])
+	}
+	if !c.defaultFlags.Quiet {
+		fmt.Fprintf(os.Stderr, "Running: %s\n", strings.Join(command, " "))
+		fmt.Fprintf(os.Stderr, "In:      %s\n", cwd)
+	}
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Dir = cwd
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+				return status.ExitStatus(), nil
+			}
+		}
+		return 1, err
+	}
+	return 0, nil
+}
+
+func (c *runRun) Run(a subcommands.Application, args []string) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	exitCode, err := c.main(a, args)
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+	}
+	return exitCode
+}
@@ -0,0 +1,196 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/luci/luci-go/client/downloader"
+	"github.com/luci/luci-go/client/internal/common"
+	"github.com/luci/luci-go/client/isolatedclient"
+	"github.com/luci/luci-go/common/cache"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/maruel/subcommands"
+)
+
+var cmdDiff = &subcommands.Command{
+	UsageLine: "diff <options> <old> <new>",
+	ShortDesc: "compares two .isolated trees on an isolate server.",
+	LongDesc: `Fetches two .isolated files and all their includes from the isolate server and
+prints the difference between the two trees.
+
+Each differing path is printed on its own line, prefixed with '+' when added,
+'-' when removed and '~' when changed. The content of the files themselves is
+not fetched, only their digests are compared. Use -json to get the difference
+in a machine readable format.
+
+The exit code is 0 if the trees are equivalent, 1 if they differ and 2 on
+error.`,
+	CommandRun: func() subcommands.CommandRun {
+		c := diffRun{}
+		c.commonFlags.Init()
+		c.Flags.BoolVar(&c.json, "json", false, "Print the difference as JSON")
+		return &c
+	},
+}
+
+type diffRun struct {
+	commonFlags
+	json bool
+}
+
+func (c *diffRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if len(args) != 2 {
+		return errors.New("must specify the hashes of the two .isolated files to compare")
+	}
+	h := isolated.GetHash(c.isolatedFlags.Namespace)
+	for _, arg := range args {
+		if !isolated.HexDigest(arg).Validate(h) {
+			return fmt.Errorf("invalid hash %q", arg)
+		}
+	}
+	return nil
+}
+
+// main fetches both trees and prints their difference. It returns true if the
+// trees differ.
+func (c *diffRun) main(a subcommands.Application, args []string) (bool, error) {
+	is := isolatedclient.New(c.createClient(), c.isolatedFlags.ServerURL, c.isolatedFlags.Namespace)
+	// Only .isolated files are fetched, there is no need for a persistent cache.
+	d := downloader.New(is, cache.NewMemory(cache.Policies{}, is.Hash()))
+	common.CancelOnCtrlC(d)
+	before, err := d.FetchTree(isolated.HexDigest(args[0]))
+	var after *isolated.Isolated
+	if err == nil {
+		after, err = d.FetchTree(isolated.HexDigest(args[1]))
+	}
+	if err2 := d.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		return false, err
+	}
+	diff := isolated.NewDiff(before, after)
+	if c.json {
+		b, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return false, err
+		}
+		_, err = fmt.Fprintf(os.Stdout, "%s\n", b)
+		return !diff.Empty(), err
+	}
+	printDiff(os.Stdout, diff)
+	return !diff.Empty(), nil
+}
+
+func (c *diffRun) Run(a subcommands.Application, args []string) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 2
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 2
+	}
+	defer cl.Close()
+	differ, err := c.main(a, args)
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 2
+	}
+	if differ {
+		return 1
+	}
+	return 0
+}
+
+// printDiff prints a human readable version of d to w.
+func printDiff(w io.Writer, d *isolated.Diff) {
+	for _, p := range d.Paths() {
+		if f, ok := d.Added[p]; ok {
+			fmt.Fprintf(w, "+ %s %s\n", p, describeFile(f))
+		} else if f, ok := d.Removed[p]; ok {
+			fmt.Fprintf(w, "- %s %s\n", p, describeFile(f))
+		} else {
+			fmt.Fprintf(w, "~ %s %s\n", p, describeChange(d.Changed[p]))
+		}
+	}
+	for _, h := range d.IncludesAdded {
+		fmt.Fprintf(w, "+ includes %s\n", h)
+	}
+	for _, h := range d.IncludesRemoved {
+		fmt.Fprintf(w, "- includes %s\n", h)
+	}
+	if d.OldCommand != nil || d.NewCommand != nil {
+		fmt.Fprintf(w, "~ command %q -> %q\n", strings.Join(d.OldCommand, " "), strings.Join(d.NewCommand, " "))
+	}
+	if d.OldRelativeCwd != nil {
+		fmt.Fprintf(w, "~ relative_cwd %q -> %q\n", *d.OldRelativeCwd, *d.NewRelativeCwd)
+	}
+	if d.OldReadOnly != nil || d.NewReadOnly != nil {
+		fmt.Fprintf(w, "~ read_only %s -> %s\n", describeReadOnly(d.OldReadOnly), describeReadOnly(d.NewReadOnly))
+	}
+}
+
+func describeFile(f isolated.File) string {
+	if f.Link != nil {
+		return fmt.Sprintf("-> %s", *f.Link)
+	}
+	out := string(f.Digest)
+	if f.Size != nil {
+		out += fmt.Sprintf(" %d bytes", *f.Size)
+	}
+	if f.Mode != nil {
+		out += fmt.Sprintf(" mode %#o", *f.Mode)
+	}
+	return out
+}
+
+func describeChange(c isolated.FileChange) string {
+	if c.Link && (c.Old.Link == nil || c.New.Link == nil) {
+		return fmt.Sprintf("%s => %s", describeFile(c.Old), describeFile(c.New))
+	}
+	var parts []string
+	if c.Link {
+		parts = append(parts, fmt.Sprintf("link %s -> %s", *c.Old.Link, *c.New.Link))
+	}
+	if c.Content {
+		parts = append(parts, fmt.Sprintf("content %s -> %s", describeContent(c.Old), describeContent(c.New)))
+	}
+	if c.Mode {
+		parts = append(parts, fmt.Sprintf("mode %s -> %s", describeMode(c.Old.Mode), describeMode(c.New.Mode)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func describeContent(f isolated.File) string {
+	if f.Size == nil {
+		return string(f.Digest)
+	}
+	return fmt.Sprintf("%s (%d bytes)", f.Digest, *f.Size)
+}
+
+func describeMode(m *int) string {
+	if m == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%#o", *m)
+}
+
+func describeReadOnly(r *isolated.ReadOnlyValue) string {
+	if r == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%d", *r)
+}
@@ -7,25 +7,48 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/luci/luci-go/client/downloader"
+	"github.com/luci/luci-go/client/internal/common"
+	"github.com/luci/luci-go/client/isolatedclient"
+	"github.com/luci/luci-go/common/cache"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/units"
 	"github.com/maruel/subcommands"
 )
 
 var cmdDownload = &subcommands.Command{
 	UsageLine: "download <options>...",
-	ShortDesc: "downloads a file or a .isolated tree from an isolate server.",
-	LongDesc: `Downloads one or multiple files, or a isolated tree from the isolate server.
+	ShortDesc: "downloads a .isolated tree from an isolate server.",
+	LongDesc: `Downloads a .isolated tree from the isolate server and maps it in a directory.
 
-Files are referenced by their hash`,
+The tree is referenced by the hash of its .isolated file. Files are kept in a
+local cache so subsequent downloads only fetch the items that changed.`,
 	CommandRun: func() subcommands.CommandRun {
 		c := downloadRun{}
 		c.commonFlags.Init()
+		c.Flags.StringVar(&c.isolated, "isolated", "", "Hash of the .isolated file to download")
+		c.Flags.StringVar(&c.isolated, "s", "", "Alias for -isolated")
+		c.Flags.StringVar(&c.outputDir, "output-dir", "", "Directory to map the isolated tree into")
+		c.Flags.StringVar(&c.outputDir, "t", "", "Alias for -output-dir")
+		c.Flags.StringVar(&c.cacheDir, "cache-dir", "", "Directory to use as a local cache; a temporary one is used if not specified")
+		c.Flags.Int64Var(&c.maxCacheSize, "max-cache-size", 20*1024*1024*1024, "Trim the cache if it gets larger than this value, in bytes")
+		c.Flags.IntVar(&c.maxItems, "max-items", 100000, "Maximum number of items to keep in the cache")
 		return &c
 	},
 }
 
 type downloadRun struct {
 	commonFlags
+	isolated     string
+	outputDir    string
+	cacheDir     string
+	maxCacheSize int64
+	maxItems     int
 }
 
 func (c *downloadRun) Parse(a subcommands.Application, args []string) error {
@@ -35,11 +58,58 @@ func (c *downloadRun) Parse(a subcommands.Application, args []string) error {
 	if len(args) != 0 {
 		return errors.New("position arguments not expected")
 	}
+	if !isolated.HexDigest(c.isolated).Validate(isolated.GetHash(c.isolatedFlags.Namespace)) {
+		return errors.New("-isolated must be a valid hash")
+	}
+	if c.outputDir == "" {
+		return errors.New("-output-dir must be specified")
+	}
+	var err error
+	if c.outputDir, err = filepath.Abs(c.outputDir); err != nil {
+		return err
+	}
+	if c.cacheDir != "" {
+		if c.cacheDir, err = filepath.Abs(c.cacheDir); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (c *downloadRun) main(a subcommands.Application, args []string) error {
-	return errors.New("TODO")
+	start := time.Now()
+	cacheDir := c.cacheDir
+	if cacheDir == "" {
+		tmp, err := ioutil.TempDir("", "isolated")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		cacheDir = tmp
+	} else if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		return err
+	}
+	// An error loading the previous cache state is not fatal, the cache starts
+	// empty in that case.
+	is := isolatedclient.New(c.createClient(), c.isolatedFlags.ServerURL, c.isolatedFlags.Namespace)
+	diskCache, err := cache.NewDisk(cache.Policies{MaxSize: units.Size(c.maxCacheSize), MaxItems: c.maxItems}, cacheDir, is.Hash())
+	if diskCache == nil {
+		return fmt.Errorf("failed to open the cache: %s", err)
+	}
+
+	d := downloader.New(is, diskCache)
+	common.CancelOnCtrlC(d)
+	_, err = d.FetchIsolated(isolated.HexDigest(c.isolated), c.outputDir)
+	if err2 := d.Close(); err == nil {
+		err = err2
+	}
+	if err2 := diskCache.Close(); err == nil {
+		err = err2
+	}
+	if !c.defaultFlags.Quiet {
+		fmt.Fprintf(os.Stderr, "Duration: %s\n", units.Round(time.Since(start), time.Millisecond))
+	}
+	return err
 }
 
 func (c *downloadRun) Run(a subcommands.Application, args []string) int {
@@ -16,7 +16,7 @@ import (
 
 // version must be updated whenever functional change (behavior, arguments,
 // supported commands) is done.
-const version = "0.2"
+const version = "0.4"
 
 var opts = auth.Options{}
 
@@ -26,6 +26,7 @@ var application = &subcommands.DefaultApplication{
 	// Keep in alphabetical order of their name.
 	Commands: []*subcommands.Command{
 		cmdArchive,
+		cmdDiff,
 		cmdDownload,
 		subcommands.CmdHelp,
 		authcli.SubcommandInfo(opts, "info"),
@@ -22,6 +22,8 @@ import (
 	"github.com/luci/luci-go/client/authcli"
 	"github.com/luci/luci-go/client/internal/logdog/butler"
 	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	"github.com/luci/luci-go/client/internal/logdog/butler/output/fanout"
+	"github.com/luci/luci-go/client/internal/logdog/butler/spool"
 	"github.com/luci/luci-go/common/auth"
 	"github.com/luci/luci-go/common/cli"
 	"github.com/luci/luci-go/common/clock/clockflag"
@@ -46,6 +48,10 @@ const (
 	// or a configuration error, standard Butler return codes (likely to overlap
 	// with standard process return codes) will be used.
 	runtimeErrorReturnCode = 250
+
+	// defaultSpoolRetryTimeout is the default amount of time that a message will
+	// be retried before it is spooled.
+	defaultSpoolRetryTimeout = time.Minute
 )
 
 // application is the Butler application instance and its runtime configuration
@@ -59,11 +65,16 @@ type application struct {
 	outputWorkers int
 	outputConfig  outputConfigFlag
 
+	requireAllOutputs bool
+
 	authFlags authcli.Flags
 
 	maxBufferAge clockflag.Duration
 	noBufferLogs bool
 
+	spoolDir          string
+	spoolRetryTimeout clockflag.Duration
+
 	cpuProfile string
 
 	client *http.Client
@@ -86,13 +97,18 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 	}
 
 	a.maxBufferAge = clockflag.Duration(butler.DefaultMaxBufferAge)
+	a.spoolRetryTimeout = clockflag.Duration(defaultSpoolRetryTimeout)
 
 	fs.Var(&a.project, "project",
 		"The log prefix's project name (required).")
 	fs.Var(&a.prefix, "prefix",
 		"Prefix to apply to all stream names.")
 	fs.Var(&a.outputConfig, "output",
-		"The output name and configuration. Specify 'help' for more information.")
+		"The output name and configuration. Specify 'help' for more information. May be specified "+
+			"multiple times to send logs to multiple outputs.")
+	fs.BoolVar(&a.requireAllOutputs, "require-all-outputs", false,
+		"If multiple outputs are specified, the Butler fails if any output fails to send logs. By "+
+			"default, only the first output's failures fail the Butler, and the others are best-effort.")
 	fs.StringVar(&a.cpuProfile,
 		"cpuprofile", "", "If specified, enables CPU profiling and profiles to the specified path.")
 	fs.IntVar(&a.outputWorkers, "output-workers", butler.DefaultOutputWorkers,
@@ -102,6 +118,12 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&a.noBufferLogs, "output-no-buffer", false,
 		"If true, dispatch logs immediately. Setting this flag simplifies output at the expense "+
 			"of wire-format efficiency.")
+	fs.StringVar(&a.spoolDir, "spool-dir", "",
+		"If specified, messages that can't be sent are spooled to this directory and resent once "+
+			"the output recovers. Messages that are still spooled when the Butler exits can be sent "+
+			"later with the 'replay' subcommand. Only a single -output may be used when spooling.")
+	fs.Var(&a.spoolRetryTimeout, "spool-retry-timeout",
+		"If spooling, the amount of time to retry sending a message before spooling it.")
 }
 
 func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, error) {
@@ -113,17 +135,61 @@ func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, e
 }
 
 func (a *application) configOutput() (output.Output, error) {
-	factory := a.outputConfig.getFactory()
-	if factory == nil {
+	factories := a.outputConfig.getFactories()
+	if len(factories) == 0 {
 		return nil, errors.New("main: No output is configured")
 	}
 
-	output, err := factory.configOutput(a)
-	if err != nil {
-		return nil, err
+	// Spooled messages belong to the prefix that they were generated under, so
+	// a spool directory must be replayed before it can be used again. They are
+	// also replayed to every output, which would duplicate the messages that
+	// the other outputs already received.
+	if a.spoolDir != "" {
+		if len(factories) > 1 {
+			return nil, errors.New("main: -spool-dir can't be used with multiple outputs")
+		}
+
+		sp, err := spool.New(a, a.spoolDir)
+		if err != nil {
+			return nil, err
+		}
+		pending := sp.Pending()
+		sp.Close()
+
+		if pending > 0 {
+			log.Fields{
+				"spoolDir": a.spoolDir,
+				"pending":  pending,
+			}.Errorf(a, "Spool directory contains unsent messages. Send them with the 'replay' subcommand.")
+			return nil, errors.New("main: spool directory is not empty")
+		}
+	}
+
+	outputs := make([]output.Output, 0, len(factories))
+	for _, factory := range factories {
+		o, err := factory.configOutput(a)
+		if err != nil {
+			for _, o := range outputs {
+				o.Close()
+			}
+			return nil, err
+		}
+		outputs = append(outputs, o)
+	}
+	if len(outputs) == 1 {
+		return outputs[0], nil
 	}
 
-	return output, nil
+	// Fan out to all of our outputs. The first output is the primary output;
+	// unless all outputs are required, failures of the others are ignored.
+	sinks := make([]fanout.Sink, len(outputs))
+	for i, o := range outputs {
+		sinks[i] = fanout.Sink{
+			Output:   o,
+			Required: i == 0 || a.requireAllOutputs,
+		}
+	}
+	return fanout.New(a, sinks...), nil
 }
 
 // runWithButler is an execution harness that adds application-level management
@@ -149,6 +215,7 @@ func (a *application) runWithButler(out output.Output, runFunc func(b *butler.Bu
 		OutputWorkers: a.outputWorkers,
 		TeeStdout:     os.Stdout,
 		TeeStderr:     os.Stderr,
+		SpoolDir:      a.spoolDir,
 	}
 	b, err := butler.New(a, butlerOpts)
 	if err != nil {
@@ -215,6 +282,7 @@ func mainImpl(ctx context.Context, argv []string) int {
 				subcommandRun,
 				subcommandStream,
 				subcommandServe,
+				subcommandReplay,
 
 				authcli.SubcommandLogin(authOptions, "auth-login"),
 				authcli.SubcommandLogout(authOptions, "auth-logout"),
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/luci/luci-go/client/internal/logdog/butler/output"
@@ -18,8 +19,34 @@ type outputFactory interface {
 }
 
 // outputConfigFlag instance that produces a MessageOutput instance when run.
+//
+// The flag may be specified multiple times to select multiple outputs.
 type outputConfigFlag struct {
 	multiflag.MultiFlag
+
+	// factories is the list of selected output factories, in the order that they
+	// were specified.
+	factories []outputFactory
+}
+
+// Set implements flag.Value. Each time the flag is set, the selected output is
+// added to the list of selected outputs.
+func (ocf *outputConfigFlag) Set(value string) error {
+	if err := ocf.MultiFlag.Set(value); err != nil {
+		return err
+	}
+
+	f := ocf.getFactory()
+	if f == nil {
+		return nil
+	}
+	for _, sf := range ocf.factories {
+		if sf == f {
+			return fmt.Errorf("output %q may only be specified once", ocf.Selected.Descriptor().Name)
+		}
+	}
+	ocf.factories = append(ocf.factories, f)
+	return nil
 }
 
 // Adds an output factory to this outputConfigFlag instance.
@@ -39,6 +66,12 @@ func (ocf *outputConfigFlag) getFactory() outputFactory {
 	return nil
 }
 
+// Returns the Factories associated with each configured output, in the order
+// that they were specified.
+func (ocf *outputConfigFlag) getFactories() []outputFactory {
+	return ocf.factories
+}
+
 // outputOption is a multiflag.Option extension that records its Factory when
 // chosen.
 type outputOption struct {
@@ -7,11 +7,13 @@ package main
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"runtime"
 	"strings"
 	"time"
 
 	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	directOut "github.com/luci/luci-go/client/internal/logdog/butler/output/direct"
 	out "github.com/luci/luci-go/client/internal/logdog/butler/output/pubsub"
 	api "github.com/luci/luci-go/common/api/logdog_coordinator/registration/v1"
 	"github.com/luci/luci-go/common/auth"
@@ -108,10 +110,59 @@ func (f *logdogOutputFactory) configOutput(a *application) (output.Output, error
 	log.Fields{
 		"prefix":      a.prefix,
 		"bundleTopic": resp.LogBundleTopic,
+		"bundleURL":   resp.LogBundleUrl,
 	}.Debugf(a, "Successfully registered log stream prefix.")
 
+	reg := spoolRegistration{
+		Host:           f.host,
+		Project:        string(a.project),
+		Prefix:         string(a.prefix),
+		Secret:         resp.Secret,
+		LogBundleTopic: resp.LogBundleTopic,
+		LogBundleURL:   resp.LogBundleUrl,
+	}
+
+	// If we're spooling, record our registration so that spooled bundles can be
+	// replayed by a later Butler instance.
+	if a.spoolDir != "" {
+		if err := reg.write(a.spoolDir); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"spoolDir":   a.spoolDir,
+			}.Errorf(a, "Failed to record registration in spool directory.")
+			return nil, err
+		}
+	}
+
+	return newLogDogOutput(a, authenticator, httpClient, &reg, f.track)
+}
+
+// newLogDogOutput instantiates an Output that sends bundles to the transport
+// described by a prefix registration.
+func newLogDogOutput(a *application, authenticator *auth.Authenticator, httpClient *http.Client,
+	reg *spoolRegistration, track bool) (output.Output, error) {
+	// When spooling, bundles that can't be sent in a timely manner are spooled
+	// rather than retried indefinitely.
+	var retryTimeout time.Duration
+	if a.spoolDir != "" {
+		retryTimeout = time.Duration(a.spoolRetryTimeout)
+	}
+
+	// If the service uses a direct transport, send bundles straight to its
+	// Collector.
+	if reg.LogBundleURL != "" {
+		return directOut.New(a.ncCtx, directOut.Config{
+			URL:          reg.LogBundleURL,
+			Client:       httpClient,
+			Secret:       reg.Secret,
+			Compress:     true,
+			Track:        track,
+			RetryTimeout: retryTimeout,
+		}), nil
+	}
+
 	// Validate the response topic.
-	fullTopic := ps.Topic(resp.LogBundleTopic)
+	fullTopic := ps.Topic(reg.LogBundleTopic)
 	if err := fullTopic.Validate(); err != nil {
 		log.Fields{
 			log.ErrorKey: err,
@@ -161,10 +212,11 @@ func (f *logdogOutputFactory) configOutput(a *application) (output.Output, error
 	//
 	// Note that we use our non-cancelling context here.
 	return out.New(a.ncCtx, out.Config{
-		Topic:    psTopic,
-		Secret:   resp.Secret,
-		Compress: true,
-		Track:    f.track,
+		Topic:        psTopic,
+		Secret:       reg.Secret,
+		Compress:     true,
+		Track:        track,
+		RetryTimeout: retryTimeout,
 	}), nil
 }
 
@@ -0,0 +1,71 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// spoolRegistrationFileName is the name of the file in a spool directory that
+// records the prefix registration that its bundles belong to.
+const spoolRegistrationFileName = "registration.json"
+
+// spoolRegistration is the LogDog prefix registration that a spool
+// directory's bundles were generated under. It contains everything needed to
+// send those bundles without registering the prefix again.
+type spoolRegistration struct {
+	// Host is the LogDog Coordinator host that the prefix was registered with.
+	Host string `json:"host"`
+	// Project is the prefix's project.
+	Project string `json:"project,omitempty"`
+	// Prefix is the registered prefix.
+	Prefix string `json:"prefix"`
+	// Secret is the prefix secret.
+	Secret []byte `json:"secret"`
+
+	// LogBundleTopic is the Pub/Sub topic to publish bundles to.
+	LogBundleTopic string `json:"logBundleTopic,omitempty"`
+	// LogBundleURL is the Collector URL to send bundles to.
+	LogBundleURL string `json:"logBundleURL,omitempty"`
+}
+
+// write writes the registration to the spool directory, replacing any
+// registration that is already there.
+//
+// The registration contains the prefix secret, so it is only readable by the
+// current user.
+func (r *spoolRegistration) write(dir string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, spoolRegistrationFileName)
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// loadSpoolRegistration loads the registration from a spool directory.
+func loadSpoolRegistration(dir string) (*spoolRegistration, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, spoolRegistrationFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var r spoolRegistration
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
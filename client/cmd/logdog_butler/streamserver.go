@@ -6,9 +6,18 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"net"
 	"strings"
+
+	"github.com/luci/luci-go/client/internal/logdog/butler/streamserver"
+	"golang.org/x/net/context"
 )
 
+// tcpStreamServerScheme is the URI scheme of a TCP stream server, e.g.,
+// "tcp:127.0.0.1:0". It is supported on all platforms.
+const tcpStreamServerScheme = "tcp"
+
 var (
 	// (*streamServerURI) must implement flag.Value.
 	_ = flag.Value((*streamServerURI)(nil))
@@ -29,6 +38,49 @@ func (u *streamServerURI) Set(v string) error {
 	return nil
 }
 
+// Validate validates that the URI is correct for the current platform.
+func (u streamServerURI) Validate() error {
+	if typ, value := parseStreamServer(string(u)); typ == tcpStreamServerScheme {
+		if _, _, err := net.SplitHostPort(value); err != nil {
+			return fmt.Errorf("invalid TCP stream server address: %s", err)
+		}
+		return nil
+	}
+	_, err := u.Parse()
+	return err
+}
+
+// createStreamServer creates the stream server described by a valid URI.
+func createStreamServer(ctx context.Context, uri streamServerURI) streamserver.StreamServer {
+	if typ, value := parseStreamServer(string(uri)); typ == tcpStreamServerScheme {
+		return streamserver.NewTCPServer(ctx, value)
+	}
+	return createNamedPipeServer(ctx, uri)
+}
+
+// streamServerClientPath returns the path that clients use to connect to the
+// listening stream server 's', created from 'uri'.
+//
+// TCP stream server paths include the secret generated by the server when it
+// starts listening.
+func streamServerClientPath(uri streamServerURI, s streamserver.StreamServer) string {
+	if ts, ok := s.(*streamserver.TCPServer); ok {
+		return ts.ClientPath()
+	}
+	return string(uri)
+}
+
+// redactStreamServerClientPath returns a stream server client path with the
+// secret of a TCP stream server path removed, so that it can be logged.
+func redactStreamServerClientPath(path string) string {
+	if typ, value := parseStreamServer(path); typ == tcpStreamServerScheme {
+		if idx := strings.Index(value, "@"); idx >= 0 {
+			return fmt.Sprintf("%s:<redacted>%s", typ, value[idx:])
+		}
+	}
+	return path
+}
+
 func parseStreamServer(v string) (string, string) {
 	parts := strings.SplitN(v, ":", 2)
 	if len(parts) == 1 {
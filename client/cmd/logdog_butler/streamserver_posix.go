@@ -32,14 +32,8 @@ func (u streamServerURI) Parse() (string, error) {
 	return value, nil
 }
 
-// Validates that the URI is correct for Windows.
-func (u streamServerURI) Validate() (err error) {
-	_, err = u.Parse()
-	return
-}
-
 // Create a POSIX (UNIX named pipe) stream server
-func createStreamServer(ctx context.Context, uri streamServerURI) streamserver.StreamServer {
+func createNamedPipeServer(ctx context.Context, uri streamServerURI) streamserver.StreamServer {
 	path, err := uri.Parse()
 	if err != nil {
 		panic("Failed to parse stream server URI.")
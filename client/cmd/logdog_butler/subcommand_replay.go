@@ -0,0 +1,96 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/luci/luci-go/client/internal/logdog/butler/spool"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/maruel/subcommands"
+)
+
+var subcommandReplay = &subcommands.Command{
+	UsageLine: "replay",
+	ShortDesc: "Sends log bundles that a previous Butler run spooled.",
+	LongDesc: "Sends the log bundles in the spool directory (-spool-dir) to the LogDog service " +
+		"that their prefix was registered with. Use this to send logs that a Butler could not " +
+		"send before it exited, e.g. after the machine that it ran on is rebooted.",
+	CommandRun: func() subcommands.CommandRun {
+		cmd := &replayCommandRun{}
+
+		cmd.Flags.BoolVar(&cmd.track, "track", false,
+			"Track each sent message. This adds CPU/memory overhead.")
+		return cmd
+	},
+}
+
+type replayCommandRun struct {
+	subcommands.CommandRunBase
+
+	track bool
+}
+
+func (cmd *replayCommandRun) Run(app subcommands.Application, args []string) int {
+	a := app.(*application)
+
+	if a.spoolDir == "" {
+		log.Errorf(a, "A spool directory (-spool-dir) is required.")
+		return configErrorReturnCode
+	}
+
+	reg, err := loadSpoolRegistration(a.spoolDir)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"spoolDir":   a.spoolDir,
+		}.Errorf(a, "Failed to load registration from spool directory.")
+		return runtimeErrorReturnCode
+	}
+
+	authenticator, err := a.authenticator(a)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to get authenticator.")
+		return runtimeErrorReturnCode
+	}
+	httpClient, err := authenticator.Client()
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to get authenticated HTTP client.")
+		return runtimeErrorReturnCode
+	}
+
+	output, err := newLogDogOutput(a, authenticator, httpClient, reg, cmd.track)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to create output instance.")
+		return runtimeErrorReturnCode
+	}
+	defer output.Close()
+
+	sp, err := spool.New(a, a.spoolDir)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Failed to open spool.")
+		return runtimeErrorReturnCode
+	}
+	defer sp.Close()
+
+	log.Fields{
+		"host":    reg.Host,
+		"prefix":  reg.Prefix,
+		"pending": sp.Pending(),
+	}.Infof(a, "Replaying spooled bundles.")
+	sent, err := sp.Replay(output.SendBundle)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"sent":       sent,
+			"pending":    sp.Pending(),
+		}.Errorf(a, "Failed to replay spooled bundles.")
+		return runtimeErrorReturnCode
+	}
+
+	log.Fields{
+		"sent":  sent,
+		"stats": output.Stats(),
+	}.Infof(a, "Replayed all spooled bundles.")
+	return 0
+}
@@ -39,7 +39,8 @@ var subcommandRun = &subcommands.Command{
 		cmd.Flags.StringVar(&cmd.chdir, "chdir", "",
 			"If specified, switch to this directory prior to running the command.")
 		cmd.Flags.Var(&cmd.streamServerURI, "streamserver-uri",
-			"The stream server URI to bind to (e.g., "+string(exampleStreamServerURI)+").")
+			"The stream server URI to bind to (e.g., "+string(exampleStreamServerURI)+
+				" or "+tcpStreamServerScheme+":127.0.0.1:0).")
 		cmd.Flags.BoolVar(&cmd.attach, "attach", true,
 			"If true, attaches the bootstrapped process' STDOUT and STDERR streams.")
 		cmd.Flags.BoolVar(&cmd.stdin, "forward-stdin", false,
@@ -163,10 +164,6 @@ func (cmd *runCommandRun) Run(app subcommands.Application, args []string) int {
 		cwd, _ = os.Getwd()
 	}
 
-	// Update our environment for the child process to inherit
-	env := newEnviron(nil)
-	cmd.updateEnvironment(env, a)
-
 	// Configure stream server
 	streamServer := streamserver.StreamServer(nil)
 	streamServerOwned := true
@@ -187,6 +184,10 @@ func (cmd *runCommandRun) Run(app subcommands.Application, args []string) int {
 		}()
 	}
 
+	// Update our environment for the child process to inherit
+	env := newEnviron(nil)
+	cmd.updateEnvironment(env, a, streamServer)
+
 	// We're about ready to execute our command. Initialize our Output instance.
 	// We want to do this before we execute our subprocess so that if this fails,
 	// we don't have to interrupt an already-running process.
@@ -214,6 +215,9 @@ func (cmd *runCommandRun) Run(app subcommands.Application, args []string) int {
 			"args":        args,
 		}.Debugf(a, "Executing application.")
 		for _, entry := range proc.Env {
+			if k, v := environSplit(entry); k == bootstrap.EnvStreamServerPath {
+				entry = environJoin(k, redactStreamServerClientPath(v))
+			}
 			log.Debugf(a, "Environment variable: %s", entry)
 		}
 	}
@@ -347,8 +351,13 @@ func (cmd *runCommandRun) Run(app subcommands.Application, args []string) int {
 		cancelFunc()
 	}
 
-	// Reap the process' return code.
-	return <-returnCodeC
+	// Reap the process' return code. If the process succeeded but the Butler
+	// failed, e.g. because its logs could not be sent, the run fails.
+	returnCode := <-returnCodeC
+	if err != nil && returnCode == 0 {
+		returnCode = runtimeErrorReturnCode
+	}
+	return returnCode
 }
 
 func (cmd *runCommandRun) loadJSONArgs() ([]string, error) {
@@ -368,13 +377,13 @@ func (cmd *runCommandRun) loadJSONArgs() ([]string, error) {
 
 // updateEnvironment adds common Butler bootstrapping environment variables
 // to the environment.
-func (cmd *runCommandRun) updateEnvironment(e environ, a *application) {
+func (cmd *runCommandRun) updateEnvironment(e environ, a *application, s streamserver.StreamServer) {
 	e.set(bootstrap.EnvStreamPrefix, string(a.prefix))
 	e.set(bootstrap.EnvStreamProject, string(a.project))
 
 	// Set stream server path (if applicable)
-	if cmd.streamServerURI != "" {
-		e.set(bootstrap.EnvStreamServerPath, string(cmd.streamServerURI))
+	if s != nil {
+		e.set(bootstrap.EnvStreamServerPath, streamServerClientPath(cmd.streamServerURI, s))
 	}
 }
 
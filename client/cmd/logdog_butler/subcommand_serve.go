@@ -5,6 +5,8 @@
 package main
 
 import (
+	"io/ioutil"
+
 	"github.com/luci/luci-go/client/internal/logdog/butler"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/maruel/subcommands"
@@ -18,7 +20,11 @@ var subcommandServe = &subcommands.Command{
 		cmd := &serveCommandRun{}
 
 		cmd.Flags.Var(&cmd.uri, "streamserver-uri",
-			"The stream server URI to bind to (e.g., "+string(exampleStreamServerURI)+").")
+			"The stream server URI to bind to (e.g., "+string(exampleStreamServerURI)+
+				" or "+tcpStreamServerScheme+":127.0.0.1:0).")
+		cmd.Flags.StringVar(&cmd.clientPathFile, "client-path-file", "",
+			"If set, write the path that clients use to connect to the stream server to this file. "+
+				"The path of a TCP stream server includes its secret, which is not logged.")
 		return cmd
 	},
 }
@@ -26,7 +32,8 @@ var subcommandServe = &subcommands.Command{
 type serveCommandRun struct {
 	subcommands.CommandRunBase
 
-	uri streamServerURI
+	uri            streamServerURI
+	clientPathFile string
 }
 
 func (cmd *serveCommandRun) Run(app subcommands.Application, args []string) int {
@@ -46,6 +53,24 @@ func (cmd *serveCommandRun) Run(app subcommands.Application, args []string) int
 		return runtimeErrorReturnCode
 	}
 
+	// Clients need the path to connect, which includes the secret of TCP stream
+	// servers. The secret is only written to the client path file, which only
+	// the current user can read.
+	path := streamServerClientPath(cmd.uri, streamServer)
+	if cmd.clientPathFile != "" {
+		if err := ioutil.WriteFile(cmd.clientPathFile, []byte(path), 0600); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       cmd.clientPathFile,
+			}.Errorf(a, "Failed to write client path file.")
+			streamServer.Close()
+			return runtimeErrorReturnCode
+		}
+	}
+	log.Fields{
+		"path": redactStreamServerClientPath(path),
+	}.Infof(a, "Stream server is listening.")
+
 	// We think everything will work. Configure our Output instance.
 	output, err := a.configOutput()
 	if err != nil {
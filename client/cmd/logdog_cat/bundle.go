@@ -0,0 +1,171 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/logdog/fetcher"
+	"github.com/luci/luci-go/common/logdog/types"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/common/recordio"
+	"golang.org/x/net/context"
+)
+
+// A log stream bundle file is a sequence of RecordIO frames. Each frame is a
+// binary logpb.ButlerLogBundle holding a single Entry: the descriptor of a log
+// stream and a chunk of its sequential log entries. Chunks of different streams
+// may be interleaved. The last chunk of a stream whose terminal entry was
+// exported is marked terminal.
+
+const (
+	// maxBundleFrameSize is the maximum size of a bundle frame.
+	maxBundleFrameSize = 64 * 1024 * 1024 // 64 MB
+)
+
+// bundleWriter writes log stream chunks to a bundle file. It is goroutine-safe.
+type bundleWriter struct {
+	sync.Mutex
+
+	w io.Writer
+}
+
+// writeChunk writes a chunk of sequential log entries of a stream to the
+// bundle. If terminal is true, the chunk ends the stream at tidx.
+func (w *bundleWriter) writeChunk(project config.ProjectName, desc *logpb.LogStreamDescriptor,
+	logs []*logpb.LogEntry, terminal bool, tidx types.MessageIndex) error {
+	b := logpb.ButlerLogBundle{
+		Project: string(project),
+		Prefix:  desc.Prefix,
+		Entries: []*logpb.ButlerLogBundle_Entry{
+			{
+				Desc:     desc,
+				Terminal: terminal,
+				Logs:     logs,
+			},
+		},
+	}
+	if terminal {
+		b.Entries[0].TerminalIndex = uint64(tidx)
+	}
+	data, err := proto.Marshal(&b)
+	if err != nil {
+		return err
+	}
+
+	w.Lock()
+	defer w.Unlock()
+	_, err = recordio.WriteFrame(w.w, data)
+	return err
+}
+
+// bundleStream is a log stream loaded from a bundle.
+type bundleStream struct {
+	desc *logpb.LogStreamDescriptor
+	// logs are the log entries of the stream, sorted by stream index.
+	logs []*logpb.LogEntry
+	// tidx is the terminal index of the stream, or -1 if it is not terminal.
+	tidx types.MessageIndex
+}
+
+// bundle is the set of log streams of a bundle file, keyed by unified path.
+type bundle map[string]*bundleStream
+
+// loadBundle reads a bundle file into memory.
+func loadBundle(path string) (bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readBundle(bufio.NewReader(f))
+}
+
+func readBundle(r io.Reader) (bundle, error) {
+	b := bundle{}
+	rr := recordio.NewReader(r, maxBundleFrameSize)
+	for {
+		data, err := rr.ReadFrameAll()
+		switch err {
+		case nil:
+		case io.EOF:
+			for _, s := range b {
+				sort.Sort(logEntriesByIndex(s.logs))
+			}
+			return b, nil
+		default:
+			return nil, err
+		}
+
+		lb := logpb.ButlerLogBundle{}
+		if err := proto.Unmarshal(data, &lb); err != nil {
+			return nil, fmt.Errorf("invalid bundle frame: %s", err)
+		}
+		for _, e := range lb.Entries {
+			if e.Desc == nil {
+				return nil, errors.New("bundle entry has no descriptor")
+			}
+			key := makeUnifiedPath(config.ProjectName(lb.Project), e.Desc.Path())
+			s := b[key]
+			if s == nil {
+				s = &bundleStream{desc: e.Desc, tidx: -1}
+				b[key] = s
+			}
+			s.logs = append(s.logs, e.Logs...)
+			if e.Terminal {
+				s.tidx = types.MessageIndex(e.TerminalIndex)
+			}
+		}
+	}
+}
+
+// source returns a streamSource for a log stream of the bundle.
+func (b bundle) source(project config.ProjectName, path types.StreamPath) (streamSource, error) {
+	s := b[makeUnifiedPath(project, path)]
+	if s == nil {
+		return nil, fmt.Errorf("stream %q is not in the bundle", makeUnifiedPath(project, path))
+	}
+	return s, nil
+}
+
+// LogEntries implements fetcher.Source.
+//
+// A bundle is a snapshot, so the last bundled log entry is reported as the
+// terminal one if the stream wasn't terminated when it was exported.
+func (s *bundleStream) LogEntries(c context.Context, req *fetcher.LogRequest) (
+	[]*logpb.LogEntry, types.MessageIndex, error) {
+	tidx := s.tidx
+	if tidx < 0 && len(s.logs) > 0 {
+		tidx = types.MessageIndex(s.logs[len(s.logs)-1].StreamIndex)
+	}
+
+	i := sort.Search(len(s.logs), func(i int) bool {
+		return types.MessageIndex(s.logs[i].StreamIndex) >= req.Index
+	})
+	logs := s.logs[i:]
+	if req.Count > 0 && len(logs) > req.Count {
+		logs = logs[:req.Count]
+	}
+	return logs, tidx, nil
+}
+
+func (s *bundleStream) descriptor() (*logpb.LogStreamDescriptor, error) {
+	return s.desc, nil
+}
+
+// logEntriesByIndex sorts log entries by stream index.
+type logEntriesByIndex []*logpb.LogEntry
+
+func (l logEntriesByIndex) Len() int           { return len(l) }
+func (l logEntriesByIndex) Less(i, j int) bool { return l[i].StreamIndex < l[j].StreamIndex }
+func (l logEntriesByIndex) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
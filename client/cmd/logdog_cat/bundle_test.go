@@ -0,0 +1,93 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/luci/luci-go/common/logdog/fetcher"
+	"github.com/luci/luci-go/common/logdog/types"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestBundle(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A bundle with two interleaved streams and an empty one`, t, func() {
+		descA := &logpb.LogStreamDescriptor{Prefix: "foo", Name: "a"}
+		descB := &logpb.LogStreamDescriptor{Prefix: "foo", Name: "b"}
+		descEmpty := &logpb.LogStreamDescriptor{Prefix: "foo", Name: "empty"}
+		entries := func(indices ...uint64) []*logpb.LogEntry {
+			logs := make([]*logpb.LogEntry, len(indices))
+			for i, idx := range indices {
+				logs[i] = &logpb.LogEntry{StreamIndex: idx}
+			}
+			return logs
+		}
+
+		buf := bytes.Buffer{}
+		bw := bundleWriter{w: &buf}
+		So(bw.writeChunk("proj", descA, entries(0, 1), false, -1), ShouldBeNil)
+		So(bw.writeChunk("proj", descB, entries(0), false, -1), ShouldBeNil)
+		So(bw.writeChunk("proj", descA, entries(2), true, 2), ShouldBeNil)
+		So(bw.writeChunk("proj", descEmpty, nil, false, -1), ShouldBeNil)
+
+		b, err := readBundle(&buf)
+		So(err, ShouldBeNil)
+		So(b, ShouldHaveLength, 3)
+
+		indices := func(logs []*logpb.LogEntry) []uint64 {
+			out := make([]uint64, len(logs))
+			for i, le := range logs {
+				out[i] = le.StreamIndex
+			}
+			return out
+		}
+
+		Convey(`Can read back a terminated stream.`, func() {
+			src, err := b.source("proj", "foo/+/a")
+			So(err, ShouldBeNil)
+			desc, err := src.descriptor()
+			So(err, ShouldBeNil)
+			So(desc.Name, ShouldEqual, "a")
+
+			logs, tidx, err := src.LogEntries(context.Background(), &fetcher.LogRequest{Index: 1})
+			So(err, ShouldBeNil)
+			So(indices(logs), ShouldResemble, []uint64{1, 2})
+			So(tidx, ShouldEqual, types.MessageIndex(2))
+		})
+
+		Convey(`Reports the last log entry of a streaming stream as terminal.`, func() {
+			src, err := b.source("proj", "foo/+/b")
+			So(err, ShouldBeNil)
+
+			logs, tidx, err := src.LogEntries(context.Background(), &fetcher.LogRequest{Count: 5})
+			So(err, ShouldBeNil)
+			So(indices(logs), ShouldResemble, []uint64{0})
+			So(tidx, ShouldEqual, types.MessageIndex(0))
+		})
+
+		Convey(`Can read back a stream without log entries.`, func() {
+			src, err := b.source("proj", "foo/+/empty")
+			So(err, ShouldBeNil)
+			desc, err := src.descriptor()
+			So(err, ShouldBeNil)
+			So(desc.Name, ShouldEqual, "empty")
+
+			logs, tidx, err := src.LogEntries(context.Background(), &fetcher.LogRequest{})
+			So(err, ShouldBeNil)
+			So(logs, ShouldHaveLength, 0)
+			So(tidx, ShouldEqual, types.MessageIndex(-1))
+		})
+
+		Convey(`Fails for a stream that is not in the bundle.`, func() {
+			_, err := b.source("proj", "foo/+/c")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -33,6 +33,9 @@ type coordinatorSource struct {
 
 	stream *coordinator.Stream
 	tidx   types.MessageIndex
+	// follow, if true, waits for the stream to be registered if it doesn't exist
+	// yet.
+	follow bool
 
 	state coordinator.LogStream
 }
@@ -60,6 +63,9 @@ func (s *coordinatorSource) LogEntries(c context.Context, req *fetcher.LogReques
 			return logs, s.tidx, nil
 
 		case coordinator.ErrNoSuchStream:
+			if !s.follow {
+				return nil, 0, err
+			}
 			log.WithError(err).Warningf(c, "Stream does not exist. Sleeping pending registration.")
 
 			// Delay, interrupting if our Context is interrupted.
@@ -52,9 +52,6 @@ func (a *application) addToFlagSet(ctx context.Context, fs *flag.FlagSet) {
 }
 
 func (a *application) validate() error {
-	if a.coordinator == "" {
-		return errors.New("main: missing coordinator URL (-url)")
-	}
 	// TODO(dnj): Error on empty project once that's disallowed.
 	if a.project != "" {
 		if err := a.project.Validate(); err != nil {
@@ -64,6 +61,15 @@ func (a *application) validate() error {
 	return nil
 }
 
+// checkCoordinator returns an error if no Coordinator was configured. It must
+// be called by subcommands that use the Coordinator.
+func (a *application) checkCoordinator() error {
+	if a.coord == nil {
+		return errors.New("main: missing coordinator host (-host)")
+	}
+	return nil
+}
+
 // splitPath converts between a possible user-facing "unified" stream path
 // (e.g., "project/path...") to separate project/path values.
 //
@@ -114,6 +120,7 @@ func mainImpl() int {
 				newCatCommand(),
 				newQueryCommand(),
 				newListCommand(),
+				newExportCommand(),
 				authcli.SubcommandLogin(authOptions, "auth-login"),
 				authcli.SubcommandLogout(authOptions, "auth-logout"),
 				authcli.SubcommandInfo(authOptions, "auth-info"),
@@ -178,15 +185,18 @@ func mainImpl() int {
 		return 1
 	}
 
-	// Get our Coordinator client instance.
-	prpcClient := &prpc.Client{
-		C:       httpClient,
-		Host:    a.coordinator,
-		Options: prpc.DefaultOptions(),
-	}
-	prpcClient.Options.Insecure = a.insecure
+	// Get our Coordinator client instance. Without a host, only local sources
+	// can be used.
+	if a.coordinator != "" {
+		prpcClient := &prpc.Client{
+			C:       httpClient,
+			Host:    a.coordinator,
+			Options: prpc.DefaultOptions(),
+		}
+		prpcClient.Options.Insecure = a.insecure
 
-	a.coord = coordinator.NewClient(prpcClient)
+		a.coord = coordinator.NewClient(prpcClient)
+	}
 	a.Context = ctx
 	return subcommands.Run(&a, flags.Args())
 }
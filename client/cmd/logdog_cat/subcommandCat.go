@@ -8,6 +8,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/luci/luci-go/common/config"
@@ -17,6 +18,7 @@ import (
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/proto/logdog/logpb"
 	"github.com/luci/luci-go/common/proto/milo"
+	"github.com/luci/luci-go/common/retry"
 	"github.com/maruel/subcommands"
 )
 
@@ -31,6 +33,13 @@ type catCommandRun struct {
 	fetchSize    int
 	fetchBytes   int
 	originalText bool
+
+	follow       bool
+	pollDelay    time.Duration
+	pollMaxDelay time.Duration
+
+	bundlePath string
+	bundle     bundle
 }
 
 func newCatCommand() *subcommands.Command {
@@ -49,6 +58,17 @@ func newCatCommand() *subcommands.Command {
 			cmd.Flags.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
 			cmd.Flags.BoolVar(&cmd.originalText, "original-text", false,
 				"Reproduce original text log stream, instead of converting for native rendering.")
+			cmd.Flags.BoolVar(&cmd.follow, "follow", false,
+				"Keep polling for new log entries until the stream is terminated, instead of exiting "+
+					"after the available log entries.")
+			cmd.Flags.DurationVar(&cmd.pollDelay, "poll-delay", time.Second,
+				"When following, the initial delay in between polls that return no log entries. It doubles "+
+					"after each such poll.")
+			cmd.Flags.DurationVar(&cmd.pollMaxDelay, "poll-max-delay", 30*time.Second,
+				"When following, the maximum delay in between polls.")
+			cmd.Flags.StringVar(&cmd.bundlePath, "bundle", "",
+				"Read the log streams from this bundle file, written by 'export -bundle', instead of the "+
+					"Coordinator.")
 			return cmd
 		},
 	}
@@ -90,6 +110,31 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string) int
 			"value": cmd.buffer,
 		}.Errorf(a, "Buffer size must be >0.")
 	}
+	if cmd.pollDelay <= 0 || cmd.pollMaxDelay < cmd.pollDelay {
+		log.Fields{
+			"pollDelay":    cmd.pollDelay,
+			"pollMaxDelay": cmd.pollMaxDelay,
+		}.Errorf(a, "Poll delays must be >0, and the maximum must not be lower than the initial delay.")
+		return 1
+	}
+
+	if cmd.bundlePath != "" {
+		if cmd.follow {
+			log.Errorf(a, "A bundle can't be followed.")
+			return 1
+		}
+		var err error
+		if cmd.bundle, err = loadBundle(cmd.bundlePath); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       cmd.bundlePath,
+			}.Errorf(a, "Failed to load bundle.")
+			return 1
+		}
+	} else if err := a.checkCoordinator(); err != nil {
+		log.WithError(err).Errorf(a, "Invalid application configuration.")
+		return 1
+	}
 
 	for i, cp := range catPaths {
 		if err := cmd.catPath(a, cp); err != nil {
@@ -113,23 +158,49 @@ type catPath struct {
 }
 
 func (cmd *catCommandRun) catPath(a *application, cp *catPath) error {
-	// Pull stream information.
-	src := coordinatorSource{
-		stream: a.coord.Stream(cp.project, cp.path),
+	var src streamSource
+	if cmd.bundle != nil {
+		var err error
+		if src, err = cmd.bundle.source(cp.project, cp.path); err != nil {
+			return err
+		}
+	} else {
+		// Pull stream information.
+		cs := coordinatorSource{
+			stream: a.coord.Stream(cp.project, cp.path),
+			follow: cmd.follow,
+		}
+		cs.tidx = -1 // Must be set to probe for state.
+		src = &cs
 	}
-	src.tidx = -1 // Must be set to probe for state.
 
-	f := fetcher.New(a, fetcher.Options{
-		Source:      &src,
+	return renderStream(a, os.Stdout, src, fetcher.Options{
 		Index:       types.MessageIndex(cmd.index),
 		Count:       cmd.count,
 		BufferCount: cmd.fetchSize,
 		BufferBytes: int64(cmd.fetchBytes),
-	})
+		Backoff:     cmd.pollBackoff,
+	}, cmd.originalText, make([]byte, cmd.buffer))
+}
+
+// streamSource is a fetcher.Source for a single log stream that can also
+// supply the stream's descriptor.
+type streamSource interface {
+	fetcher.Source
+
+	descriptor() (*logpb.LogStreamDescriptor, error)
+}
+
+// renderStream fetches the log stream from src and writes it to w, rendered
+// for native display unless originalText is true.
+func renderStream(a *application, w io.Writer, src streamSource, o fetcher.Options, originalText bool,
+	buf []byte) error {
+	o.Source = src
+	f := fetcher.New(a, o)
 
 	rend := renderer.Renderer{
 		Source:    f,
-		Reproduce: cmd.originalText,
+		Reproduce: originalText,
 		DatagramWriter: func(w io.Writer, dg []byte) bool {
 			desc, err := src.descriptor()
 			if err != nil {
@@ -147,12 +218,28 @@ func (cmd *catCommandRun) catPath(a *application, cp *catPath) error {
 			return true
 		},
 	}
-	if _, err := copyBuffer(os.Stdout, &rend, make([]byte, cmd.buffer)); err != nil {
+	if _, err := copyBuffer(w, &rend, buf); err != nil {
 		return err
 	}
 	return nil
 }
 
+// pollBackoff is the retry.Factory used when the stream has no new log
+// entries. When following, polls back off exponentially until the stream
+// terminates; otherwise, fetching stops at the first empty poll.
+func (cmd *catCommandRun) pollBackoff() retry.Iterator {
+	if !cmd.follow {
+		return &retry.Limited{}
+	}
+	return &retry.ExponentialBackoff{
+		Limited: retry.Limited{
+			Delay:   cmd.pollDelay,
+			Retries: -1,
+		},
+		MaxDelay: cmd.pollMaxDelay,
+	}
+}
+
 // TODO: Use io.CopyBuffer once we move to Go >= 1.5.
 func copyBuffer(dst io.Writer, src io.Reader, buf []byte) (written int64, err error) {
 	for {
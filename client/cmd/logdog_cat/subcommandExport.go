@@ -0,0 +1,249 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/luci/luci-go/common/logdog/coordinator"
+	"github.com/luci/luci-go/common/logdog/fetcher"
+	"github.com/luci/luci-go/common/logdog/types"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/parallel"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/common/retry"
+	"github.com/maruel/subcommands"
+)
+
+const (
+	// exportBufferSize is the size of the buffer used to write rendered streams.
+	exportBufferSize = 32 * 1024
+
+	// bundleChunkSize is the maximum number of log entries in a bundle frame.
+	bundleChunkSize = 256
+)
+
+type exportCommandRun struct {
+	subcommands.CommandRunBase
+
+	outDir       string
+	bundlePath   string
+	parallel     int
+	originalText bool
+}
+
+func newExportCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "export [-out <dir> | -bundle <file>] <prefix>",
+		ShortDesc: "Download all log streams under a prefix.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &exportCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.StringVar(&cmd.outDir, "out", "",
+				"Write each log stream to a file in this directory, mirroring the stream hierarchy. Text "+
+					"streams are rendered to '.txt' files and binary streams to '.bin' files.")
+			fs.StringVar(&cmd.bundlePath, "bundle", "",
+				"Write all log streams to this bundle file, which can be read back with 'cat -bundle'.")
+			fs.IntVar(&cmd.parallel, "parallel", 8, "The maximum number of log streams to fetch concurrently.")
+			fs.BoolVar(&cmd.originalText, "original-text", false,
+				"Reproduce original text log streams, instead of converting for native rendering.")
+			return cmd
+		},
+	}
+}
+
+func (cmd *exportCommandRun) Run(scApp subcommands.Application, args []string) int {
+	a := scApp.(*application)
+	if err := a.checkCoordinator(); err != nil {
+		log.WithError(err).Errorf(a, "Invalid application configuration.")
+		return 1
+	}
+
+	if len(args) != 1 {
+		log.Errorf(a, "Exactly one log stream prefix must be supplied.")
+		return 1
+	}
+	if (cmd.outDir == "") == (cmd.bundlePath == "") {
+		log.Errorf(a, "Exactly one of -out and -bundle must be supplied.")
+		return 1
+	}
+
+	project, path, _, err := a.splitPath(args[0])
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+	prefix := types.StreamName(path).Trim()
+	if err := prefix.Validate(); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"prefix":     prefix,
+		}.Errorf(a, "Invalid log stream prefix.")
+		return 1
+	}
+
+	// Query all of the streams under the prefix.
+	var streams []*coordinator.LogStream
+	qo := coordinator.QueryOptions{
+		State: true,
+	}
+	query := string(prefix.AsPathPrefix("**"))
+	err = a.coord.Query(a, project, query, qo, func(s *coordinator.LogStream) bool {
+		streams = append(streams, s)
+		return true
+	})
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"project":    project,
+			"prefix":     prefix,
+		}.Errorf(a, "Failed to query log streams.")
+		return 1
+	}
+	log.Fields{
+		"count": len(streams),
+	}.Infof(a, "Exporting log streams.")
+
+	var bw *bundleWriter
+	var bundleBuf *bufio.Writer
+	if cmd.bundlePath != "" {
+		f, err := os.Create(cmd.bundlePath)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       cmd.bundlePath,
+			}.Errorf(a, "Failed to create bundle file.")
+			return 1
+		}
+		defer f.Close()
+
+		bundleBuf = bufio.NewWriter(f)
+		bw = &bundleWriter{w: bundleBuf}
+	}
+
+	err = parallel.WorkPool(cmd.parallel, func(workC chan<- func() error) {
+		for _, s := range streams {
+			s := s
+			workC <- func() error {
+				if err := cmd.exportStream(a, s, bw); err != nil {
+					log.Fields{
+						log.ErrorKey: err,
+						"path":       s.Path,
+					}.Errorf(a, "Failed to export log stream.")
+					return err
+				}
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return 1
+	}
+
+	if bundleBuf != nil {
+		if err := bundleBuf.Flush(); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       cmd.bundlePath,
+			}.Errorf(a, "Failed to write bundle file.")
+			return 1
+		}
+	}
+	return 0
+}
+
+// exportStream exports a single log stream, to bw if it is not nil and to a
+// file in the output directory otherwise.
+func (cmd *exportCommandRun) exportStream(a *application, s *coordinator.LogStream, bw *bundleWriter) error {
+	src := &coordinatorSource{
+		stream: a.coord.Stream(s.Project, s.Path),
+	}
+	src.tidx = -1 // Must be set to probe for state.
+
+	// An export is a snapshot of the streams, so stop at the first empty poll.
+	o := fetcher.Options{
+		Backoff: func() retry.Iterator { return &retry.Limited{} },
+	}
+
+	if bw != nil {
+		o.Source = src
+		return exportBundleStream(fetcher.New(a, o), src, s, bw)
+	}
+
+	ext := ".txt"
+	if s.Desc != nil && s.Desc.StreamType == logpb.StreamType_BINARY {
+		ext = ".bin"
+	}
+	path := filepath.Join(cmd.outDir, filepath.FromSlash(string(s.Path))+ext)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := renderStream(a, f, src, o, cmd.originalText, make([]byte, exportBufferSize)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// exportBundleStream writes the log entries of a stream to the bundle, in
+// chunks of up to bundleChunkSize entries. A stream without log entries is
+// written as a single empty chunk, so that it is still in the bundle.
+func exportBundleStream(f *fetcher.Fetcher, src *coordinatorSource, s *coordinator.LogStream, bw *bundleWriter) error {
+	desc := s.Desc
+	last := types.MessageIndex(-1)
+	written := false
+	var logs []*logpb.LogEntry
+	for {
+		le, err := f.NextLogEntry()
+		if le != nil {
+			logs = append(logs, le)
+			last = types.MessageIndex(le.StreamIndex)
+		}
+		done := false
+		switch err {
+		case nil:
+			if len(logs) < bundleChunkSize {
+				continue
+			}
+		case io.EOF:
+			done = true
+		default:
+			return err
+		}
+
+		if desc == nil {
+			if desc, err = src.descriptor(); err != nil {
+				return err
+			}
+		}
+
+		// The last chunk is terminal if the stream was exported up to its terminal
+		// index.
+		terminal := false
+		tidx := types.MessageIndex(-1)
+		if st := src.getState().State; done && st != nil && st.TerminalIndex >= 0 {
+			tidx = st.TerminalIndex
+			terminal = last == tidx
+		}
+		if len(logs) > 0 || terminal || (done && !written) {
+			if err := bw.writeChunk(s.Project, desc, logs, terminal, tidx); err != nil {
+				return err
+			}
+			written = true
+		}
+		if done {
+			return nil
+		}
+		logs = nil
+	}
+}
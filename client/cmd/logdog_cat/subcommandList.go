@@ -46,6 +46,10 @@ func newListCommand() *subcommands.Command {
 
 func (cmd *listCommandRun) Run(scApp subcommands.Application, args []string) int {
 	a := scApp.(*application)
+	if err := a.checkCoordinator(); err != nil {
+		log.WithError(err).Errorf(a, "Invalid application configuration.")
+		return 1
+	}
 
 	if len(args) == 0 {
 		args = []string{""}
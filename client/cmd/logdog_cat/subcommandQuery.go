@@ -64,6 +64,10 @@ func newQueryCommand() *subcommands.Command {
 
 func (cmd *queryCommandRun) Run(scApp subcommands.Application, args []string) int {
 	a := scApp.(*application)
+	if err := a.checkCoordinator(); err != nil {
+		log.WithError(err).Errorf(a, "Invalid application configuration.")
+		return 1
+	}
 
 	// User-friendly: trim any leading or trailing slashes from the path.
 	project, path, unified, err := a.splitPath(cmd.path)
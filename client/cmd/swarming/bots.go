@@ -0,0 +1,167 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/luci/luci-go/common/flag/stringmapflag"
+	"github.com/maruel/subcommands"
+)
+
+var cmdBots = &subcommands.Command{
+	UsageLine: "bots <options>",
+	ShortDesc: "lists bots",
+	LongDesc:  "Lists the bots of the Swarming server, optionally filtered by dimensions.",
+	CommandRun: func() subcommands.CommandRun {
+		r := &botsRun{}
+		r.Init()
+		return r
+	},
+}
+
+type botsRun struct {
+	commonFlags
+
+	dimensions stringmapflag.Value
+	keepDead   bool
+	deadOnly   bool
+	bare       bool
+	jsonOutput string
+}
+
+func (c *botsRun) Init() {
+	c.commonFlags.Init()
+
+	c.Flags.Var(&c.dimensions, "dimension", "Only list bots with this dimension, as key=value. Can be repeated.")
+	c.Flags.BoolVar(&c.keepDead, "keep-dead", false, "Also list dead bots.")
+	c.Flags.BoolVar(&c.deadOnly, "dead-only", false, "Only list dead bots.")
+	c.Flags.BoolVar(&c.bare, "bare", false, "Only print the bot IDs.")
+	c.Flags.StringVar(&c.jsonOutput, "json", "", "Write the bots to this file as json.")
+}
+
+func (c *botsRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return errors.New("position arguments not expected")
+	}
+	if c.keepDead && c.deadOnly {
+		return errors.New("can't use both -keep-dead and -dead-only")
+	}
+	return nil
+}
+
+// listBots returns all the bots with the dimensions, following the pages of
+// the reply.
+func listBots(s *swarming.Service, dimensions stringmapflag.Value) ([]*swarming.SwarmingRpcsBotInfo, error) {
+	dims := make([]string, 0, len(dimensions))
+	for _, d := range mapToArray(dimensions) {
+		dims = append(dims, d.Key+":"+d.Value)
+	}
+	bots := []*swarming.SwarmingRpcsBotInfo{}
+	cursor := ""
+	for {
+		call := s.Bots.List().Dimensions(dims...)
+		if cursor != "" {
+			call.Cursor(cursor)
+		}
+		resp, err := call.Do()
+		if err != nil {
+			return nil, err
+		}
+		bots = append(bots, resp.Items...)
+		if resp.Cursor == "" {
+			return bots, nil
+		}
+		cursor = resp.Cursor
+	}
+}
+
+// filterDead keeps alive bots, dead bots, or both.
+func filterDead(bots []*swarming.SwarmingRpcsBotInfo, keepAlive, keepDead bool) []*swarming.SwarmingRpcsBotInfo {
+	out := []*swarming.SwarmingRpcsBotInfo{}
+	for _, b := range bots {
+		if (b.IsDead && keepDead) || (!b.IsDead && keepAlive) {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// printBot prints the bot ID followed by its dimensions and state.
+func printBot(w io.Writer, b *swarming.SwarmingRpcsBotInfo) {
+	fmt.Fprintln(w, b.BotId)
+	dims := make([]string, 0, len(b.Dimensions))
+	for _, d := range b.Dimensions {
+		dims = append(dims, fmt.Sprintf("%s=%s", d.Key, strings.Join(d.Value, ",")))
+	}
+	fmt.Fprintf(w, "  %s\n", strings.Join(dims, " "))
+	if b.TaskId != "" {
+		fmt.Fprintf(w, "  task: %s\n", b.TaskId)
+	}
+	if b.IsDead {
+		fmt.Fprintln(w, "  dead")
+	}
+	if b.Quarantined {
+		fmt.Fprintln(w, "  quarantined")
+	}
+}
+
+func (c *botsRun) main(a subcommands.Application) error {
+	s, _, err := c.createService()
+	if err != nil {
+		return err
+	}
+	bots, err := listBots(s, c.dimensions)
+	if err != nil {
+		return err
+	}
+	bots = filterDead(bots, !c.deadOnly, c.keepDead || c.deadOnly)
+
+	if c.jsonOutput != "" {
+		b, err := json.MarshalIndent(bots, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(c.jsonOutput, b, 0666); err != nil {
+			return err
+		}
+	}
+	for _, b := range bots {
+		if c.bare {
+			fmt.Println(b.BotId)
+		} else {
+			printBot(os.Stdout, b)
+		}
+	}
+	return nil
+}
+
+func (c *botsRun) Run(a subcommands.Application, args []string) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	if err := c.main(a); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,82 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/maruel/subcommands"
+)
+
+var cmdCancel = &subcommands.Command{
+	UsageLine: "cancel <options> <task_id>...",
+	ShortDesc: "cancels tasks",
+	LongDesc:  "Cancels Swarming tasks. Running tasks are killed by the bot running them.",
+	CommandRun: func() subcommands.CommandRun {
+		r := &cancelRun{}
+		r.Init()
+		return r
+	},
+}
+
+type cancelRun struct {
+	commonFlags
+}
+
+func (c *cancelRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("must provide at least one task id")
+	}
+	return nil
+}
+
+func (c *cancelRun) main(a subcommands.Application, taskIDs []string) error {
+	s, _, err := c.createService()
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, id := range taskIDs {
+		resp, err := s.Task.Cancel(id).Do()
+		switch {
+		case err != nil:
+			fmt.Fprintf(a.GetErr(), "failed to cancel %s: %s\n", id, err)
+			failed++
+		case !resp.Ok:
+			fmt.Fprintf(a.GetErr(), "can't cancel %s, it has already completed\n", id)
+			failed++
+		case resp.WasRunning:
+			fmt.Printf("Canceled %s, it was running\n", id)
+		default:
+			fmt.Printf("Canceled %s\n", id)
+		}
+	}
+	if failed != 0 {
+		return fmt.Errorf("%d of %d tasks were not canceled", failed, len(taskIDs))
+	}
+	return nil
+}
+
+func (c *cancelRun) Run(a subcommands.Application, args []string) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	if err := c.main(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,242 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/maruel/subcommands"
+)
+
+var cmdCollect = &subcommands.Command{
+	UsageLine: "collect <options> <task_id>...",
+	ShortDesc: "waits for tasks and returns their results",
+	LongDesc: `Waits for Swarming tasks to complete, prints their output and exits with
+their exit code.
+
+Tasks are given as arguments or read from a file written by 'trigger -dump-json'.
+When several tasks are collected, the first non-zero exit code is used. Tasks
+that didn't complete, e.g. expired or canceled, make the command exit with 1.`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &collectRun{}
+		r.Init()
+		return r
+	},
+}
+
+// collectPollInterval is how often the task state is polled.
+const collectPollInterval = 5 * time.Second
+
+type collectRun struct {
+	commonFlags
+
+	jsonInput   string
+	timeout     int
+	noStdout    bool
+	outputDir   string
+	summaryJSON string
+}
+
+func (c *collectRun) Init() {
+	c.commonFlags.Init()
+
+	c.Flags.StringVar(&c.jsonInput, "json", "", "Load the task IDs from a file written by 'trigger -dump-json'.")
+	c.Flags.IntVar(&c.timeout, "timeout", 0, "Seconds to wait for the tasks to complete. 0 means no timeout.")
+	c.Flags.BoolVar(&c.noStdout, "no-stdout", false, "Don't print the output of the tasks.")
+	c.Flags.StringVar(&c.outputDir, "task-output-dir", "", "Download the isolated outputs of each task into <dir>/<task_id>.")
+	c.Flags.StringVar(&c.summaryJSON, "task-summary-json", "", "Write the task results to this file as json.")
+}
+
+func (c *collectRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if c.timeout < 0 {
+		return errors.New("-timeout can't be negative")
+	}
+	if c.jsonInput != "" {
+		if len(args) != 0 {
+			return errors.New("can't use both -json and task IDs")
+		}
+		return nil
+	}
+	if len(args) == 0 {
+		return errors.New("must provide at least one task id or -json")
+	}
+	return nil
+}
+
+// loadTaskIDs returns the IDs of the tasks in a file written by trigger with
+// -dump-json, sorted.
+func loadTaskIDs(path string) ([]string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Tasks map[string]struct {
+			TaskID string `json:"task_id"`
+		} `json:"tasks"`
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", path, err)
+	}
+	ids := []string{}
+	for _, t := range data.Tasks {
+		if t.TaskID != "" {
+			ids = append(ids, t.TaskID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no tasks in %s", path)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (c *collectRun) main(a subcommands.Application, taskIDs []string) (int, error) {
+	if c.jsonInput != "" {
+		var err error
+		if taskIDs, err = loadTaskIDs(c.jsonInput); err != nil {
+			return 1, err
+		}
+	}
+	s, client, err := c.createService()
+	if err != nil {
+		return 1, err
+	}
+
+	ctx := context.Background()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(c.timeout)*time.Second)
+		defer cancel()
+	}
+
+	// Tasks are collected one after the other, so the output of each task is
+	// printed in one piece.
+	var stdout io.Writer = os.Stdout
+	if c.noStdout {
+		stdout = ioutil.Discard
+	}
+	results := make([]*swarming.SwarmingRpcsTaskResult, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		if len(taskIDs) > 1 {
+			fmt.Fprintf(stdout, "--- Task %s ---\n", id)
+		}
+		result, err := collectTask(ctx, s, id, stdout, collectPollInterval)
+		if err != nil {
+			return 1, err
+		}
+		results = append(results, result)
+		if err := c.downloadOutputs(client, result); err != nil {
+			return 1, err
+		}
+	}
+
+	if c.summaryJSON != "" {
+		b, err := json.MarshalIndent(map[string]interface{}{"shards": results}, "", "  ")
+		if err != nil {
+			return 1, err
+		}
+		if err := ioutil.WriteFile(c.summaryJSON, b, 0666); err != nil {
+			return 1, err
+		}
+	}
+	return exitCode(a.GetErr(), results), nil
+}
+
+// downloadOutputs downloads the isolated outputs of the task, if requested.
+func (c *collectRun) downloadOutputs(client *http.Client, result *swarming.SwarmingRpcsTaskResult) error {
+	if c.outputDir == "" || result.OutputsRef == nil || result.OutputsRef.Isolated == "" {
+		return nil
+	}
+	outDir := filepath.Join(c.outputDir, result.TaskId)
+	if err := os.MkdirAll(outDir, 0777); err != nil {
+		return err
+	}
+	_, err := downloadFilesRef(client, result.OutputsRef, outDir)
+	return err
+}
+
+// collectTask polls the task every 'pollInterval' until it's done, then writes
+// its output to 'stdout'.
+//
+// The output is fetched only once the task is done, since it can only be
+// fetched whole.
+func collectTask(ctx context.Context, s *swarming.Service, taskID string, stdout io.Writer, pollInterval time.Duration) (*swarming.SwarmingRpcsTaskResult, error) {
+	for {
+		result, err := s.Task.Result(taskID).Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the result of task %s: %s", taskID, err)
+		}
+		if taskDone(result.State) {
+			out, err := s.Task.Stdout(taskID).Context(ctx).Do()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the output of task %s: %s", taskID, err)
+			}
+			if _, err := io.WriteString(stdout, out.Output); err != nil {
+				return nil, err
+			}
+			return result, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for task %s", taskID)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// exitCode returns the exit code of collect for the task results: the first
+// non-zero exit code, or 1 if a task didn't complete. Tasks that didn't
+// complete are reported to 'errOut'.
+func exitCode(errOut io.Writer, results []*swarming.SwarmingRpcsTaskResult) int {
+	code := 0
+	for _, r := range results {
+		if r.State != "COMPLETED" {
+			fmt.Fprintf(errOut, "task %s ended in state %s\n", r.TaskId, r.State)
+		}
+		switch {
+		case code != 0:
+		case r.ExitCode != 0:
+			code = int(r.ExitCode)
+		case r.State != "COMPLETED" || r.InternalFailure:
+			code = 1
+		}
+	}
+	return code
+}
+
+func (c *collectRun) Run(a subcommands.Application, args []string) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	code, err := c.main(a, args)
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return code
+}
@@ -0,0 +1,114 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/maruel/ut"
+)
+
+// fakeTask serves the result and output of a task that goes through 'states',
+// one per result request, with 'outputs' the matching output.
+type fakeTask struct {
+	states        []string
+	outputs       []string
+	polls         int
+	stdoutFetches int
+}
+
+func (f *fakeTask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var reply interface{}
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/result"):
+		f.polls++
+		reply = map[string]interface{}{"task_id": "123", "state": f.states[f.polls-1], "exit_code": "3"}
+	case strings.HasSuffix(r.URL.Path, "/stdout"):
+		f.stdoutFetches++
+		reply = map[string]interface{}{"output": f.outputs[f.polls-1]}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reply)
+}
+
+func TestCollectTask(t *testing.T) {
+	t.Parallel()
+	fake := &fakeTask{
+		states:  []string{"PENDING", "RUNNING", "RUNNING", "COMPLETED"},
+		outputs: []string{"", "hello", "hello world", "hello world\n"},
+	}
+	ts := httptest.NewServer(fake)
+	defer ts.Close()
+	s, err := newService(http.DefaultClient, ts.URL)
+	ut.AssertEqual(t, nil, err)
+
+	out := bytes.Buffer{}
+	result, err := collectTask(context.Background(), s, "123", &out, time.Millisecond)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "COMPLETED", result.State)
+	ut.AssertEqual(t, int64(3), result.ExitCode)
+	ut.AssertEqual(t, "hello world\n", out.String())
+	ut.AssertEqual(t, 4, fake.polls)
+	ut.AssertEqual(t, 1, fake.stdoutFetches)
+}
+
+func TestCollectTaskTimeout(t *testing.T) {
+	t.Parallel()
+	fake := &fakeTask{states: []string{"PENDING", "PENDING"}, outputs: []string{"", ""}}
+	ts := httptest.NewServer(fake)
+	defer ts.Close()
+	s, err := newService(http.DefaultClient, ts.URL)
+	ut.AssertEqual(t, nil, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = collectTask(ctx, s, "123", &bytes.Buffer{}, collectPollInterval)
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestCollectParse_NegativeTimeout(t *testing.T) {
+	t.Parallel()
+	for _, args := range [][]string{
+		{"-server", "http://localhost:9050", "-timeout", "-1"},
+		{"-server", "http://localhost:9050", "-timeout", "-1", "-json", "tasks.json"},
+	} {
+		c := collectRun{}
+		c.Init()
+		ut.AssertEqual(t, nil, c.GetFlags().Parse(args))
+		ut.AssertEqual(t, errors.New("-timeout can't be negative"), c.Parse(nil, nil))
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		results []*swarming.SwarmingRpcsTaskResult
+		code    int
+	}{
+		{[]*swarming.SwarmingRpcsTaskResult{{State: "COMPLETED"}}, 0},
+		{[]*swarming.SwarmingRpcsTaskResult{{State: "COMPLETED"}, {State: "COMPLETED", ExitCode: 2}}, 2},
+		{[]*swarming.SwarmingRpcsTaskResult{{State: "COMPLETED", ExitCode: 2}, {State: "COMPLETED", ExitCode: 3}}, 2},
+		{[]*swarming.SwarmingRpcsTaskResult{{State: "EXPIRED"}}, 1},
+		{[]*swarming.SwarmingRpcsTaskResult{{State: "COMPLETED", InternalFailure: true}}, 1},
+		{[]*swarming.SwarmingRpcsTaskResult{{State: "TIMED_OUT", ExitCode: -9}}, -9},
+	}
+	for i, line := range data {
+		errOut := bytes.Buffer{}
+		ut.AssertEqualIndex(t, i, line.code, exitCode(&errOut, line.results))
+	}
+}
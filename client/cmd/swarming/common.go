@@ -6,11 +6,23 @@ package main
 
 import (
 	"errors"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"runtime"
 
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/client/authcli"
+	"github.com/luci/luci-go/client/downloader"
 	"github.com/luci/luci-go/client/internal/common"
 	"github.com/luci/luci-go/client/internal/lhttp"
+	"github.com/luci/luci-go/client/isolatedclient"
+	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/luci/luci-go/common/auth"
+	"github.com/luci/luci-go/common/cache"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/logging/gologger"
 	"github.com/maruel/subcommands"
 )
 
@@ -18,16 +30,26 @@ func init() {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 }
 
+// apiPath is the path of the Swarming API, relative to the server URL.
+const apiPath = "/_ah/api/swarming/v1/"
+
 type commonFlags struct {
 	subcommands.CommandRunBase
 	defaultFlags common.Flags
 	serverURL    string
+
+	// Used to authenticate requests to server.
+	authFlags      authcli.Flags
+	parsedAuthOpts auth.Options
 }
 
 // Init initializes common flags.
 func (c *commonFlags) Init() {
 	c.defaultFlags.Init(&c.Flags)
 	c.Flags.StringVar(&c.serverURL, "server", os.Getenv("SWARMING_SERVER"), "Server URL; required. Set $SWARMING_SERVER to set a default.")
+	c.authFlags.Register(&c.Flags, auth.Options{
+		Method: auth.UserCredentialsMethod, // disable GCE service account for now
+	})
 }
 
 // Parse parses the common flags.
@@ -43,5 +65,67 @@ func (c *commonFlags) Parse() error {
 		return err
 	}
 	c.serverURL = s
-	return nil
+	c.parsedAuthOpts, err = c.authFlags.Options()
+	return err
+}
+
+func (c *commonFlags) createAuthClient() (*http.Client, error) {
+	ctx := gologger.StdConfig.Use(context.Background())
+	return auth.NewAuthenticator(ctx, auth.OptionalLogin, c.parsedAuthOpts).Client()
+}
+
+// createService returns a client for the Swarming API of the server, along
+// with the authenticated HTTP client it uses.
+func (c *commonFlags) createService() (*swarming.Service, *http.Client, error) {
+	client, err := c.createAuthClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	s, err := newService(client, c.serverURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, client, nil
+}
+
+// newService returns a client for the Swarming API of the server at
+// 'serverURL'.
+func newService(client *http.Client, serverURL string) (*swarming.Service, error) {
+	s, err := swarming.New(client)
+	if err != nil {
+		return nil, err
+	}
+	s.BasePath = serverURL + apiPath
+	return s, nil
+}
+
+// taskDone returns true if a task in this state will not change anymore.
+func taskDone(state string) bool {
+	return state != "PENDING" && state != "RUNNING"
+}
+
+// downloadFilesRef maps the isolated tree referenced by 'ref' into 'outDir',
+// using a temporary cache. It returns the merged .isolated file of the tree.
+func downloadFilesRef(client *http.Client, ref *swarming.SwarmingRpcsFilesRef, outDir string) (*isolated.Isolated, error) {
+	cacheDir, err := ioutil.TempDir("", "swarming")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(cacheDir)
+
+	is := isolatedclient.New(client, ref.Isolatedserver, ref.Namespace)
+	diskCache, err := cache.NewDisk(cache.Policies{}, cacheDir, is.Hash())
+	if err != nil {
+		return nil, err
+	}
+	d := downloader.New(is, diskCache)
+	common.CancelOnCtrlC(d)
+	i, err := d.FetchIsolated(isolated.HexDigest(ref.Isolated), outDir)
+	if err2 := d.Close(); err == nil {
+		err = err2
+	}
+	if err2 := diskCache.Close(); err == nil {
+		err = err2
+	}
+	return i, err
 }
@@ -16,13 +16,18 @@ import (
 
 // version must be updated whenever functional change (behavior, arguments,
 // supported commands) is done.
-const version = "0.2"
+const version = "0.4"
 
 var application = &subcommands.DefaultApplication{
 	Name:  "swarming",
 	Title: "Client tool to access a swarming server.",
 	// Keep in alphabetical order of their name.
 	Commands: []*subcommands.Command{
+		cmdBots,
+		cmdCancel,
+		cmdCollect,
+		cmdQuery,
+		cmdReproduce,
 		cmdRequestShow,
 		cmdTrigger,
 		subcommands.CmdHelp,
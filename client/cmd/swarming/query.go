@@ -0,0 +1,169 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/maruel/subcommands"
+)
+
+var cmdQuery = &subcommands.Command{
+	UsageLine: "query <options> <method>",
+	ShortDesc: "calls a Swarming API method",
+	LongDesc: `Calls a Swarming API method with GET and prints the reply as json.
+
+The method is relative to /_ah/api/swarming/v1/ and may include query
+parameters, e.g. 'tasks/list?state=PENDING'. Replies with a list of items are
+followed page by page, up to -limit items.`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &queryRun{}
+		r.Init()
+		return r
+	},
+}
+
+type queryRun struct {
+	commonFlags
+
+	limit      int
+	jsonOutput string
+}
+
+func (c *queryRun) Init() {
+	c.commonFlags.Init()
+
+	c.Flags.IntVar(&c.limit, "limit", 200, "Maximum number of items to fetch. 0 means no limit.")
+	c.Flags.StringVar(&c.jsonOutput, "json", "", "Write the reply to this file instead of stdout.")
+}
+
+func (c *queryRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return errors.New("must provide exactly one method")
+	}
+	if c.limit < 0 {
+		return errors.New("-limit can't be negative")
+	}
+	return nil
+}
+
+// queryAll calls the API method 'method' relative to 'baseURL' and returns the
+// decoded reply.
+//
+// If the reply has "items", the following pages are fetched using the reply
+// cursor until there are no more or 'limit' items were fetched, and all items
+// are returned in one list. The reply keeps the cursor of the last page, if
+// any.
+func queryAll(client *http.Client, baseURL, method string, limit int) (map[string]interface{}, error) {
+	u, err := url.Parse(baseURL + strings.TrimPrefix(method, "/"))
+	if err != nil {
+		return nil, err
+	}
+	params := u.Query()
+
+	var out map[string]interface{}
+	var items []interface{}
+	for {
+		if limit > 0 {
+			params.Set("limit", strconv.Itoa(limit-len(items)))
+		}
+		u.RawQuery = params.Encode()
+		page, err := getJSON(client, u.String())
+		if err != nil {
+			return nil, err
+		}
+		pageItems, ok := page["items"].([]interface{})
+		if !ok {
+			// Not a list, or an empty one.
+			if out == nil {
+				return page, nil
+			}
+			break
+		}
+		out = page
+		items = append(items, pageItems...)
+		cursor, _ := page["cursor"].(string)
+		if cursor == "" || (limit > 0 && len(items) >= limit) {
+			break
+		}
+		params.Set("cursor", cursor)
+	}
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	out["items"] = items
+	return out, nil
+}
+
+// getJSON fetches the URL and decodes the json object it returns.
+func getJSON(client *http.Client, u string) (map[string]interface{}, error) {
+	resp, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	out := map[string]interface{}{}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("failed to decode the reply: %s", err)
+	}
+	return out, nil
+}
+
+func (c *queryRun) main(a subcommands.Application, method string) error {
+	client, err := c.createAuthClient()
+	if err != nil {
+		return err
+	}
+	data, err := queryAll(client, c.serverURL+apiPath, method, c.limit)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	if c.jsonOutput != "" {
+		return ioutil.WriteFile(c.jsonOutput, b, 0666)
+	}
+	_, err = os.Stdout.Write(b)
+	return err
+}
+
+func (c *queryRun) Run(a subcommands.Application, args []string) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	if err := c.main(a, args[0]); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
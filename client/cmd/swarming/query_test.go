@@ -0,0 +1,56 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/maruel/ut"
+)
+
+func TestQueryAll(t *testing.T) {
+	t.Parallel()
+	// Serves 5 items, 2 per page at most.
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != apiPath+"bots/list" || r.FormValue("dimensions") != "pool:a" {
+			http.NotFound(w, r)
+			return
+		}
+		start := 0
+		if c := r.FormValue("cursor"); c != "" {
+			start = int(c[0] - '0')
+		}
+		end := start + 2
+		if end > 5 {
+			end = 5
+		}
+		items := []int{}
+		for i := start; i < end; i++ {
+			items = append(items, i)
+		}
+		reply := map[string]interface{}{"items": items, "now": "x"}
+		if end < 5 {
+			reply["cursor"] = string('0' + byte(end))
+		}
+		json.NewEncoder(w).Encode(reply)
+	}))
+	defer ts.Close()
+
+	data, err := queryAll(http.DefaultClient, ts.URL+apiPath, "bots/list?dimensions=pool:a", 0)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []interface{}{0., 1., 2., 3., 4.}, data["items"])
+	ut.AssertEqual(t, nil, data["cursor"])
+
+	data, err = queryAll(http.DefaultClient, ts.URL+apiPath, "bots/list?dimensions=pool:a", 3)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []interface{}{0., 1., 2., 3.}[:3], data["items"])
+	ut.AssertEqual(t, "4", data["cursor"])
+
+	_, err = queryAll(http.DefaultClient, ts.URL+apiPath, "bots/missing", 0)
+	ut.AssertEqual(t, true, err != nil)
+}
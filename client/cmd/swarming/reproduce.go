@@ -0,0 +1,236 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/client/environ"
+	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/luci/luci-go/common/ctxcmd"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/maruel/subcommands"
+)
+
+var cmdReproduce = &subcommands.Command{
+	UsageLine: "reproduce <options> <task_id> [-- <extra args>]",
+	ShortDesc: "runs a task locally",
+	LongDesc: `Fetches the request of a Swarming task and runs it locally.
+
+The isolated inputs of the task are mapped in the work directory, which must not
+exist yet, and the command of the task is run there with the environment
+variables of the task. Arguments after -- are appended to the command. The
+outputs of the task are written to the -out directory, or to a temporary one
+that is deleted once the task exits.
+
+The command exits with the exit code of the task command. Ctrl-C interrupts the
+task command.`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &reproduceRun{}
+		r.Init()
+		return r
+	},
+}
+
+// isolatedOutdirParameter is replaced in the task command by a directory for
+// the outputs of the task, like on a Swarming bot.
+const isolatedOutdirParameter = "${ISOLATED_OUTDIR}"
+
+type reproduceRun struct {
+	commonFlags
+
+	workDir string
+	outDir  string
+}
+
+func (c *reproduceRun) Init() {
+	c.commonFlags.Init()
+
+	c.Flags.StringVar(&c.workDir, "work", "work", "Directory to map the inputs of the task into. It must not exist.")
+	c.Flags.StringVar(&c.outDir, "out", "", "Directory for the outputs of the task, ${ISOLATED_OUTDIR}. If not set, a temporary directory is used and deleted once the task exits.")
+}
+
+func (c *reproduceRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if len(args) == 0 || (len(args) > 1 && args[1] != "--") {
+		return errors.New("must provide a task id, optionally followed by -- and extra arguments")
+	}
+	if _, err := os.Stat(c.workDir); err == nil {
+		return fmt.Errorf("%s already exists, please delete it first", c.workDir)
+	}
+	var err error
+	if c.workDir, err = filepath.Abs(c.workDir); err != nil {
+		return err
+	}
+	if c.outDir != "" {
+		c.outDir, err = filepath.Abs(c.outDir)
+	}
+	return err
+}
+
+// taskCommand returns the command of the task and the directory to run it in.
+//
+// The command of the task takes precedence over the command of the isolated
+// tree, which may be nil. 'extraArgs' are appended after the extra arguments of
+// the task. Occurrences of ${ISOLATED_OUTDIR} are replaced with 'outDir'.
+func taskCommand(props *swarming.SwarmingRpcsTaskProperties, i *isolated.Isolated, workDir, outDir string, extraArgs []string) ([]string, string, error) {
+	command := []string{}
+	cwd := workDir
+	if len(props.Command) != 0 {
+		command = append(command, props.Command...)
+	} else if i != nil {
+		command = append(command, i.Command...)
+		cwd = filepath.Join(workDir, filepath.FromSlash(i.RelativeCwd))
+	}
+	command = append(command, props.ExtraArgs...)
+	command = append(command, extraArgs...)
+	if len(command) == 0 {
+		return nil, "", errors.New("the task has no command")
+	}
+	for j, arg := range command {
+		command[j] = strings.Replace(arg, isolatedOutdirParameter, outDir, -1)
+	}
+	// Relative paths of commands are relative to the working directory.
+	if !filepath.IsAbs(command[0]) && filepath.Base(command[0]) != command[0] {
+		command[0] = filepath.Join(cwd, command[0])
+	}
+	return command, cwd, nil
+}
+
+// taskEnv returns 'base' updated with the environment variables of the task,
+// as a sorted list of KEY=VALUE. Variables with an empty value are removed.
+func taskEnv(base environ.Environment, env []*swarming.SwarmingRpcsStringPair) []string {
+	merged := environ.Environment{}
+	for k, v := range base {
+		merged[k] = v
+	}
+	for _, p := range env {
+		if p.Value == "" {
+			delete(merged, p.Key)
+		} else {
+			merged[p.Key] = p.Value
+		}
+	}
+	out := make([]string, 0, len(merged))
+	for k, v := range merged {
+		out = append(out, k+"="+v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// main maps the inputs of the task and runs its command. It returns the exit
+// code of the command.
+func (c *reproduceRun) main(a subcommands.Application, taskID string, extraArgs []string) (int, error) {
+	s, client, err := c.createService()
+	if err != nil {
+		return 1, err
+	}
+	request, err := s.Task.Request(taskID).Do()
+	if err != nil {
+		return 1, fmt.Errorf("failed to get the request of task %s: %s", taskID, err)
+	}
+	props := request.Properties
+	if props == nil {
+		return 1, fmt.Errorf("task %s has no properties", taskID)
+	}
+
+	if err := os.MkdirAll(c.workDir, 0777); err != nil {
+		return 1, err
+	}
+	var i *isolated.Isolated
+	if ref := props.InputsRef; ref != nil && ref.Isolated != "" {
+		if !c.defaultFlags.Quiet {
+			fmt.Fprintf(os.Stderr, "Fetching %s into %s\n", ref.Isolated, c.workDir)
+		}
+		if i, err = downloadFilesRef(client, ref, c.workDir); err != nil {
+			return 1, err
+		}
+	}
+
+	outDir := c.outDir
+	if outDir == "" {
+		if outDir, err = ioutil.TempDir("", "swarming_outdir"); err != nil {
+			return 1, err
+		}
+		defer os.RemoveAll(outDir)
+	} else if err := os.MkdirAll(outDir, 0777); err != nil {
+		return 1, err
+	}
+	command, cwd, err := taskCommand(props, i, c.workDir, outDir, extraArgs)
+	if err != nil {
+		return 1, err
+	}
+	if !c.defaultFlags.Quiet {
+		fmt.Fprintf(os.Stderr, "Running: %s\n", strings.Join(command, " "))
+		fmt.Fprintf(os.Stderr, "In:      %s\n", cwd)
+		fmt.Fprintf(os.Stderr, "Outputs: %s\n", outDir)
+	}
+
+	// Interrupt the command on Ctrl-C.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+	go func() {
+		select {
+		case <-interrupted:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	proc := ctxcmd.CtxCmd{
+		Cmd:          exec.Command(command[0], command[1:]...),
+		CancelSignal: os.Interrupt,
+	}
+	proc.Dir = cwd
+	proc.Env = taskEnv(environ.Get(), props.Env)
+	proc.Stdin = os.Stdin
+	proc.Stdout = os.Stdout
+	proc.Stderr = os.Stderr
+	if err := proc.Run(ctx); err != nil {
+		if code, ok := ctxcmd.ExitCode(proc.ProcessError); ok {
+			return code, nil
+		}
+		return 1, err
+	}
+	return 0, nil
+}
+
+func (c *reproduceRun) Run(a subcommands.Application, args []string) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	var extraArgs []string
+	if len(args) > 1 {
+		extraArgs = args[2:]
+	}
+	code, err := c.main(a, args[0], extraArgs)
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+	}
+	return code
+}
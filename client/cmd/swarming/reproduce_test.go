@@ -0,0 +1,52 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/luci/luci-go/client/environ"
+	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/maruel/ut"
+)
+
+func TestTaskCommand(t *testing.T) {
+	t.Parallel()
+	work := filepath.Join("base", "work")
+	i := &isolated.Isolated{Command: []string{"tools/run.py", "-v"}, RelativeCwd: "src"}
+
+	// Command from the isolated tree, with extra arguments.
+	props := &swarming.SwarmingRpcsTaskProperties{ExtraArgs: []string{"--out", "${ISOLATED_OUTDIR}/x"}}
+	command, cwd, err := taskCommand(props, i, work, "OUT", []string{"--local"})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, filepath.Join(work, "src"), cwd)
+	ut.AssertEqual(t, []string{filepath.Join(work, "src", "tools", "run.py"), "-v", "--out", "OUT/x", "--local"}, command)
+
+	// The command of the task wins.
+	props = &swarming.SwarmingRpcsTaskProperties{Command: []string{"python", "-c", "pass"}}
+	command, cwd, err = taskCommand(props, i, work, "OUT", nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, work, cwd)
+	ut.AssertEqual(t, []string{"python", "-c", "pass"}, command)
+
+	// No command at all.
+	_, _, err = taskCommand(&swarming.SwarmingRpcsTaskProperties{}, nil, work, "OUT", nil)
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestTaskEnv(t *testing.T) {
+	t.Parallel()
+	base := environ.Environment{"PATH": "/bin", "HOME": "/home/me", "TMP": "/tmp"}
+	env := taskEnv(base, []*swarming.SwarmingRpcsStringPair{
+		{Key: "PATH", Value: "/usr/bin"},
+		{Key: "TMP", Value: ""},
+		{Key: "FOO", Value: "bar"},
+	})
+	ut.AssertEqual(t, []string{"FOO=bar", "HOME=/home/me", "PATH=/usr/bin"}, env)
+	// The base environment is not modified.
+	ut.AssertEqual(t, "/tmp", base["TMP"])
+}
@@ -7,15 +7,8 @@ package main
 import (
 	"errors"
 	"fmt"
-	"net/http"
-
-	"golang.org/x/net/context"
 
 	"github.com/kr/pretty"
-	"github.com/luci/luci-go/client/authcli"
-	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
-	"github.com/luci/luci-go/common/auth"
-	"github.com/luci/luci-go/common/logging/gologger"
 	"github.com/maruel/subcommands"
 )
 
@@ -32,22 +25,10 @@ var cmdRequestShow = &subcommands.Command{
 
 type requestShowRun struct {
 	commonFlags
-
-	// Used to authenticate requests to server.
-	authFlags      authcli.Flags
-	parsedAuthOpts auth.Options
 }
 
 func (c *requestShowRun) Init() {
 	c.commonFlags.Init()
-	c.authFlags.Register(&c.Flags, auth.Options{
-		Method: auth.UserCredentialsMethod, // disable GCE service account for now
-	})
-}
-
-func (c *requestShowRun) createAuthClient() (*http.Client, error) {
-	ctx := gologger.StdConfig.Use(context.Background())
-	return auth.NewAuthenticator(ctx, auth.OptionalLogin, c.parsedAuthOpts).Client()
 }
 
 func (c *requestShowRun) Parse(a subcommands.Application, args []string) error {
@@ -61,16 +42,10 @@ func (c *requestShowRun) Parse(a subcommands.Application, args []string) error {
 }
 
 func (c *requestShowRun) main(a subcommands.Application, taskid string) error {
-	client, err := c.createAuthClient()
-	if err != nil {
-		return err
-	}
-
-	s, err := swarming.New(client)
+	s, _, err := c.createService()
 	if err != nil {
 		return err
 	}
-	s.BasePath = c.commonFlags.serverURL + "/_ah/api/swarming/v1/"
 
 	call := s.Task.Request(taskid)
 	result, err := call.Do()
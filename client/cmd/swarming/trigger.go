@@ -9,20 +9,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"sort"
 	"strings"
 	"time"
 
-	"golang.org/x/net/context"
-
-	"github.com/luci/luci-go/client/authcli"
 	"github.com/luci/luci-go/client/internal/common"
 	"github.com/luci/luci-go/common/api/swarming/swarming/v1"
-	"github.com/luci/luci-go/common/auth"
 	"github.com/luci/luci-go/common/flag/stringmapflag"
-	"github.com/luci/luci-go/common/logging/gologger"
 	"github.com/luci/luci-go/common/units"
 	"github.com/maruel/subcommands"
 )
@@ -98,10 +92,6 @@ type triggerRun struct {
 	ioTimeout   int64
 	rawCmd      bool
 	dumpJSON    string
-
-	// Used to authenticate requests to server.
-	authFlags      authcli.Flags
-	parsedAuthOpts auth.Options
 }
 
 func (c *triggerRun) Init() {
@@ -126,24 +116,12 @@ func (c *triggerRun) Init() {
 	c.Flags.Int64Var(&c.ioTimeout, "io-timeout", 20*60, "Seconds to allow the task to be silent.")
 	c.Flags.BoolVar(&c.rawCmd, "raw-cmd", false, "When set, the command after -- is used as-is without run_isolated. In this case, no isolated hash is expected.")
 	c.Flags.StringVar(&c.dumpJSON, "dump-json", "", "Dump details about the triggered task(s) to this file as json.")
-
-	c.authFlags.Register(&c.Flags, auth.Options{
-		Method: auth.UserCredentialsMethod, // disable GCE service account for now
-	})
-}
-
-func (c *triggerRun) createAuthClient() (*http.Client, error) {
-	ctx := gologger.StdConfig.Use(context.Background())
-	return auth.NewAuthenticator(ctx, auth.OptionalLogin,
-		c.parsedAuthOpts).Client()
 }
 
 func (c *triggerRun) Parse(args []string) error {
-	var err error
 	if err := c.commonFlags.Parse(); err != nil {
 		return err
 	}
-	c.parsedAuthOpts, err = c.authFlags.Options()
 
 	// Validate options and args.
 	if c.dimensions == nil {
@@ -172,7 +150,7 @@ func (c *triggerRun) Parse(args []string) error {
 		c.user = os.Getenv("USER")
 	}
 
-	return err
+	return nil
 }
 
 func (c *triggerRun) Run(a subcommands.Application, args []string) int {
@@ -326,16 +304,10 @@ func (c *triggerRun) processTriggerOptions(args []string) (*swarming.SwarmingRpc
 }
 
 func (c *triggerRun) createNewTask(request *swarming.SwarmingRpcsNewTaskRequest) (*swarming.SwarmingRpcsTaskRequestMetadata, error) {
-	client, err := c.createAuthClient()
-	if err != nil {
-		return &swarming.SwarmingRpcsTaskRequestMetadata{}, err
-	}
-
-	s, err := swarming.New(client)
+	s, _, err := c.createService()
 	if err != nil {
 		return &swarming.SwarmingRpcsTaskRequestMetadata{}, err
 	}
-	s.BasePath = c.commonFlags.serverURL + "/_ah/api/swarming/v1/"
 
 	call := s.Tasks.New(request).Fields("task_result")
 	result, err := call.Do()
@@ -0,0 +1,7 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package downloader implements the pipeline to efficiently fetch an isolated
+// tree from an isolated server and map it to a local directory.
+package downloader
@@ -0,0 +1,310 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package downloader
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/luci/luci-go/client/internal/common"
+	"github.com/luci/luci-go/client/internal/tracer"
+	"github.com/luci/luci-go/client/isolatedclient"
+	"github.com/luci/luci-go/common/cache"
+	"github.com/luci/luci-go/common/isolated"
+)
+
+// Downloader is an high level interface to an isolatedclient.IsolateServer to
+// fetch isolated trees.
+type Downloader interface {
+	common.Canceler
+	// FetchIsolated downloads the .isolated file 'root', all the .isolated files
+	// it includes and all the files they reference, and maps them in 'outDir'.
+	//
+	// The returned Isolated is the merge of 'root' and all its includes, see
+	// FetchTree().
+	FetchIsolated(root isolated.HexDigest, outDir string) (*isolated.Isolated, error)
+	// FetchTree downloads the .isolated file 'root' and all the .isolated files
+	// it includes, without fetching the files they reference.
+	//
+	// The returned Isolated is the merge of 'root' and all its includes. Files
+	// listed in an .isolated file take precedence over the ones listed in its
+	// includes, and earlier includes take precedence over later ones. Its
+	// Includes lists all the .isolated files that were merged, except 'root',
+	// in the order they were loaded.
+	FetchTree(root isolated.HexDigest) (*isolated.Isolated, error)
+}
+
+// New returns a thread-safe Downloader instance.
+//
+// All the content is fetched through 'c', so items already present in the
+// cache are not downloaded again. 'c' must use the same hashing algorithm as
+// 'is'. When 'c' is a disk based cache, the files are hardlinked from the cache
+// into the output directory.
+func New(is isolatedclient.IsolateServer, c cache.Cache) Downloader {
+	d := &downloader{
+		Canceler:           common.NewCanceler(),
+		is:                 is,
+		cache:              c,
+		maxConcurrentFetch: 8,
+	}
+	tracer.NewPID(d, "downloader")
+	return d
+}
+
+// Private details.
+
+type downloader struct {
+	common.Canceler
+
+	// Immutable.
+	is                 isolatedclient.IsolateServer
+	cache              cache.Cache
+	maxConcurrentFetch int
+}
+
+func (d *downloader) FetchIsolated(root isolated.HexDigest, outDir string) (*isolated.Isolated, error) {
+	end := tracer.Span(d, "fetchIsolated", tracer.Args{"root": root})
+	out, err := d.FetchTree(root)
+	if err == nil {
+		err = d.mapTree(out, outDir)
+	}
+	end(tracer.Args{"err": err})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (d *downloader) FetchTree(root isolated.HexDigest) (*isolated.Isolated, error) {
+	out := &isolated.Isolated{Files: map[string]isolated.File{}}
+	seen := map[isolated.HexDigest]bool{}
+	var load func(digest isolated.HexDigest) error
+	load = func(digest isolated.HexDigest) error {
+		if seen[digest] {
+			return fmt.Errorf("%s is included multiple times", digest)
+		}
+		seen[digest] = true
+		if digest != root {
+			out.Includes = append(out.Includes, digest)
+		}
+		i, err := d.loadIsolated(digest)
+		if err != nil {
+			return err
+		}
+		if out.Algo == "" {
+			out.Algo = i.Algo
+			out.Version = i.Version
+		}
+		if out.Command == nil {
+			out.Command = i.Command
+			out.RelativeCwd = i.RelativeCwd
+		}
+		if out.ReadOnly == nil {
+			out.ReadOnly = i.ReadOnly
+		}
+		for name, f := range i.Files {
+			if _, ok := out.Files[name]; !ok {
+				out.Files[name] = f
+			}
+		}
+		for _, include := range i.Includes {
+			if err := load(include); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := load(root); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// loadIsolated fetches and decodes a single .isolated file.
+func (d *downloader) loadIsolated(digest isolated.HexDigest) (*isolated.Isolated, error) {
+	if err := d.ensureCached(digest); err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %s", digest, err)
+	}
+	src, err := d.cache.Read(digest)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	i := &isolated.Isolated{}
+	if err := json.NewDecoder(src).Decode(i); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %s", digest, err)
+	}
+	if algo := isolated.GetAlgo(d.is.Hash()); i.Algo != algo {
+		return nil, fmt.Errorf("%s uses algo %q; expected %q", digest, i.Algo, algo)
+	}
+	return i, nil
+}
+
+// ensureCached downloads the item 'digest' into the cache unless it is already
+// there.
+func (d *downloader) ensureCached(digest isolated.HexDigest) error {
+	if d.cache.Touch(digest) {
+		return nil
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(d.is.Fetch(digest, pw))
+	}()
+	err := d.cache.Add(digest, pr)
+	// Unblock the fetch in case Add() returned before reading everything.
+	pr.Close()
+	return err
+}
+
+// mapTree creates all the files listed in 'i' in 'outDir'.
+func (d *downloader) mapTree(i *isolated.Isolated, outDir string) error {
+	if err := checkTree(i.Files); err != nil {
+		return err
+	}
+	readOnly := isolated.Writeable
+	if i.ReadOnly != nil {
+		readOnly = *i.ReadOnly
+	}
+	names := make([]string, 0, len(i.Files))
+	for name := range i.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var lock sync.Mutex
+	var firstErr error
+	setErr := func(err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	failed := func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return firstErr != nil
+	}
+
+	pool := common.NewGoroutinePool(d.maxConcurrentFetch, d.Canceler)
+	for _, name := range names {
+		name := name
+		f := i.Files[name]
+		dest := filepath.Join(outDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			setErr(err)
+			break
+		}
+		if f.Link != nil {
+			if err := os.Symlink(*f.Link, dest); err != nil {
+				setErr(err)
+				break
+			}
+			continue
+		}
+		perm := os.FileMode(0644)
+		if f.Mode != nil {
+			perm = os.FileMode(*f.Mode) & os.ModePerm
+		}
+		if readOnly != isolated.Writeable {
+			perm &^= 0222
+		}
+		pool.Schedule(func() {
+			if failed() {
+				// Do not bother fetching more items once the tree can't be mapped.
+				return
+			}
+			if err := d.ensureCached(f.Digest); err != nil {
+				setErr(fmt.Errorf("failed to fetch %s: %s", name, err))
+				return
+			}
+			if err := d.cache.Hardlink(f.Digest, dest, perm); err != nil {
+				setErr(fmt.Errorf("failed to map %s: %s", name, err))
+			}
+		}, nil)
+	}
+	if err := pool.Wait(); err != nil {
+		setErr(err)
+	}
+	if failed() {
+		return firstErr
+	}
+	if readOnly == isolated.DirsReadOnly {
+		return makeDirsReadOnly(outDir)
+	}
+	return nil
+}
+
+// checkTree verifies that mapping 'files' can't create anything outside of the
+// output directory.
+//
+// Names must be clean relative paths inside the output directory and must not
+// go through a symlink of the tree. Symlinks must point inside the output
+// directory.
+func checkTree(files map[string]isolated.File) error {
+	for name, f := range files {
+		if name == "." || !isLocalPath(name) {
+			return fmt.Errorf("invalid file name %q", name)
+		}
+		for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+			if files[dir].Link != nil {
+				return fmt.Errorf("invalid file name %q: %q is a symlink", name, dir)
+			}
+		}
+		if f.Link != nil {
+			target := filepath.ToSlash(*f.Link)
+			if filepath.IsAbs(*f.Link) || path.IsAbs(target) || !isLocalPath(path.Join(path.Dir(name), target)) {
+				return fmt.Errorf("invalid symlink %q: %q is outside of the tree", name, *f.Link)
+			}
+		}
+	}
+	return nil
+}
+
+// isLocalPath returns true if 'p' is a clean relative slash separated path
+// inside the root it is relative to, or the root itself.
+func isLocalPath(p string) bool {
+	if p == "" || path.IsAbs(p) || filepath.IsAbs(filepath.FromSlash(p)) || filepath.VolumeName(filepath.FromSlash(p)) != "" {
+		return false
+	}
+	return path.Clean(p) == p && p != ".." && !strings.HasPrefix(p, "../")
+}
+
+// makeDirsReadOnly removes the write bits on all the directories in 'root',
+// including 'root' itself.
+func makeDirsReadOnly(root string) error {
+	var dirs []string
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Process the deepest directories first, so their parents are still
+	// writeable while they are being processed.
+	for i := len(dirs) - 1; i >= 0; i-- {
+		info, err := os.Stat(dirs[i])
+		if err != nil {
+			return err
+		}
+		if err := os.Chmod(dirs[i], info.Mode()&os.ModePerm&^0222); err != nil {
+			return err
+		}
+	}
+	return nil
+}
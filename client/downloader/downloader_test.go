@@ -0,0 +1,270 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package downloader
+
+import (
+	"crypto"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/luci/luci-go/client/isolatedclient"
+	"github.com/luci/luci-go/client/isolatedclient/isolatedfake"
+	"github.com/luci/luci-go/common/cache"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/maruel/ut"
+)
+
+func init() {
+	log.SetOutput(ioutil.Discard)
+}
+
+func TestDownloaderFetchIsolated(t *testing.T) {
+	t.Parallel()
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	foo := []byte("foo")
+	bar := []byte("bar")
+	server.Inject("default-gzip", foo)
+	server.Inject("default-gzip", bar)
+	mode := 0500
+	link := "foo"
+	included := injectIsolated(t, server, &isolated.Isolated{
+		Files: map[string]isolated.File{
+			"foo":     {Digest: isolated.HashBytes(crypto.SHA1, bar)},
+			"sub/bar": {Digest: isolated.HashBytes(crypto.SHA1, bar), Mode: &mode},
+		},
+	})
+	files := map[string]isolated.File{
+		"foo": {Digest: isolated.HashBytes(crypto.SHA1, foo)},
+	}
+	if runtime.GOOS != "windows" {
+		files["link"] = isolated.File{Link: &link}
+	}
+	root := injectIsolated(t, server, &isolated.Isolated{
+		Command:  []string{"foo"},
+		Files:    files,
+		Includes: isolated.HexDigests{included},
+	})
+
+	td, err := ioutil.TempDir("", "downloader")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	ut.AssertEqual(t, nil, os.Mkdir(filepath.Join(td, "cache"), 0700))
+	c, err := cache.NewDisk(cache.Policies{MaxSize: 1024, MaxItems: 10}, filepath.Join(td, "cache"), crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	out := filepath.Join(td, "out")
+
+	d := New(isolatedclient.New(nil, ts.URL, "default-gzip"), c)
+	i, err := d.FetchIsolated(root, out)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"foo"}, i.Command)
+	ut.AssertEqual(t, isolated.HashBytes(crypto.SHA1, foo), i.Files["foo"].Digest)
+	ut.AssertEqual(t, isolated.HexDigests{included}, i.Includes)
+	ut.AssertEqual(t, nil, d.Close())
+	ut.AssertEqual(t, nil, c.Close())
+	ut.AssertEqual(t, nil, server.Error())
+
+	// The root .isolated takes precedence over its includes.
+	content, err := ioutil.ReadFile(filepath.Join(out, "foo"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, foo, content)
+	content, err = ioutil.ReadFile(filepath.Join(out, "sub", "bar"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, bar, content)
+	if runtime.GOOS != "windows" {
+		fi, err := os.Stat(filepath.Join(out, "sub", "bar"))
+		ut.AssertEqual(t, nil, err)
+		ut.AssertEqual(t, os.FileMode(0500), fi.Mode())
+		actual, err := os.Readlink(filepath.Join(out, "link"))
+		ut.AssertEqual(t, nil, err)
+		ut.AssertEqual(t, link, actual)
+	}
+}
+
+func TestDownloaderMissing(t *testing.T) {
+	t.Parallel()
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	td, err := ioutil.TempDir("", "downloader")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	d := New(isolatedclient.New(nil, ts.URL, "default-gzip"), cache.NewMemory(cache.Policies{MaxSize: 1024, MaxItems: 10}, crypto.SHA1))
+	i, err := d.FetchIsolated(isolated.HashBytes(crypto.SHA1, []byte("missing")), td)
+	ut.AssertEqual(t, (*isolated.Isolated)(nil), i)
+	ut.AssertEqual(t, true, err != nil)
+	ut.AssertEqual(t, nil, d.Close())
+}
+
+func TestDownloaderOutsideOfTree(t *testing.T) {
+	t.Parallel()
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	foo := []byte("foo")
+	server.Inject("default-gzip", foo)
+	root := injectIsolated(t, server, &isolated.Isolated{
+		Files: map[string]isolated.File{"../escaped": {Digest: isolated.HashBytes(crypto.SHA1, foo)}},
+	})
+
+	td, err := ioutil.TempDir("", "downloader")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	d := New(isolatedclient.New(nil, ts.URL, "default-gzip"), cache.NewMemory(cache.Policies{MaxSize: 1024, MaxItems: 10}, crypto.SHA1))
+	_, err = d.FetchIsolated(root, filepath.Join(td, "out"))
+	ut.AssertEqual(t, true, err != nil)
+	_, err = os.Lstat(filepath.Join(td, "escaped"))
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+	ut.AssertEqual(t, nil, d.Close())
+}
+
+func TestDownloaderHardlink(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not fully supported on Windows")
+	}
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	foo := []byte("foo")
+	server.Inject("default-gzip", foo)
+	ro := isolated.FilesReadOnly
+	root := injectIsolated(t, server, &isolated.Isolated{
+		Files:    map[string]isolated.File{"foo": {Digest: isolated.HashBytes(crypto.SHA1, foo)}},
+		ReadOnly: &ro,
+	})
+
+	td, err := ioutil.TempDir("", "downloader")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	ut.AssertEqual(t, nil, os.Mkdir(filepath.Join(td, "cache"), 0700))
+
+	// Read-only files of repeated downloads are hardlinked from the cache.
+	var infos []os.FileInfo
+	for _, out := range []string{"out1", "out2"} {
+		c, err := cache.NewDisk(cache.Policies{MaxSize: 1024, MaxItems: 10}, filepath.Join(td, "cache"), crypto.SHA1)
+		ut.AssertEqual(t, nil, err)
+		d := New(isolatedclient.New(nil, ts.URL, "default-gzip"), c)
+		_, err = d.FetchIsolated(root, filepath.Join(td, out))
+		ut.AssertEqual(t, nil, err)
+		ut.AssertEqual(t, nil, d.Close())
+		ut.AssertEqual(t, nil, c.Close())
+		info, err := os.Stat(filepath.Join(td, out, "foo"))
+		ut.AssertEqual(t, nil, err)
+		ut.AssertEqual(t, os.FileMode(0444), info.Mode())
+		infos = append(infos, info)
+	}
+	ut.AssertEqual(t, true, os.SameFile(infos[0], infos[1]))
+	ut.AssertEqual(t, nil, server.Error())
+}
+
+func TestCheckTree(t *testing.T) {
+	t.Parallel()
+	link := func(target string) isolated.File {
+		return isolated.File{Link: &target}
+	}
+	file := isolated.File{Digest: isolated.HashBytes(crypto.SHA1, []byte("foo"))}
+	ok := []map[string]isolated.File{
+		{"foo": file, "sub/bar": file},
+		{"link": link("foo"), "sub/link": link("../foo"), "sub/dir": link(".."), "self": link(".")},
+	}
+	for i, files := range ok {
+		ut.AssertEqualIndex(t, i, nil, checkTree(files))
+	}
+	bad := []map[string]isolated.File{
+		{"": file},
+		{".": file},
+		{"..": file},
+		{"../foo": file},
+		{"sub/../../foo": file},
+		{"/foo": file},
+		{"sub//foo": file},
+		{"link": link("/etc/passwd")},
+		{"link": link("..")},
+		{"sub/link": link("../../foo")},
+		{"link": link("sub"), "link/foo": file},
+	}
+	for i, files := range bad {
+		ut.AssertEqualIndex(t, i, true, checkTree(files) != nil)
+	}
+}
+
+func TestDownloaderSHA256(t *testing.T) {
+	t.Parallel()
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	foo := []byte("foo")
+	server.Inject("sha256-deflate", foo)
+	root := injectIsolatedNamespace(t, server, "sha256-deflate", &isolated.Isolated{
+		Files: map[string]isolated.File{"foo": {Digest: isolated.HashBytes(crypto.SHA256, foo)}},
+	})
+	ut.AssertEqual(t, 64, len(root))
+
+	td, err := ioutil.TempDir("", "downloader")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	is := isolatedclient.New(nil, ts.URL, "sha256-deflate")
+	d := New(is, cache.NewMemory(cache.Policies{MaxSize: 1024, MaxItems: 10}, is.Hash()))
+	_, err = d.FetchIsolated(root, td)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, d.Close())
+	ut.AssertEqual(t, nil, server.Error())
+	content, err := ioutil.ReadFile(filepath.Join(td, "foo"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, foo, content)
+
+	// A SHA-1 .isolated is refused in a SHA-256 namespace.
+	sha1Root := injectIsolated(t, server, &isolated.Isolated{})
+	wrongAlgo := server.Inject("sha256-deflate", server.Contents()["default-gzip"][sha1Root])
+	d = New(is, cache.NewMemory(cache.Policies{MaxSize: 1024, MaxItems: 10}, is.Hash()))
+	_, err = d.FetchIsolated(wrongAlgo, td)
+	ut.AssertEqual(t, true, err != nil)
+	ut.AssertEqual(t, nil, d.Close())
+}
+
+func injectIsolated(t *testing.T, server isolatedfake.IsolatedFake, i *isolated.Isolated) isolated.HexDigest {
+	return injectIsolatedNamespace(t, server, "default-gzip", i)
+}
+
+func injectIsolatedNamespace(t *testing.T, server isolatedfake.IsolatedFake, namespace string, i *isolated.Isolated) isolated.HexDigest {
+	i.Algo = isolated.GetAlgo(isolated.GetHash(namespace))
+	i.Version = isolated.IsolatedFormatVersion
+	data, err := json.Marshal(i)
+	ut.AssertEqual(t, nil, err)
+	return server.Inject(namespace, data)
+}
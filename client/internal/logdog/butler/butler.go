@@ -14,6 +14,7 @@ import (
 
 	"github.com/luci/luci-go/client/internal/logdog/butler/bundler"
 	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	"github.com/luci/luci-go/client/internal/logdog/butler/spool"
 	"github.com/luci/luci-go/client/internal/logdog/butler/streamserver"
 	"github.com/luci/luci-go/client/logdog/butlerlib/streamproto"
 	"github.com/luci/luci-go/common/clock"
@@ -24,6 +25,7 @@ import (
 	"github.com/luci/luci-go/common/paniccatcher"
 	"github.com/luci/luci-go/common/parallel"
 	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
 	"github.com/luci/luci-go/common/stringset"
 	"golang.org/x/net/context"
 )
@@ -38,11 +40,16 @@ const (
 
 	// streamBufferSize is the maximum amount of stream data to buffer in memory.
 	streamBufferSize = 1024 * 1024 * 5
+
+	// spoolReplayInterval is the amount of time between attempts to replay
+	// spooled bundles to the Output after a replay has failed.
+	spoolReplayInterval = 30 * time.Second
 )
 
 // Config is the set of Butler configuration parameters.
 type Config struct {
-	// Output is the output instance to use for log dispatch.
+	// Output is the output instance to use for log dispatch. If it fails to
+	// send a bundle, the Butler keeps running and Wait returns the error.
 	Output output.Output
 	// OutputWorkers is the number of simultaneous goroutines that will be used
 	// to output Butler log data. If zero, DefaultOutputWorkers will be used.
@@ -67,6 +74,16 @@ type Config struct {
 	// TeeStderr, if not nil, is the Writer that will be used for streams
 	// requesting STDERR tee.
 	TeeStderr io.Writer
+
+	// SpoolDir, if not empty, is a directory in which bundles that fail to send
+	// will be durably spooled. Spooled bundles are replayed to the Output, in
+	// order, until they are sent. Bundles that are still spooled when
+	// the Butler finishes remain in the directory, and may be replayed later.
+	//
+	// Spooled bundles are replayed to the whole Output, so it should not fan
+	// out to other outputs. The directory must not contain bundles spooled by a
+	// previous run, since they belong to another prefix.
+	SpoolDir string
 }
 
 // Validate validates that the configuration is sufficient to instantiate a
@@ -102,6 +119,15 @@ type Butler struct {
 	// been drained.
 	bundlerDrainedC chan struct{}
 
+	// spool, if not nil, is the Spool that unsent bundles are written to.
+	spool *spool.Spool
+	// spoolStopC is closed to stop the spool replay loop.
+	spoolStopC chan struct{}
+	// spoolReplayC signals the spool replay loop that bundles have been spooled.
+	spoolReplayC chan struct{}
+	// spoolFinishedC is closed when the spool replay loop has finished.
+	spoolFinishedC chan struct{}
+
 	// activateC is closed when Activate() is called.
 	activateC chan struct{}
 	// activateOnce ensures we close activeC exactly once.
@@ -129,6 +155,9 @@ type Butler struct {
 	isShutdown bool
 	// runErr is the error returned by Run.
 	runErr error
+	// sendErr is the first error returned by the Output. It is returned by Run
+	// if the Butler was not shut down with another error.
+	sendErr error
 	// streamStopC is a stop signal channel for stream. This will cause streams
 	// to prematurely terminate (before EOF) on shutdown.
 	streamStopC chan struct{}
@@ -144,6 +173,18 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		config.OutputWorkers = DefaultOutputWorkers
 	}
 
+	var sp *spool.Spool
+	if config.SpoolDir != "" {
+		var err error
+		if sp, err = spool.New(ctx, config.SpoolDir); err != nil {
+			return nil, fmt.Errorf("butler: failed to open spool: %v", err)
+		}
+		if n := sp.Pending(); n > 0 {
+			sp.Close()
+			return nil, fmt.Errorf("butler: spool directory contains %d unsent bundles of a previous run", n)
+		}
+	}
+
 	bc := bundler.Config{
 		Clock:            clock.Get(ctx),
 		Project:          config.Project,
@@ -163,6 +204,8 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		bundler:         lb,
 		bundlerDrainedC: make(chan struct{}),
 
+		spool: sp,
+
 		streamsFinishedC: make(chan struct{}),
 
 		activateC:         make(chan struct{}),
@@ -172,6 +215,16 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		streamStopC:       make(chan struct{}),
 	}
 
+	if b.spool != nil {
+		b.spoolStopC = make(chan struct{})
+		b.spoolReplayC = make(chan struct{}, 1)
+		b.spoolFinishedC = make(chan struct{})
+		go func() {
+			defer close(b.spoolFinishedC)
+			b.runSpoolReplay()
+		}()
+	}
+
 	// Load bundles from our Bundler into the queue.
 	go func() {
 		defer close(b.bundlerDrainedC)
@@ -184,7 +237,7 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 				}
 
 				workC <- func() error {
-					b.c.Output.SendBundle(bundle)
+					b.sendBundle(bundle)
 					return nil
 				}
 			}
@@ -258,6 +311,23 @@ func (b *Butler) Wait() error {
 	<-b.bundlerDrainedC
 	log.Debugf(b.ctx, "Output queue has shut down.")
 
+	if b.spool != nil {
+		log.Debugf(b.ctx, "Replaying spooled bundles.")
+		close(b.spoolStopC)
+		<-b.spoolFinishedC
+
+		b.replaySpool()
+		if n := b.spool.Pending(); n > 0 {
+			log.Fields{
+				"spoolDir": b.spool.Dir(),
+				"pending":  n,
+			}.Warningf(b.ctx, "Some bundles could not be sent; they remain in the spool directory.")
+		}
+		if err := b.spool.Close(); err != nil {
+			log.WithError(err).Warningf(b.ctx, "Failed to close spool.")
+		}
+	}
+
 	log.Debugf(b.ctx, "Waiting for message output to Close")
 	b.c.Output.Close()
 
@@ -267,6 +337,99 @@ func (b *Butler) Wait() error {
 	return b.getRunErr()
 }
 
+// sendBundle sends a bundle to the Output. If the Butler is spooling, a bundle
+// that fails to send is written to the spool instead.
+func (b *Butler) sendBundle(bundle *logpb.ButlerLogBundle) {
+	if b.spool == nil {
+		if err := b.c.Output.SendBundle(bundle); err != nil {
+			b.sendFailed(err)
+		}
+		return
+	}
+
+	// If there are already spooled bundles, add this bundle behind them so that
+	// bundles are sent in order.
+	if b.spool.Pending() == 0 {
+		err := b.c.Output.SendBundle(bundle)
+		if err == nil {
+			return
+		}
+		log.WithError(err).Warningf(b.ctx, "Failed to send bundle; spooling.")
+	}
+
+	if err := b.spool.Write(bundle); err != nil {
+		b.sendFailed(fmt.Errorf("failed to spool bundle: %v", err))
+		return
+	}
+
+	select {
+	case b.spoolReplayC <- struct{}{}:
+	default:
+		// A replay has already been requested.
+	}
+}
+
+// sendFailed records that a bundle could not be sent. The first such error is
+// returned by Wait.
+func (b *Butler) sendFailed(err error) {
+	log.WithError(err).Errorf(b.ctx, "Failed to send bundle.")
+
+	b.shutdownMu.Lock()
+	defer b.shutdownMu.Unlock()
+	if b.sendErr == nil {
+		b.sendErr = err
+	}
+}
+
+// runSpoolReplay replays spooled bundles to the Output until spoolStopC is
+// closed.
+//
+// Bundles are replayed as soon as they are spooled, so that new bundles are
+// sent directly to the Output again once the spool has been emptied. After a
+// replay fails, the next one is only attempted after spoolReplayInterval.
+func (b *Butler) runSpoolReplay() {
+	for {
+		select {
+		case <-b.spoolStopC:
+			return
+		case <-b.spoolReplayC:
+		}
+
+		for !b.replaySpool() {
+			select {
+			case <-b.spoolStopC:
+				return
+			case tr := <-clock.After(b.ctx, spoolReplayInterval):
+				if tr.Incomplete() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// replaySpool sends any spooled bundles to the Output. It returns false if the
+// spool could not be emptied.
+func (b *Butler) replaySpool() bool {
+	if b.spool.Pending() == 0 {
+		return true
+	}
+
+	n, err := b.spool.Replay(b.c.Output.SendBundle)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"sent":       n,
+			"pending":    b.spool.Pending(),
+		}.Warningf(b.ctx, "Failed to replay spooled bundles.")
+		return false
+	}
+	log.Fields{
+		"sent": n,
+	}.Infof(b.ctx, "Replayed spooled bundles.")
+	return true
+}
+
 // Streams returns a sorted list of stream names that have been registered to
 // the Butler.
 func (b *Butler) Streams() []types.StreamName {
@@ -550,5 +713,8 @@ func (b *Butler) shutdown(err error) {
 func (b *Butler) getRunErr() error {
 	b.shutdownMu.Lock()
 	defer b.shutdownMu.Unlock()
-	return b.runErr
+	if b.runErr != nil {
+		return b.runErr
+	}
+	return b.sendErr
 }
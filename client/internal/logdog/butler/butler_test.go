@@ -9,11 +9,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	"github.com/luci/luci-go/client/internal/logdog/butler/spool"
 	"github.com/luci/luci-go/client/logdog/butlerlib/streamproto"
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/clock/testclock"
 	"github.com/luci/luci-go/common/logdog/types"
 	"github.com/luci/luci-go/common/proto/logdog/logpb"
@@ -33,13 +38,13 @@ type testOutput struct {
 }
 
 func (to *testOutput) SendBundle(b *logpb.ButlerLogBundle) error {
+	to.Lock()
+	defer to.Unlock()
+
 	if to.err != nil {
 		return to.err
 	}
 
-	to.Lock()
-	defer to.Unlock()
-
 	if to.streams == nil {
 		to.streams = map[string][]*logpb.LogEntry{}
 		to.terminal = map[string]struct{}{}
@@ -72,6 +77,13 @@ func (to *testOutput) Close() {
 	to.closed = true
 }
 
+func (to *testOutput) setErr(err error) {
+	to.Lock()
+	defer to.Unlock()
+
+	to.err = err
+}
+
 func (to *testOutput) logs(name string) []*logpb.LogEntry {
 	to.Lock()
 	defer to.Unlock()
@@ -268,7 +280,7 @@ func TestButler(t *testing.T) {
 	t.Parallel()
 
 	Convey(`A testing Butler instance`, t, func() {
-		c, _ := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+		c, clk := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
 		teeStdout := bytes.Buffer{}
 		teeStderr := bytes.Buffer{}
 		to := testOutput{
@@ -499,6 +511,124 @@ func TestButler(t *testing.T) {
 				})
 			})
 
+			Convey(`Will fail once finished if the Output fails to send a bundle.`, func() {
+				to.setErr(errors.New("output unavailable"))
+				b := mkb(c, conf)
+				s := newTestStream(props)
+				So(b.AddStream(s, *s.properties), ShouldBeNil)
+				s.data([]byte("line 0\n"), io.EOF)
+
+				b.Activate()
+				So(b.Wait(), ShouldErrLike, "output unavailable")
+			})
+
+			Convey(`When spooling to a directory`, func() {
+				tdir, err := ioutil.TempDir("", "logdog_butler_spool")
+				So(err, ShouldBeNil)
+				defer os.RemoveAll(tdir)
+
+				conf.SpoolDir = tdir
+				to.setErr(errors.New("output unavailable"))
+
+				Convey(`Will spool bundles that fail to send, and send them in order once the Output recovers.`, func() {
+					b := mkb(c, conf)
+					s := newTestStream(props)
+					So(b.AddStream(s, *s.properties), ShouldBeNil)
+					s.data([]byte("line 0\n"), nil)
+
+					// Wait for the first bundle to be spooled.
+					for b.spool.Pending() == 0 {
+						time.Sleep(time.Millisecond)
+					}
+
+					// Bundles sent after the Output recovers are spooled behind the
+					// spooled bundle, and are all replayed when the Butler finishes.
+					to.setErr(nil)
+					s.data([]byte("line 1\n"), io.EOF)
+
+					b.Activate()
+					So(b.Wait(), ShouldBeNil)
+
+					So(to.logs("test"), shouldHaveTextLogs, "line 0", "line 1")
+					So(to.isTerminal("test"), ShouldBeTrue)
+
+					sp, err := spool.New(c, tdir)
+					So(err, ShouldBeNil)
+					defer sp.Close()
+					So(sp.Pending(), ShouldEqual, 0)
+				})
+
+				Convey(`Will replay spooled bundles once the Output recovers, then send directly.`, func() {
+					timerC := make(chan struct{}, 1)
+					clk.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+						if d == spoolReplayInterval {
+							select {
+							case timerC <- struct{}{}:
+							default:
+							}
+						}
+					})
+
+					b := mkb(c, conf)
+					s := newTestStream(props)
+					So(b.AddStream(s, *s.properties), ShouldBeNil)
+					s.data([]byte("line 0\n"), nil)
+
+					// Wait for the replay of the spooled bundle to fail, then let the
+					// Output recover.
+					<-timerC
+					So(b.spool.Pending(), ShouldBeGreaterThan, 0)
+					to.setErr(nil)
+					clk.Add(spoolReplayInterval)
+					for len(to.logs("test")) == 0 {
+						time.Sleep(time.Millisecond)
+					}
+					So(b.spool.Pending(), ShouldEqual, 0)
+
+					s.data([]byte("line 1\n"), io.EOF)
+					b.Activate()
+					So(b.Wait(), ShouldBeNil)
+					So(to.logs("test"), shouldHaveTextLogs, "line 0", "line 1")
+				})
+
+				Convey(`Will refuse a spool directory containing bundles of a previous run.`, func() {
+					sp, err := spool.New(c, tdir)
+					So(err, ShouldBeNil)
+					So(sp.Write(&logpb.ButlerLogBundle{
+						Entries: []*logpb.ButlerLogBundle_Entry{{
+							Desc: &logpb.LogStreamDescriptor{Name: "previous"},
+							Logs: []*logpb.LogEntry{{}},
+						}},
+					}), ShouldBeNil)
+					So(sp.Close(), ShouldBeNil)
+
+					_, err = New(c, conf)
+					So(err, ShouldErrLike, "unsent bundles of a previous run")
+				})
+
+				Convey(`Will leave unsent bundles in the spool directory for later replay.`, func() {
+					b := mkb(c, conf)
+					s := newTestStream(props)
+					So(b.AddStream(s, *s.properties), ShouldBeNil)
+					s.data([]byte("line 0\n"), io.EOF)
+
+					b.Activate()
+					So(b.Wait(), ShouldBeNil)
+					So(to.logs("test"), ShouldHaveLength, 0)
+
+					sp, err := spool.New(c, tdir)
+					So(err, ShouldBeNil)
+					defer sp.Close()
+					So(sp.Pending(), ShouldBeGreaterThan, 0)
+
+					to.setErr(nil)
+					_, err = sp.Replay(to.SendBundle)
+					So(err, ShouldBeNil)
+					So(to.logs("test"), shouldHaveTextLogs, "line 0")
+					So(to.isTerminal("test"), ShouldBeTrue)
+				})
+			})
+
 			Convey(`Will ignore stream registration errors, allowing re-registration.`, func() {
 				tss := newTestStreamServer()
 
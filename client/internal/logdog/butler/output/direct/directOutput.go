@@ -0,0 +1,255 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package direct
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logdog/butlerproto"
+	"github.com/luci/luci-go/common/logdog/types"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/common/recordio"
+	"github.com/luci/luci-go/common/retry"
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+const (
+	// maxMessageSize is the maximum size of a log bundle request body. This
+	// must not exceed the Collector's maximum bundle size.
+	maxMessageSize = 8 * 1024 * 1024
+)
+
+// Config is a configuration structure for direct output.
+type Config struct {
+	// URL is the Collector URL to POST log bundles to.
+	URL string
+
+	// Client is the HTTP client to use. If nil, http.DefaultClient will be used.
+	Client *http.Client
+
+	// Secret, if not nil, is the prefix secret to attach to each outgoing bundle.
+	Secret types.PrefixSecret
+
+	// Compress, if true, enables zlib compression.
+	Compress bool
+
+	// Track, if true, tracks all log entries that have been successfully
+	// submitted.
+	Track bool
+
+	// RetryTimeout, if >0, is the maximum amount of time to spend retrying
+	// transient errors before failing to send a bundle. If zero, transient
+	// errors will be retried indefinitely.
+	RetryTimeout time.Duration
+}
+
+// buffer
+type buffer struct {
+	bytes.Buffer // Output buffer for request body data.
+
+	frameWriter recordio.Writer
+	protoWriter *butlerproto.Writer
+}
+
+// Butler Output that POSTs messages directly to a LogDog Collector as
+// compressed protocol buffer blobs.
+type directOutput struct {
+	*Config
+	context.Context
+
+	bufferPool sync.Pool // Pool of reusable buffer instances.
+
+	statsMu sync.Mutex
+	stats   output.StatsBase
+
+	et *output.EntryTracker
+}
+
+// New instantiates a new direct output.
+func New(ctx context.Context, c Config) output.Output {
+	o := directOutput{
+		Config: &c,
+	}
+	o.bufferPool.New = func() interface{} { return &buffer{} }
+
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+	if c.Track {
+		o.et = &output.EntryTracker{}
+	}
+
+	o.Context = log.SetField(ctx, "direct", &o)
+	return &o
+}
+
+func (o *directOutput) String() string {
+	return fmt.Sprintf("direct(%s)", o.URL)
+}
+
+func (o *directOutput) SendBundle(bundle *logpb.ButlerLogBundle) error {
+	st := output.StatsBase{}
+	defer o.mergeStats(&st)
+
+	b := o.bufferPool.Get().(*buffer)
+	defer o.bufferPool.Put(b)
+
+	bundle.Secret = []byte(o.Secret)
+	if err := o.buildMessage(b, bundle); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+		}.Errorf(o, "Failed to build message from bundle.")
+		st.F.DiscardedMessages++
+		st.F.Errors++
+		return err
+	}
+	if b.Len() > maxMessageSize {
+		log.Fields{
+			"messageSize":    b.Len(),
+			"maxMessageSize": maxMessageSize,
+		}.Errorf(o, "Constructed message exceeds maximum size.")
+		return errors.New("direct: bundle contents violate size limit")
+	}
+	if err := o.sendMessage(b.Bytes()); err != nil {
+		st.F.DiscardedMessages++
+		st.F.Errors++
+		return err
+	}
+
+	if o.et != nil {
+		o.et.Track(bundle)
+	}
+
+	st.F.SentBytes += b.Len()
+	st.F.SentMessages++
+	return nil
+}
+
+func (*directOutput) MaxSize() int {
+	return maxMessageSize / 2
+}
+
+func (o *directOutput) Stats() output.Stats {
+	o.statsMu.Lock()
+	defer o.statsMu.Unlock()
+
+	statsCopy := o.stats
+	return &statsCopy
+}
+
+func (o *directOutput) Record() *output.EntryRecord {
+	if o.et == nil {
+		return nil
+	}
+	return o.et.Record()
+}
+
+func (o *directOutput) Close() {
+	// Nothing to do.
+}
+
+// buildMessage writes LogDog frames into buf.
+//
+// The first frame will be a ButlerMetadata message describing the second
+// frame. The second frame will be a ButlerLogBundle containing the bundle
+// data.
+func (o *directOutput) buildMessage(buf *buffer, bundle *logpb.ButlerLogBundle) error {
+	if buf.protoWriter == nil {
+		buf.protoWriter = &butlerproto.Writer{
+			Compress:          o.Compress,
+			CompressThreshold: butlerproto.DefaultCompressThreshold,
+		}
+	}
+
+	// Clear our buffer and (re)initialize our frame writer.
+	buf.Reset()
+	if buf.frameWriter == nil {
+		buf.frameWriter = recordio.NewWriter(buf)
+	} else {
+		buf.frameWriter.Reset(buf)
+	}
+
+	return buf.protoWriter.WriteWith(buf.frameWriter, bundle)
+}
+
+// sendMessage POSTs a message to the Collector. It will retry transient errors
+// until the request succeeds, or until the output's RetryTimeout has elapsed.
+func (o *directOutput) sendMessage(data []byte) error {
+	err := retry.Retry(o, retry.TransientOnly(o.retryIterator), func() error {
+		return o.post(data)
+	}, func(err error, d time.Duration) {
+		log.Fields{
+			log.ErrorKey: err,
+			"delay":      d,
+		}.Warningf(o, "TRANSIENT error sending message; retrying...")
+	})
+	if err != nil {
+		log.WithError(err).Errorf(o, "Failed to send message.")
+		return err
+	}
+
+	log.Fields{
+		"size": len(data),
+	}.Debugf(o, "Sent message.")
+	return nil
+}
+
+// post performs a single POST request. Connection errors and server errors
+// are returned as transient errors.
+func (o *directOutput) post(data []byte) error {
+	resp, err := ctxhttp.Post(o, o.Client, o.URL, "application/octet-stream", bytes.NewReader(data))
+	if err != nil {
+		return errors.WrapTransient(err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the response body so the connection can be reused.
+	io.Copy(ioutil.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests:
+		return errors.WrapTransient(fmt.Errorf("direct: server returned transient status %d", resp.StatusCode))
+	default:
+		return fmt.Errorf("direct: server returned status %d", resp.StatusCode)
+	}
+}
+
+func (o *directOutput) mergeStats(s output.Stats) {
+	o.statsMu.Lock()
+	defer o.statsMu.Unlock()
+
+	o.stats.Merge(s)
+}
+
+// retryIterator returns the retry.Iterator to use for transient send
+// errors. If the output has a RetryTimeout, retries are bounded by it.
+func (o *directOutput) retryIterator() retry.Iterator {
+	it := indefiniteRetry()
+	it.MaxTotal = o.RetryTimeout
+	return it
+}
+
+// indefiniteRetry is a retry.Iterator that will indefinitely retry errors with
+// a maximum backoff.
+func indefiniteRetry() *retry.ExponentialBackoff {
+	return &retry.ExponentialBackoff{
+		Limited: retry.Limited{
+			Retries: -1,
+		},
+		MaxDelay: 30 * time.Second,
+	}
+}
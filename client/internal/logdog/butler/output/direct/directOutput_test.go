@@ -0,0 +1,151 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package direct
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/logdog/butlerproto"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testCollector struct {
+	sync.Mutex
+
+	// status, if not empty, is the sequence of status codes to respond with.
+	status []int
+	bodies [][]byte
+
+	// onRequest, if not nil, is called for each received request.
+	onRequest func()
+}
+
+func (tc *testCollector) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	tc.Lock()
+	defer tc.Unlock()
+
+	if tc.onRequest != nil {
+		tc.onRequest()
+	}
+	if len(tc.status) > 0 {
+		code := tc.status[0]
+		tc.status = tc.status[1:]
+		if code != http.StatusOK {
+			rw.WriteHeader(code)
+			return
+		}
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		panic(err)
+	}
+	tc.bodies = append(tc.bodies, body)
+}
+
+func (tc *testCollector) bundles() ([]*logpb.ButlerLogBundle, error) {
+	tc.Lock()
+	defer tc.Unlock()
+
+	var bundles []*logpb.ButlerLogBundle
+	for _, body := range tc.bodies {
+		pr := butlerproto.Reader{}
+		if err := pr.Read(bytes.NewReader(body)); err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, pr.Bundle)
+	}
+	return bundles, nil
+}
+
+func TestOutput(t *testing.T) {
+	Convey(`An Output using a test Collector`, t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+		tc.SetTimerCallback(func(d time.Duration, _ clock.Timer) {
+			tc.Add(d)
+		})
+
+		coll := testCollector{}
+		srv := httptest.NewServer(&coll)
+		defer srv.Close()
+
+		conf := Config{
+			URL:    srv.URL,
+			Secret: []byte("secret"),
+		}
+		o := New(ctx, conf).(*directOutput)
+		So(o, ShouldNotBeNil)
+		defer o.Close()
+
+		bundle := &logpb.ButlerLogBundle{
+			Source:    "Direct Test",
+			Timestamp: google.NewTimestamp(clock.Now(ctx)),
+			Entries: []*logpb.ButlerLogBundle_Entry{
+				{},
+			},
+		}
+
+		Convey(`Can send/receive a bundle.`, func() {
+			So(o.SendBundle(bundle), ShouldBeNil)
+
+			bundles, err := coll.bundles()
+			So(err, ShouldBeNil)
+			So(bundles, ShouldResemble, []*logpb.ButlerLogBundle{bundle})
+			So(bundles[0].Secret, ShouldResemble, []byte("secret"))
+
+			Convey(`And records stats.`, func() {
+				st := o.Stats()
+				So(st.Errors(), ShouldEqual, 0)
+				So(st.SentBytes(), ShouldBeGreaterThan, 0)
+				So(st.SentMessages(), ShouldEqual, 1)
+				So(st.DiscardedMessages(), ShouldEqual, 0)
+			})
+		})
+
+		Convey(`Will retry transient server errors.`, func() {
+			coll.status = []int{http.StatusServiceUnavailable, http.StatusInternalServerError}
+			So(o.SendBundle(bundle), ShouldBeNil)
+
+			bundles, err := coll.bundles()
+			So(err, ShouldBeNil)
+			So(bundles, ShouldHaveLength, 1)
+		})
+
+		Convey(`Will fail the bundle if transient errors outlast its RetryTimeout.`, func() {
+			o.RetryTimeout = time.Minute
+			coll.onRequest = func() { tc.Add(10 * time.Second) }
+			for i := 0; i < 10; i++ {
+				coll.status = append(coll.status, http.StatusServiceUnavailable)
+			}
+			So(o.SendBundle(bundle), ShouldNotBeNil)
+
+			bundles, err := coll.bundles()
+			So(err, ShouldBeNil)
+			So(bundles, ShouldHaveLength, 0)
+			So(o.Stats().DiscardedMessages(), ShouldEqual, 1)
+		})
+
+		Convey(`Will return an error if the Collector rejects the bundle.`, func() {
+			coll.status = []int{http.StatusBadRequest}
+			So(o.SendBundle(bundle), ShouldNotBeNil)
+
+			st := o.Stats()
+			So(st.Errors(), ShouldEqual, 1)
+			So(st.DiscardedMessages(), ShouldEqual, 1)
+		})
+	})
+}
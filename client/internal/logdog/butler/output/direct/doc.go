@@ -0,0 +1,13 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package direct implements the "direct" Output.
+//
+// The "direct" Output POSTs ButlerLogBundle protobufs directly to a LogDog
+// Collector's HTTP endpoint using the protocol defined in:
+//   github.com/luci/luci-go/common/logdog/butlerproto
+//
+// This is used by services that are configured with a direct transport
+// instead of Google Cloud Pub/Sub.
+package direct
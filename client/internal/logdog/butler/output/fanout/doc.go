@@ -0,0 +1,7 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package fanout implements an Output that sends each log bundle to several
+// Outputs, e.g., to stream logs to LogDog while also writing them locally.
+package fanout
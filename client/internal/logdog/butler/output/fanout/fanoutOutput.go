@@ -0,0 +1,253 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package fanout
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"golang.org/x/net/context"
+)
+
+const (
+	// DefaultBufferSize is the default number of bundles that will be buffered
+	// for a non-required Output.
+	DefaultBufferSize = 64
+)
+
+// errClosed is returned when a bundle is sent to a closed fan-out Output.
+var errClosed = errors.New("fanout: output is closed")
+
+// Sink is an Output that a fan-out Output sends bundles to.
+type Sink struct {
+	// Output is the Output to send bundles to.
+	Output output.Output
+
+	// Required, if true, means that a failure to send a bundle through Output
+	// fails the fan-out Output's SendBundle.
+	//
+	// Bundles are sent to required Outputs synchronously. Other Outputs are sent
+	// bundles asynchronously through their own buffer, so that a slow or failing
+	// Output cannot hold up the rest. If that buffer is full, the bundle will be
+	// discarded for that Output. Send failures of non-required Outputs are
+	// logged and ignored.
+	Required bool
+
+	// BufferSize is the maximum number of bundles to buffer for a non-required
+	// Output. If <= 0, DefaultBufferSize will be used.
+	BufferSize int
+}
+
+// sink is the runtime state of a Sink.
+type sink struct {
+	*Sink
+
+	// name is the name of the Output, for logging.
+	name string
+
+	// bundleC is the bundle buffer for a non-required Output. It is nil for
+	// required Outputs.
+	bundleC chan *logpb.ButlerLogBundle
+}
+
+// fanoutOutput is an Output that sends each bundle to several Outputs.
+type fanoutOutput struct {
+	context.Context
+
+	sinks []*sink
+
+	// workersWG tracks the non-required Outputs' send goroutines.
+	workersWG sync.WaitGroup
+
+	// closeMu protects closed, and the closing of sink buffers.
+	closeMu sync.RWMutex
+	closed  bool
+
+	statsMu sync.Mutex
+	stats   output.StatsBase
+}
+
+// New instantiates a new fan-out Output that sends each bundle to all of the
+// supplied sinks' Outputs.
+//
+// The fan-out Output assumes ownership of the sinks' Outputs, and will close
+// them when it is closed.
+func New(ctx context.Context, sinks ...Sink) output.Output {
+	o := fanoutOutput{
+		sinks: make([]*sink, len(sinks)),
+	}
+	o.Context = log.SetField(ctx, "fanout", &o)
+
+	for i := range sinks {
+		s := sink{
+			Sink: &sinks[i],
+			name: fmt.Sprintf("%d", i),
+		}
+		if st, ok := s.Output.(fmt.Stringer); ok {
+			s.name = st.String()
+		}
+
+		if !s.Required {
+			size := s.BufferSize
+			if size <= 0 {
+				size = DefaultBufferSize
+			}
+			s.bundleC = make(chan *logpb.ButlerLogBundle, size)
+
+			o.workersWG.Add(1)
+			go func() {
+				defer o.workersWG.Done()
+				o.runSink(&s)
+			}()
+		}
+		o.sinks[i] = &s
+	}
+	return &o
+}
+
+func (o *fanoutOutput) String() string {
+	names := make([]string, len(o.sinks))
+	for i, s := range o.sinks {
+		names[i] = s.name
+	}
+	return fmt.Sprintf("fanout%v", names)
+}
+
+func (o *fanoutOutput) SendBundle(bundle *logpb.ButlerLogBundle) error {
+	o.closeMu.RLock()
+	defer o.closeMu.RUnlock()
+
+	if o.closed {
+		return errClosed
+	}
+
+	// Outputs may modify the bundles that they are sent (e.g., to add a
+	// secret), so each Output gets its own copy. The first required Output, which
+	// finishes with the bundle before we return, can use the original. All copies
+	// are made before any Output is sent its bundle.
+	bundles := make([]*logpb.ButlerLogBundle, len(o.sinks))
+	shared := false
+	for i, s := range o.sinks {
+		if s.Required && !shared {
+			bundles[i] = bundle
+			shared = true
+		} else {
+			bundles[i] = proto.Clone(bundle).(*logpb.ButlerLogBundle)
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := errors.NewLazyMultiError(len(o.sinks))
+	for i, s := range o.sinks {
+		if s.Required {
+			wg.Add(1)
+			go func(i int, s *sink) {
+				defer wg.Done()
+				errs.Assign(i, s.Output.SendBundle(bundles[i]))
+			}(i, s)
+			continue
+		}
+
+		select {
+		case s.bundleC <- bundles[i]:
+		default:
+			log.Fields{
+				"output": s.name,
+			}.Warningf(o, "Output buffer is full; discarding bundle.")
+
+			o.statsMu.Lock()
+			o.stats.F.DiscardedMessages++
+			o.statsMu.Unlock()
+		}
+	}
+	wg.Wait()
+	return errs.Get()
+}
+
+// MaxSize returns the smallest maximum size of the fan-out's Outputs, since
+// every bundle must be acceptable to all of them.
+func (o *fanoutOutput) MaxSize() int {
+	maxSize := 0
+	for _, s := range o.sinks {
+		if size := s.Output.MaxSize(); size > 0 && (maxSize <= 0 || size < maxSize) {
+			maxSize = size
+		}
+	}
+	return maxSize
+}
+
+// Stats returns the sum of the fan-out's Outputs' Stats, as well as any
+// bundles that the fan-out itself discarded.
+func (o *fanoutOutput) Stats() output.Stats {
+	st := func() output.StatsBase {
+		o.statsMu.Lock()
+		defer o.statsMu.Unlock()
+		return o.stats
+	}()
+
+	for _, s := range o.sinks {
+		st.Merge(s.Output.Stats())
+	}
+	return &st
+}
+
+// Record returns the stream record of the first Output that keeps one.
+func (o *fanoutOutput) Record() *output.EntryRecord {
+	for _, s := range o.sinks {
+		if r := s.Output.Record(); r != nil {
+			return r
+		}
+	}
+	return nil
+}
+
+// Close flushes any buffered bundles, then closes the fan-out's Outputs.
+func (o *fanoutOutput) Close() {
+	if !o.markClosed() {
+		return
+	}
+
+	for _, s := range o.sinks {
+		if s.bundleC != nil {
+			close(s.bundleC)
+		}
+	}
+	o.workersWG.Wait()
+
+	for _, s := range o.sinks {
+		s.Output.Close()
+	}
+}
+
+// markClosed marks the Output as closed. It returns false if the Output was
+// already closed.
+func (o *fanoutOutput) markClosed() bool {
+	o.closeMu.Lock()
+	defer o.closeMu.Unlock()
+
+	if o.closed {
+		return false
+	}
+	o.closed = true
+	return true
+}
+
+// runSink sends buffered bundles to a non-required Output until its buffer is
+// closed.
+func (o *fanoutOutput) runSink(s *sink) {
+	for b := range s.bundleC {
+		if err := s.Output.SendBundle(b); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"output":     s.name,
+			}.Warningf(o, "Failed to send bundle to non-required output.")
+		}
+	}
+}
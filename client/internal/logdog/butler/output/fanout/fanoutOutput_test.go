@@ -0,0 +1,178 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package fanout
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/client/internal/logdog/butler/output"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testOutput struct {
+	sync.Mutex
+
+	err     error
+	maxSize int
+	record  *output.EntryRecord
+
+	// blockC, if not nil, blocks SendBundle until it is closed.
+	blockC chan struct{}
+
+	bundles []*logpb.ButlerLogBundle
+	closed  bool
+}
+
+func (to *testOutput) SendBundle(b *logpb.ButlerLogBundle) error {
+	if to.blockC != nil {
+		<-to.blockC
+	}
+
+	to.Lock()
+	defer to.Unlock()
+
+	if to.err != nil {
+		return to.err
+	}
+	to.bundles = append(to.bundles, b)
+	b.Secret = []byte("modified")
+	return nil
+}
+
+func (to *testOutput) MaxSize() int { return to.maxSize }
+
+func (to *testOutput) Stats() output.Stats {
+	to.Lock()
+	defer to.Unlock()
+
+	st := output.StatsBase{}
+	st.F.SentMessages = len(to.bundles)
+	return &st
+}
+
+func (to *testOutput) Record() *output.EntryRecord { return to.record }
+
+func (to *testOutput) Close() {
+	to.Lock()
+	defer to.Unlock()
+
+	if to.closed {
+		panic("double close")
+	}
+	to.closed = true
+}
+
+func (to *testOutput) sent() []*logpb.ButlerLogBundle {
+	to.Lock()
+	defer to.Unlock()
+
+	return to.bundles
+}
+
+func TestOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A fan-out Output with a required and a non-required Output`, t, func() {
+		c := context.Background()
+
+		req := &testOutput{maxSize: 1024}
+		opt := &testOutput{maxSize: 512}
+		o := New(c, Sink{Output: req, Required: true}, Sink{Output: opt, BufferSize: 1})
+		closed := false
+		defer func() {
+			if !closed {
+				o.Close()
+			}
+		}()
+		closeOutput := func() {
+			o.Close()
+			closed = true
+		}
+
+		bundle := &logpb.ButlerLogBundle{
+			Source: "Fan-out Test",
+		}
+
+		Convey(`Uses the smallest Output MaxSize.`, func() {
+			So(o.MaxSize(), ShouldEqual, 512)
+
+			opt.maxSize = 0
+			So(o.MaxSize(), ShouldEqual, 1024)
+		})
+
+		Convey(`Sends each Output its own copy of the bundle.`, func() {
+			So(o.SendBundle(bundle), ShouldBeNil)
+			closeOutput()
+
+			So(req.sent(), ShouldHaveLength, 1)
+			So(opt.sent(), ShouldHaveLength, 1)
+			So(req.sent()[0], ShouldEqual, bundle)
+			So(opt.sent()[0], ShouldNotEqual, bundle)
+			So(opt.sent()[0].Source, ShouldEqual, "Fan-out Test")
+
+			Convey(`And merges their Stats.`, func() {
+				So(o.Stats().SentMessages(), ShouldEqual, 2)
+			})
+		})
+
+		Convey(`Fails if a required Output fails.`, func() {
+			req.err = errors.New("test error")
+			So(o.SendBundle(bundle), ShouldErrLike, "test error")
+		})
+
+		Convey(`Ignores failures of a non-required Output.`, func() {
+			opt.err = errors.New("test error")
+			So(o.SendBundle(bundle), ShouldBeNil)
+			closeOutput()
+
+			So(req.sent(), ShouldHaveLength, 1)
+			So(opt.sent(), ShouldHaveLength, 0)
+		})
+
+		Convey(`Discards bundles when a non-required Output's buffer is full.`, func() {
+			opt.blockC = make(chan struct{})
+
+			// One bundle is in SendBundle, one is buffered, and the rest are
+			// discarded. The first send must be dequeued before we can be sure that
+			// the buffer has room for exactly one more.
+			So(o.SendBundle(bundle), ShouldBeNil)
+			for len(o.(*fanoutOutput).sinks[1].bundleC) > 0 {
+				time.Sleep(time.Millisecond)
+			}
+			for i := 0; i < 3; i++ {
+				So(o.SendBundle(bundle), ShouldBeNil)
+			}
+			So(req.sent(), ShouldHaveLength, 4)
+
+			close(opt.blockC)
+			closeOutput()
+			So(opt.sent(), ShouldHaveLength, 2)
+			So(o.Stats().DiscardedMessages(), ShouldEqual, 2)
+		})
+
+		Convey(`Returns the first available Record.`, func() {
+			So(o.Record(), ShouldBeNil)
+
+			opt.record = &output.EntryRecord{}
+			So(o.Record(), ShouldEqual, opt.record)
+		})
+
+		Convey(`When closed, closes its Outputs exactly once and rejects new bundles.`, func() {
+			closeOutput()
+			o.Close()
+
+			So(req.closed, ShouldBeTrue)
+			So(opt.closed, ShouldBeTrue)
+			So(o.SendBundle(bundle), ShouldErrLike, "output is closed")
+		})
+	})
+}
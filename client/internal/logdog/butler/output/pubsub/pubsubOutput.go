@@ -50,6 +50,11 @@ type Config struct {
 	// Track, if true, tracks all log entries that have been successfully
 	// submitted.
 	Track bool
+
+	// RetryTimeout, if >0, is the maximum amount of time to spend retrying
+	// transient errors before failing to send a bundle. If zero, transient
+	// errors will be retried indefinitely.
+	RetryTimeout time.Duration
 }
 
 // buffer
@@ -185,11 +190,12 @@ func (o *pubSubOutput) buildMessage(buf *buffer, bundle *logpb.ButlerLogBundle)
 	}, nil
 }
 
-// publishMessages handles an individual publish request. It will indefinitely
-// retry transient errors until the publish succeeds.
+// publishMessages handles an individual publish request. It will retry
+// transient errors until the publish succeeds, or until the output's
+// RetryTimeout has elapsed.
 func (o *pubSubOutput) publishMessages(messages []*pubsub.Message) error {
 	var messageIDs []string
-	err := retry.Retry(o, retry.TransientOnly(indefiniteRetry), func() (err error) {
+	err := retry.Retry(o, retry.TransientOnly(o.retryIterator), func() (err error) {
 		messageIDs, err = o.Topic.Publish(o, messages...)
 		return
 	}, func(err error, d time.Duration) {
@@ -217,9 +223,17 @@ func (o *pubSubOutput) mergeStats(s output.Stats) {
 	o.stats.Merge(s)
 }
 
+// retryIterator returns the retry.Iterator to use for transient publish
+// errors. If the output has a RetryTimeout, retries are bounded by it.
+func (o *pubSubOutput) retryIterator() retry.Iterator {
+	it := indefiniteRetry()
+	it.MaxTotal = o.RetryTimeout
+	return it
+}
+
 // indefiniteRetry is a retry.Iterator that will indefinitely retry errors with
 // a maximum backoff.
-func indefiniteRetry() retry.Iterator {
+func indefiniteRetry() *retry.ExponentialBackoff {
 	return &retry.ExponentialBackoff{
 		Limited: retry.Limited{
 			Retries: -1,
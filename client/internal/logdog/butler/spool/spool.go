@@ -0,0 +1,410 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package spool implements a durable, on-disk queue of Butler log bundles.
+//
+// A spool directory holds a sequence of spool files. Each spool file is a
+// series of RecordIO frames, each of which contains a marshalled
+// ButlerLogBundle protobuf. Spool files are replayed in sequence order, and
+// removed once all of their bundles have been sent.
+//
+// Bundles' prefix secrets are not spooled, and spool files and directories are
+// only accessible by the current user.
+package spool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/common/recordio"
+	"golang.org/x/net/context"
+)
+
+const (
+	// fileExt is the file extension of spool files.
+	fileExt = ".spool"
+
+	// maxFileSize is the size at which a spool file will stop accepting new
+	// bundles, and a new spool file will be started.
+	maxFileSize = 16 * 1024 * 1024
+
+	// maxFrameSize is the maximum size of a spooled bundle frame.
+	maxFrameSize = 64 * 1024 * 1024
+)
+
+// errClosed is returned when an operation is performed on a closed Spool.
+var errClosed = errors.New("spool: spool is closed")
+
+// SendFunc sends a spooled bundle. If it returns an error, the bundle is
+// considered unsent and will remain in the spool.
+type SendFunc func(*logpb.ButlerLogBundle) error
+
+// Spool is a durable, ordered, on-disk queue of log bundles.
+//
+// A Spool is goroutine-safe. Only one Spool instance may operate on a given
+// directory at a time.
+type Spool struct {
+	ctx context.Context
+	dir string
+
+	// replayMu serializes Replay calls.
+	replayMu sync.Mutex
+
+	// mu protects the following fields.
+	mu sync.Mutex
+	// cur is the spool file that is currently being written to. If nil, the
+	// next Write will create a new spool file.
+	cur *os.File
+	// curSize is the number of bytes written to cur.
+	curSize int64
+	// nextSeq is the sequence number of the next spool file to create.
+	nextSeq int64
+	// pending is the number of spooled bundles that have not been sent.
+	pending int
+	// closed is true if the Spool has been closed.
+	closed bool
+}
+
+// New opens the spool in directory dir, creating the directory if it does not
+// exist. Any bundles that are already in the spool directory are retained, and
+// will be sent by the next Replay.
+func New(ctx context.Context, dir string) (*Spool, error) {
+	if dir == "" {
+		return nil, errors.New("spool: a spool directory is required")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("spool: failed to create spool directory %q: %v", dir, err)
+	}
+
+	s := Spool{
+		ctx: log.SetField(ctx, "spoolDir", dir),
+		dir: dir,
+	}
+
+	files, err := s.files(-1)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		_, frames, err := readFrames(s.ctx, f.path)
+		if err != nil {
+			return nil, err
+		}
+		s.pending += len(frames)
+		s.nextSeq = f.seq + 1
+	}
+	return &s, nil
+}
+
+// Dir returns the spool directory.
+func (s *Spool) Dir() string { return s.dir }
+
+// Pending returns the number of spooled bundles that have not been sent.
+func (s *Spool) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pending
+}
+
+// Write durably adds a bundle to the end of the spool.
+//
+// The bundle's Secret is not written. The Output that replayed bundles are sent
+// to is expected to attach it again.
+func (s *Spool) Write(b *logpb.ButlerLogBundle) error {
+	nb := *b
+	nb.Secret = nil
+	data, err := proto.Marshal(&nb)
+	if err != nil {
+		return fmt.Errorf("spool: failed to marshal bundle: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errClosed
+	}
+
+	if s.cur != nil && s.curSize >= maxFileSize {
+		s.closeCurrentLocked()
+	}
+	if s.cur == nil {
+		path := s.filePath(s.nextSeq)
+		fd, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return fmt.Errorf("spool: failed to create spool file %q: %v", path, err)
+		}
+		s.cur, s.curSize = fd, 0
+		s.nextSeq++
+	}
+
+	n, err := recordio.WriteFrame(s.cur, data)
+	if err == nil {
+		err = s.cur.Sync()
+	}
+	if err != nil {
+		// The file may now end with a partial frame. Stop writing to it; the
+		// partial frame will be ignored when the file is read.
+		s.closeCurrentLocked()
+		return fmt.Errorf("spool: failed to write bundle: %v", err)
+	}
+	s.curSize += int64(n)
+	s.pending++
+	return nil
+}
+
+// Replay sends spooled bundles, in the order that they were written, using
+// send. Replay stops at the first bundle that fails to send, leaving it and all
+// subsequent bundles in the spool, and returns the send error.
+//
+// Bundles that are written while Replay is running are replayed too, so Replay
+// only returns without an error once the spool is empty.
+//
+// Replay returns the number of bundles that were sent.
+func (s *Spool) Replay(send SendFunc) (int, error) {
+	s.replayMu.Lock()
+	defer s.replayMu.Unlock()
+
+	sent := 0
+	for {
+		// Finish the current spool file so that bundles written during this pass
+		// are written to a later file, and replayed by the next pass.
+		var limit int64
+		var pending int
+		if err := func() error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			if s.closed {
+				return errClosed
+			}
+			s.closeCurrentLocked()
+			limit, pending = s.nextSeq, s.pending
+			return nil
+		}(); err != nil {
+			return sent, err
+		}
+		if pending == 0 {
+			return sent, nil
+		}
+
+		n, err := s.replayFiles(limit, send)
+		sent += n
+		if err != nil {
+			return sent, err
+		}
+		if n == 0 {
+			// No bundle could be sent (e.g., they were all corrupt). Don't spin.
+			return sent, nil
+		}
+	}
+}
+
+// replayFiles sends the bundles in the spool files whose sequence number is
+// less than limit, removing the files once they have been sent.
+func (s *Spool) replayFiles(limit int64, send SendFunc) (int, error) {
+	files, err := s.files(limit)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, f := range files {
+		data, frames, err := readFrames(s.ctx, f.path)
+		if err != nil {
+			return sent, err
+		}
+
+		for i, fr := range frames {
+			b := logpb.ButlerLogBundle{}
+			if err := proto.Unmarshal(data[fr.start:fr.end], &b); err != nil {
+				log.Fields{
+					log.ErrorKey: err,
+					"path":       f.path,
+				}.Errorf(s.ctx, "Discarding corrupt spooled bundle.")
+				s.release()
+				continue
+			}
+
+			if err := send(&b); err != nil {
+				// Rewrite the spool file without the bundles that we've already sent,
+				// so they will not be sent again.
+				if i > 0 {
+					if err := rewriteFile(f.path, data[fr.offset:]); err != nil {
+						log.Fields{
+							log.ErrorKey: err,
+							"path":       f.path,
+						}.Warningf(s.ctx, "Failed to remove sent bundles from spool file.")
+					}
+				}
+				return sent, err
+			}
+
+			sent++
+			s.release()
+		}
+
+		if err := os.Remove(f.path); err != nil {
+			return sent, fmt.Errorf("spool: failed to remove spool file %q: %v", f.path, err)
+		}
+	}
+	return sent, nil
+}
+
+// Close closes the Spool. Any bundles that have not been sent remain in the
+// spool directory.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errClosed
+	}
+	s.closeCurrentLocked()
+	s.closed = true
+	return nil
+}
+
+func (s *Spool) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending--
+}
+
+func (s *Spool) closeCurrentLocked() {
+	if s.cur == nil {
+		return
+	}
+	if err := s.cur.Close(); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       s.cur.Name(),
+		}.Warningf(s.ctx, "Failed to close spool file.")
+	}
+	s.cur = nil
+}
+
+func (s *Spool) filePath(seq int64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%016d%s", seq, fileExt))
+}
+
+// spoolFile is a spool file in the spool directory.
+type spoolFile struct {
+	seq  int64
+	path string
+}
+
+// files returns the spool files in the spool directory, sorted by sequence
+// number. If limit is >= 0, only files whose sequence number is less than limit
+// will be returned.
+func (s *Spool) files(limit int64) ([]spoolFile, error) {
+	fis, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("spool: failed to list spool directory: %v", err)
+	}
+
+	files := make([]spoolFile, 0, len(fis))
+	for _, fi := range fis {
+		name := fi.Name()
+		if fi.IsDir() || !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+		seq, err := strconv.ParseInt(strings.TrimSuffix(name, fileExt), 10, 64)
+		if err != nil || seq < 0 || (limit >= 0 && seq >= limit) {
+			continue
+		}
+		files = append(files, spoolFile{seq, filepath.Join(s.dir, name)})
+	}
+	sort.Sort(spoolFilesBySeq(files))
+	return files, nil
+}
+
+type spoolFilesBySeq []spoolFile
+
+func (s spoolFilesBySeq) Len() int           { return len(s) }
+func (s spoolFilesBySeq) Less(i, j int) bool { return s[i].seq < s[j].seq }
+func (s spoolFilesBySeq) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// frame describes the location of a RecordIO frame within spool file data.
+type frame struct {
+	// offset is the offset of the frame's header.
+	offset int64
+	// start and end are the bounds of the frame's data.
+	start, end int64
+}
+
+// readFrames reads a spool file and locates its frames.
+//
+// If the file ends with a partial frame (e.g., the process was terminated
+// while writing it), the partial frame will be ignored. So will any data that
+// follows a frame that cannot be read.
+func readFrames(ctx context.Context, path string) ([]byte, []frame, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("spool: failed to read spool file %q: %v", path, err)
+	}
+
+	br := bytes.NewReader(data)
+	rr := recordio.NewReader(br, maxFrameSize)
+
+	var frames []frame
+	for {
+		offset := int64(len(data) - br.Len())
+		size, _, err := rr.ReadFrame()
+		if err == nil {
+			start := int64(len(data) - br.Len())
+			if end := start + size; end <= int64(len(data)) {
+				frames = append(frames, frame{offset, start, end})
+				br.Seek(end, os.SEEK_SET)
+				continue
+			}
+			err = io.ErrUnexpectedEOF
+		}
+
+		if err != io.EOF {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       path,
+				"offset":     offset,
+			}.Warningf(ctx, "Ignoring unreadable data at end of spool file.")
+		}
+		return data, frames, nil
+	}
+}
+
+// rewriteFile atomically and durably replaces the contents of the file at path
+// with data.
+func rewriteFile(path string, data []byte) error {
+	tmpPath := path + ".tmp"
+	fd, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = fd.Write(data)
+	if err == nil {
+		err = fd.Sync()
+	}
+	if cerr := fd.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
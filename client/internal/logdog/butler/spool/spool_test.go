@@ -0,0 +1,225 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package spool
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSpool(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Spool instance`, t, func() {
+		c := context.Background()
+
+		tdir, err := ioutil.TempDir("", "logdog_butler_spool")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tdir)
+
+		s, err := New(c, tdir)
+		So(err, ShouldBeNil)
+		defer s.Close()
+
+		bundle := func(i int) *logpb.ButlerLogBundle {
+			return &logpb.ButlerLogBundle{
+				Source: fmt.Sprintf("bundle #%d", i),
+			}
+		}
+		write := func(s *Spool, start, count int) {
+			for i := start; i < start+count; i++ {
+				So(s.Write(bundle(i)), ShouldBeNil)
+			}
+		}
+
+		var sent []string
+		failAfter := -1
+		send := func(b *logpb.ButlerLogBundle) error {
+			if failAfter == 0 {
+				return errors.New("test error")
+			}
+			failAfter--
+			sent = append(sent, b.Source)
+			return nil
+		}
+		sources := func(start, count int) []string {
+			v := make([]string, count)
+			for i := range v {
+				v[i] = bundle(start + i).Source
+			}
+			return v
+		}
+		spoolFiles := func() []string {
+			files, err := filepath.Glob(filepath.Join(tdir, "*"+fileExt))
+			if err != nil {
+				panic(err)
+			}
+			return files
+		}
+
+		Convey(`Requires a spool directory.`, func() {
+			_, err := New(c, "")
+			So(err, ShouldErrLike, "a spool directory is required")
+		})
+
+		Convey(`Will replay nothing when empty.`, func() {
+			So(s.Pending(), ShouldEqual, 0)
+
+			n, err := s.Replay(send)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 0)
+			So(sent, ShouldBeNil)
+		})
+
+		Convey(`Will fail after it has been closed.`, func() {
+			So(s.Close(), ShouldBeNil)
+
+			So(s.Write(bundle(0)), ShouldErrLike, "spool is closed")
+			_, err := s.Replay(send)
+			So(err, ShouldErrLike, "spool is closed")
+		})
+
+		Convey(`Will not write bundle secrets, and is only accessible by the current user.`, func() {
+			b := bundle(0)
+			b.Secret = []byte("super secret")
+			So(s.Write(b), ShouldBeNil)
+			So(b.Secret, ShouldResemble, []byte("super secret"))
+
+			files := spoolFiles()
+			So(files, ShouldHaveLength, 1)
+			data, err := ioutil.ReadFile(files[0])
+			So(err, ShouldBeNil)
+			So(string(data), ShouldNotContainSubstring, "super secret")
+			fi, err := os.Stat(files[0])
+			So(err, ShouldBeNil)
+			So(fi.Mode().Perm(), ShouldEqual, 0600)
+
+			sdir := filepath.Join(tdir, "new")
+			s2, err := New(c, sdir)
+			So(err, ShouldBeNil)
+			defer s2.Close()
+			fi, err = os.Stat(sdir)
+			So(err, ShouldBeNil)
+			So(fi.Mode().Perm(), ShouldEqual, 0700)
+
+			var secrets [][]byte
+			_, err = s.Replay(func(b *logpb.ButlerLogBundle) error {
+				secrets = append(secrets, b.Secret)
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(secrets, ShouldResemble, [][]byte{nil})
+		})
+
+		Convey(`With spooled bundles`, func() {
+			write(s, 0, 5)
+			So(s.Pending(), ShouldEqual, 5)
+
+			Convey(`Will replay them in order and remove them.`, func() {
+				n, err := s.Replay(send)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 5)
+				So(sent, ShouldResemble, sources(0, 5))
+				So(s.Pending(), ShouldEqual, 0)
+				So(spoolFiles(), ShouldHaveLength, 0)
+
+				Convey(`And can spool more bundles.`, func() {
+					write(s, 5, 2)
+
+					_, err := s.Replay(send)
+					So(err, ShouldBeNil)
+					So(sent, ShouldResemble, sources(0, 7))
+				})
+			})
+
+			Convey(`Will stop replaying at the first failed bundle, and resume from it.`, func() {
+				failAfter = 2
+				n, err := s.Replay(send)
+				So(err, ShouldErrLike, "test error")
+				So(n, ShouldEqual, 2)
+				So(s.Pending(), ShouldEqual, 3)
+
+				// Bundles written after a replay follow the remaining bundles.
+				write(s, 5, 2)
+
+				failAfter = -1
+				n, err = s.Replay(send)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 5)
+				So(sent, ShouldResemble, sources(0, 7))
+			})
+
+			Convey(`Will also replay bundles that are written while replaying.`, func() {
+				written := false
+				n, err := s.Replay(func(b *logpb.ButlerLogBundle) error {
+					if !written {
+						write(s, 5, 2)
+						written = true
+					}
+					return send(b)
+				})
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 7)
+				So(sent, ShouldResemble, sources(0, 7))
+				So(s.Pending(), ShouldEqual, 0)
+				So(spoolFiles(), ShouldHaveLength, 0)
+			})
+
+			Convey(`Can be replayed by a new Spool instance.`, func() {
+				failAfter = 2
+				_, err := s.Replay(send)
+				So(err, ShouldErrLike, "test error")
+				So(s.Close(), ShouldBeNil)
+
+				s, err := New(c, tdir)
+				So(err, ShouldBeNil)
+				defer s.Close()
+				So(s.Pending(), ShouldEqual, 3)
+
+				// New bundles are written after the existing ones.
+				write(s, 5, 1)
+
+				failAfter = -1
+				n, err := s.Replay(send)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 4)
+				So(sent, ShouldResemble, sources(0, 6))
+			})
+
+			Convey(`Will ignore a partial trailing frame.`, func() {
+				So(s.Close(), ShouldBeNil)
+
+				files := spoolFiles()
+				So(files, ShouldHaveLength, 1)
+				fd, err := os.OpenFile(files[0], os.O_WRONLY|os.O_APPEND, 0644)
+				So(err, ShouldBeNil)
+				_, err = fd.Write([]byte{0x40, 0x01, 0x02})
+				So(err, ShouldBeNil)
+				So(fd.Close(), ShouldBeNil)
+
+				s, err := New(c, tdir)
+				So(err, ShouldBeNil)
+				defer s.Close()
+				So(s.Pending(), ShouldEqual, 5)
+
+				n, err := s.Replay(send)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 5)
+				So(sent, ShouldResemble, sources(0, 5))
+				So(spoolFiles(), ShouldHaveLength, 0)
+			})
+		})
+	})
+}
@@ -5,11 +5,14 @@
 package streamserver
 
 import (
+	"crypto/subtle"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/luci/luci-go/client/logdog/butlerlib/streamproto"
 	"github.com/luci/luci-go/common/iotools"
@@ -18,6 +21,10 @@ import (
 	"golang.org/x/net/context"
 )
 
+// defaultHandshakeTimeout is the maximum amount of time that a client may take
+// to send its secret and handshake.
+const defaultHandshakeTimeout = time.Minute
+
 // streamParams are parameters representing a negotiated stream ready to
 // deliver.
 type streamParams struct {
@@ -39,6 +46,12 @@ type listenerStreamServer struct {
 	l     net.Listener
 	laddr string
 
+	// secret, if not empty, must be sent by clients before the handshake.
+	secret []byte
+
+	// handshakeTimeout, if > 0, overrides defaultHandshakeTimeout.
+	handshakeTimeout time.Duration
+
 	streamParamsC   chan *streamParams
 	closedC         chan struct{}
 	acceptFinishedC chan struct{}
@@ -126,9 +139,14 @@ func (s *listenerStreamServer) serve() {
 		// Spawn a goroutine to handle this connection. This goroutine will take
 		// ownership of the connection, closing it as appropriate.
 		client := &streamClient{
-			closedC: s.closedC,
-			id:      nextID,
-			conn:    &iotools.DeadlineReader{conn, 0},
+			closedC:          s.closedC,
+			id:               nextID,
+			conn:             &iotools.DeadlineReader{conn, 0},
+			secret:           s.secret,
+			handshakeTimeout: s.handshakeTimeout,
+		}
+		if client.handshakeTimeout <= 0 {
+			client.handshakeTimeout = defaultHandshakeTimeout
 		}
 		client.Context = log.SetFields(s, log.Fields{
 			"id":     client.id,
@@ -194,6 +212,11 @@ type streamClient struct {
 	closedC chan struct{} // Signal channel to indicate that the server has closed.
 	id      int           // Client ID, used for debugging correlation.
 	conn    net.Conn      // The underlying client connection.
+	secret  []byte        // If not empty, the secret the client must send.
+
+	// handshakeTimeout is the maximum amount of time that the client may take to
+	// send its secret and handshake.
+	handshakeTimeout time.Duration
 
 	// decoupleMu is used to ensure that decoupleConn is called at most one time.
 	decoupleMu sync.Mutex
@@ -214,6 +237,19 @@ func (c *streamClient) handle() (*streamParams, error) {
 	// will end up being a no-op.
 	defer c.closeConn()
 
+	// Bound the time that the client may take to authenticate and handshake, so
+	// that a client that connects and sends nothing can't keep the server from
+	// closing.
+	if err := c.conn.SetReadDeadline(time.Now().Add(c.handshakeTimeout)); err != nil {
+		return nil, fmt.Errorf("failed to set handshake deadline: %s", err)
+	}
+
+	if len(c.secret) > 0 {
+		if err := checkSecret(c.conn, c.secret); err != nil {
+			return nil, err
+		}
+	}
+
 	// Perform our handshake. We pass the connection explicitly into this method
 	// because it can get decoupled during operation.
 	p, err := handshake(c, c.conn)
@@ -221,6 +257,11 @@ func (c *streamClient) handle() (*streamParams, error) {
 		return nil, err
 	}
 
+	// The stream itself may be idle for any amount of time.
+	if err := c.conn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, fmt.Errorf("failed to clear handshake deadline: %s", err)
+	}
+
 	// Successful handshake. Forward our properties.
 	return &streamParams{c.decoupleConn(), p}, nil
 }
@@ -237,6 +278,19 @@ func handshake(ctx context.Context, conn net.Conn) (*streamproto.Properties, err
 	return hs.Handshake(ctx, conn)
 }
 
+// checkSecret reads the secret sent by the client and checks that it matches
+// 'secret'.
+func checkSecret(r io.Reader, secret []byte) error {
+	got := make([]byte, len(secret))
+	if _, err := io.ReadFull(r, got); err != nil {
+		return fmt.Errorf("failed to read client secret: %s", err)
+	}
+	if subtle.ConstantTimeCompare(got, secret) != 1 {
+		return errors.New("client sent an invalid secret")
+	}
+	return nil
+}
+
 // Closes the underlying connection.
 func (c *streamClient) closeConn() {
 	conn := c.decoupleConn()
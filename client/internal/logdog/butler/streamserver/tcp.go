@@ -0,0 +1,62 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamserver
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+
+	log "github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+// tcpSecretSize is the size, in bytes, of the secret generated by a TCP stream
+// server.
+const tcpSecretSize = 32
+
+// TCPServer is a StreamServer that listens on a TCP address.
+//
+// Unlike a named pipe, a TCP port can't be protected by file permissions, so
+// the server generates a random secret when it starts listening. Clients must
+// send this secret before the handshake, or they are rejected. ClientPath
+// returns the streamclient path that includes it.
+type TCPServer struct {
+	*listenerStreamServer
+}
+
+// NewTCPServer instantiates a new TCP stream server instance listening on
+// 'address', a "host:port" string. If the port is 0, a free port is chosen when
+// the server starts listening.
+func NewTCPServer(ctx context.Context, address string) *TCPServer {
+	ctx = log.SetField(ctx, "address", address)
+	s := &listenerStreamServer{
+		Context: ctx,
+	}
+	s.gen = func() (net.Listener, error) {
+		log.Infof(ctx, "Creating TCP server socket Listener.")
+
+		secret := make([]byte, tcpSecretSize)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate stream server secret: %s", err)
+		}
+		l, err := net.Listen("tcp", address)
+		if err != nil {
+			return nil, err
+		}
+		s.secret = secret
+		return l, nil
+	}
+	return &TCPServer{s}
+}
+
+// ClientPath returns the streamclient path to connect to this server, of the
+// form "tcp:<secret>@<host>:<port>" where <secret> is hex-encoded.
+//
+// It is only valid while the server is listening.
+func (s *TCPServer) ClientPath() string {
+	return fmt.Sprintf("tcp:%s@%s", hex.EncodeToString(s.secret), s.laddr)
+}
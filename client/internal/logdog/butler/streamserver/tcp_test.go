@@ -0,0 +1,76 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamserver
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/client/logdog/butlerlib/streamproto"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+)
+
+func TestTCPServer(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A TCP stream server`, t, func() {
+		hb := handshakeBuilder{
+			magic: streamproto.ProtocolFrameHeaderMagic,
+		}
+
+		s := NewTCPServer(context.Background(), "127.0.0.1:0")
+		s.handshakeTimeout = 100 * time.Millisecond
+		s.discardC = make(chan *streamClient, 1)
+		So(s.Listen(), ShouldBeNil)
+		defer s.Close()
+
+		path := s.ClientPath()
+		So(path, ShouldStartWith, "tcp:")
+		parts := strings.SplitN(strings.TrimPrefix(path, "tcp:"), "@", 2)
+		So(parts, ShouldHaveLength, 2)
+		secret, err := hex.DecodeString(parts[0])
+		So(err, ShouldBeNil)
+		So(secret, ShouldHaveLength, tcpSecretSize)
+		So(parts[1], ShouldEqual, s.laddr)
+
+		conn, err := net.Dial("tcp", parts[1])
+		So(err, ShouldBeNil)
+		defer conn.Close()
+
+		Convey(`Accepts a client that sends the secret.`, func() {
+			content := []byte("THIS IS A TEST STREAM")
+			_, err := conn.Write(secret)
+			So(err, ShouldBeNil)
+			hb.writeTo(conn, `{"name": "test"}`, content)
+			conn.Close()
+
+			stream, props := s.Next()
+			So(stream, ShouldNotBeNil)
+			defer stream.Close()
+			So(props.Name, ShouldEqual, "test")
+
+			recvData, _ := ioutil.ReadAll(stream)
+			So(recvData, ShouldResemble, content)
+		})
+
+		Convey(`Rejects a client that sends an invalid secret.`, func() {
+			_, err := conn.Write(bytes.Repeat([]byte{0}, tcpSecretSize))
+			So(err, ShouldBeNil)
+			hb.writeTo(conn, `{"name": "test"}`, nil)
+
+			So(<-s.discardC, ShouldNotBeNil)
+		})
+
+		Convey(`Rejects a client that sends nothing once the handshake times out.`, func() {
+			So(<-s.discardC, ShouldNotBeNil)
+		})
+	})
+}
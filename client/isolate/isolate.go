@@ -6,6 +6,7 @@ package isolate
 
 import (
 	"bytes"
+	"crypto"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
@@ -146,7 +147,7 @@ func Archive(arch archiver.Archiver, opts *ArchiveOptions) archiver.Future {
 	return f
 }
 
-func processing(opts *ArchiveOptions) (int, int, []string, string, *isolated.Isolated, error) {
+func processing(opts *ArchiveOptions, h crypto.Hash) (int, int, []string, string, *isolated.Isolated, error) {
 	content, err := ioutil.ReadFile(opts.Isolate)
 	if err != nil {
 		return 0, 0, nil, "", nil, err
@@ -208,7 +209,7 @@ func processing(opts *ArchiveOptions) (int, int, []string, string, *isolated.Iso
 
 	// Prepare the .isolated file.
 	i := &isolated.Isolated{
-		Algo:     "sha-1",
+		Algo:     isolated.GetAlgo(h),
 		Files:    map[string]isolated.File{},
 		ReadOnly: readOnly.ToIsolated(),
 		Version:  isolated.IsolatedFormatVersion,
@@ -229,7 +230,7 @@ func processing(opts *ArchiveOptions) (int, int, []string, string, *isolated.Iso
 
 func archive(arch archiver.Archiver, opts *ArchiveOptions, displayName string) (archiver.Future, error) {
 	end := tracer.Span(arch, strings.SplitN(displayName, ".", 2)[0]+":loading", nil)
-	filesCount, dirsCount, deps, rootDir, i, err := processing(opts)
+	filesCount, dirsCount, deps, rootDir, i, err := processing(opts, arch.Hash())
 	end(tracer.Args{"err": err})
 	if err != nil {
 		return nil, err
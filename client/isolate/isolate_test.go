@@ -5,6 +5,7 @@
 package isolate
 
 import (
+	"crypto"
 	"encoding/json"
 	"io/ioutil"
 	"log"
@@ -19,6 +20,7 @@ import (
 	"github.com/luci/luci-go/client/isolatedclient"
 	"github.com/luci/luci-go/client/isolatedclient/isolatedfake"
 	"github.com/luci/luci-go/common/api/isolate/isolateservice/v1"
+	"github.com/luci/luci-go/common/cache"
 	"github.com/luci/luci-go/common/isolated"
 	"github.com/luci/luci-go/common/units"
 	"github.com/maruel/ut"
@@ -138,7 +140,7 @@ func TestArchive(t *testing.T) {
 	encoded, err := json.Marshal(baseIsolatedData)
 	ut.AssertEqual(t, nil, err)
 	baseIsolatedEncoded := string(encoded) + "\n"
-	baseIsolatedHash := isolated.HashBytes([]byte(baseIsolatedEncoded))
+	baseIsolatedHash := isolated.HashBytes(crypto.SHA1, []byte(baseIsolatedEncoded))
 
 	//   /second/
 	secondIsolatedData := isolated.Isolated{
@@ -151,7 +153,7 @@ func TestArchive(t *testing.T) {
 	encoded, err = json.Marshal(secondIsolatedData)
 	ut.AssertEqual(t, nil, err)
 	secondIsolatedEncoded := string(encoded) + "\n"
-	secondIsolatedHash := isolated.HashBytes([]byte(secondIsolatedEncoded))
+	secondIsolatedHash := isolated.HashBytes(crypto.SHA1, []byte(secondIsolatedEncoded))
 
 	isolatedData := isolated.Isolated{
 		Algo:    "sha-1",
@@ -170,7 +172,7 @@ func TestArchive(t *testing.T) {
 	encoded, err = json.Marshal(isolatedData)
 	ut.AssertEqual(t, nil, err)
 	isolatedEncoded := string(encoded) + "\n"
-	isolatedHash := isolated.HashBytes([]byte(isolatedEncoded))
+	isolatedHash := isolated.HashBytes(crypto.SHA1, []byte(isolatedEncoded))
 
 	expected := map[string]string{
 		"0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33": "foo",
@@ -183,7 +185,7 @@ func TestArchive(t *testing.T) {
 		expected["12339b9756c2994f85c310d560bc8c142a6b79a1"] = "no link on Windows"
 	}
 	actual := map[string]string{}
-	for k, v := range server.Contents() {
+	for k, v := range server.Contents()["default-gzip"] {
 		actual[string(k)] = string(v)
 		ut.AssertEqualf(t, expected[string(k)], actual[string(k)], "%s: %#v", k, actual[string(k)])
 	}
@@ -204,7 +206,7 @@ func TestArchive(t *testing.T) {
 	}
 
 	ut.AssertEqual(t, nil, server.Error())
-	digest, err := isolated.HashFile(filepath.Join(tmpDir, "baz.isolated"))
+	digest, err := isolated.HashFile(crypto.SHA1, filepath.Join(tmpDir, "baz.isolated"))
 	ut.AssertEqual(t, isolateservice.HandlersEndpointsV1Digest{Digest: string(isolatedHash), IsIsolated: false, Size: int64(len(isolatedEncoded))}, digest)
 	ut.AssertEqual(t, nil, err)
 }
@@ -225,3 +227,67 @@ func TestArchiveFileNotFoundReturnsError(t *testing.T) {
 	ut.AssertEqual(t, true, closeErr != nil)
 	ut.AssertEqual(t, true, strings.HasPrefix(closeErr.Error(), "open /this-file-does-not-exist: "))
 }
+
+func TestMapTree(t *testing.T) {
+	t.Parallel()
+	// Setup temporary directory.
+	//   /src/base/bar
+	//   /src/base/ignored
+	//   /src/foo/baz.isolate
+	// Result:
+	//   /out/base/bar
+	//   /out/foo/
+	tmpDir, err := ioutil.TempDir("", "isolate")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			t.Fail()
+		}
+	}()
+	srcDir := filepath.Join(tmpDir, "src")
+	baseDir := filepath.Join(srcDir, "base")
+	fooDir := filepath.Join(srcDir, "foo")
+	ut.AssertEqual(t, nil, os.MkdirAll(baseDir, 0700))
+	ut.AssertEqual(t, nil, os.MkdirAll(fooDir, 0700))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(baseDir, "bar"), []byte("foo"), 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(baseDir, "ignored"), []byte("ignored"), 0600))
+	isolate := `{
+		'variables': {
+			'command': ['amiga', '<(EXTRA)'],
+			'files': ['../base/'],
+			'read_only': 1,
+		},
+	}`
+	isolatePath := filepath.Join(fooDir, "baz.isolate")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(isolatePath, []byte(isolate), 0600))
+	opts := &ArchiveOptions{
+		Isolate:        isolatePath,
+		Blacklist:      common.Strings{"ignored"},
+		ExtraVariables: map[string]string{"EXTRA": "really"},
+	}
+	ut.AssertEqual(t, nil, os.Mkdir(filepath.Join(tmpDir, "cache"), 0700))
+	c, err := cache.NewDisk(cache.Policies{MaxSize: 1024, MaxItems: 10}, filepath.Join(tmpDir, "cache"), crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	outDir := filepath.Join(tmpDir, "out")
+	i, err := MapTree(c, crypto.SHA1, opts, outDir)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, c.Close())
+
+	ut.AssertEqual(t, []string{"amiga", "really"}, i.Command)
+	ut.AssertEqual(t, "foo", i.RelativeCwd)
+	ut.AssertEqual(t, 1, len(i.Files))
+	ut.AssertEqual(t, isolated.HexDigest("0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"), i.Files[filepath.Join("base", "bar")].Digest)
+	content, err := ioutil.ReadFile(filepath.Join(outDir, "base", "bar"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "foo", string(content))
+	_, err = os.Stat(filepath.Join(outDir, "base", "ignored"))
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+	info, err := os.Stat(filepath.Join(outDir, "foo"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, true, info.IsDir())
+	if !common.IsWindows() {
+		info, err = os.Stat(filepath.Join(outDir, "base", "bar"))
+		ut.AssertEqual(t, nil, err)
+		ut.AssertEqual(t, os.FileMode(0400), info.Mode())
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package isolate
+
+import (
+	"crypto"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/luci/luci-go/common/cache"
+	"github.com/luci/luci-go/common/isolated"
+)
+
+// MapTree processes a .isolate and maps all its dependencies into outDir.
+//
+// Files are first added to 'c' then hardlinked from it into outDir, so the
+// content is not copied when 'c' is on the same partition as outDir. 'h' must
+// be the hashing algorithm used by 'c'. Files are made read-only when the
+// .isolate requests it.
+//
+// Returns the .isolated describing the mapped tree. Its Command and
+// RelativeCwd define the command to run and where to run it, relative to
+// outDir.
+func MapTree(c cache.Cache, h crypto.Hash, opts *ArchiveOptions, outDir string) (*isolated.Isolated, error) {
+	_, _, deps, rootDir, i, err := processing(opts, h)
+	if err != nil {
+		return nil, err
+	}
+	m := &treeMapper{c: c, h: h, i: i, outDir: outDir}
+	if i.ReadOnly != nil && *i.ReadOnly != isolated.Writeable {
+		m.readOnly = true
+	}
+	for _, dep := range deps {
+		if dep[len(dep)-1] == os.PathSeparator {
+			err = m.mapDir(rootDir, dep, opts.Blacklist)
+		} else {
+			var info os.FileInfo
+			if info, err = os.Lstat(dep); err == nil {
+				err = m.mapItem(rootDir, dep, info)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(outDir, i.RelativeCwd), 0700); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// Private details.
+
+type treeMapper struct {
+	c        cache.Cache
+	h        crypto.Hash
+	i        *isolated.Isolated
+	outDir   string
+	readOnly bool
+}
+
+// mapDir maps all the files in dir that do not match blacklist.
+//
+// It uses the same matching rules as archiver.PushDirectory.
+func (m *treeMapper) mapDir(rootDir, dir string, blacklist []string) error {
+	for _, b := range blacklist {
+		if _, err := filepath.Match(b, b); err != nil {
+			return fmt.Errorf("bad blacklist pattern \"%s\"", b)
+		}
+	}
+	dir = strings.TrimSuffix(dir, osPathSeparator)
+	return filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk(%s): %s", p, err)
+		}
+		if p == dir {
+			return nil
+		}
+		relPath := p[len(dir)+1:]
+		for _, b := range blacklist {
+			matched, _ := filepath.Match(b, relPath)
+			if !matched {
+				matched, _ = filepath.Match(b, filepath.Base(relPath))
+			}
+			if matched {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+		if info.IsDir() {
+			return nil
+		}
+		return m.mapItem(rootDir, p, info)
+	})
+}
+
+// mapItem maps a single file or symlink at src into the output directory.
+func (m *treeMapper) mapItem(rootDir, src string, info os.FileInfo) error {
+	relPath, err := filepath.Rel(rootDir, src)
+	if err != nil {
+		return err
+	}
+	dest := filepath.Join(m.outDir, relPath)
+	if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink == os.ModeSymlink {
+		l, err := os.Readlink(src)
+		if err != nil {
+			return err
+		}
+		m.i.Files[relPath] = isolated.File{Link: newString(l)}
+		return os.Symlink(l, dest)
+	}
+
+	d, err := isolated.HashFile(m.h, src)
+	if err != nil {
+		return err
+	}
+	digest := isolated.HexDigest(d.Digest)
+	if !m.c.Touch(digest) {
+		f, err := os.Open(src)
+		if err != nil {
+			return err
+		}
+		err = m.c.Add(digest, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	perm := info.Mode().Perm()
+	if m.readOnly {
+		perm &^= 0222
+	}
+	m.i.Files[relPath] = isolated.File{Digest: digest, Mode: newInt(int(perm)), Size: newInt64(info.Size())}
+	return m.c.Hardlink(digest, dest, perm)
+}
@@ -6,6 +6,7 @@ package isolatedclient
 
 import (
 	"bytes"
+	"crypto"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -39,6 +40,8 @@ func NewBytesSource(d []byte) Source {
 // IsolateServer is the low-level client interface to interact with an Isolate
 // server.
 type IsolateServer interface {
+	// Hash returns the hashing algorithm used in the namespace of this client.
+	Hash() crypto.Hash
 	ServerCapabilities() (*isolateservice.HandlersEndpointsV1ServerDetails, error)
 	// Contains looks up cache presence on the server of multiple items.
 	//
@@ -46,6 +49,9 @@ type IsolateServer interface {
 	// items that were present.
 	Contains(items []*isolateservice.HandlersEndpointsV1Digest) ([]*PushState, error)
 	Push(state *PushState, src Source) error
+	// Fetch downloads the content of the item 'digest' and writes it
+	// uncompressed to 'dest'.
+	Fetch(digest isolated.HexDigest, dest io.Writer) error
 }
 
 // PushState is per-item state passed from IsolateServer.Contains() to
@@ -103,6 +109,10 @@ func (i *isolateServer) postJSON(resource string, headers map[string]string, in,
 	return err
 }
 
+func (i *isolateServer) Hash() crypto.Hash {
+	return isolated.GetHash(i.namespace)
+}
+
 func (i *isolateServer) ServerCapabilities() (*isolateservice.HandlersEndpointsV1ServerDetails, error) {
 	out := &isolateservice.HandlersEndpointsV1ServerDetails{}
 	if err := i.postJSON("/_ah/api/isolateservice/v1/server_details", nil, map[string]string{}, out); err != nil {
@@ -163,6 +173,41 @@ func (i *isolateServer) Push(state *PushState, source Source) (err error) {
 	return
 }
 
+func (i *isolateServer) Fetch(digest isolated.HexDigest, dest io.Writer) (err error) {
+	end := tracer.Span(i, "fetch", tracer.Args{"digest": digest})
+	defer func() { end(tracer.Args{"err": err}) }()
+	in := isolateservice.HandlersEndpointsV1RetrieveRequest{Digest: string(digest), Namespace: &isolateservice.HandlersEndpointsV1Namespace{}}
+	in.Namespace.Namespace = i.namespace
+	out := &isolateservice.HandlersEndpointsV1RetrievedContent{}
+	if err = i.postJSON("/_ah/api/isolateservice/v1/retrieve", nil, in, out); err != nil {
+		return err
+	}
+
+	// Small items are returned inline.
+	if out.Url == "" {
+		return decompress(i.namespace, bytes.NewReader(out.Content), dest)
+	}
+
+	// Larger items are stored on Google Storage. The URL is signed and doesn't
+	// require additional authentication, use the anonymous client.
+	written := false
+	req := lhttp.NewRequest(i.anonClient, func() (*http.Request, error) {
+		return http.NewRequest("GET", out.Url, nil)
+	}, func(resp *http.Response) error {
+		defer resp.Body.Close()
+		w := &writeTracker{Writer: dest, written: &written}
+		if err := decompress(i.namespace, resp.Body, w); err != nil {
+			if !written {
+				// Nothing was written to 'dest' yet, so it is safe to retry.
+				return retry.Error{Err: err}
+			}
+			return err
+		}
+		return nil
+	})
+	return i.config.Do(req)
+}
+
 func (i *isolateServer) doPush(state *PushState, source Source) (err error) {
 	useDB := state.status.GsUploadUrl == ""
 	end := tracer.Span(i, "push", tracer.Args{"useDB": useDB, "size": state.size})
@@ -178,7 +223,7 @@ func (i *isolateServer) doPush(state *PushState, source Source) (err error) {
 	} else {
 		err = i.doPushGCS(state, source)
 	}
-	if err != nil {
+	if err == nil {
 		tracer.CounterAdd(i, "bytesUploaded", float64(state.size))
 	}
 	return err
@@ -186,7 +231,7 @@ func (i *isolateServer) doPush(state *PushState, source Source) (err error) {
 
 func (i *isolateServer) doPushDB(state *PushState, reader io.Reader) error {
 	buf := bytes.Buffer{}
-	compressor := isolated.GetCompressor(&buf)
+	compressor := isolated.GetCompressor(i.namespace, &buf)
 	if _, err := io.Copy(compressor, reader); err != nil {
 		return err
 	}
@@ -213,7 +258,7 @@ func (i *isolateServer) doPushGCS(state *PushState, source Source) (err error) {
 			src.Close()
 			return nil, err
 		}
-		request.Body = newCompressed(src)
+		request.Body = newCompressed(i.namespace, src)
 		request.Header.Set("Content-Type", "application/octet-stream")
 		return request, nil
 	}, func(resp *http.Response) error {
@@ -227,17 +272,46 @@ func (i *isolateServer) doPushGCS(state *PushState, source Source) (err error) {
 	return i.config.Do(req)
 }
 
+// decompress decompresses 'src' as stored in 'namespace' and writes the result
+// to 'dest'.
+func decompress(namespace string, src io.Reader, dest io.Writer) error {
+	decompressor, err := isolated.GetDecompressor(namespace, src)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dest, decompressor); err != nil {
+		decompressor.Close()
+		return err
+	}
+	return decompressor.Close()
+}
+
+// writeTracker is an io.Writer that records whether any data was written to
+// the underlying io.Writer.
+type writeTracker struct {
+	io.Writer
+	written *bool
+}
+
+func (w *writeTracker) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	if n != 0 {
+		*w.written = true
+	}
+	return n, err
+}
+
 // compressed is an io.ReadCloser that transparently compresses source data in
 // a separate goroutine.
 type compressed struct {
 	io.ReadCloser
 }
 
-func newCompressed(src io.Reader) *compressed {
+func newCompressed(namespace string, src io.Reader) *compressed {
 	pr, pw := io.Pipe()
 	go func() {
 		// The compressor itself is not thread safe.
-		compressor := isolated.GetCompressor(pw)
+		compressor := isolated.GetCompressor(namespace, pw)
 
 		buf := make([]byte, compressedBufSize)
 		pw.CloseWithError(func() error {
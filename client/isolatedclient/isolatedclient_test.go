@@ -5,6 +5,8 @@
 package isolatedclient
 
 import (
+	"bytes"
+	"crypto"
 	"io"
 	"log"
 	"math/rand"
@@ -80,7 +82,7 @@ func TestIsolateServerRetryGCSPartial(t *testing.T) {
 		err = client.Push(state, NewBytesSource(contents[state.status.Index]))
 		ut.AssertEqual(t, nil, err)
 	}
-	ut.AssertEqual(t, expected, server.Contents())
+	ut.AssertEqual(t, map[string]map[isolated.HexDigest][]byte{"default-gzip": expected}, server.Contents())
 	ut.AssertEqual(t, map[string]int{}, flaky.tearDown)
 
 	// Look up again to confirm.
@@ -93,6 +95,56 @@ func TestIsolateServerRetryGCSPartial(t *testing.T) {
 	ut.AssertEqual(t, nil, server.Error())
 }
 
+func TestIsolateServerRetryFetch(t *testing.T) {
+	t.Parallel()
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	client := newIsolateServer(nil, ts.URL, "default-gzip", fastRetry)
+
+	server.Inject("default-gzip", foo)
+	server.Inject("default-gzip", large)
+	server.FailNext("/_ah/api/isolateservice/v1/retrieve", 2)
+	server.FailNext("/fake/cloudstorage", 2)
+	for _, content := range [][]byte{foo, large} {
+		buf := bytes.Buffer{}
+		ut.AssertEqual(t, nil, client.Fetch(isolated.HashBytes(crypto.SHA1, content), &buf))
+		ut.AssertEqual(t, content, buf.Bytes())
+	}
+	ut.AssertEqual(t, nil, server.Error())
+}
+
+func TestIsolateServerFetchMissing(t *testing.T) {
+	t.Parallel()
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	client := newIsolateServer(nil, ts.URL, "default-gzip", fastRetry)
+
+	buf := bytes.Buffer{}
+	ut.AssertEqual(t, true, client.Fetch(isolated.HashBytes(crypto.SHA1, foo), &buf) != nil)
+	ut.AssertEqual(t, 0, buf.Len())
+	ut.AssertEqual(t, nil, server.Error())
+}
+
+func TestIsolateServerNamespaces(t *testing.T) {
+	t.Parallel()
+	server := isolatedfake.New()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	client := newIsolateServer(nil, ts.URL, "other-gzip", cantRetry)
+
+	// Content in another namespace is not visible.
+	server.Inject("default-gzip", foo)
+	digests, contents, expected := makeItems(foo)
+	states, err := client.Contains(digests)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 1, len(states))
+	ut.AssertEqual(t, nil, client.Push(states[0], NewBytesSource(contents[0])))
+	ut.AssertEqual(t, expected, server.Contents()["other-gzip"])
+	ut.AssertEqual(t, nil, server.Error())
+}
+
 func TestIsolateServerBadURL(t *testing.T) {
 	t.Parallel()
 	if testing.Short() {
@@ -139,7 +191,7 @@ func makeItems(contents ...[]byte) ([]*isolateservice.HandlersEndpointsV1Digest,
 	digests := make([]*isolateservice.HandlersEndpointsV1Digest, 0, len(contents))
 	expected := make(map[isolated.HexDigest][]byte, len(contents))
 	for _, content := range contents {
-		hex := isolated.HashBytes(content)
+		hex := isolated.HashBytes(crypto.SHA1, content)
 		digests = append(digests, &isolateservice.HandlersEndpointsV1Digest{Digest: string(hex), IsIsolated: false, Size: int64(len(content))})
 		expected[hex] = content
 	}
@@ -161,7 +213,12 @@ func testNormal(t *testing.T, contents ...[]byte) {
 		ut.AssertEqual(t, nil, err)
 	}
 	ut.AssertEqual(t, nil, server.Error())
-	ut.AssertEqual(t, expected, server.Contents())
+	ut.AssertEqual(t, map[string]map[isolated.HexDigest][]byte{"default-gzip": expected}, server.Contents())
+	for digest, content := range expected {
+		buf := bytes.Buffer{}
+		ut.AssertEqual(t, nil, client.Fetch(digest, &buf))
+		ut.AssertEqual(t, content, buf.Bytes())
+	}
 	states, err = client.Contains(digests)
 	ut.AssertEqual(t, nil, err)
 	ut.AssertEqual(t, len(digests), len(states))
@@ -186,7 +243,7 @@ func testFlaky(t *testing.T, flake string) {
 		err = client.Push(state, NewBytesSource(contents[state.status.Index]))
 		ut.AssertEqual(t, nil, err)
 	}
-	ut.AssertEqual(t, expected, server.Contents())
+	ut.AssertEqual(t, map[string]map[isolated.HexDigest][]byte{"default-gzip": expected}, server.Contents())
 	states, err = client.Contains(digests)
 	ut.AssertEqual(t, nil, err)
 	ut.AssertEqual(t, len(digests), len(states))
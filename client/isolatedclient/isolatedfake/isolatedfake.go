@@ -4,12 +4,19 @@
 
 // Package isolatedfake implements an in-process fake Isolated server for
 // integration testing.
+//
+// It supports the full upload and download protocol: preupload, store_inline,
+// the Cloud Storage upload and its finalization, and retrieve. Content is kept
+// per namespace. The namespace defines the hashing algorithm and whether the
+// content is compressed on the wire, see isolated.GetHash() and
+// isolated.IsCompressed().
 package isolatedfake
 
 import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -22,6 +29,10 @@ import (
 
 const contentType = "application/json; charset=utf-8"
 
+// inlineMaxSize is the largest item size that is transferred inline in the
+// JSON requests. Larger items go through the fake Cloud Storage.
+const inlineMaxSize = 1024
+
 type jsonAPI func(r *http.Request) interface{}
 
 type failure interface {
@@ -38,6 +49,10 @@ func handlerJSON(f failure, handler jsonAPI) http.Handler {
 		}
 		defer r.Body.Close()
 		out := handler(r)
+		if status, ok := out.(httpStatus); ok {
+			w.WriteHeader(int(status))
+			return
+		}
 		w.Header().Set("Content-Type", contentType)
 		j := json.NewEncoder(w)
 		if err := j.Encode(out); err != nil {
@@ -46,13 +61,22 @@ func handlerJSON(f failure, handler jsonAPI) http.Handler {
 	})
 }
 
+// httpStatus can be returned by a jsonAPI to reply with an HTTP error status
+// instead of a JSON body.
+type httpStatus int
+
 // IsolatedFake is a functional fake in-memory isolated server.
 type IsolatedFake interface {
 	http.Handler
-	// Contents returns all the uncompressed data on the fake isolated server.
-	Contents() map[isolated.HexDigest][]byte
+	// Contents returns all the uncompressed data on the fake isolated server,
+	// per namespace.
+	Contents() map[string]map[isolated.HexDigest][]byte
 	// Inject adds uncompressed data in the fake isolated server.
-	Inject(data []byte)
+	Inject(namespace string, data []byte) isolated.HexDigest
+	// FailNext makes the next 'count' requests to the endpoint 'path' fail with
+	// HTTP 503, without any side effect.
+	FailNext(path string, count int)
+	// Error returns the first unexpected request made to the server, if any.
 	Error() error
 }
 
@@ -60,22 +84,25 @@ type isolatedFake struct {
 	mux      *http.ServeMux
 	lock     sync.Mutex
 	err      error
-	contents map[isolated.HexDigest][]byte
-	staging  map[isolated.HexDigest][]byte // Uploaded to GCS but not yet finalized.
+	failNext map[string]int
+	contents map[string]map[isolated.HexDigest][]byte
+	staging  map[string]map[isolated.HexDigest][]byte // Uploaded to GCS but not yet finalized.
 }
 
 // New create a HTTP router that implements an isolated server.
 func New() IsolatedFake {
 	server := &isolatedFake{
 		mux:      http.NewServeMux(),
-		contents: map[isolated.HexDigest][]byte{},
-		staging:  map[isolated.HexDigest][]byte{},
+		failNext: map[string]int{},
+		contents: map[string]map[isolated.HexDigest][]byte{},
+		staging:  map[string]map[isolated.HexDigest][]byte{},
 	}
 
 	server.handleJSON("/_ah/api/isolateservice/v1/server_details", server.serverDetails)
 	server.handleJSON("/_ah/api/isolateservice/v1/preupload", server.preupload)
 	server.handleJSON("/_ah/api/isolateservice/v1/finalize_gs_upload", server.finalizeGSUpload)
 	server.handleJSON("/_ah/api/isolateservice/v1/store_inline", server.storeInline)
+	server.handleJSON("/_ah/api/isolateservice/v1/retrieve", server.retrieve)
 	server.mux.HandleFunc("/fake/cloudstorage", server.fakeCloudStorage)
 
 	// Fail on anything else.
@@ -88,24 +115,39 @@ func New() IsolatedFake {
 // Private details.
 
 func (server *isolatedFake) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if server.shouldFail(r.URL.Path) {
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		w.WriteHeader(503)
+		return
+	}
 	server.mux.ServeHTTP(w, r)
 }
 
-func (server *isolatedFake) Contents() map[isolated.HexDigest][]byte {
+func (server *isolatedFake) Contents() map[string]map[isolated.HexDigest][]byte {
 	server.lock.Lock()
 	defer server.lock.Unlock()
-	out := map[isolated.HexDigest][]byte{}
-	for k, v := range server.contents {
-		out[k] = v
+	out := map[string]map[isolated.HexDigest][]byte{}
+	for namespace, contents := range server.contents {
+		out[namespace] = map[isolated.HexDigest][]byte{}
+		for k, v := range contents {
+			out[namespace][k] = v
+		}
 	}
 	return out
 }
 
-func (server *isolatedFake) Inject(data []byte) {
-	h := isolated.HashBytes(data)
+func (server *isolatedFake) Inject(namespace string, data []byte) isolated.HexDigest {
+	h := isolated.HashBytes(isolated.GetHash(namespace), data)
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.namespaceLocked(server.contents, namespace)[h] = data
+	return h
+}
+
+func (server *isolatedFake) FailNext(path string, count int) {
 	server.lock.Lock()
 	defer server.lock.Unlock()
-	server.contents[h] = data
+	server.failNext[path] += count
 }
 
 func (server *isolatedFake) Fail(err error) {
@@ -126,6 +168,30 @@ func (server *isolatedFake) failLocked(err error) {
 	}
 }
 
+func (server *isolatedFake) shouldFail(path string) bool {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	if server.failNext[path] == 0 {
+		return false
+	}
+	server.failNext[path]--
+	if server.failNext[path] == 0 {
+		delete(server.failNext, path)
+	}
+	return true
+}
+
+// namespaceLocked returns the items of 'namespace' in 'm', creating the
+// namespace if needed.
+func (server *isolatedFake) namespaceLocked(m map[string]map[isolated.HexDigest][]byte, namespace string) map[isolated.HexDigest][]byte {
+	items, ok := m[namespace]
+	if !ok {
+		items = map[isolated.HexDigest][]byte{}
+		m[namespace] = items
+	}
+	return items
+}
+
 func (server *isolatedFake) handleJSON(path string, handler jsonAPI) {
 	server.mux.Handle(path, handlerJSON(server, handler))
 }
@@ -146,27 +212,26 @@ func (server *isolatedFake) preupload(r *http.Request) interface{} {
 	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
 		server.Fail(err)
 	}
-	if data.Namespace == nil || data.Namespace.Namespace != "default-gzip" {
-		server.Fail(fmt.Errorf("unexpected namespace %#v", data.Namespace.Namespace))
+	if data.Namespace == nil || data.Namespace.Namespace == "" {
+		err := fmt.Errorf("unexpected namespace %#v", data.Namespace)
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
 	}
+	namespace := data.Namespace.Namespace
 	out := &isolateservice.HandlersEndpointsV1UrlCollection{}
 
 	server.lock.Lock()
 	defer server.lock.Unlock()
+	contents := server.contents[namespace]
 	for i, d := range data.Items {
-		if _, ok := server.contents[isolated.HexDigest(d.Digest)]; !ok {
-			// Simulate a write to Cloud Storage for larger writes.
-			ticket := "ticket:" + string(d.Digest)
+		if _, ok := contents[isolated.HexDigest(d.Digest)]; !ok {
 			s := &isolateservice.HandlersEndpointsV1PreuploadStatus{
 				Index:        int64(i),
-				UploadTicket: ticket,
+				UploadTicket: makeTicket(namespace, isolated.HexDigest(d.Digest)),
 			}
-			if d.Size > 1024 {
-				v := url.Values{}
-				v.Add("digest", string(d.Digest))
-				u := &url.URL{Scheme: "http", Host: r.Host, Path: "/fake/cloudstorage", RawQuery: v.Encode()}
-				s.GsUploadUrl = u.String()
-				//log.Printf("%s", s.GsUploadUrl)
+			// Simulate a write to Cloud Storage for larger writes.
+			if d.Size > inlineMaxSize {
+				s.GsUploadUrl = server.cloudStorageURL(r, namespace, isolated.HexDigest(d.Digest))
 			}
 			out.Items = append(out.Items, s)
 		}
@@ -176,24 +241,44 @@ func (server *isolatedFake) preupload(r *http.Request) interface{} {
 
 func (server *isolatedFake) fakeCloudStorage(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
-	if r.Header.Get("Content-Type") != "application/octet-stream" {
-		w.WriteHeader(400)
-		server.Fail(fmt.Errorf("invalid content type: %s", r.Header.Get("Content-Type")))
+	namespace := r.URL.Query().Get("namespace")
+	digest := isolated.HexDigest(r.URL.Query().Get("digest"))
+	switch r.Method {
+	case "GET":
+		server.lock.Lock()
+		raw, ok := server.contents[namespace][digest]
+		server.lock.Unlock()
+		if !ok {
+			w.WriteHeader(404)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(200)
+		if err := encode(namespace, raw, w); err != nil {
+			server.Fail(err)
+		}
 		return
-	}
-	if r.Method != "PUT" {
+
+	case "PUT":
+		if r.Header.Get("Content-Type") != "application/octet-stream" {
+			w.WriteHeader(400)
+			server.Fail(fmt.Errorf("invalid content type: %s", r.Header.Get("Content-Type")))
+			return
+		}
+
+	default:
 		w.WriteHeader(405)
 		server.Fail(fmt.Errorf("invalid method: %s", r.Method))
 		return
 	}
-	raw, err := ioutil.ReadAll(isolated.GetDecompressor(r.Body))
+
+	raw, err := decode(namespace, r.Body)
 	if err != nil {
 		w.WriteHeader(500)
 		server.Fail(err)
 		return
 	}
-	digest := isolated.HexDigest(r.URL.Query().Get("digest"))
-	if digest != isolated.HashBytes(raw) {
+	if digest != isolated.HashBytes(isolated.GetHash(namespace), raw) {
 		w.WriteHeader(400)
 		server.Fail(fmt.Errorf("invalid digest %#v", digest))
 		return
@@ -201,7 +286,7 @@ func (server *isolatedFake) fakeCloudStorage(w http.ResponseWriter, r *http.Requ
 
 	server.lock.Lock()
 	defer server.lock.Unlock()
-	server.staging[digest] = raw
+	server.namespaceLocked(server.staging, namespace)[digest] = raw
 	w.WriteHeader(200)
 }
 
@@ -211,28 +296,22 @@ func (server *isolatedFake) finalizeGSUpload(r *http.Request) interface{} {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-	prefix := "ticket:"
-	if !strings.HasPrefix(data.UploadTicket, prefix) {
-		err := fmt.Errorf("unexpected ticket %#v", data.UploadTicket)
-		server.Fail(err)
-		return map[string]string{"err": err.Error()}
-	}
-	digest := isolated.HexDigest(data.UploadTicket[len(prefix):])
-	if !digest.Validate() {
-		err := fmt.Errorf("invalid digest %#v", digest)
+	namespace, digest, err := parseTicket(data.UploadTicket)
+	if err != nil {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
 
 	server.lock.Lock()
 	defer server.lock.Unlock()
-	if _, ok := server.staging[digest]; !ok {
+	raw, ok := server.staging[namespace][digest]
+	if !ok {
 		err := fmt.Errorf("finalizing non uploaded file")
 		server.failLocked(err)
 		return map[string]string{"err": err.Error()}
 	}
-	server.contents[digest] = server.staging[digest]
-	delete(server.staging, digest)
+	server.namespaceLocked(server.contents, namespace)[digest] = raw
+	delete(server.staging[namespace], digest)
 	return map[string]string{"ok": "true"}
 }
 
@@ -242,26 +321,17 @@ func (server *isolatedFake) storeInline(r *http.Request) interface{} {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-
-	prefix := "ticket:"
-	if !strings.HasPrefix(data.UploadTicket, prefix) {
-		err := fmt.Errorf("unexpected ticket %#v", data.UploadTicket)
-		server.Fail(err)
-		return map[string]string{"err": err.Error()}
-	}
-
-	digest := isolated.HexDigest(data.UploadTicket[len(prefix):])
-	if !digest.Validate() {
-		err := fmt.Errorf("invalid digest %#v", digest)
+	namespace, digest, err := parseTicket(data.UploadTicket)
+	if err != nil {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-	raw, err := ioutil.ReadAll(isolated.GetDecompressor(bytes.NewReader([]byte(data.Content))))
+	raw, err := decode(namespace, bytes.NewReader(data.Content))
 	if err != nil {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-	if digest != isolated.HashBytes(raw) {
+	if digest != isolated.HashBytes(isolated.GetHash(namespace), raw) {
 		err := fmt.Errorf("invalid digest %#v", digest)
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
@@ -269,7 +339,94 @@ func (server *isolatedFake) storeInline(r *http.Request) interface{} {
 
 	server.lock.Lock()
 	defer server.lock.Unlock()
-	server.contents[digest] = raw
+	server.namespaceLocked(server.contents, namespace)[digest] = raw
 	//log.Printf("  storing %s = %d bytes", digest, len(raw))
 	return map[string]string{"ok": "true"}
 }
+
+func (server *isolatedFake) retrieve(r *http.Request) interface{} {
+	data := &isolateservice.HandlersEndpointsV1RetrieveRequest{}
+	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
+	}
+	if data.Namespace == nil || data.Namespace.Namespace == "" {
+		err := fmt.Errorf("unexpected namespace %#v", data.Namespace)
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
+	}
+	namespace := data.Namespace.Namespace
+	digest := isolated.HexDigest(data.Digest)
+	if !digest.Validate(isolated.GetHash(namespace)) {
+		err := fmt.Errorf("invalid digest %#v", digest)
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
+	}
+
+	server.lock.Lock()
+	raw, ok := server.contents[namespace][digest]
+	server.lock.Unlock()
+	if !ok {
+		// This is not a failure of the client, the item is simply not there.
+		return httpStatus(404)
+	}
+	if len(raw) > inlineMaxSize {
+		return &isolateservice.HandlersEndpointsV1RetrievedContent{Url: server.cloudStorageURL(r, namespace, digest)}
+	}
+	buf := bytes.Buffer{}
+	if err := encode(namespace, raw, &buf); err != nil {
+		server.Fail(err)
+		return map[string]string{"err": err.Error()}
+	}
+	return &isolateservice.HandlersEndpointsV1RetrievedContent{Content: buf.Bytes()}
+}
+
+// encode writes 'raw' to 'w' as expected on the wire for 'namespace'.
+func encode(namespace string, raw []byte, w io.Writer) error {
+	compressor := isolated.GetCompressor(namespace, w)
+	if _, err := compressor.Write(raw); err != nil {
+		compressor.Close()
+		return err
+	}
+	return compressor.Close()
+}
+
+// decode reads the content from the wire for 'namespace'.
+func decode(namespace string, r io.Reader) ([]byte, error) {
+	decompressor, err := isolated.GetDecompressor(namespace, r)
+	if err != nil {
+		return nil, err
+	}
+	defer decompressor.Close()
+	return ioutil.ReadAll(decompressor)
+}
+
+// cloudStorageURL returns the fake Cloud Storage URL of an item.
+func (server *isolatedFake) cloudStorageURL(r *http.Request, namespace string, digest isolated.HexDigest) string {
+	v := url.Values{}
+	v.Add("namespace", namespace)
+	v.Add("digest", string(digest))
+	u := &url.URL{Scheme: "http", Host: r.Host, Path: "/fake/cloudstorage", RawQuery: v.Encode()}
+	return u.String()
+}
+
+const ticketPrefix = "ticket:"
+
+func makeTicket(namespace string, digest isolated.HexDigest) string {
+	return ticketPrefix + namespace + ":" + string(digest)
+}
+
+func parseTicket(ticket string) (string, isolated.HexDigest, error) {
+	if !strings.HasPrefix(ticket, ticketPrefix) {
+		return "", "", fmt.Errorf("unexpected ticket %#v", ticket)
+	}
+	parts := strings.SplitN(ticket[len(ticketPrefix):], ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("unexpected ticket %#v", ticket)
+	}
+	digest := isolated.HexDigest(parts[1])
+	if !digest.Validate(isolated.GetHash(parts[0])) {
+		return "", "", fmt.Errorf("invalid digest %#v", digest)
+	}
+	return parts[0], digest, nil
+}
@@ -38,6 +38,9 @@ type clientImpl struct {
 // Supported protocols and their respective specs are:
 //   - unix:/path/to/socket describes a stream server listening on UNIX domain
 //     socket at "/path/to/socket".
+//   - tcp:secret@host:port describes a stream server listening on TCP address
+//     "host:port". "secret" is the hex-encoded secret of the stream server,
+//     which is sent before the handshake.
 //
 // Windows-only:
 //   - net.pipe:name describes a stream server listening on Windows named pipe
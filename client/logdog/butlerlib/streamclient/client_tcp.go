@@ -0,0 +1,54 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamclient
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+)
+
+// Register the TCP protocol, which is supported on all platforms.
+func init() {
+	registerProtocol("tcp", newTCPClient)
+}
+
+// newTCPClient creates a new Client instance bound to a TCP stream server.
+//
+// The spec has the form "<secret>@<host>:<port>", where <secret> is the
+// hex-encoded secret of the stream server. It is sent at the beginning of each
+// connection, before the handshake.
+func newTCPClient(spec string) (Client, error) {
+	parts := strings.SplitN(spec, "@", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("missing secret in TCP stream server spec [%s]", spec)
+	}
+	secret, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid TCP stream server secret: %s", err)
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("empty TCP stream server secret")
+	}
+	addr := parts[1]
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return nil, fmt.Errorf("invalid TCP stream server address [%s]: %s", addr, err)
+	}
+
+	return &clientImpl{func() (io.WriteCloser, error) {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := conn.Write(secret); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to write secret: %s", err)
+		}
+		return conn, nil
+	}}, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package streamclient
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/luci/luci-go/client/logdog/butlerlib/streamproto"
+	"github.com/luci/luci-go/common/clock/clockflag"
+	"github.com/luci/luci-go/common/clock/testclock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTCPClient(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A TCP client`, t, func() {
+		Convey(`Will reject invalid specs.`, func() {
+			for _, spec := range []string{
+				"",
+				"127.0.0.1:1234",
+				"@127.0.0.1:1234",
+				"not-hex@127.0.0.1:1234",
+				"abcd@127.0.0.1",
+			} {
+				_, err := newTCPClient(spec)
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey(`Will send its secret before the handshake.`, func() {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			So(err, ShouldBeNil)
+			defer l.Close()
+
+			dataC := make(chan []byte)
+			go func() {
+				conn, err := l.Accept()
+				if err != nil {
+					close(dataC)
+					return
+				}
+				defer conn.Close()
+				data, _ := ioutil.ReadAll(conn)
+				dataC <- data
+			}()
+
+			client, err := New("tcp:abcd@" + l.Addr().String())
+			So(err, ShouldBeNil)
+			stream, err := client.NewStream(streamproto.Flags{
+				Name:      "test",
+				Timestamp: clockflag.Time(testclock.TestTimeUTC),
+			})
+			So(err, ShouldBeNil)
+			_, err = io.WriteString(stream, "content")
+			So(err, ShouldBeNil)
+			So(stream.Close(), ShouldBeNil)
+
+			data := <-dataC
+			So(data[:2], ShouldResemble, []byte{0xab, 0xcd})
+			So(data[2:2+len(streamproto.ProtocolFrameHeaderMagic)], ShouldResemble,
+				streamproto.ProtocolFrameHeaderMagic)
+		})
+	})
+}
@@ -22,7 +22,7 @@ type ClientFactory func(string) (Client, error)
 // Registry maps protocol prefix strings to their Client generator functions.
 //
 // This allows multiple Butler stream protocols (e.g., "unix:", "net.pipe:",
-// etc.) to be parsed from string.
+// "tcp:", etc.) to be parsed from string.
 type Registry struct {
 	// lock protects the fields in Registry.
 	lock sync.Mutex
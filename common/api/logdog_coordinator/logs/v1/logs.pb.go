@@ -17,6 +17,8 @@ It has these top-level messages:
 	QueryResponse
 	ListRequest
 	ListResponse
+	SearchRequest
+	SearchResponse
 	LogStreamState
 */
 package logdog
@@ -524,6 +526,117 @@ func (m *ListResponse_Component) GetDesc() *logpb.LogStreamDescriptor {
 	return nil
 }
 
+// SearchRequest is the request structure for the user Search endpoint.
+//
+// Search scans the text content of archived text log streams for lines that
+// contain a literal string. Log streams that have not been archived are not
+// searched.
+type SearchRequest struct {
+	// The project to search.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The path query constraining the set of log streams to search. It uses the
+	// same syntax as the QueryRequest's path field.
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// The literal text to search for. It may not be empty.
+	Text string `protobuf:"bytes,3,opt,name=text" json:"text,omitempty"`
+	// If true, the text is matched case-insensitively.
+	IgnoreCase bool `protobuf:"varint,4,opt,name=ignore_case,json=ignoreCase" json:"ignore_case,omitempty"`
+	// Next, if not empty, indicates that this search should continue at the
+	// point where the previous search left off.
+	Next string `protobuf:"bytes,5,opt,name=next" json:"next,omitempty"`
+	// MaxResults is the maximum number of log streams to search in this request.
+	//
+	// If MaxResults is zero, no upper bound will be indicated. However, the
+	// number of searched streams is still be subject to internal constraints.
+	MaxResults int32 `protobuf:"varint,6,opt,name=max_results,json=maxResults" json:"max_results,omitempty"`
+	// MaxMatches is the maximum number of matching lines to return for each log
+	// stream. If zero, a default maximum will be used.
+	MaxMatches int32 `protobuf:"varint,7,opt,name=max_matches,json=maxMatches" json:"max_matches,omitempty"`
+	// Newer restricts the search to streams created after the specified date.
+	Newer *google_protobuf.Timestamp `protobuf:"bytes,10,opt,name=newer" json:"newer,omitempty"`
+	// Older restricts the search to streams created before the specified date.
+	Older *google_protobuf.Timestamp `protobuf:"bytes,11,opt,name=older" json:"older,omitempty"`
+}
+
+func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
+func (m *SearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()               {}
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *SearchRequest) GetNewer() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Newer
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetOlder() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Older
+	}
+	return nil
+}
+
+// SearchResponse is the response structure for the user Search endpoint.
+type SearchResponse struct {
+	// Project is the project name that all responses belong to.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The log streams that contain matching lines.
+	Streams []*SearchResponse_Stream `protobuf:"bytes,2,rep,name=streams" json:"streams,omitempty"`
+	// If not empty, indicates that there are more log streams to search. They
+	// can be searched by repeating the Search request with the same fields and
+	// supplying this value in the Next field.
+	Next string `protobuf:"bytes,3,opt,name=next" json:"next,omitempty"`
+}
+
+func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
+func (m *SearchResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()               {}
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *SearchResponse) GetStreams() []*SearchResponse_Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// Match is a single matching log stream line.
+type SearchResponse_Match struct {
+	// The line index of the matching line within its log stream.
+	Line int64 `protobuf:"varint,1,opt,name=line" json:"line,omitempty"`
+	// The stream index of the log entry that contains the start of the
+	// matching line.
+	StreamIndex int64 `protobuf:"varint,2,opt,name=stream_index,json=streamIndex" json:"stream_index,omitempty"`
+}
+
+func (m *SearchResponse_Match) Reset()                    { *m = SearchResponse_Match{} }
+func (m *SearchResponse_Match) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse_Match) ProtoMessage()               {}
+func (*SearchResponse_Match) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 0} }
+
+// Stream is a log stream that contains at least one matching line.
+type SearchResponse_Stream struct {
+	// Path is the log stream path.
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	// The matching lines of the log stream, in line order.
+	Matches []*SearchResponse_Match `protobuf:"bytes,2,rep,name=matches" json:"matches,omitempty"`
+	// If true, the log stream had more matching lines than were returned.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated" json:"truncated,omitempty"`
+}
+
+func (m *SearchResponse_Stream) Reset()                    { *m = SearchResponse_Stream{} }
+func (m *SearchResponse_Stream) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse_Stream) ProtoMessage()               {}
+func (*SearchResponse_Stream) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 1} }
+
+func (m *SearchResponse_Stream) GetMatches() []*SearchResponse_Match {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetRequest)(nil), "logdog.GetRequest")
 	proto.RegisterType((*TailRequest)(nil), "logdog.TailRequest")
@@ -535,6 +648,10 @@ func init() {
 	proto.RegisterType((*ListRequest)(nil), "logdog.ListRequest")
 	proto.RegisterType((*ListResponse)(nil), "logdog.ListResponse")
 	proto.RegisterType((*ListResponse_Component)(nil), "logdog.ListResponse.Component")
+	proto.RegisterType((*SearchRequest)(nil), "logdog.SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "logdog.SearchResponse")
+	proto.RegisterType((*SearchResponse_Match)(nil), "logdog.SearchResponse.Match")
+	proto.RegisterType((*SearchResponse_Stream)(nil), "logdog.SearchResponse.Stream")
 	proto.RegisterEnum("logdog.QueryRequest_Trinary", QueryRequest_Trinary_name, QueryRequest_Trinary_value)
 	proto.RegisterEnum("logdog.ListResponse_Component_Type", ListResponse_Component_Type_name, ListResponse_Component_Type_value)
 }
@@ -558,6 +675,9 @@ type LogsClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	// List returns log stream paths rooted under the path hierarchy.
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Search returns the lines of archived text log streams that contain the
+	// requested text.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 type logsPRPCClient struct {
 	client *prpccommon.Client
@@ -603,6 +723,15 @@ func (c *logsPRPCClient) List(ctx context.Context, in *ListRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *logsPRPCClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.client.Call(ctx, "logdog.Logs", "Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type logsClient struct {
 	cc *grpc.ClientConn
 }
@@ -647,6 +776,15 @@ func (c *logsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *logsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := grpc.Invoke(ctx, "/logdog.Logs/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Logs service
 
 type LogsServer interface {
@@ -658,6 +796,9 @@ type LogsServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	// List returns log stream paths rooted under the path hierarchy.
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Search returns the lines of archived text log streams that contain the
+	// requested text.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 }
 
 func RegisterLogsServer(s prpc.Registrar, srv LogsServer) {
@@ -736,6 +877,24 @@ func _Logs_List_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Logs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Logs/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Logs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Logs",
 	HandlerType: (*LogsServer)(nil),
@@ -756,70 +915,85 @@ var _Logs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Logs_List_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Logs_Search_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{},
 }
 
 var fileDescriptor0 = []byte{
-	// 954 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xc6, 0x89, 0xf3, 0x77, 0x9c, 0xdd, 0x86, 0x61, 0xa9, 0x2c, 0xf3, 0x53, 0x48, 0xa9, 0x00,
-	0x09, 0x9c, 0x12, 0x2a, 0x8a, 0xa8, 0x84, 0xd4, 0x2e, 0x5b, 0x10, 0x2a, 0xec, 0x76, 0x36, 0x42,
-	0xe2, 0x2a, 0x72, 0x92, 0x59, 0xd7, 0xe0, 0x78, 0x82, 0x3d, 0x2e, 0x9b, 0xc7, 0xe0, 0x0a, 0xf1,
-	0x0a, 0xbc, 0x09, 0x97, 0x3c, 0x02, 0x4f, 0xc0, 0x13, 0x20, 0x71, 0xe6, 0xcc, 0x38, 0x49, 0xa3,
-	0xb0, 0x5b, 0xa4, 0xed, 0xcd, 0x66, 0xe6, 0x3b, 0x3f, 0x33, 0x73, 0xce, 0xf7, 0x1d, 0x2f, 0x40,
-	0x2a, 0xe3, 0x22, 0x5c, 0xe4, 0x52, 0x49, 0xd6, 0xc4, 0xf5, 0x4c, 0xc6, 0x81, 0x57, 0xa8, 0x48,
-	0x09, 0x03, 0x06, 0xf7, 0xe2, 0x44, 0x3d, 0x29, 0x27, 0xe1, 0x54, 0xce, 0x07, 0x69, 0x39, 0x4d,
-	0xe8, 0xcf, 0x87, 0xb1, 0x1c, 0x20, 0x30, 0x97, 0xd9, 0x80, 0xbc, 0x06, 0x26, 0x52, 0xff, 0x2c,
-	0x26, 0xfa, 0xaf, 0x0d, 0xbe, 0x11, 0x4b, 0x19, 0xa7, 0xc2, 0x38, 0x4d, 0xca, 0xb3, 0x81, 0x4a,
-	0xe6, 0x02, 0xb3, 0xcf, 0x17, 0xc6, 0xa1, 0xff, 0x87, 0x03, 0xf0, 0xa5, 0x50, 0x5c, 0xfc, 0x54,
-	0x22, 0xce, 0x7c, 0x68, 0x21, 0xfe, 0x83, 0x98, 0x2a, 0xdf, 0x79, 0xcb, 0x79, 0xaf, 0xc3, 0xab,
-	0x2d, 0x63, 0xe0, 0x2e, 0x22, 0xf5, 0xc4, 0xaf, 0x11, 0x4c, 0x6b, 0x76, 0x00, 0x0d, 0xba, 0xa9,
-	0x5f, 0x47, 0xb0, 0xcd, 0xcd, 0x46, 0xa3, 0x49, 0x36, 0x13, 0xe7, 0xbe, 0x8b, 0x68, 0x9d, 0x9b,
-	0x0d, 0x7b, 0x03, 0x60, 0xb2, 0x54, 0x62, 0x3c, 0x95, 0x65, 0xa6, 0xfc, 0x06, 0x9a, 0x1a, 0xbc,
-	0xa3, 0x91, 0x43, 0x0d, 0xb0, 0xd7, 0xa0, 0x83, 0xb7, 0xb6, 0xd6, 0x26, 0x59, 0xdb, 0x08, 0x18,
-	0xe3, 0x2d, 0xd8, 0xcf, 0x64, 0x86, 0xc6, 0x4c, 0x25, 0x71, 0x29, 0xcb, 0xc2, 0x6f, 0xd1, 0x81,
-	0x7b, 0x88, 0x1e, 0xae, 0xc0, 0xfe, 0x63, 0xf0, 0x46, 0x51, 0x92, 0x5e, 0xe1, 0x5b, 0xfa, 0xbf,
-	0x3b, 0xe0, 0x51, 0x79, 0x8a, 0x85, 0xcc, 0x0a, 0x71, 0x41, 0xce, 0x0f, 0xaa, 0x78, 0x9d, 0xd4,
-	0x1b, 0x5e, 0x0f, 0x4d, 0x47, 0xc2, 0x47, 0x32, 0x3e, 0x55, 0xb9, 0x88, 0xe6, 0xa7, 0xda, 0x5a,
-	0xd5, 0x28, 0x04, 0x77, 0x26, 0x8a, 0x29, 0x1d, 0xe6, 0x0d, 0x83, 0x90, 0xfa, 0xb6, 0xf6, 0xfd,
-	0x02, 0x6d, 0x79, 0xb2, 0x50, 0x32, 0xe7, 0xe4, 0xc7, 0x6e, 0x82, 0xab, 0x79, 0x82, 0x25, 0xad,
-	0xa3, 0xff, 0xb5, 0xb5, 0xff, 0x51, 0xa6, 0xf2, 0x25, 0x27, 0x63, 0xff, 0xd7, 0x06, 0x74, 0x1f,
-	0x97, 0x02, 0xf7, 0x57, 0xdb, 0x4d, 0x62, 0x0a, 0x75, 0x13, 0x51, 0xc3, 0x54, 0x8c, 0xcf, 0xc4,
-	0xb9, 0xe9, 0x23, 0xc6, 0xeb, 0x35, 0xbb, 0x01, 0xde, 0x3c, 0x3a, 0x1f, 0xe7, 0xa2, 0x28, 0x53,
-	0x55, 0xd8, 0x26, 0x02, 0x42, 0xdc, 0x20, 0xec, 0x6d, 0xe8, 0xea, 0x16, 0x8a, 0x4c, 0x8d, 0xd5,
-	0x72, 0x21, 0x7c, 0xa0, 0x60, 0xcf, 0x62, 0x23, 0x84, 0xd8, 0x43, 0x40, 0xee, 0xeb, 0x0a, 0x18,
-	0x0f, 0x8f, 0xca, 0x73, 0xab, 0xaa, 0xe5, 0xe6, 0xe3, 0x42, 0x53, 0x29, 0x1d, 0xf5, 0x30, 0x49,
-	0x95, 0xc8, 0x39, 0x14, 0x2b, 0x84, 0xdd, 0x86, 0x46, 0x26, 0x7e, 0x16, 0xb9, 0xdf, 0xb5, 0x05,
-	0x36, 0x3a, 0x08, 0x2b, 0x1d, 0x84, 0xa3, 0x4a, 0x07, 0xdc, 0x38, 0xea, 0x08, 0x99, 0xce, 0x30,
-	0x62, 0xef, 0xf2, 0x08, 0x72, 0xc4, 0x9e, 0xec, 0x91, 0x71, 0xfc, 0x54, 0xe4, 0x45, 0x22, 0x33,
-	0x7f, 0x9f, 0xde, 0xd3, 0x25, 0xf0, 0x3b, 0x83, 0xb1, 0x21, 0xb8, 0x2a, 0xc2, 0xc6, 0x5d, 0xa3,
-	0xc6, 0xbd, 0xb9, 0xf3, 0x25, 0x23, 0x74, 0xb0, 0x7d, 0xd4, 0xbe, 0xec, 0x0e, 0x34, 0x17, 0x65,
-	0x1e, 0x8b, 0x99, 0xdf, 0xc3, 0x8c, 0xfb, 0xc3, 0xd7, 0x77, 0x47, 0xe5, 0x49, 0x16, 0xe1, 0xd6,
-	0xfa, 0x06, 0xf7, 0xa0, 0xb7, 0x5d, 0x12, 0xf6, 0x2e, 0x34, 0x9e, 0x46, 0x69, 0x29, 0xa8, 0xfd,
-	0xfb, 0xc3, 0x97, 0x2d, 0x6f, 0xd6, 0x7e, 0xdc, 0xd8, 0x83, 0xbb, 0xd0, 0x59, 0xdd, 0x82, 0xf5,
-	0xa0, 0xfe, 0xa3, 0x58, 0x5a, 0xca, 0xe8, 0xa5, 0x26, 0x81, 0xc9, 0x63, 0xf8, 0x62, 0x36, 0x9f,
-	0xd5, 0x3e, 0x75, 0xfa, 0xef, 0x40, 0xcb, 0x5e, 0x84, 0xb5, 0xc1, 0x7d, 0x70, 0x3c, 0xfa, 0xaa,
-	0xf7, 0x12, 0x6b, 0x41, 0xfd, 0xfb, 0xa3, 0xd3, 0x9e, 0xc3, 0x9a, 0x50, 0xfb, 0xf6, 0xb8, 0x57,
-	0xeb, 0xff, 0x52, 0x83, 0x3d, 0x7b, 0xf9, 0x4b, 0x85, 0xf4, 0x09, 0xb4, 0x4c, 0x23, 0x0b, 0x3c,
-	0x4d, 0x17, 0x6d, 0xfb, 0xf9, 0x26, 0x83, 0x7d, 0x04, 0xaf, 0x9c, 0x57, 0x94, 0xac, 0xaf, 0x29,
-	0x19, 0xfc, 0xe6, 0x40, 0xd3, 0xf8, 0xad, 0x18, 0xef, 0x6c, 0x30, 0xfe, 0xc5, 0x6a, 0x16, 0x27,
-	0x9e, 0xfe, 0x1d, 0xaf, 0xe5, 0xd3, 0xe5, 0x1d, 0x8d, 0x9c, 0xd0, 0xe4, 0xfd, 0x1b, 0x47, 0xcb,
-	0xa3, 0xa4, 0x78, 0x8e, 0xd1, 0x8b, 0xb3, 0x51, 0x5f, 0x77, 0x3c, 0x89, 0x8a, 0xaa, 0x03, 0x6d,
-	0x0d, 0x3c, 0xc0, 0xfd, 0x7f, 0xa8, 0xb6, 0x2a, 0x86, 0xfb, 0xac, 0x3e, 0xad, 0xb6, 0x64, 0x96,
-	0x2e, 0x49, 0xba, 0xed, 0x4a, 0x34, 0xc7, 0x88, 0xe8, 0x31, 0x9b, 0x64, 0xd3, 0xb4, 0x9c, 0x89,
-	0xb1, 0xe5, 0x5f, 0xd3, 0x8c, 0x59, 0x8b, 0x9e, 0x10, 0xc8, 0xae, 0x43, 0x53, 0x9e, 0x9d, 0x15,
-	0x42, 0xd1, 0x14, 0x6e, 0x70, 0xbb, 0xdb, 0xd6, 0x7f, 0x7b, 0x5b, 0xff, 0xfd, 0x7f, 0x6a, 0xd0,
-	0x35, 0x2f, 0xbe, 0x94, 0x04, 0x17, 0x3e, 0x79, 0x47, 0xa7, 0xd9, 0xe7, 0x00, 0xf8, 0x3d, 0xc4,
-	0xb4, 0x38, 0x49, 0xaa, 0x31, 0xb9, 0x52, 0xdb, 0xe6, 0xa1, 0xe1, 0x61, 0xe5, 0xc6, 0x37, 0x22,
-	0x82, 0xbf, 0x1c, 0xe8, 0xac, 0x2c, 0x74, 0x42, 0x34, 0x17, 0x15, 0x59, 0xf4, 0x9a, 0xdd, 0x45,
-	0x25, 0xeb, 0x99, 0x54, 0x23, 0x29, 0xdd, 0xbc, 0x38, 0x77, 0x48, 0xe2, 0xa2, 0x80, 0x35, 0xcb,
-	0xea, 0xff, 0x87, 0x65, 0xee, 0xf3, 0xb1, 0xac, 0xff, 0x3e, 0xb8, 0x34, 0xf1, 0x50, 0x7d, 0x27,
-	0xf7, 0x49, 0x7d, 0x80, 0x9c, 0x1f, 0xf1, 0xa3, 0xfb, 0xdf, 0xa0, 0x00, 0x3d, 0x68, 0x9d, 0xf0,
-	0xe3, 0xaf, 0x8f, 0x0e, 0x47, 0xbd, 0xda, 0xf0, 0x4f, 0x07, 0x5c, 0x4c, 0x54, 0xe0, 0x19, 0x75,
-	0xfc, 0xa8, 0x31, 0x56, 0xdd, 0x64, 0xfd, 0x0f, 0x40, 0xf0, 0xca, 0x33, 0x98, 0xed, 0xd3, 0x6d,
-	0x3c, 0x03, 0x3f, 0xac, 0x6c, 0x65, 0xdc, 0xf8, 0xcc, 0xee, 0x8e, 0xb8, 0x03, 0x0d, 0x52, 0x2b,
-	0x3b, 0xd8, 0x35, 0xbb, 0x82, 0x57, 0x77, 0x4a, 0x9a, 0x7d, 0x84, 0xf7, 0xc3, 0x72, 0xae, 0xcf,
-	0xd9, 0xd0, 0x47, 0x70, 0xb0, 0xab, 0xe2, 0x93, 0x26, 0xa9, 0xeb, 0xe3, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0x2d, 0x50, 0x3e, 0x47, 0x09, 0x00, 0x00,
+	// 1123 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xc6, 0x8e, 0xf3, 0x77, 0x9c, 0xdd, 0x86, 0xa1, 0xad, 0x2c, 0xb7, 0xa5, 0x21, 0x4b, 0x45,
+	0x90, 0xc0, 0x5b, 0x42, 0xd5, 0x45, 0x54, 0xaa, 0xd4, 0x2e, 0xdb, 0x02, 0xda, 0xb2, 0xdb, 0xd9,
+	0x08, 0x89, 0xab, 0xc8, 0x71, 0x66, 0xbd, 0x06, 0x7b, 0xc6, 0x78, 0xc6, 0x65, 0xf3, 0x0a, 0xdc,
+	0x71, 0x85, 0x78, 0x05, 0xe0, 0x45, 0x78, 0x0c, 0x9e, 0x80, 0x27, 0x40, 0x42, 0xf3, 0xe3, 0x24,
+	0x1b, 0x85, 0xfd, 0x41, 0xe5, 0x26, 0x99, 0xf9, 0xce, 0x39, 0xf3, 0x73, 0xce, 0xf7, 0x9d, 0x31,
+	0x40, 0xca, 0x62, 0x1e, 0xe4, 0x05, 0x13, 0x0c, 0x35, 0x52, 0x16, 0x4f, 0x59, 0xec, 0xbb, 0x5c,
+	0x84, 0x82, 0x68, 0xd0, 0x7f, 0x14, 0x27, 0xe2, 0xa4, 0x9c, 0x04, 0x11, 0xcb, 0xb6, 0xd3, 0x32,
+	0x4a, 0xd4, 0xcf, 0x87, 0x31, 0xdb, 0x8e, 0x58, 0x96, 0x31, 0xba, 0xad, 0xbc, 0xb6, 0x75, 0xa4,
+	0xfc, 0xcb, 0x27, 0xf2, 0xd7, 0x04, 0xdf, 0x8d, 0x19, 0x8b, 0x53, 0xa2, 0x9d, 0x26, 0xe5, 0xf1,
+	0xb6, 0x48, 0x32, 0xc2, 0x45, 0x98, 0xe5, 0xda, 0xa1, 0xff, 0x87, 0x05, 0xf0, 0x9c, 0x08, 0x4c,
+	0xbe, 0x2f, 0x09, 0x17, 0xc8, 0x83, 0x66, 0x5e, 0xb0, 0x6f, 0x49, 0x24, 0x3c, 0xab, 0x67, 0x0d,
+	0xda, 0xb8, 0x9a, 0x22, 0x04, 0x4e, 0x1e, 0x8a, 0x13, 0xcf, 0x56, 0xb0, 0x1a, 0xa3, 0xeb, 0x50,
+	0x57, 0x27, 0xf5, 0x6a, 0x3d, 0x6b, 0xd0, 0xc2, 0x7a, 0x22, 0xd1, 0x84, 0x4e, 0xc9, 0xa9, 0xe7,
+	0xf4, 0xac, 0x41, 0x0d, 0xeb, 0x09, 0xba, 0x03, 0x30, 0x99, 0x09, 0x32, 0x8e, 0x58, 0x49, 0x85,
+	0x57, 0xef, 0x59, 0x83, 0x3a, 0x6e, 0x4b, 0x64, 0x57, 0x02, 0xe8, 0x16, 0xb4, 0x53, 0x16, 0x1b,
+	0x6b, 0x43, 0x59, 0x5b, 0x29, 0x8b, 0xb5, 0xf1, 0x1e, 0x6c, 0x52, 0x46, 0xc7, 0x11, 0xa3, 0x22,
+	0x89, 0x4b, 0x56, 0x72, 0xaf, 0xa9, 0x36, 0xdc, 0xa0, 0x8c, 0xee, 0xce, 0xc1, 0xfe, 0x4b, 0x70,
+	0x47, 0x61, 0x92, 0xbe, 0xc6, 0xbb, 0xf4, 0x7f, 0xb5, 0xc0, 0x55, 0xe9, 0xe1, 0x39, 0xa3, 0x9c,
+	0x9c, 0xb3, 0xe6, 0x07, 0x55, 0xbc, 0x5c, 0xd4, 0x1d, 0xde, 0x0c, 0x74, 0x45, 0x82, 0x7d, 0x16,
+	0x1f, 0x89, 0x82, 0x84, 0xd9, 0x91, 0xb4, 0x56, 0x39, 0x0a, 0xc0, 0x99, 0x12, 0x1e, 0xa9, 0xcd,
+	0xdc, 0xa1, 0x1f, 0xa8, 0xba, 0x2d, 0x7c, 0x3f, 0x23, 0x3c, 0x2a, 0x92, 0x5c, 0xb0, 0x02, 0x2b,
+	0x3f, 0xb4, 0x05, 0x8e, 0xe4, 0x89, 0xe7, 0xf4, 0x6a, 0x03, 0x77, 0x78, 0x6d, 0xe1, 0xbf, 0x47,
+	0x45, 0x31, 0xc3, 0xca, 0xd8, 0xff, 0xb9, 0x0e, 0x9d, 0x97, 0x25, 0x29, 0x66, 0xaf, 0xb9, 0x9a,
+	0x8a, 0x29, 0xaa, 0x9a, 0x2d, 0xac, 0x27, 0x32, 0x9e, 0x92, 0x53, 0x5d, 0xc7, 0x36, 0x56, 0x63,
+	0x74, 0x17, 0xdc, 0x2c, 0x3c, 0x1d, 0x17, 0x84, 0x97, 0xa9, 0xe0, 0xa6, 0x88, 0x90, 0x85, 0xa7,
+	0x58, 0x23, 0xe8, 0x1d, 0xe8, 0xc8, 0x12, 0x12, 0x2a, 0xc6, 0x62, 0x96, 0x13, 0x0f, 0x54, 0xb0,
+	0x6b, 0xb0, 0xd1, 0x2c, 0x27, 0xe8, 0x19, 0xb8, 0x5c, 0x65, 0x40, 0x7b, 0xb8, 0x2a, 0x3d, 0xf7,
+	0xaa, 0x5c, 0x2e, 0x5f, 0x2e, 0xd0, 0x99, 0x92, 0x51, 0xcf, 0x92, 0x54, 0x90, 0x02, 0x03, 0x9f,
+	0x23, 0xe8, 0x3e, 0xd4, 0x29, 0xf9, 0x81, 0x14, 0x5e, 0xc7, 0x24, 0x58, 0xeb, 0x20, 0xa8, 0x74,
+	0x10, 0x8c, 0x2a, 0x1d, 0x60, 0xed, 0x28, 0x23, 0x58, 0x3a, 0x25, 0x85, 0xb7, 0x71, 0x71, 0x84,
+	0x72, 0x44, 0x5b, 0xb0, 0xa1, 0x8c, 0xe3, 0x57, 0xa4, 0xe0, 0x09, 0xa3, 0xde, 0xa6, 0xba, 0x4f,
+	0x47, 0x81, 0x5f, 0x6b, 0x0c, 0x0d, 0xc1, 0x11, 0x61, 0xcc, 0xbd, 0x6b, 0xaa, 0x70, 0x6f, 0xaf,
+	0xbd, 0xc9, 0x28, 0x8c, 0xb9, 0xa9, 0xa3, 0xf4, 0x45, 0x0f, 0xa0, 0x91, 0x97, 0x45, 0x4c, 0xa6,
+	0x5e, 0xb7, 0x67, 0x0d, 0x36, 0x87, 0xb7, 0xd7, 0x47, 0x15, 0x09, 0x0d, 0x8b, 0x19, 0x36, 0xbe,
+	0xfe, 0x23, 0xe8, 0xae, 0xa6, 0x04, 0xbd, 0x07, 0xf5, 0x57, 0x61, 0x5a, 0x12, 0x55, 0xfe, 0xcd,
+	0xe1, 0x9b, 0x86, 0x37, 0x0b, 0x3f, 0xac, 0xed, 0xfe, 0x0e, 0xb4, 0xe7, 0xa7, 0x40, 0x5d, 0xa8,
+	0x7d, 0x47, 0x66, 0x86, 0x32, 0x72, 0x28, 0x49, 0xa0, 0xd7, 0xd1, 0x7c, 0xd1, 0x93, 0x4f, 0xed,
+	0x4f, 0xac, 0xfe, 0xbb, 0xd0, 0x34, 0x07, 0x41, 0x2d, 0x70, 0x9e, 0x1e, 0x8c, 0x3e, 0xef, 0xbe,
+	0x81, 0x9a, 0x50, 0xfb, 0x66, 0xef, 0xa8, 0x6b, 0xa1, 0x06, 0xd8, 0x5f, 0x1d, 0x74, 0xed, 0xfe,
+	0x4f, 0x36, 0x6c, 0x98, 0xc3, 0x5f, 0x28, 0xa4, 0x87, 0xd0, 0xd4, 0x85, 0xe4, 0x9e, 0xad, 0x92,
+	0xb6, 0x7a, 0x7d, 0xbd, 0x82, 0xb9, 0x04, 0xae, 0x9c, 0xe7, 0x94, 0xac, 0x2d, 0x28, 0xe9, 0xff,
+	0x62, 0x41, 0x43, 0xfb, 0xcd, 0x19, 0x6f, 0x2d, 0x31, 0xfe, 0xff, 0xd5, 0xec, 0x1d, 0x00, 0xf9,
+	0x3f, 0x5e, 0xc8, 0xa7, 0x83, 0xdb, 0x12, 0x39, 0x54, 0x9d, 0xf7, 0x2f, 0x0b, 0xdc, 0xfd, 0x84,
+	0x5f, 0xa2, 0xf5, 0xde, 0x82, 0xb6, 0x3c, 0xee, 0x78, 0x12, 0xf2, 0xaa, 0x02, 0x2d, 0x09, 0x3c,
+	0x0d, 0x39, 0xf9, 0x17, 0xd5, 0x56, 0xc9, 0x70, 0xce, 0xea, 0xd3, 0x68, 0x8b, 0xd1, 0x74, 0xa6,
+	0xa4, 0xdb, 0xaa, 0x44, 0x73, 0x40, 0xd3, 0x99, 0x6c, 0xb3, 0x09, 0x8d, 0xd2, 0x72, 0x4a, 0xc6,
+	0x86, 0x7f, 0x0d, 0xdd, 0x66, 0x0d, 0x7a, 0xa8, 0x40, 0x74, 0x13, 0x1a, 0xec, 0xf8, 0x98, 0x13,
+	0xa1, 0xba, 0x70, 0x1d, 0x9b, 0xd9, 0xaa, 0xfe, 0x5b, 0xab, 0xfa, 0xef, 0xff, 0x6d, 0x43, 0x47,
+	0xdf, 0xf8, 0x42, 0x12, 0x9c, 0x7b, 0xe5, 0x35, 0x95, 0x46, 0x8f, 0x01, 0x22, 0x96, 0xe5, 0x8c,
+	0x12, 0x2a, 0xaa, 0x36, 0x39, 0x57, 0xdb, 0xf2, 0xa6, 0xc1, 0x6e, 0xe5, 0x86, 0x97, 0x22, 0xfc,
+	0x3f, 0x2d, 0x68, 0xcf, 0x2d, 0x6a, 0x87, 0x30, 0x23, 0x15, 0x59, 0xe4, 0x18, 0xed, 0x80, 0xa3,
+	0x7a, 0x92, 0xad, 0xa4, 0xb4, 0x75, 0xfe, 0xda, 0x81, 0x12, 0x97, 0x0a, 0x58, 0xb0, 0xac, 0x76,
+	0x15, 0x96, 0x39, 0x97, 0x63, 0x59, 0xff, 0x7d, 0x70, 0x54, 0xc7, 0x6b, 0x81, 0x73, 0xf8, 0x44,
+	0xa9, 0x0f, 0xa0, 0x71, 0x34, 0xc2, 0x7b, 0x4f, 0x5e, 0x74, 0x2d, 0xe4, 0x42, 0xf3, 0x10, 0x1f,
+	0x7c, 0xb9, 0xb7, 0x3b, 0xea, 0xda, 0xfd, 0xdf, 0x6d, 0xd8, 0x38, 0x22, 0x61, 0x11, 0x9d, 0xfc,
+	0xb7, 0x07, 0x02, 0x81, 0x23, 0x96, 0xf2, 0x2e, 0x0c, 0xa9, 0x92, 0x98, 0xb2, 0x82, 0x8c, 0x23,
+	0x59, 0x2a, 0xfd, 0x48, 0x80, 0x86, 0x76, 0x97, 0x8b, 0x75, 0xa5, 0x97, 0xc2, 0x38, 0x64, 0xa1,
+	0x88, 0x4e, 0x08, 0xf7, 0x9a, 0x73, 0x87, 0x17, 0x1a, 0x59, 0xf4, 0x77, 0xb8, 0x72, 0x7f, 0x77,
+	0x2f, 0xd9, 0xdf, 0xfb, 0xbf, 0xd9, 0xb0, 0x59, 0xa5, 0xeb, 0x42, 0xc2, 0xee, 0xac, 0x76, 0xad,
+	0x3b, 0x55, 0x99, 0xcf, 0x2e, 0x71, 0xa9, 0xb6, 0xf5, 0x18, 0xea, 0xea, 0xa2, 0xd2, 0x98, 0x26,
+	0x54, 0xf3, 0xb0, 0x86, 0xd5, 0x58, 0xbe, 0xa2, 0x46, 0xc6, 0xfa, 0x2b, 0xcb, 0x56, 0x36, 0x23,
+	0xed, 0x2f, 0x24, 0xe4, 0x17, 0xe7, 0x76, 0xbd, 0x87, 0xd0, 0xac, 0x12, 0xbb, 0xd2, 0x60, 0x57,
+	0x8e, 0xaa, 0xce, 0x80, 0x2b, 0x67, 0x74, 0x1b, 0xda, 0xa2, 0x28, 0x69, 0x14, 0x0a, 0x32, 0x35,
+	0xdd, 0x66, 0x01, 0x0c, 0x7f, 0xb4, 0xc1, 0xd9, 0x67, 0x31, 0x47, 0x01, 0xd4, 0x9e, 0x13, 0x81,
+	0x50, 0xb5, 0xe8, 0xe2, 0xeb, 0xd2, 0x7f, 0xeb, 0x0c, 0x66, 0x72, 0x7a, 0x1f, 0x1c, 0xf9, 0xd5,
+	0x86, 0xe6, 0xc6, 0xa5, 0x6f, 0xb8, 0xf5, 0x11, 0x0f, 0xa0, 0xae, 0x9e, 0x02, 0x74, 0x7d, 0xdd,
+	0xc3, 0xe8, 0xdf, 0x58, 0xfb, 0x5e, 0xa0, 0x8f, 0xc0, 0x91, 0x5a, 0x5d, 0xec, 0xb3, 0xd4, 0x7c,
+	0xfd, 0xeb, 0xeb, 0xe4, 0x8c, 0x76, 0xa0, 0xa1, 0x53, 0x82, 0x6e, 0xac, 0xa6, 0x48, 0x87, 0xdd,
+	0x5c, 0x9f, 0xb9, 0x49, 0x43, 0xb1, 0xea, 0xe3, 0x7f, 0x06, 0x00, 0xb6, 0xda, 0xf3, 0x7c, 0xdd,
+	0x0b, 0x00, 0x00,
 }
@@ -46,3 +46,11 @@ func (s *DecoratedLogs) List(c context.Context, req *ListRequest) (*ListResponse
 	}
 	return s.Service.List(c, req)
 }
+
+func (s *DecoratedLogs) Search(c context.Context, req *SearchRequest) (*SearchResponse, error) {
+	c, err := s.Prelude(c, "Search", req)
+	if err != nil {
+		return nil, err
+	}
+	return s.Service.Search(c, req)
+}
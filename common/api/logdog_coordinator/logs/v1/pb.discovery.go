@@ -10,1015 +10,1447 @@ func init() {
 			"logdog.Logs",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 125, 11, 112, 92, 201,
-			113, 24, 222, 219, 183, 139, 197, 0, 32, 192, 37, 0, 130, 143,
-			191, 225, 242, 7, 144, 139, 5, 72, 30, 239, 36, 242, 120, 49,
-			72, 128, 36, 78, 56, 0, 92, 44, 239, 116, 212, 135, 124, 216,
-			125, 0, 246, 110, 177, 111, 111, 223, 46, 1, 28, 45, 71, 174,
-			36, 150, 149, 212, 85, 229, 98, 203, 182, 108, 89, 81, 228, 202,
-			85, 172, 203, 69, 145, 98, 203, 178, 83, 81, 57, 101, 59, 42,
-			203, 114, 100, 73, 41, 89, 78, 98, 85, 36, 59, 81, 69, 37,
-			39, 41, 165, 82, 73, 57, 73, 41, 221, 61, 159, 55, 111, 1,
-			240, 35, 157, 171, 236, 212, 93, 73, 119, 152, 121, 51, 61, 61,
-			61, 61, 221, 61, 221, 61, 179, 236, 39, 79, 178, 195, 43, 65,
-			176, 82, 245, 199, 235, 141, 160, 25, 44, 181, 150, 199, 155, 149,
-			53, 63, 108, 122, 107, 245, 60, 85, 101, 250, 68, 131, 188, 106,
-			144, 189, 200, 186, 138, 170, 77, 102, 152, 117, 134, 126, 41, 168,
-			149, 195, 97, 139, 91, 35, 137, 130, 42, 102, 6, 88, 178, 230,
-			213, 130, 112, 216, 134, 250, 100, 65, 20, 46, 23, 217, 158, 82,
-			176, 150, 111, 131, 121, 121, 151, 134, 184, 128, 85, 11, 214, 199,
-			44, 235, 127, 89, 214, 199, 237, 196, 181, 133, 203, 159, 176, 15,
-			93, 19, 237, 23, 100, 251, 252, 115, 126, 181, 250, 142, 90, 176,
-			94, 43, 110, 214, 253, 240, 233, 207, 30, 103, 169, 140, 115, 168,
-			99, 205, 98, 95, 236, 97, 86, 79, 38, 113, 168, 35, 115, 246,
-			95, 246, 112, 234, 80, 10, 170, 252, 114, 107, 121, 217, 111, 132,
-			124, 140, 11, 80, 39, 67, 94, 246, 154, 30, 175, 212, 154, 126,
-			163, 180, 234, 213, 86, 124, 190, 28, 52, 214, 188, 38, 227, 87,
-			130, 250, 102, 163, 178, 178, 218, 228, 103, 39, 38, 222, 38, 59,
-			240, 153, 90, 41, 207, 249, 100, 181, 202, 233, 91, 200, 27, 126,
-			232, 55, 238, 250, 229, 60, 227, 171, 205, 102, 61, 188, 48, 62,
-			94, 246, 239, 250, 213, 160, 14, 3, 169, 25, 194, 100, 5, 105,
-			1, 137, 177, 37, 129, 196, 56, 99, 188, 224, 151, 43, 97, 179,
-			81, 89, 106, 53, 43, 65, 141, 123, 181, 50, 111, 133, 62, 160,
-			195, 195, 160, 213, 40, 249, 84, 179, 84, 169, 121, 141, 77, 194,
-			43, 204, 241, 245, 74, 115, 149, 7, 13, 250, 111, 208, 2, 60,
-			215, 130, 114, 101, 185, 82, 242, 16, 66, 142, 123, 13, 159, 195,
-			200, 107, 149, 102, 211, 47, 115, 24, 243, 110, 165, 12, 127, 52,
-			87, 189, 38, 252, 11, 103, 87, 173, 6, 235, 149, 218, 10, 199,
-			245, 169, 96, 167, 16, 59, 1, 28, 191, 121, 1, 80, 194, 127,
-			78, 181, 33, 22, 242, 96, 89, 97, 84, 10, 202, 62, 95, 107,
-			133, 77, 152, 121, 211, 3, 76, 17, 170, 183, 20, 220, 197, 79,
-			146, 98, 140, 215, 130, 102, 165, 228, 231, 224, 99, 37, 228, 85,
-			0, 133, 16, 204, 17, 107, 229, 54, 116, 96, 188, 82, 213, 131,
-			245, 111, 228, 119, 66, 2, 6, 51, 104, 161, 144, 128, 57, 150,
-			91, 128, 152, 198, 131, 69, 136, 252, 80, 120, 48, 46, 103, 87,
-			14, 74, 173, 53, 191, 214, 244, 212, 34, 141, 3, 253, 3, 248,
-			210, 224, 192, 41, 126, 163, 226, 85, 195, 136, 212, 180, 64, 240,
-			145, 113, 19, 123, 61, 169, 57, 191, 66, 61, 17, 112, 205, 91,
-			243, 17, 33, 147, 183, 106, 65, 244, 141, 232, 94, 105, 134, 56,
-			163, 154, 0, 21, 0, 247, 174, 121, 155, 124, 201, 71, 78, 1,
-			228, 3, 238, 215, 202, 80, 235, 35, 83, 0, 18, 107, 65, 211,
-			231, 130, 38, 192, 157, 101, 192, 14, 152, 147, 47, 195, 7, 38,
-			168, 16, 6, 203, 205, 117, 100, 19, 201, 65, 60, 172, 251, 37,
-			228, 32, 232, 85, 65, 198, 106, 32, 239, 212, 4, 23, 133, 33,
-			225, 206, 120, 241, 250, 204, 34, 95, 156, 191, 90, 124, 110, 178,
-			48, 205, 225, 239, 133, 194, 252, 179, 51, 83, 211, 83, 252, 242,
-			243, 240, 113, 154, 95, 153, 95, 120, 190, 48, 115, 237, 122, 145,
-			95, 159, 159, 157, 154, 46, 44, 242, 201, 185, 41, 168, 157, 43,
-			22, 102, 46, 223, 44, 206, 23, 22, 25, 207, 78, 46, 66, 215,
-			44, 125, 153, 156, 123, 158, 79, 191, 115, 161, 48, 189, 184, 200,
-			231, 11, 124, 230, 153, 133, 217, 25, 128, 6, 224, 11, 147, 115,
-			197, 153, 233, 197, 28, 159, 153, 187, 50, 123, 115, 106, 102, 238,
-			90, 142, 3, 4, 62, 55, 95, 100, 124, 118, 230, 153, 153, 34,
-			180, 43, 206, 231, 104, 216, 173, 253, 248, 252, 85, 254, 204, 116,
-			225, 202, 117, 40, 78, 94, 158, 153, 157, 41, 62, 79, 3, 94,
-			157, 41, 206, 225, 96, 87, 231, 11, 140, 79, 242, 133, 201, 66,
-			113, 230, 202, 205, 217, 201, 2, 95, 184, 89, 88, 152, 95, 156,
-			230, 56, 179, 169, 153, 197, 43, 179, 147, 51, 207, 76, 79, 229,
-			97, 124, 24, 147, 79, 63, 59, 61, 87, 228, 139, 215, 39, 103,
-			103, 227, 19, 101, 124, 254, 185, 185, 233, 2, 98, 111, 78, 147,
-			95, 158, 6, 44, 39, 47, 207, 78, 227, 80, 52, 207, 169, 153,
-			194, 244, 149, 34, 78, 40, 250, 235, 10, 16, 15, 16, 156, 205,
-			49, 190, 184, 48, 125, 101, 6, 254, 2, 122, 76, 195, 116, 38,
-			11, 207, 231, 36, 208, 197, 233, 27, 55, 161, 21, 124, 228, 83,
-			147, 207, 76, 94, 131, 217, 141, 60, 136, 42, 176, 48, 87, 110,
-			22, 166, 159, 65, 172, 129, 20, 139, 55, 47, 47, 22, 103, 138,
-			55, 139, 211, 252, 218, 252, 252, 20, 17, 123, 113, 186, 240, 236,
-			204, 149, 233, 197, 139, 124, 118, 126, 145, 8, 118, 115, 113, 26,
-			16, 153, 154, 44, 78, 210, 208, 0, 3, 200, 5, 223, 225, 239,
-			203, 55, 23, 103, 136, 112, 51, 115, 197, 233, 66, 225, 230, 66,
-			113, 102, 126, 110, 20, 86, 249, 57, 160, 12, 96, 57, 9, 125,
-			167, 136, 194, 243, 115, 56, 91, 228, 149, 233, 249, 194, 243, 8,
-			22, 233, 64, 43, 144, 227, 207, 93, 159, 134, 250, 2, 18, 149,
-			168, 53, 137, 100, 88, 4, 170, 93, 41, 154, 205, 96, 64, 32,
-			34, 76, 41, 154, 39, 159, 155, 190, 54, 59, 115, 109, 122, 238,
-			202, 52, 126, 158, 71, 48, 207, 205, 44, 78, 143, 194, 130, 205,
-			44, 98, 131, 25, 26, 24, 120, 0, 6, 189, 73, 179, 198, 133,
-			2, 188, 152, 248, 219, 96, 221, 28, 173, 39, 159, 185, 202, 39,
-			167, 158, 157, 65, 204, 101, 107, 224, 128, 197, 25, 201, 46, 68,
-			182, 43, 215, 37, 205, 129, 253, 211, 204, 178, 51, 9, 158, 222,
-			139, 127, 165, 51, 137, 108, 199, 69, 214, 205, 156, 244, 183, 59,
-			59, 68, 161, 135, 37, 177, 0, 141, 178, 157, 123, 89, 47, 75,
-			81, 169, 67, 20, 119, 177, 78, 81, 180, 68, 89, 54, 238, 132,
-			146, 123, 65, 66, 60, 218, 113, 88, 66, 180, 68, 65, 52, 194,
-			97, 143, 106, 136, 22, 66, 60, 170, 33, 90, 4, 241, 168, 134,
-			104, 37, 160, 228, 30, 146, 16, 143, 117, 228, 36, 68, 91, 20,
-			68, 35, 27, 75, 157, 123, 36, 68, 27, 33, 98, 81, 64, 180,
-			9, 34, 150, 101, 99, 192, 241, 216, 208, 105, 9, 241, 120, 199,
-			184, 132, 152, 16, 5, 209, 40, 1, 16, 143, 119, 238, 151, 16,
-			19, 8, 17, 139, 2, 98, 130, 32, 98, 89, 54, 6, 136, 199,
-			15, 229, 37, 196, 19, 29, 89, 9, 209, 17, 5, 209, 200, 1,
-			136, 39, 58, 93, 9, 209, 65, 136, 88, 20, 16, 29, 130, 136,
-			101, 217, 24, 144, 57, 113, 240, 136, 132, 120, 82, 207, 58, 41,
-			10, 162, 81, 18, 32, 158, 236, 60, 38, 33, 38, 17, 34, 22,
-			5, 196, 36, 65, 196, 178, 108, 12, 16, 79, 158, 84, 179, 30,
-			233, 56, 34, 33, 166, 68, 65, 52, 74, 1, 196, 145, 206, 97,
-			9, 49, 133, 16, 177, 40, 32, 166, 8, 34, 150, 101, 99, 152,
-			245, 200, 126, 206, 94, 233, 103, 182, 211, 145, 113, 60, 176, 81,
-			220, 247, 247, 131, 24, 210, 22, 15, 105, 50, 176, 37, 106, 32,
-			181, 61, 94, 15, 192, 36, 33, 253, 3, 223, 225, 191, 101, 191,
-			14, 98, 30, 62, 162, 70, 240, 106, 155, 162, 254, 229, 160, 6,
-			106, 6, 100, 118, 201, 171, 194, 103, 175, 145, 139, 160, 128, 208,
-			247, 64, 216, 11, 51, 140, 244, 220, 114, 195, 43, 69, 218, 92,
-			125, 64, 101, 141, 54, 25, 149, 209, 154, 9, 170, 194, 24, 129,
-			193, 111, 22, 175, 240, 233, 122, 80, 90, 165, 225, 64, 32, 2,
-			74, 33, 232, 27, 180, 1, 208, 82, 65, 125, 73, 154, 14, 12,
-			172, 170, 95, 7, 85, 203, 175, 53, 252, 149, 0, 52, 98, 141,
-			95, 145, 56, 241, 245, 213, 10, 64, 240, 55, 64, 169, 192, 128,
-			168, 219, 162, 70, 10, 113, 198, 151, 188, 210, 139, 160, 150, 176,
-			69, 192, 55, 125, 232, 7, 147, 107, 31, 210, 11, 195, 214, 26,
-			142, 234, 129, 1, 6, 127, 180, 154, 62, 89, 47, 252, 241, 9,
-			166, 167, 84, 13, 106, 43, 57, 94, 201, 67, 239, 170, 239, 213,
-			163, 169, 66, 187, 108, 184, 6, 160, 253, 114, 22, 212, 160, 48,
-			138, 106, 129, 217, 10, 116, 164, 183, 4, 170, 24, 198, 172, 249,
-			62, 14, 9, 166, 134, 48, 15, 235, 104, 239, 144, 42, 231, 5,
-			50, 20, 161, 141, 80, 171, 19, 19, 19, 103, 198, 232, 127, 197,
-			137, 137, 11, 244, 191, 91, 56, 139, 183, 195, 63, 99, 103, 206,
-			142, 157, 59, 83, 60, 123, 238, 194, 249, 183, 195, 255, 242, 111,
-			87, 255, 220, 2, 139, 224, 242, 38, 18, 28, 20, 59, 172, 11,
-			146, 82, 162, 212, 64, 240, 96, 233, 249, 48, 239, 176, 213, 240,
-			69, 45, 20, 75, 72, 177, 160, 118, 215, 111, 128, 49, 23, 48,
-			185, 170, 193, 26, 231, 133, 171, 87, 248, 185, 115, 231, 222, 142,
-			230, 172, 207, 17, 100, 109, 37, 132, 17, 22, 125, 159, 191, 75,
-			217, 165, 235, 235, 235, 249, 138, 223, 92, 206, 7, 141, 149, 241,
-			198, 114, 9, 255, 143, 157, 242, 205, 141, 230, 123, 70, 30, 166,
-			213, 40, 154, 2, 211, 27, 192, 174, 64, 163, 51, 23, 192, 70,
-			94, 171, 195, 26, 24, 92, 76, 232, 128, 44, 157, 121, 39, 191,
-			131, 76, 51, 50, 122, 39, 47, 173, 202, 168, 145, 62, 92, 92,
-			20, 95, 162, 195, 70, 232, 55, 111, 203, 245, 26, 161, 238, 115,
-			55, 103, 103, 71, 71, 183, 109, 71, 108, 59, 50, 1, 31, 35,
-			156, 206, 62, 8, 167, 21, 191, 137, 80, 130, 229, 178, 183, 105,
-			224, 6, 20, 3, 123, 137, 6, 184, 235, 85, 121, 243, 174, 28,
-			49, 214, 252, 68, 243, 110, 142, 19, 66, 23, 127, 208, 41, 221,
-			205, 55, 239, 98, 233, 126, 51, 18, 141, 192, 186, 43, 129, 185,
-			120, 6, 152, 43, 54, 195, 115, 59, 206, 240, 185, 74, 237, 220,
-			89, 126, 231, 154, 223, 92, 220, 12, 155, 254, 26, 126, 158, 12,
-			175, 86, 170, 126, 49, 190, 16, 87, 103, 102, 167, 139, 96, 226,
-			240, 229, 166, 68, 99, 167, 62, 39, 150, 155, 10, 211, 155, 160,
-			254, 31, 127, 12, 16, 46, 189, 24, 242, 75, 124, 100, 100, 68,
-			212, 140, 46, 55, 243, 229, 245, 235, 96, 109, 79, 1, 235, 97,
-			175, 81, 254, 228, 147, 252, 220, 217, 81, 254, 163, 156, 190, 205,
-			6, 235, 234, 147, 162, 219, 248, 56, 136, 62, 192, 183, 28, 172,
-			135, 4, 18, 55, 19, 76, 213, 16, 69, 97, 94, 55, 240, 73,
-			4, 157, 121, 124, 235, 46, 211, 208, 176, 251, 153, 199, 31, 123,
-			236, 177, 39, 206, 61, 14, 96, 212, 150, 95, 242, 97, 251, 250,
-			252, 102, 173, 178, 161, 160, 188, 253, 137, 137, 118, 40, 249, 31,
-			108, 49, 71, 196, 252, 129, 20, 130, 40, 227, 180, 88, 248, 207,
-			40, 28, 48, 13, 116, 30, 192, 193, 8, 7, 201, 165, 224, 28,
-			55, 224, 16, 3, 140, 198, 24, 224, 177, 29, 25, 224, 105, 239,
-			174, 199, 239, 136, 133, 204, 151, 90, 141, 6, 168, 1, 108, 242,
-			76, 165, 10, 71, 31, 131, 1, 80, 66, 130, 248, 196, 90, 88,
-			202, 157, 59, 220, 135, 205, 161, 159, 174, 205, 215, 252, 245, 203,
-			173, 74, 21, 14, 27, 35, 163, 56, 177, 69, 73, 33, 57, 132,
-			32, 204, 168, 128, 133, 255, 96, 155, 57, 49, 119, 16, 173, 56,
-			115, 217, 82, 76, 93, 78, 155, 40, 48, 154, 95, 66, 200, 132,
-			75, 68, 131, 243, 59, 210, 64, 206, 66, 233, 77, 190, 176, 9,
-			135, 156, 154, 154, 120, 45, 88, 7, 196, 73, 153, 9, 225, 36,
-			119, 191, 100, 151, 75, 40, 233, 71, 160, 145, 172, 167, 245, 145,
-			181, 88, 13, 203, 42, 155, 10, 20, 79, 157, 122, 251, 104, 219,
-			186, 154, 116, 25, 145, 141, 47, 201, 255, 230, 4, 192, 75, 244,
-			239, 81, 70, 255, 36, 28, 180, 20, 188, 244, 110, 246, 15, 44,
-			230, 56, 100, 51, 46, 219, 3, 238, 79, 89, 112, 252, 213, 6,
-			129, 66, 16, 20, 55, 234, 100, 154, 28, 104, 223, 146, 201, 218,
-			108, 123, 222, 230, 207, 224, 49, 25, 142, 139, 68, 30, 250, 215,
-			14, 250, 138, 109, 167, 176, 110, 193, 228, 75, 85, 80, 245, 119,
-			125, 32, 98, 47, 75, 34, 138, 78, 198, 89, 182, 61, 50, 18,
-			177, 152, 68, 148, 59, 85, 9, 166, 179, 156, 238, 83, 37, 48,
-			165, 150, 51, 123, 216, 159, 138, 201, 193, 183, 170, 157, 113, 191,
-			102, 241, 185, 160, 54, 86, 243, 87, 64, 161, 222, 245, 227, 150,
-			137, 39, 103, 203, 81, 57, 111, 103, 153, 228, 225, 12, 45, 58,
-			42, 157, 207, 65, 102, 183, 192, 18, 160, 163, 119, 4, 140, 28,
-			4, 97, 19, 88, 139, 175, 122, 48, 76, 205, 28, 83, 172, 173,
-			232, 200, 132, 134, 45, 5, 45, 224, 28, 144, 24, 104, 135, 40,
-			227, 171, 141, 128, 19, 82, 177, 231, 228, 255, 217, 54, 244, 177,
-			128, 62, 85, 123, 121, 64, 210, 192, 74, 226, 172, 21, 125, 44,
-			164, 65, 186, 87, 149, 128, 62, 213, 254, 221, 75, 41, 242, 14,
-			157, 99, 175, 12, 176, 110, 224, 157, 166, 116, 138, 101, 82, 213,
-			96, 165, 28, 172, 184, 15, 242, 207, 101, 127, 42, 193, 118, 205,
-			6, 43, 139, 205, 134, 239, 173, 45, 34, 132, 204, 81, 214, 75,
-			223, 110, 131, 189, 128, 199, 120, 114, 205, 117, 21, 122, 168, 242,
-			89, 81, 151, 121, 140, 117, 150, 160, 11, 152, 139, 228, 161, 235,
-			62, 235, 182, 123, 229, 242, 154, 159, 11, 170, 105, 230, 56, 219,
-			213, 68, 247, 64, 205, 171, 222, 70, 227, 116, 99, 56, 65, 110,
-			191, 94, 85, 59, 131, 149, 153, 39, 89, 167, 215, 40, 173, 2,
-			109, 134, 29, 2, 158, 205, 139, 249, 228, 227, 168, 230, 39, 69,
-			171, 153, 218, 114, 80, 80, 93, 50, 67, 44, 85, 111, 53, 86,
-			0, 179, 36, 116, 78, 23, 100, 201, 253, 71, 22, 235, 54, 58,
-			100, 246, 179, 46, 194, 225, 118, 171, 81, 149, 115, 76, 83, 197,
-			205, 70, 53, 115, 144, 177, 144, 6, 162, 175, 54, 125, 237, 18,
-			53, 248, 121, 31, 75, 163, 3, 144, 62, 38, 232, 99, 39, 150,
-			241, 147, 203, 210, 165, 0, 165, 77, 83, 96, 159, 46, 232, 114,
-			230, 4, 235, 131, 137, 220, 134, 189, 217, 216, 188, 77, 108, 67,
-			56, 2, 1, 160, 122, 26, 107, 175, 96, 229, 211, 159, 233, 71,
-			143, 164, 211, 49, 97, 177, 79, 88, 228, 145, 4, 235, 255, 236,
-			223, 183, 98, 206, 197, 51, 143, 243, 34, 24, 197, 87, 86, 129,
-			191, 42, 173, 53, 62, 217, 2, 145, 213, 0, 253, 183, 189, 151,
-			241, 102, 72, 62, 35, 233, 203, 137, 124, 114, 80, 92, 9, 96,
-			169, 107, 96, 180, 46, 109, 194, 54, 186, 188, 56, 53, 22, 54,
-			55, 65, 92, 86, 43, 37, 176, 36, 165, 29, 137, 70, 228, 18,
-			108, 157, 101, 192, 176, 172, 92, 92, 179, 51, 87, 166, 231, 22,
-			193, 34, 0, 205, 175, 207, 187, 169, 244, 46, 214, 197, 236, 4,
-			136, 164, 116, 231, 8, 251, 168, 37, 14, 47, 189, 48, 29, 247,
-			85, 139, 199, 215, 16, 199, 247, 248, 82, 165, 92, 105, 248, 180,
-			255, 192, 140, 34, 78, 22, 123, 76, 120, 171, 240, 76, 81, 71,
-			19, 85, 116, 68, 243, 191, 26, 162, 112, 222, 10, 203, 95, 91,
-			242, 203, 101, 97, 140, 215, 248, 116, 173, 76, 71, 34, 164, 196,
-			75, 176, 99, 155, 227, 64, 145, 122, 128, 147, 18, 166, 27, 66,
-			81, 210, 180, 55, 61, 196, 166, 148, 48, 237, 75, 31, 113, 159,
-			16, 30, 96, 201, 243, 8, 29, 231, 172, 24, 156, 203, 253, 65,
-			166, 190, 32, 43, 161, 18, 147, 119, 125, 233, 222, 97, 67, 222,
-			245, 1, 105, 34, 121, 215, 215, 119, 192, 144, 119, 125, 135, 57,
-			91, 80, 226, 46, 147, 206, 187, 87, 104, 125, 73, 104, 175, 175,
-			250, 130, 224, 192, 39, 114, 24, 190, 238, 225, 172, 86, 42, 160,
-			133, 27, 134, 255, 16, 120, 36, 104, 148, 97, 51, 53, 131, 70,
-			76, 180, 100, 210, 125, 71, 148, 248, 72, 225, 8, 71, 12, 209,
-			146, 201, 142, 26, 162, 37, 147, 27, 99, 119, 9, 21, 88, 206,
-			33, 160, 68, 133, 80, 145, 3, 211, 38, 17, 188, 100, 34, 116,
-			18, 200, 35, 183, 49, 135, 157, 31, 122, 43, 120, 14, 19, 173,
-			196, 90, 2, 137, 198, 206, 228, 152, 238, 71, 36, 67, 49, 43,
-			0, 192, 217, 67, 35, 108, 3, 194, 67, 233, 76, 94, 34, 101,
-			39, 17, 15, 37, 253, 144, 64, 67, 187, 20, 237, 108, 64, 120,
-			8, 104, 119, 29, 17, 6, 166, 115, 246, 217, 35, 9, 247, 2,
-			55, 246, 58, 249, 65, 189, 10, 185, 172, 169, 146, 151, 209, 9,
-			93, 13, 229, 218, 153, 211, 0, 20, 8, 110, 2, 87, 104, 31,
-			27, 100, 55, 88, 10, 75, 200, 20, 251, 157, 125, 238, 101, 34,
-			133, 116, 189, 46, 2, 145, 97, 158, 252, 102, 97, 22, 215, 168,
-			225, 111, 165, 137, 160, 86, 69, 15, 13, 123, 145, 245, 177, 78,
-			1, 18, 102, 9, 48, 201, 49, 35, 42, 146, 56, 8, 139, 202,
-			128, 195, 254, 238, 129, 168, 12, 115, 221, 191, 119, 152, 189, 75,
-			226, 4, 159, 15, 58, 174, 59, 251, 136, 56, 53, 188, 117, 181,
-			152, 34, 142, 177, 45, 118, 200, 52, 7, 157, 253, 251, 244, 232,
-			168, 145, 14, 26, 216, 33, 227, 28, 236, 30, 140, 202, 128, 221,
-			193, 225, 125, 236, 150, 196, 14, 152, 231, 176, 51, 236, 190, 227,
-			17, 177, 131, 211, 58, 236, 226, 42, 240, 244, 125, 144, 67, 6,
-			57, 236, 28, 116, 245, 224, 200, 34, 135, 13, 228, 144, 54, 135,
-			187, 247, 68, 101, 64, 238, 240, 208, 94, 246, 199, 150, 196, 14,
-			202, 199, 156, 33, 247, 247, 45, 98, 210, 70, 11, 142, 206, 232,
-			30, 64, 84, 80, 64, 87, 124, 60, 7, 52, 215, 125, 216, 121,
-			19, 194, 225, 31, 83, 93, 112, 176, 6, 228, 53, 102, 0, 4,
-			100, 163, 87, 13, 85, 212, 0, 26, 97, 116, 197, 15, 163, 32,
-			74, 219, 230, 173, 5, 104, 59, 8, 213, 80, 221, 132, 175, 94,
-			153, 100, 29, 154, 84, 228, 58, 88, 243, 203, 21, 113, 46, 23,
-			52, 211, 82, 64, 140, 10, 251, 140, 154, 225, 31, 254, 70, 29,
-			4, 168, 73, 160, 4, 16, 232, 152, 115, 120, 88, 19, 32, 145,
-			196, 9, 167, 163, 50, 186, 236, 186, 118, 71, 101, 36, 200, 192,
-			32, 59, 42, 233, 227, 100, 18, 39, 157, 67, 238, 0, 173, 94,
-			173, 5, 114, 181, 129, 155, 30, 39, 17, 141, 226, 192, 40, 39,
-			157, 99, 67, 26, 138, 131, 254, 51, 167, 43, 42, 163, 139, 140,
-			69, 60, 132, 110, 183, 147, 7, 14, 50, 143, 196, 11, 20, 78,
-			219, 174, 91, 196, 37, 32, 51, 171, 82, 205, 181, 147, 202, 88,
-			255, 156, 12, 188, 160, 11, 165, 226, 87, 203, 237, 251, 218, 171,
-			50, 181, 179, 181, 36, 65, 58, 156, 6, 137, 32, 165, 69, 34,
-			133, 67, 42, 73, 130, 52, 56, 189, 107, 80, 149, 16, 29, 224,
-			223, 191, 78, 184, 193, 252, 199, 211, 195, 110, 131, 207, 24, 43,
-			9, 226, 159, 172, 9, 169, 163, 200, 238, 4, 92, 65, 233, 18,
-			202, 180, 212, 171, 30, 114, 14, 134, 66, 68, 83, 192, 54, 168,
-			85, 55, 25, 247, 74, 47, 194, 105, 0, 24, 123, 69, 68, 96,
-			188, 50, 240, 19, 6, 123, 132, 105, 89, 170, 86, 208, 106, 215,
-			152, 35, 109, 199, 211, 167, 93, 137, 29, 82, 118, 60, 221, 163,
-			74, 128, 249, 120, 175, 178, 165, 145, 170, 227, 67, 123, 181, 61,
-			248, 223, 143, 176, 67, 237, 166, 95, 185, 213, 16, 126, 168, 29,
-			34, 179, 23, 88, 122, 74, 54, 121, 228, 192, 236, 194, 246, 129,
-			217, 94, 5, 48, 138, 203, 62, 108, 80, 246, 151, 185, 8, 202,
-			222, 126, 43, 40, 251, 86, 80, 246, 173, 160, 236, 91, 65, 217,
-			183, 130, 178, 111, 5, 101, 255, 202, 4, 101, 115, 102, 80, 54,
-			23, 11, 202, 238, 137, 7, 101, 247, 180, 5, 101, 85, 8, 213,
-			2, 136, 71, 117, 8, 245, 88, 71, 222, 12, 202, 230, 99, 65,
-			217, 253, 241, 160, 236, 254, 182, 160, 236, 126, 51, 40, 123, 104,
-			76, 7, 101, 179, 102, 80, 54, 27, 11, 202, 186, 241, 160, 172,
-			219, 22, 148, 85, 33, 84, 180, 151, 142, 235, 16, 234, 9, 61,
-			107, 71, 20, 204, 160, 236, 177, 120, 80, 246, 88, 91, 80, 246,
-			152, 25, 148, 213, 33, 212, 147, 58, 132, 154, 20, 5, 51, 40,
-			59, 28, 15, 202, 14, 183, 5, 101, 85, 8, 53, 9, 179, 62,
-			185, 159, 179, 63, 97, 194, 11, 81, 0, 139, 194, 253, 26, 10,
-			13, 101, 155, 196, 35, 168, 97, 101, 165, 134, 134, 230, 114, 101,
-			195, 47, 143, 85, 253, 218, 10, 104, 128, 176, 238, 213, 232, 192,
-			139, 103, 113, 35, 84, 202, 48, 86, 234, 73, 7, 160, 25, 29,
-			221, 57, 108, 106, 248, 38, 89, 204, 57, 41, 34, 150, 219, 132,
-			108, 85, 172, 147, 160, 66, 191, 146, 95, 111, 162, 254, 123, 209,
-			231, 217, 178, 183, 153, 165, 72, 110, 118, 13, 148, 202, 106, 86,
-			129, 105, 248, 85, 116, 184, 161, 70, 137, 28, 221, 164, 1, 165,
-			233, 0, 102, 6, 216, 45, 62, 186, 130, 229, 33, 7, 52, 202,
-			186, 217, 90, 250, 70, 201, 61, 163, 73, 133, 40, 84, 148, 47,
-			8, 236, 87, 212, 145, 48, 122, 216, 90, 106, 226, 116, 145, 34,
-			228, 238, 244, 12, 47, 127, 20, 246, 244, 234, 160, 203, 54, 42,
-			168, 103, 225, 188, 115, 122, 236, 204, 68, 110, 98, 98, 130, 162,
-			182, 225, 14, 17, 66, 61, 50, 129, 141, 97, 72, 54, 68, 61,
-			244, 91, 229, 128, 12, 153, 173, 241, 66, 248, 119, 163, 201, 47,
-			241, 124, 62, 127, 177, 253, 27, 144, 52, 246, 69, 15, 164, 204,
-			100, 245, 85, 124, 214, 198, 115, 228, 243, 7, 8, 186, 52, 38,
-			198, 82, 229, 139, 109, 157, 84, 64, 0, 187, 136, 191, 85, 7,
-			42, 169, 65, 42, 203, 124, 100, 203, 64, 79, 194, 233, 243, 196,
-			137, 118, 88, 79, 241, 137, 81, 126, 79, 133, 70, 182, 116, 58,
-			125, 137, 159, 185, 184, 229, 171, 28, 250, 146, 14, 20, 77, 76,
-			200, 70, 239, 227, 126, 21, 109, 85, 3, 129, 80, 3, 123, 106,
-			91, 12, 158, 188, 63, 6, 99, 247, 193, 224, 244, 118, 24, 60,
-			84, 52, 54, 42, 158, 142, 86, 236, 209, 217, 96, 199, 197, 222,
-			153, 73, 196, 39, 115, 205, 47, 197, 215, 28, 48, 106, 39, 194,
-			197, 168, 147, 226, 0, 99, 213, 205, 14, 91, 216, 32, 234, 19,
-			167, 115, 140, 233, 76, 18, 71, 29, 182, 165, 110, 180, 190, 81,
-			195, 167, 204, 134, 59, 140, 113, 122, 251, 49, 182, 229, 33, 35,
-			48, 85, 72, 247, 179, 186, 114, 165, 62, 107, 15, 184, 37, 190,
-			72, 130, 213, 12, 73, 161, 28, 50, 37, 107, 91, 200, 100, 236,
-			220, 153, 243, 185, 243, 79, 60, 142, 50, 2, 255, 207, 80, 154,
-			157, 110, 171, 220, 33, 204, 244, 172, 93, 200, 24, 110, 215, 103,
-			99, 97, 166, 103, 99, 97, 166, 103, 51, 123, 216, 223, 72, 40,
-			191, 235, 123, 237, 140, 251, 63, 108, 133, 236, 163, 5, 152, 204,
-			57, 177, 104, 82, 138, 217, 64, 102, 251, 33, 121, 131, 106, 152,
-			163, 162, 161, 53, 98, 42, 69, 28, 57, 60, 14, 211, 189, 35,
-			105, 117, 71, 58, 59, 80, 248, 98, 138, 79, 88, 33, 119, 1,
-			200, 93, 29, 149, 186, 67, 8, 201, 134, 121, 126, 21, 190, 233,
-			125, 76, 168, 24, 3, 194, 183, 181, 160, 129, 222, 46, 114, 185,
-			188, 236, 55, 2, 233, 159, 85, 206, 208, 24, 52, 113, 52, 67,
-			207, 191, 154, 158, 71, 81, 196, 149, 26, 42, 63, 106, 30, 199,
-			179, 125, 25, 99, 81, 47, 92, 66, 163, 98, 135, 40, 216, 123,
-			237, 103, 205, 40, 216, 123, 99, 81, 176, 247, 198, 162, 96, 239,
-			53, 162, 96, 255, 237, 29, 236, 226, 10, 144, 175, 181, 68, 135,
-			244, 106, 171, 84, 161, 127, 141, 173, 4, 227, 80, 1, 42, 82,
-			28, 220, 199, 69, 52, 9, 255, 83, 95, 194, 127, 75, 151, 72,
-			146, 42, 30, 24, 52, 115, 31, 224, 90, 201, 254, 153, 205, 246,
-			232, 200, 196, 148, 31, 150, 26, 149, 58, 28, 246, 40, 50, 213,
-			240, 193, 178, 144, 225, 38, 89, 202, 100, 152, 131, 39, 68, 25,
-			102, 162, 191, 51, 103, 49, 160, 71, 1, 168, 230, 102, 221, 167,
-			32, 211, 174, 179, 187, 243, 132, 97, 94, 64, 70, 71, 73, 65,
-			134, 169, 240, 239, 12, 24, 72, 120, 182, 4, 62, 18, 157, 28,
-			130, 215, 45, 235, 168, 201, 219, 88, 151, 158, 13, 197, 158, 238,
-			31, 185, 139, 26, 67, 79, 167, 233, 173, 132, 195, 41, 158, 128,
-			78, 199, 36, 38, 219, 76, 51, 95, 132, 102, 20, 204, 42, 80,
-			15, 140, 122, 137, 163, 254, 109, 12, 19, 221, 246, 55, 154, 195,
-			157, 132, 89, 175, 168, 198, 180, 145, 233, 141, 166, 251, 4, 235,
-			210, 93, 51, 253, 44, 241, 162, 191, 41, 9, 133, 127, 162, 231,
-			137, 216, 84, 146, 73, 20, 46, 216, 111, 179, 178, 47, 48, 167,
-			8, 80, 97, 160, 100, 181, 82, 243, 209, 103, 133, 56, 246, 75,
-			28, 241, 91, 126, 22, 62, 20, 196, 103, 176, 211, 29, 44, 70,
-			16, 45, 3, 98, 230, 0, 235, 42, 251, 213, 202, 90, 165, 233,
-			55, 84, 228, 79, 87, 100, 31, 99, 169, 203, 132, 53, 174, 102,
-			176, 188, 28, 250, 77, 234, 238, 20, 100, 9, 87, 19, 253, 80,
-			212, 181, 167, 64, 127, 103, 127, 222, 98, 233, 41, 248, 99, 165,
-			225, 173, 233, 6, 86, 212, 32, 115, 134, 117, 214, 65, 51, 84,
-			188, 170, 140, 167, 238, 149, 200, 171, 94, 249, 5, 241, 185, 160,
-			218, 185, 215, 88, 167, 172, 195, 137, 136, 112, 42, 130, 236, 45,
-			136, 2, 142, 19, 86, 94, 22, 244, 114, 10, 244, 55, 214, 85,
-			189, 176, 73, 252, 148, 46, 208, 223, 217, 127, 98, 179, 244, 172,
-			140, 63, 102, 46, 176, 110, 92, 243, 219, 198, 212, 186, 207, 238,
-			219, 194, 34, 74, 168, 21, 24, 182, 158, 23, 51, 7, 254, 19,
-			28, 45, 131, 187, 98, 224, 110, 81, 39, 66, 187, 208, 68, 178,
-			117, 20, 255, 133, 38, 162, 78, 52, 113, 89, 58, 196, 96, 29,
-			216, 166, 196, 193, 78, 65, 151, 161, 187, 211, 68, 254, 97, 132,
-			86, 183, 177, 192, 215, 59, 10, 244, 41, 115, 146, 165, 4, 91,
-			13, 119, 83, 163, 94, 217, 72, 172, 26, 52, 147, 159, 51, 99,
-			34, 134, 139, 196, 29, 238, 161, 166, 125, 109, 52, 135, 198, 186,
-			201, 229, 46, 214, 41, 55, 82, 246, 117, 65, 48, 129, 110, 30,
-			86, 19, 120, 95, 82, 202, 221, 121, 95, 20, 168, 93, 102, 156,
-			117, 202, 240, 2, 208, 7, 217, 116, 48, 234, 66, 16, 243, 98,
-			239, 168, 86, 238, 191, 176, 88, 82, 172, 205, 78, 28, 103, 82,
-			204, 222, 66, 177, 248, 154, 36, 30, 188, 38, 206, 214, 53, 105,
-			227, 138, 228, 35, 112, 197, 169, 9, 198, 34, 121, 149, 73, 195,
-			94, 157, 126, 103, 177, 191, 35, 195, 96, 39, 205, 204, 77, 22,
-			158, 239, 183, 50, 61, 176, 61, 38, 139, 147, 215, 10, 147, 207,
-			244, 219, 79, 127, 236, 71, 88, 103, 6, 4, 252, 7, 237, 251,
-			198, 191, 207, 255, 165, 141, 127, 247, 154, 241, 111, 252, 19, 212,
-			86, 87, 231, 73, 198, 153, 157, 132, 51, 104, 79, 71, 191, 229,
-			14, 200, 144, 129, 140, 114, 160, 192, 206, 115, 52, 160, 146, 104,
-			163, 244, 36, 251, 240, 232, 155, 36, 3, 170, 215, 238, 70, 141,
-			151, 20, 230, 75, 175, 157, 82, 37, 24, 172, 183, 139, 201, 134,
-			240, 105, 151, 8, 108, 36, 133, 162, 220, 101, 167, 85, 9, 26,
-			238, 234, 238, 145, 13, 161, 208, 103, 247, 201, 79, 216, 173, 207,
-			102, 170, 132, 223, 122, 119, 177, 151, 196, 97, 121, 168, 227, 29,
-			150, 235, 159, 162, 56, 187, 14, 24, 106, 134, 38, 119, 123, 30,
-			22, 161, 18, 170, 216, 248, 114, 11, 163, 185, 62, 157, 93, 43,
-			53, 225, 125, 71, 203, 8, 41, 200, 100, 215, 37, 204, 189, 197,
-			169, 175, 160, 139, 86, 7, 93, 149, 233, 56, 148, 222, 207, 254,
-			131, 206, 105, 58, 12, 182, 227, 87, 44, 102, 132, 159, 79, 162,
-			123, 22, 153, 151, 143, 96, 76, 31, 163, 94, 163, 50, 21, 0,
-			204, 52, 88, 119, 12, 125, 35, 100, 113, 98, 84, 6, 203, 229,
-			86, 179, 234, 99, 74, 46, 104, 51, 60, 245, 174, 83, 220, 121,
-			21, 173, 47, 143, 47, 16, 64, 132, 50, 137, 166, 80, 165, 172,
-			134, 208, 113, 107, 143, 11, 30, 158, 67, 88, 106, 30, 200, 6,
-			23, 162, 80, 193, 3, 76, 15, 105, 116, 224, 66, 135, 166, 173,
-			122, 216, 30, 58, 104, 216, 170, 135, 197, 170, 73, 91, 245, 112,
-			151, 105, 171, 30, 6, 91, 245, 187, 58, 37, 106, 20, 108, 213,
-			111, 72, 218, 196, 2, 168, 228, 138, 142, 83, 71, 45, 146, 242,
-			51, 183, 106, 21, 16, 16, 112, 10, 175, 160, 171, 161, 178, 188,
-			41, 150, 68, 135, 39, 97, 42, 146, 197, 195, 82, 80, 247, 181,
-			239, 186, 190, 133, 82, 52, 216, 95, 52, 157, 208, 40, 28, 181,
-			15, 155, 70, 225, 168, 166, 19, 242, 250, 104, 151, 105, 20, 142,
-			246, 239, 102, 111, 83, 249, 11, 57, 251, 160, 123, 122, 43, 145,
-			164, 48, 167, 141, 103, 18, 139, 27, 25, 8, 57, 123, 84, 29,
-			35, 236, 20, 66, 234, 49, 50, 16, 114, 58, 179, 3, 195, 152,
-			185, 253, 7, 216, 55, 44, 21, 212, 60, 111, 187, 238, 31, 180,
-			179, 237, 78, 35, 170, 229, 145, 134, 54, 216, 249, 252, 122, 177,
-			184, 0, 194, 142, 218, 143, 161, 220, 20, 20, 86, 238, 157, 53,
-			15, 4, 151, 119, 215, 171, 84, 41, 209, 5, 214, 19, 184, 127,
-			42, 88, 97, 42, 134, 40, 66, 197, 176, 194, 141, 205, 104, 147,
-			97, 84, 200, 19, 123, 22, 192, 208, 6, 240, 170, 97, 64, 67,
-			214, 235, 208, 81, 4, 37, 101, 172, 149, 169, 248, 12, 82, 141,
-			122, 153, 17, 213, 243, 118, 78, 49, 45, 198, 149, 207, 235, 197,
-			64, 81, 119, 190, 203, 140, 168, 158, 31, 222, 199, 254, 185, 165,
-			66, 170, 151, 236, 83, 238, 27, 219, 49, 237, 146, 23, 250, 70,
-			62, 228, 54, 244, 169, 5, 42, 6, 43, 78, 244, 228, 141, 219,
-			146, 134, 34, 228, 191, 180, 103, 48, 136, 239, 111, 224, 225, 138,
-			58, 86, 26, 204, 24, 2, 142, 47, 107, 149, 82, 67, 29, 228,
-			132, 110, 11, 149, 220, 80, 49, 230, 88, 56, 246, 146, 125, 94,
-			135, 99, 83, 56, 153, 253, 70, 56, 246, 210, 129, 227, 70, 56,
-			246, 210, 200, 40, 251, 41, 49, 109, 32, 208, 148, 125, 216, 253,
-			91, 56, 109, 143, 210, 94, 96, 137, 189, 198, 82, 165, 217, 64,
-			2, 131, 113, 59, 46, 54, 16, 152, 26, 152, 253, 16, 148, 42,
-			94, 51, 138, 62, 97, 180, 203, 76, 79, 145, 139, 173, 215, 26,
-			53, 19, 45, 53, 29, 224, 162, 166, 130, 166, 48, 177, 26, 1,
-			22, 14, 61, 61, 157, 36, 76, 103, 202, 190, 116, 74, 162, 156,
-			76, 33, 146, 106, 221, 146, 48, 157, 169, 33, 53, 85, 188, 216,
-			50, 117, 240, 16, 251, 176, 152, 14, 180, 124, 26, 246, 212, 223,
-			129, 233, 204, 160, 107, 179, 153, 147, 139, 34, 69, 7, 144, 31,
-			88, 234, 133, 160, 82, 35, 142, 90, 241, 41, 70, 6, 103, 36,
-			186, 129, 161, 82, 23, 128, 213, 26, 190, 72, 12, 20, 17, 54,
-			41, 187, 85, 54, 16, 29, 119, 219, 24, 29, 212, 240, 147, 66,
-			2, 61, 53, 126, 122, 252, 73, 20, 61, 79, 229, 209, 186, 87,
-			147, 74, 193, 164, 158, 182, 167, 14, 75, 196, 83, 73, 68, 85,
-			177, 102, 10, 38, 245, 116, 151, 218, 180, 41, 152, 212, 211, 176,
-			105, 179, 12, 23, 207, 153, 235, 120, 143, 229, 14, 113, 52, 37,
-			21, 2, 114, 191, 10, 173, 236, 160, 148, 153, 75, 247, 176, 139,
-			64, 3, 11, 211, 140, 22, 236, 119, 37, 220, 49, 218, 165, 149,
-			149, 86, 208, 194, 244, 39, 232, 76, 71, 12, 21, 139, 172, 192,
-			188, 213, 209, 1, 44, 19, 26, 218, 162, 204, 162, 5, 182, 139,
-			93, 101, 41, 44, 161, 158, 43, 56, 131, 238, 19, 98, 83, 64,
-			255, 147, 18, 150, 196, 32, 71, 169, 34, 116, 84, 46, 35, 13,
-			43, 20, 12, 148, 96, 1, 42, 38, 99, 88, 50, 157, 168, 224,
-			44, 244, 83, 178, 133, 37, 211, 137, 10, 50, 39, 198, 146, 233,
-			68, 133, 110, 227, 59, 208, 160, 176, 103, 128, 253, 51, 75, 98,
-			2, 223, 111, 57, 251, 220, 95, 82, 27, 84, 224, 162, 199, 146,
-			55, 68, 144, 7, 103, 164, 41, 37, 152, 217, 95, 171, 55, 55,
-			229, 87, 29, 160, 173, 145, 70, 192, 57, 84, 106, 45, 95, 219,
-			76, 53, 34, 49, 217, 167, 120, 120, 97, 212, 50, 167, 163, 183,
-			114, 76, 101, 192, 170, 204, 147, 114, 224, 139, 140, 25, 175, 124,
-			23, 21, 184, 204, 116, 177, 100, 158, 210, 45, 167, 48, 168, 167,
-			133, 234, 225, 150, 49, 109, 92, 186, 91, 50, 139, 202, 146, 121,
-			74, 183, 246, 14, 163, 57, 228, 80, 24, 230, 221, 182, 96, 117,
-			11, 73, 8, 37, 166, 74, 192, 234, 239, 238, 238, 83, 37, 0,
-			243, 238, 254, 65, 85, 2, 32, 239, 6, 209, 118, 12, 248, 199,
-			206, 56, 119, 58, 124, 203, 29, 230, 226, 148, 177, 61, 7, 33,
-			117, 239, 164, 119, 177, 107, 48, 44, 197, 106, 150, 192, 184, 185,
-			64, 132, 94, 218, 164, 100, 18, 20, 64, 138, 76, 18, 132, 148,
-			111, 203, 149, 6, 42, 7, 209, 76, 26, 94, 196, 243, 54, 173,
-			250, 146, 125, 167, 159, 240, 178, 105, 205, 151, 36, 207, 139, 16,
-			208, 146, 180, 33, 108, 194, 121, 9, 108, 136, 17, 194, 0, 62,
-			149, 237, 221, 238, 126, 129, 129, 137, 184, 76, 165, 208, 67, 32,
-			133, 203, 246, 210, 128, 4, 131, 244, 45, 75, 159, 140, 77, 212,
-			45, 203, 188, 20, 155, 104, 91, 238, 235, 103, 167, 25, 74, 65,
-			103, 181, 227, 199, 44, 247, 48, 87, 39, 170, 54, 194, 24, 86,
-			175, 131, 138, 99, 53, 221, 15, 251, 209, 113, 40, 238, 244, 2,
-			224, 54, 40, 36, 191, 58, 132, 153, 88, 37, 104, 226, 47, 216,
-			171, 66, 67, 39, 104, 226, 47, 72, 172, 68, 164, 234, 5, 137,
-			85, 130, 38, 254, 2, 96, 245, 126, 148, 96, 9, 220, 189, 117,
-			251, 71, 19, 110, 35, 198, 199, 196, 93, 92, 30, 175, 245, 152,
-			57, 51, 223, 136, 132, 155, 216, 136, 232, 181, 147, 153, 106, 155,
-			200, 188, 76, 166, 4, 181, 103, 22, 146, 73, 172, 128, 201, 228,
-			194, 4, 137, 128, 58, 219, 205, 60, 216, 120, 9, 33, 2, 90,
-			32, 2, 10, 98, 223, 209, 57, 44, 39, 212, 28, 110, 120, 82,
-			4, 232, 168, 203, 233, 35, 140, 38, 201, 114, 195, 91, 193, 132,
-			6, 197, 55, 56, 30, 51, 7, 196, 109, 146, 144, 210, 161, 229,
-			212, 69, 70, 92, 66, 74, 135, 150, 220, 38, 9, 41, 29, 90,
-			82, 58, 36, 164, 116, 104, 129, 116, 200, 75, 28, 225, 243, 134,
-			51, 0, 171, 73, 86, 77, 229, 101, 173, 124, 99, 83, 228, 122,
-			64, 228, 154, 13, 167, 53, 168, 1, 34, 223, 108, 24, 3, 34,
-			231, 108, 192, 246, 210, 101, 24, 112, 3, 216, 179, 40, 7, 4,
-			227, 237, 158, 147, 113, 167, 163, 4, 189, 166, 113, 214, 64, 223,
-			197, 150, 213, 82, 84, 192, 19, 157, 103, 18, 62, 66, 11, 13,
-			187, 123, 206, 198, 128, 30, 22, 245, 243, 61, 153, 24, 151, 144,
-			153, 131, 247, 192, 158, 212, 101, 64, 235, 30, 88, 148, 221, 196,
-			153, 240, 241, 125, 246, 144, 100, 65, 156, 226, 251, 128, 147, 36,
-			155, 97, 94, 237, 251, 236, 46, 85, 194, 166, 176, 198, 178, 4,
-			80, 222, 55, 48, 200, 62, 135, 233, 208, 78, 38, 245, 19, 86,
-			199, 175, 91, 150, 251, 73, 11, 142, 87, 147, 53, 74, 23, 188,
-			91, 41, 183, 188, 40, 3, 113, 83, 91, 62, 58, 205, 141, 4,
-			67, 11, 179, 145, 196, 25, 11, 12, 136, 90, 72, 153, 29, 168,
-			30, 181, 165, 6, 246, 93, 100, 110, 18, 231, 130, 233, 30, 174,
-			6, 45, 96, 225, 37, 63, 186, 151, 24, 9, 101, 106, 188, 209,
-			220, 54, 171, 55, 182, 89, 241, 180, 153, 112, 80, 99, 254, 132,
-			5, 187, 245, 117, 220, 80, 24, 212, 117, 94, 177, 236, 211, 238,
-			71, 98, 54, 175, 178, 208, 72, 172, 233, 44, 105, 37, 20, 213,
-			228, 148, 216, 11, 101, 60, 145, 174, 51, 110, 103, 28, 102, 181,
-			233, 150, 21, 214, 67, 24, 84, 239, 74, 227, 33, 178, 234, 162,
-			108, 108, 149, 10, 115, 69, 15, 216, 7, 43, 225, 224, 70, 72,
-			1, 182, 63, 97, 101, 104, 21, 29, 20, 243, 136, 190, 171, 138,
-			22, 22, 247, 159, 80, 197, 4, 22, 71, 79, 9, 243, 30, 165,
-			184, 243, 51, 208, 216, 253, 146, 156, 171, 204, 117, 150, 137, 190,
-			198, 201, 105, 97, 187, 99, 170, 58, 136, 233, 19, 147, 56, 137,
-			137, 89, 67, 81, 57, 226, 184, 135, 150, 169, 88, 113, 84, 148,
-			13, 95, 157, 171, 165, 95, 136, 209, 233, 85, 94, 165, 141, 40,
-			37, 143, 178, 242, 160, 160, 142, 123, 112, 18, 171, 172, 212, 80,
-			60, 181, 192, 104, 90, 146, 246, 74, 21, 79, 12, 65, 3, 243,
-			138, 72, 169, 11, 242, 0, 79, 167, 96, 130, 175, 88, 167, 37,
-			1, 96, 219, 226, 140, 211, 170, 72, 4, 0, 19, 95, 22, 19,
-			88, 4, 69, 248, 71, 130, 60, 160, 11, 63, 138, 228, 249, 194,
-			253, 200, 131, 22, 140, 204, 229, 223, 134, 60, 237, 180, 145, 164,
-			192, 253, 172, 50, 115, 99, 115, 199, 179, 166, 36, 54, 90, 17,
-			2, 48, 227, 120, 6, 125, 104, 66, 104, 58, 196, 206, 190, 202,
-			228, 22, 148, 1, 201, 145, 130, 185, 253, 140, 165, 56, 5, 36,
-			7, 78, 86, 81, 6, 89, 227, 163, 17, 101, 64, 110, 64, 17,
-			40, 243, 251, 54, 81, 6, 138, 191, 108, 217, 67, 238, 231, 108,
-			185, 73, 218, 76, 156, 152, 170, 87, 155, 142, 4, 129, 216, 165,
-			6, 119, 16, 169, 96, 191, 94, 136, 121, 95, 132, 201, 101, 102,
-			231, 11, 88, 82, 97, 149, 169, 65, 158, 207, 202, 102, 149, 18,
-			165, 56, 131, 209, 45, 2, 120, 96, 93, 163, 142, 193, 11, 198,
-			100, 12, 196, 129, 155, 38, 202, 86, 163, 68, 18, 76, 143, 36,
-			14, 140, 90, 239, 199, 65, 197, 80, 140, 36, 116, 81, 131, 212,
-			210, 28, 83, 105, 169, 181, 192, 80, 160, 39, 87, 3, 142, 161,
-			41, 160, 231, 71, 245, 106, 192, 65, 20, 9, 172, 86, 3, 12,
-			10, 40, 118, 237, 86, 69, 34, 63, 8, 224, 38, 46, 70, 186,
-			35, 147, 250, 164, 101, 127, 214, 74, 184, 101, 177, 26, 138, 224,
-			18, 45, 125, 131, 65, 96, 133, 202, 159, 220, 128, 48, 133, 122,
-			80, 111, 137, 244, 10, 202, 95, 199, 99, 59, 195, 228, 193, 210,
-			170, 18, 92, 176, 15, 239, 24, 209, 155, 59, 242, 124, 2, 163,
-			2, 78, 159, 180, 210, 125, 108, 156, 56, 2, 180, 199, 167, 44,
-			103, 143, 123, 68, 156, 59, 4, 227, 94, 160, 5, 10, 85, 174,
-			53, 138, 101, 105, 242, 56, 120, 232, 196, 30, 106, 138, 40, 134,
-			63, 101, 129, 170, 146, 197, 4, 22, 251, 51, 44, 71, 208, 129,
-			28, 191, 98, 57, 123, 221, 67, 113, 171, 244, 130, 72, 147, 13,
-			125, 50, 27, 52, 104, 56, 0, 98, 115, 166, 138, 22, 22, 187,
-			21, 245, 224, 8, 8, 197, 129, 33, 176, 235, 16, 52, 180, 253,
-			53, 203, 217, 239, 30, 108, 183, 236, 46, 232, 138, 80, 67, 78,
-			137, 214, 61, 170, 104, 97, 177, 87, 237, 18, 56, 135, 65, 113,
-			216, 101, 127, 98, 51, 204, 113, 78, 253, 182, 133, 142, 95, 247,
-			223, 216, 194, 239, 56, 163, 239, 79, 212, 212, 93, 138, 26, 186,
-			41, 224, 4, 221, 28, 195, 107, 240, 82, 83, 80, 138, 188, 16,
-			33, 177, 244, 113, 148, 8, 116, 217, 132, 250, 162, 191, 111, 197,
-			175, 129, 113, 208, 20, 254, 94, 92, 49, 113, 83, 4, 19, 74,
-			219, 14, 169, 228, 231, 170, 105, 151, 72, 123, 86, 58, 208, 144,
-			132, 145, 72, 67, 85, 167, 64, 45, 193, 193, 50, 3, 46, 202,
-			71, 22, 29, 114, 73, 93, 58, 63, 79, 146, 212, 169, 148, 132,
-			190, 215, 137, 12, 40, 24, 197, 8, 57, 233, 107, 147, 39, 35,
-			208, 111, 180, 189, 97, 234, 228, 136, 35, 224, 168, 25, 197, 210,
-			198, 207, 14, 219, 33, 188, 84, 13, 150, 164, 246, 198, 181, 253,
-			109, 212, 222, 95, 66, 145, 141, 9, 84, 206, 239, 89, 246, 97,
-			247, 55, 165, 200, 222, 38, 120, 17, 169, 85, 3, 100, 187, 232,
-			86, 59, 27, 23, 67, 123, 106, 164, 94, 218, 14, 166, 122, 75,
-			193, 147, 174, 12, 244, 100, 48, 142, 57, 242, 91, 156, 201, 56,
-			170, 114, 95, 225, 178, 149, 131, 245, 26, 94, 152, 80, 199, 97,
-			26, 88, 138, 134, 36, 105, 120, 152, 209, 111, 75, 13, 159, 36,
-			13, 15, 21, 131, 170, 104, 97, 113, 200, 85, 197, 4, 22, 15,
-			30, 98, 255, 144, 232, 1, 199, 131, 212, 87, 45, 251, 255, 128,
-			108, 248, 105, 160, 136, 88, 76, 177, 222, 48, 92, 85, 205, 210,
-			48, 209, 252, 232, 150, 15, 94, 232, 8, 200, 235, 178, 28, 99,
-			16, 169, 184, 114, 220, 247, 64, 78, 148, 130, 134, 184, 136, 86,
-			150, 15, 56, 120, 204, 56, 18, 243, 176, 6, 124, 178, 26, 208,
-			204, 183, 58, 184, 196, 22, 77, 226, 25, 194, 249, 170, 5, 83,
-			254, 113, 60, 190, 39, 233, 20, 225, 124, 221, 114, 134, 220, 151,
-			216, 78, 167, 74, 95, 38, 147, 27, 171, 40, 7, 40, 248, 128,
-			84, 121, 102, 94, 106, 28, 121, 124, 97, 17, 59, 111, 193, 153,
-			216, 65, 169, 163, 221, 96, 44, 39, 197, 41, 35, 5, 56, 124,
-			213, 218, 77, 6, 119, 82, 156, 51, 16, 45, 22, 85, 88, 88,
-			209, 109, 180, 72, 96, 5, 26, 199, 182, 156, 9, 180, 248, 150,
-			229, 12, 187, 111, 60, 178, 174, 124, 211, 84, 163, 80, 57, 164,
-			31, 255, 234, 168, 70, 181, 12, 104, 196, 1, 1, 191, 110, 13,
-			105, 34, 163, 25, 247, 45, 115, 25, 44, 34, 114, 247, 158, 168,
-			34, 129, 21, 67, 123, 217, 63, 86, 12, 5, 198, 220, 119, 44,
-			231, 128, 251, 11, 134, 199, 86, 160, 40, 51, 9, 233, 145, 21,
-			228, 142, 40, 182, 115, 159, 192, 3, 108, 92, 229, 180, 68, 57,
-			22, 133, 30, 180, 173, 174, 185, 77, 90, 97, 158, 20, 0, 76,
-			251, 52, 149, 229, 103, 68, 109, 212, 164, 209, 62, 3, 116, 191,
-			101, 13, 235, 41, 161, 18, 252, 142, 57, 105, 228, 172, 239, 88,
-			221, 123, 163, 138, 4, 86, 184, 251, 217, 171, 106, 210, 80, 241,
-			61, 156, 244, 251, 229, 164, 205, 83, 140, 58, 126, 235, 51, 218,
-			155, 61, 93, 178, 201, 245, 70, 87, 51, 67, 91, 7, 112, 250,
-			142, 117, 64, 227, 141, 214, 206, 247, 204, 153, 161, 189, 243, 61,
-			115, 102, 9, 154, 8, 204, 236, 191, 168, 153, 129, 189, 241, 191,
-			45, 103, 204, 253, 227, 135, 153, 89, 14, 57, 215, 112, 144, 135,
-			230, 252, 98, 71, 181, 40, 36, 8, 130, 222, 60, 165, 73, 187,
-			201, 152, 59, 137, 20, 61, 253, 232, 212, 102, 140, 30, 51, 217,
-			119, 34, 33, 219, 134, 134, 75, 226, 121, 35, 131, 108, 120, 206,
-			134, 9, 127, 207, 32, 27, 90, 80, 80, 101, 84, 88, 88, 113,
-			112, 36, 170, 72, 96, 197, 233, 28, 251, 99, 155, 180, 35, 124,
-			255, 73, 219, 62, 232, 254, 107, 27, 131, 94, 145, 68, 247, 194,
-			146, 79, 178, 112, 140, 142, 14, 128, 172, 64, 67, 90, 142, 97,
-			148, 213, 69, 33, 24, 41, 210, 69, 87, 80, 115, 219, 232, 104,
-			36, 240, 115, 234, 244, 129, 39, 88, 177, 44, 113, 176, 194, 217,
-			158, 21, 171, 150, 205, 241, 172, 25, 222, 207, 230, 24, 207, 154,
-			193, 252, 172, 48, 31, 178, 70, 244, 94, 46, 75, 168, 61, 245,
-			122, 34, 74, 153, 45, 55, 132, 180, 221, 220, 58, 186, 242, 147,
-			149, 97, 204, 154, 95, 190, 136, 217, 192, 184, 86, 117, 197, 11,
-			218, 150, 194, 88, 97, 80, 162, 192, 75, 192, 75, 171, 1, 112,
-			17, 176, 183, 6, 173, 180, 24, 185, 80, 128, 190, 186, 152, 194,
-			98, 119, 191, 42, 18, 245, 119, 15, 171, 98, 2, 139, 251, 15,
-			232, 148, 175, 255, 248, 1, 139, 49, 160, 96, 216, 246, 240, 129,
-			249, 26, 130, 251, 195, 36, 133, 61, 248, 9, 133, 223, 5, 12,
-			174, 249, 205, 130, 184, 96, 142, 87, 231, 160, 254, 5, 191, 212,
-			148, 105, 69, 170, 136, 185, 55, 117, 175, 185, 170, 210, 188, 240,
-			111, 204, 220, 33, 76, 101, 66, 142, 40, 68, 249, 60, 14, 93,
-			190, 147, 249, 60, 7, 25, 67, 133, 98, 60, 28, 144, 44, 116,
-			97, 13, 61, 26, 128, 239, 25, 224, 227, 2, 226, 107, 138, 190,
-			166, 161, 66, 124, 60, 206, 118, 213, 130, 218, 237, 200, 76, 165,
-			20, 172, 116, 161, 23, 106, 163, 8, 70, 246, 6, 235, 46, 122,
-			149, 234, 155, 56, 151, 236, 39, 44, 214, 77, 228, 17, 247, 238,
-			239, 3, 51, 167, 250, 139, 12, 168, 161, 237, 31, 125, 80, 52,
-			82, 121, 55, 137, 135, 204, 187, 57, 202, 28, 228, 19, 32, 105,
-			194, 72, 245, 81, 194, 163, 64, 31, 179, 63, 155, 100, 61, 55,
-			48, 148, 246, 38, 175, 38, 113, 138, 124, 14, 66, 20, 40, 233,
-			15, 83, 153, 146, 50, 233, 15, 115, 151, 14, 179, 238, 53, 111,
-			227, 54, 72, 141, 86, 181, 25, 202, 69, 100, 80, 85, 16, 53,
-			91, 50, 252, 216, 214, 12, 191, 171, 241, 196, 65, 145, 4, 117,
-			92, 209, 210, 156, 156, 145, 70, 120, 181, 82, 109, 250, 141, 88,
-			50, 225, 4, 75, 214, 252, 117, 191, 33, 115, 163, 238, 151, 37,
-			40, 26, 98, 143, 0, 31, 243, 25, 238, 125, 112, 15, 106, 184,
-			245, 169, 145, 93, 219, 60, 53, 114, 86, 38, 30, 246, 209, 194,
-			29, 218, 118, 38, 237, 41, 135, 143, 233, 55, 64, 250, 41, 113,
-			242, 192, 246, 189, 26, 100, 204, 233, 23, 66, 46, 178, 254, 118,
-			146, 100, 78, 154, 57, 130, 219, 102, 96, 138, 239, 63, 120, 246,
-			226, 49, 214, 41, 17, 193, 228, 168, 203, 243, 197, 235, 253, 29,
-			153, 78, 150, 120, 126, 122, 177, 223, 202, 164, 152, 61, 55, 223,
-			111, 103, 95, 181, 89, 175, 68, 254, 129, 27, 233, 113, 214, 41,
-			173, 37, 153, 98, 214, 62, 125, 1, 65, 78, 162, 160, 26, 107,
-			150, 76, 68, 44, 233, 126, 24, 108, 7, 209, 78, 115, 188, 101,
-			112, 252, 95, 236, 158, 5, 137, 135, 255, 189, 29, 109, 159, 30,
-			204, 197, 12, 75, 116, 9, 56, 251, 95, 65, 180, 204, 130, 170,
-			121, 240, 102, 5, 217, 136, 232, 222, 70, 63, 178, 92, 129, 52,
-			86, 92, 134, 242, 14, 187, 86, 17, 195, 137, 239, 79, 185, 183,
-			240, 66, 183, 124, 95, 70, 110, 154, 121, 168, 65, 49, 43, 237,
-			252, 219, 146, 255, 82, 66, 204, 202, 218, 5, 170, 52, 18, 249,
-			58, 105, 139, 171, 68, 190, 182, 253, 159, 110, 223, 255, 217, 255,
-			107, 179, 30, 49, 227, 7, 50, 193, 125, 167, 188, 205, 74, 103,
-			158, 98, 140, 14, 175, 53, 180, 182, 164, 152, 212, 187, 205, 28,
-			52, 127, 69, 53, 43, 24, 61, 220, 111, 90, 172, 75, 127, 209,
-			57, 205, 150, 145, 211, 252, 4, 236, 100, 148, 73, 54, 109, 165,
-			163, 247, 135, 157, 167, 205, 69, 29, 34, 46, 75, 60, 10, 151,
-			57, 15, 199, 101, 217, 81, 230, 168, 212, 196, 133, 73, 218, 125,
-			12, 120, 190, 88, 152, 158, 124, 6, 54, 96, 55, 235, 92, 40,
-			204, 63, 61, 125, 165, 216, 111, 159, 253, 178, 197, 28, 76, 52,
-			131, 49, 18, 215, 48, 215, 87, 97, 18, 25, 0, 238, 158, 88,
-			157, 92, 167, 9, 24, 3, 20, 107, 70, 127, 52, 212, 236, 246,
-			61, 30, 99, 73, 218, 173, 153, 129, 237, 100, 151, 59, 184, 237,
-			150, 206, 156, 193, 212, 102, 216, 10, 123, 226, 196, 21, 125, 6,
-			182, 163, 248, 211, 191, 208, 18, 217, 150, 223, 186, 127, 182, 229,
-			95, 137, 215, 134, 6, 162, 108, 203, 41, 250, 19, 26, 176, 206,
-			17, 246, 59, 182, 72, 104, 220, 211, 113, 217, 114, 63, 107, 243,
-			104, 185, 212, 193, 91, 190, 15, 36, 159, 5, 194, 215, 51, 85,
-			0, 23, 206, 33, 13, 236, 128, 215, 95, 232, 49, 33, 157, 254,
-			160, 123, 197, 29, 42, 254, 6, 144, 55, 196, 251, 21, 34, 67,
-			206, 24, 76, 100, 29, 182, 74, 37, 223, 167, 235, 127, 43, 94,
-			163, 76, 183, 66, 128, 86, 235, 171, 190, 190, 76, 30, 135, 75,
-			15, 123, 210, 147, 33, 58, 21, 6, 113, 152, 11, 154, 126, 236,
-			28, 46, 208, 163, 43, 229, 13, 31, 230, 80, 227, 203, 168, 152,
-			17, 134, 188, 119, 18, 193, 45, 139, 152, 147, 184, 192, 206, 20,
-			224, 74, 181, 210, 220, 228, 234, 233, 82, 76, 198, 1, 211, 2,
-			31, 168, 168, 212, 98, 79, 37, 237, 73, 103, 88, 94, 229, 104,
-			14, 218, 131, 232, 193, 54, 136, 40, 101, 144, 136, 210, 9, 93,
-			107, 102, 60, 14, 218, 123, 6, 140, 140, 199, 193, 88, 198, 227,
-			96, 87, 191, 145, 241, 56, 8, 45, 127, 197, 86, 25, 143, 135,
-			236, 140, 251, 154, 77, 67, 161, 40, 219, 234, 27, 195, 17, 87,
-			252, 40, 182, 136, 108, 36, 239, 232, 227, 65, 71, 101, 78, 201,
-			198, 2, 134, 160, 248, 226, 245, 201, 179, 231, 31, 71, 175, 202,
-			170, 112, 221, 169, 140, 58, 163, 45, 130, 93, 12, 224, 200, 220,
-			106, 34, 161, 240, 28, 136, 180, 134, 147, 80, 25, 190, 135, 161,
-			112, 29, 19, 199, 122, 194, 123, 41, 199, 163, 129, 154, 148, 105,
-			87, 34, 255, 85, 8, 80, 152, 90, 3, 244, 95, 138, 139, 166,
-			228, 232, 217, 36, 172, 131, 58, 145, 15, 193, 42, 152, 136, 38,
-			225, 135, 73, 172, 190, 87, 22, 89, 7, 176, 210, 112, 34, 189,
-			235, 139, 85, 22, 143, 235, 86, 154, 177, 235, 52, 135, 236, 193,
-			65, 35, 115, 242, 80, 44, 115, 242, 80, 44, 115, 242, 80, 255,
-			110, 54, 163, 50, 39, 143, 216, 187, 221, 39, 163, 224, 187, 92,
-			202, 109, 31, 180, 193, 140, 29, 245, 4, 151, 224, 61, 191, 28,
-			123, 204, 233, 136, 125, 40, 99, 60, 230, 116, 68, 36, 49, 203,
-			84, 202, 35, 157, 61, 70, 42, 229, 145, 190, 126, 153, 189, 137,
-			47, 210, 192, 146, 159, 150, 46, 214, 10, 57, 71, 77, 39, 188,
-			112, 69, 5, 154, 8, 177, 28, 197, 99, 246, 145, 221, 70, 142,
-			226, 49, 125, 139, 136, 94, 190, 209, 183, 136, 232, 221, 27, 152,
-			246, 127, 178, 85, 142, 98, 206, 222, 235, 126, 93, 176, 25, 232,
-			222, 202, 26, 136, 187, 200, 237, 136, 7, 174, 80, 142, 9, 211,
-			204, 171, 167, 107, 68, 122, 134, 56, 14, 171, 116, 74, 220, 177,
-			204, 216, 66, 216, 141, 82, 169, 68, 34, 148, 249, 166, 142, 164,
-			170, 184, 13, 166, 233, 39, 35, 228, 96, 90, 200, 218, 80, 191,
-			24, 68, 157, 64, 252, 170, 167, 141, 9, 6, 165, 51, 43, 25,
-			65, 216, 160, 196, 97, 74, 28, 192, 0, 85, 31, 211, 38, 240,
-			58, 24, 210, 113, 4, 104, 86, 195, 107, 129, 208, 242, 110, 37,
-			168, 234, 87, 110, 40, 189, 35, 66, 124, 84, 92, 62, 11, 49,
-			130, 81, 219, 68, 239, 79, 69, 190, 213, 38, 134, 13, 213, 173,
-			96, 245, 14, 133, 191, 129, 34, 78, 68, 104, 69, 204, 95, 66,
-			138, 165, 83, 230, 236, 99, 25, 227, 117, 155, 156, 94, 33, 76,
-			167, 204, 165, 51, 70, 58, 101, 110, 112, 136, 253, 61, 91, 165,
-			83, 158, 179, 135, 220, 191, 185, 211, 10, 145, 200, 36, 111, 118,
-			24, 151, 64, 58, 217, 71, 7, 152, 197, 162, 213, 2, 121, 133,
-			219, 88, 41, 237, 28, 17, 75, 153, 143, 247, 101, 216, 57, 186,
-			206, 167, 193, 84, 141, 199, 163, 20, 4, 189, 156, 145, 72, 138,
-			30, 252, 0, 13, 231, 99, 224, 176, 186, 93, 130, 125, 168, 201,
-			73, 141, 72, 188, 212, 54, 205, 249, 197, 178, 57, 207, 217, 185,
-			189, 42, 99, 147, 104, 212, 105, 100, 115, 158, 75, 239, 54, 178,
-			57, 207, 13, 12, 178, 247, 59, 42, 155, 115, 210, 118, 221, 239,
-			37, 98, 207, 96, 209, 3, 187, 40, 209, 73, 245, 40, 185, 174,
-			185, 158, 56, 222, 136, 121, 69, 232, 240, 73, 30, 171, 23, 29,
-			71, 202, 254, 178, 7, 22, 236, 168, 204, 160, 106, 82, 0, 78,
-			189, 153, 25, 26, 185, 250, 45, 65, 111, 198, 69, 220, 15, 217,
-			46, 108, 6, 117, 228, 81, 227, 177, 21, 159, 242, 117, 141, 88,
-			28, 190, 244, 221, 170, 137, 151, 240, 132, 68, 111, 136, 124, 116,
-			224, 216, 138, 144, 204, 166, 204, 192, 247, 154, 230, 76, 207, 135,
-			198, 148, 240, 107, 248, 107, 193, 93, 249, 50, 153, 215, 108, 162,
-			130, 212, 239, 204, 224, 28, 241, 126, 165, 47, 46, 43, 231, 120,
-			232, 109, 182, 107, 33, 228, 35, 180, 1, 0, 199, 11, 140, 191,
-			235, 92, 142, 63, 150, 227, 143, 231, 248, 19, 239, 217, 137, 64,
-			148, 225, 37, 166, 124, 78, 225, 128, 132, 190, 32, 122, 191, 39,
-			71, 84, 168, 227, 60, 150, 252, 146, 7, 220, 195, 248, 121, 74,
-			5, 23, 179, 195, 9, 109, 89, 147, 216, 140, 104, 217, 76, 84,
-			98, 73, 179, 147, 246, 185, 33, 35, 105, 118, 82, 139, 103, 76,
-			154, 157, 236, 28, 52, 146, 102, 39, 135, 247, 177, 159, 183, 68,
-			214, 236, 213, 142, 155, 150, 251, 138, 197, 13, 107, 246, 33, 13,
-			42, 236, 17, 89, 84, 232, 242, 149, 2, 142, 69, 201, 93, 34,
-			218, 0, 186, 116, 165, 130, 98, 202, 12, 234, 10, 9, 106, 102,
-			128, 71, 219, 92, 101, 235, 94, 77, 239, 33, 35, 133, 82, 60,
-			175, 63, 188, 145, 66, 89, 160, 206, 117, 251, 170, 78, 245, 76,
-			98, 255, 180, 145, 6, 122, 93, 26, 41, 34, 13, 244, 186, 50,
-			82, 44, 84, 102, 55, 222, 50, 82, 30, 205, 72, 177, 200, 72,
-			185, 97, 95, 87, 244, 70, 35, 229, 134, 166, 55, 46, 229, 13,
-			105, 164, 88, 100, 164, 220, 144, 70, 138, 133, 70, 74, 241, 77,
-			49, 82, 44, 50, 82, 138, 246, 141, 140, 28, 7, 181, 76, 81,
-			238, 2, 139, 140, 148, 162, 52, 82, 44, 50, 82, 138, 96, 164,
-			204, 137, 212, 223, 119, 118, 84, 45, 247, 50, 55, 14, 111, 209,
-			30, 136, 61, 54, 250, 160, 83, 133, 74, 18, 126, 39, 48, 238,
-			180, 74, 18, 190, 5, 140, 251, 54, 124, 245, 140, 152, 53, 122,
-			131, 148, 138, 116, 249, 70, 205, 52, 148, 228, 93, 242, 233, 161,
-			238, 102, 32, 231, 38, 82, 132, 111, 217, 239, 28, 52, 82, 132,
-			111, 197, 82, 132, 111, 117, 245, 27, 41, 194, 183, 128, 159, 191,
-			110, 169, 28, 225, 59, 246, 126, 247, 11, 86, 123, 90, 133, 113,
-			13, 76, 200, 104, 41, 207, 121, 252, 37, 84, 220, 216, 82, 227,
-			69, 103, 15, 56, 248, 133, 248, 64, 190, 204, 223, 146, 31, 78,
-			98, 74, 24, 65, 81, 81, 35, 92, 81, 245, 132, 40, 19, 15,
-			191, 201, 143, 72, 6, 153, 16, 234, 139, 94, 208, 219, 204, 86,
-			136, 178, 117, 49, 90, 177, 20, 133, 95, 53, 77, 144, 231, 238,
-			216, 183, 20, 77, 48, 117, 243, 142, 221, 111, 228, 52, 223, 217,
-			61, 100, 228, 52, 223, 217, 231, 178, 255, 41, 104, 2, 76, 183,
-			106, 31, 119, 255, 179, 160, 137, 191, 81, 7, 2, 192, 172, 182,
-			203, 112, 208, 174, 79, 253, 188, 11, 254, 154, 133, 252, 121, 136,
-			167, 23, 231, 231, 132, 117, 216, 90, 171, 43, 213, 34, 15, 150,
-			209, 153, 145, 114, 250, 98, 51, 55, 95, 153, 84, 70, 134, 206,
-			73, 186, 200, 196, 107, 102, 235, 149, 80, 146, 39, 164, 71, 205,
-			192, 158, 43, 71, 79, 215, 170, 110, 234, 125, 48, 25, 188, 137,
-			48, 167, 33, 89, 236, 6, 140, 77, 91, 100, 213, 190, 179, 95,
-			146, 5, 77, 135, 85, 91, 151, 48, 127, 251, 0, 87, 37, 32,
-			217, 234, 209, 99, 236, 175, 49, 145, 8, 151, 120, 209, 62, 234,
-			158, 165, 123, 132, 198, 115, 59, 2, 61, 10, 114, 41, 9, 81,
-			110, 51, 111, 4, 56, 48, 231, 1, 130, 46, 193, 192, 47, 118,
-			239, 83, 37, 24, 248, 69, 247, 144, 42, 225, 88, 71, 178, 236,
-			6, 229, 159, 39, 131, 142, 207, 91, 150, 59, 197, 77, 223, 201,
-			67, 106, 40, 234, 210, 190, 61, 113, 176, 32, 61, 192, 206, 97,
-			46, 48, 122, 20, 94, 178, 55, 18, 238, 113, 46, 61, 184, 241,
-			183, 132, 154, 178, 82, 220, 221, 146, 169, 223, 116, 126, 126, 169,
-			115, 23, 236, 240, 20, 150, 112, 143, 135, 78, 175, 251, 56, 191,
-			12, 11, 23, 61, 239, 128, 187, 74, 191, 239, 32, 61, 130, 91,
-			109, 74, 153, 76, 45, 207, 206, 161, 202, 98, 118, 196, 229, 208,
-			176, 187, 7, 78, 82, 98, 24, 76, 238, 118, 122, 220, 81, 142,
-			142, 202, 104, 152, 135, 128, 108, 81, 215, 206, 168, 12, 144, 91,
-			172, 91, 67, 134, 226, 186, 211, 173, 32, 63, 10, 206, 136, 212,
-			186, 147, 138, 202, 8, 170, 139, 145, 214, 166, 59, 0, 247, 30,
-			94, 107, 139, 251, 0, 247, 96, 57, 140, 251, 0, 247, 164, 148,
-			19, 247, 1, 238, 73, 41, 39, 238, 3, 220, 3, 41, 247, 239,
-			211, 50, 167, 219, 249, 105, 152, 149, 251, 251, 105, 26, 75, 92,
-			166, 170, 123, 152, 98, 133, 55, 108, 100, 88, 148, 20, 164, 188,
-			77, 134, 233, 90, 168, 86, 195, 214, 82, 216, 172, 52, 241, 169,
-			26, 48, 83, 170, 193, 18, 31, 201, 158, 202, 142, 18, 39, 25,
-			113, 126, 236, 202, 184, 118, 150, 162, 10, 165, 92, 194, 28, 25,
-			244, 202, 44, 192, 58, 202, 35, 244, 21, 131, 174, 193, 89, 68,
-			218, 174, 146, 69, 95, 106, 193, 94, 94, 166, 252, 223, 184, 251,
-			8, 21, 180, 60, 215, 200, 119, 158, 162, 209, 105, 177, 233, 184,
-			166, 54, 52, 166, 181, 181, 106, 75, 168, 178, 233, 162, 89, 181,
-			92, 194, 199, 235, 113, 74, 112, 226, 193, 159, 209, 145, 47, 91,
-			73, 140, 149, 197, 37, 13, 239, 165, 64, 189, 139, 88, 143, 242,
-			110, 73, 25, 9, 218, 69, 110, 225, 60, 207, 158, 58, 149, 213,
-			211, 242, 132, 117, 45, 167, 101, 52, 139, 68, 159, 58, 2, 8,
-			122, 11, 120, 250, 246, 143, 204, 132, 167, 123, 26, 248, 48, 151,
-			143, 171, 132, 146, 118, 36, 123, 58, 59, 106, 156, 120, 129, 225,
-			154, 226, 213, 121, 58, 157, 46, 75, 181, 73, 200, 194, 254, 71,
-			164, 140, 39, 167, 194, 11, 152, 69, 51, 198, 167, 233, 30, 211,
-			72, 54, 59, 26, 51, 155, 61, 253, 70, 117, 152, 23, 13, 79,
-			157, 26, 63, 61, 126, 234, 212, 3, 90, 45, 7, 193, 248, 146,
-			215, 184, 79, 195, 232, 217, 238, 172, 108, 156, 213, 119, 108, 219,
-			64, 192, 128, 75, 222, 203, 59, 2, 162, 68, 165, 154, 190, 45,
-			18, 3, 41, 82, 132, 218, 150, 170, 204, 179, 0, 47, 203, 71,
-			252, 252, 74, 62, 167, 27, 143, 191, 212, 218, 24, 175, 6, 85,
-			49, 92, 118, 52, 142, 198, 253, 38, 173, 158, 174, 185, 207, 76,
-			30, 52, 9, 9, 161, 185, 30, 140, 69, 172, 39, 241, 94, 95,
-			13, 240, 212, 131, 255, 136, 84, 40, 125, 138, 199, 1, 179, 52,
-			47, 106, 35, 24, 17, 235, 113, 2, 113, 58, 238, 60, 114, 188,
-			167, 154, 130, 90, 238, 241, 7, 173, 96, 12, 99, 122, 239, 147,
-			228, 156, 188, 36, 146, 4, 241, 114, 111, 80, 93, 26, 73, 146,
-			184, 73, 171, 34, 73, 31, 153, 196, 75, 247, 68, 160, 8, 22,
-			110, 153, 68, 19, 216, 151, 63, 103, 129, 137, 251, 172, 105, 226,
-			10, 79, 144, 182, 112, 37, 38, 39, 101, 198, 100, 187, 137, 171,
-			77, 113, 216, 184, 47, 80, 178, 50, 202, 138, 5, 113, 86, 232,
-			163, 65, 49, 115, 10, 134, 249, 105, 153, 48, 153, 160, 188, 41,
-			168, 72, 169, 162, 133, 197, 206, 30, 85, 76, 96, 17, 44, 224,
-			159, 164, 251, 84, 88, 252, 8, 34, 249, 114, 132, 36, 157, 81,
-			195, 54, 103, 182, 64, 77, 156, 49, 52, 118, 244, 115, 99, 91,
-			76, 20, 166, 30, 243, 213, 168, 151, 125, 163, 25, 218, 78, 145,
-			84, 12, 245, 68, 48, 81, 10, 80, 249, 57, 107, 183, 68, 21,
-			211, 164, 62, 18, 77, 4, 147, 164, 62, 18, 77, 36, 65, 152,
-			195, 68, 126, 140, 230, 1, 10, 228, 99, 168, 7, 234, 124, 206,
-			223, 104, 230, 80, 108, 160, 208, 161, 219, 141, 185, 173, 47, 122,
-			3, 141, 133, 120, 146, 87, 107, 212, 85, 71, 37, 44, 201, 112,
-			96, 198, 51, 231, 192, 201, 119, 43, 120, 54, 23, 221, 170, 254,
-			50, 218, 62, 203, 26, 123, 204, 87, 2, 4, 62, 162, 177, 119,
-			146, 132, 145, 98, 21, 60, 114, 127, 44, 98, 21, 204, 84, 250,
-			24, 178, 202, 31, 137, 101, 128, 198, 175, 89, 246, 48, 90, 235,
-			207, 232, 152, 161, 178, 118, 182, 250, 201, 4, 18, 74, 77, 71,
-			30, 77, 33, 138, 227, 16, 180, 135, 11, 132, 51, 158, 83, 233,
-			172, 167, 52, 187, 34, 76, 57, 207, 175, 7, 235, 96, 196, 53,
-			114, 66, 57, 232, 53, 22, 131, 72, 47, 155, 126, 246, 127, 9,
-			133, 253, 146, 82, 231, 59, 132, 28, 4, 101, 146, 64, 25, 152,
-			219, 199, 52, 131, 38, 197, 100, 59, 85, 209, 194, 98, 122, 143,
-			42, 38, 176, 56, 180, 151, 173, 18, 97, 82, 25, 231, 19, 22,
-			28, 99, 110, 169, 187, 64, 24, 239, 107, 95, 94, 245, 147, 106,
-			161, 73, 146, 45, 98, 58, 114, 80, 181, 93, 139, 18, 120, 166,
-			0, 79, 24, 234, 53, 107, 88, 98, 146, 74, 210, 216, 106, 5,
-			49, 249, 253, 19, 234, 138, 72, 130, 146, 223, 63, 129, 201, 239,
-			227, 242, 94, 98, 234, 13, 203, 254, 167, 86, 66, 93, 178, 139,
-			222, 6, 225, 116, 2, 192, 148, 4, 78, 7, 133, 94, 117, 141,
-			208, 121, 195, 98, 46, 123, 76, 223, 35, 116, 62, 105, 57, 135,
-			221, 99, 212, 63, 202, 82, 144, 194, 178, 13, 200, 238, 232, 106,
-			96, 10, 186, 189, 33, 243, 228, 168, 42, 69, 144, 6, 162, 10,
-			186, 186, 48, 232, 70, 21, 9, 172, 56, 136, 134, 55, 210, 184,
-			19, 239, 30, 216, 199, 36, 29, 58, 1, 226, 167, 104, 42, 114,
-			166, 157, 116, 115, 193, 86, 203, 215, 73, 55, 23, 246, 28, 82,
-			69, 186, 185, 112, 228, 40, 43, 18, 172, 52, 94, 54, 176, 79,
-			186, 87, 97, 31, 98, 0, 235, 190, 75, 83, 82, 106, 126, 185,
-			41, 189, 131, 210, 240, 17, 185, 182, 209, 218, 164, 1, 39, 0,
-			251, 41, 235, 152, 28, 53, 77, 87, 30, 236, 253, 170, 72, 87,
-			30, 14, 28, 81, 69, 186, 242, 112, 236, 4, 187, 73, 56, 117,
-			101, 156, 207, 32, 78, 215, 248, 60, 230, 174, 60, 28, 78, 242,
-			135, 196, 238, 131, 84, 23, 32, 5, 112, 127, 197, 58, 41, 135,
-			237, 74, 209, 64, 10, 169, 46, 11, 139, 26, 169, 174, 4, 22,
-			1, 169, 151, 9, 41, 150, 113, 126, 195, 178, 15, 184, 85, 17,
-			133, 208, 188, 172, 183, 144, 246, 67, 40, 12, 155, 164, 231, 132,
-			182, 219, 242, 75, 38, 226, 2, 55, 139, 25, 161, 218, 28, 147,
-			141, 52, 230, 12, 48, 135, 193, 63, 163, 49, 103, 73, 194, 70,
-			177, 58, 179, 176, 216, 165, 174, 74, 178, 4, 22, 247, 237, 103,
-			223, 180, 9, 245, 238, 140, 243, 91, 150, 205, 221, 175, 216, 248,
-			40, 130, 22, 83, 42, 21, 21, 171, 154, 65, 52, 17, 250, 40,
-			36, 22, 238, 69, 225, 105, 198, 215, 20, 68, 22, 46, 26, 174,
-			218, 135, 6, 198, 220, 24, 124, 141, 222, 85, 160, 126, 148, 207,
-			42, 126, 84, 178, 68, 111, 77, 24, 132, 193, 184, 137, 30, 74,
-			120, 40, 208, 149, 130, 202, 95, 144, 10, 31, 80, 144, 46, 4,
-			41, 254, 35, 232, 117, 175, 130, 239, 156, 203, 33, 165, 13, 83,
-			211, 126, 231, 145, 90, 165, 58, 42, 246, 223, 3, 80, 192, 225,
-			12, 225, 167, 176, 224, 242, 87, 5, 238, 42, 87, 15, 224, 2,
-			3, 229, 118, 58, 0, 232, 21, 234, 134, 21, 2, 26, 255, 6,
-			108, 107, 177, 6, 221, 41, 34, 186, 146, 62, 221, 22, 22, 229,
-			53, 8, 40, 38, 176, 120, 240, 48, 123, 142, 22, 168, 39, 227,
-			252, 43, 188, 174, 54, 195, 69, 214, 138, 193, 241, 209, 90, 24,
-			60, 31, 97, 9, 230, 56, 254, 183, 118, 178, 105, 254, 14, 130,
-			70, 171, 7, 208, 2, 200, 191, 101, 169, 139, 220, 61, 41, 26,
-			170, 75, 21, 45, 44, 178, 126, 85, 76, 96, 113, 207, 32, 200,
-			6, 82, 144, 95, 176, 58, 254, 12, 206, 244, 87, 121, 44, 243,
-			225, 97, 93, 110, 91, 78, 245, 234, 58, 235, 23, 172, 244, 32,
-			121, 21, 233, 54, 235, 23, 129, 74, 238, 197, 7, 187, 221, 196,
-			121, 70, 12, 25, 247, 188, 69, 119, 77, 1, 214, 23, 172, 189,
-			234, 54, 105, 146, 128, 167, 141, 187, 166, 95, 180, 186, 250, 141,
-			187, 166, 95, 180, 224, 96, 122, 5, 17, 65, 125, 240, 37, 203,
-			254, 83, 208, 7, 231, 212, 117, 201, 182, 199, 138, 233, 62, 138,
-			86, 228, 122, 230, 209, 53, 17, 135, 116, 196, 151, 144, 156, 120,
-			143, 219, 17, 58, 226, 203, 32, 217, 221, 67, 100, 3, 234, 27,
-			18, 113, 151, 179, 212, 14, 142, 212, 14, 208, 225, 75, 160, 123,
-			251, 84, 85, 146, 96, 176, 168, 194, 194, 138, 238, 190, 168, 34,
-			129, 21, 226, 39, 220, 196, 176, 208, 226, 15, 45, 231, 160, 251,
-			85, 139, 235, 95, 107, 106, 254, 127, 227, 84, 84, 196, 194, 139,
-			23, 48, 205, 47, 91, 3, 154, 20, 152, 210, 12, 85, 17, 245,
-			44, 34, 197, 158, 225, 168, 34, 129, 21, 251, 15, 176, 111, 219,
-			146, 88, 96, 246, 127, 211, 114, 78, 186, 95, 19, 129, 4, 52,
-			119, 199, 234, 94, 233, 197, 120, 166, 73, 121, 27, 79, 35, 9,
-			70, 3, 107, 191, 237, 242, 78, 168, 156, 125, 180, 248, 145, 93,
-			227, 201, 31, 12, 222, 48, 142, 226, 138, 177, 182, 35, 165, 200,
-			79, 207, 181, 93, 0, 80, 54, 33, 139, 60, 146, 250, 71, 160,
-			182, 119, 99, 78, 109, 233, 187, 147, 51, 179, 205, 59, 185, 181,
-			185, 49, 155, 232, 164, 16, 225, 198, 76, 34, 169, 245, 194, 147,
-			15, 80, 250, 15, 173, 131, 122, 53, 208, 92, 132, 42, 163, 194,
-			194, 138, 67, 217, 168, 34, 129, 21, 199, 79, 176, 31, 145, 203,
-			5, 229, 63, 193, 235, 74, 19, 242, 178, 146, 30, 221, 88, 172,
-			237, 220, 194, 17, 30, 120, 112, 1, 16, 223, 4, 109, 170, 70,
-			193, 163, 11, 84, 117, 69, 21, 22, 86, 176, 136, 179, 18, 52,
-			46, 152, 185, 69, 117, 51, 253, 219, 150, 125, 8, 68, 35, 57,
-			235, 229, 47, 100, 196, 228, 51, 253, 104, 145, 188, 70, 33, 51,
-			20, 34, 37, 164, 66, 85, 180, 236, 74, 126, 144, 163, 28, 192,
-			234, 98, 10, 139, 250, 98, 168, 69, 131, 102, 246, 25, 183, 193,
-			191, 109, 29, 56, 200, 254, 173, 190, 13, 254, 93, 60, 81, 253,
-			158, 213, 102, 161, 108, 253, 121, 36, 250, 53, 37, 159, 222, 166,
-			109, 59, 154, 24, 89, 79, 56, 179, 48, 82, 154, 219, 73, 1,
-			144, 144, 190, 167, 229, 192, 13, 147, 133, 141, 223, 243, 16, 151,
-			198, 113, 11, 68, 143, 235, 146, 169, 179, 169, 147, 22, 12, 111,
-			154, 79, 7, 66, 237, 61, 139, 174, 131, 195, 228, 190, 109, 29,
-			50, 174, 131, 127, 55, 126, 29, 252, 187, 209, 237, 92, 100, 146,
-			239, 226, 105, 237, 53, 71, 92, 116, 253, 115, 171, 227, 151, 108,
-			203, 253, 89, 135, 27, 73, 122, 15, 233, 156, 198, 30, 177, 132,
-			52, 92, 241, 88, 37, 252, 129, 36, 67, 53, 33, 126, 91, 47,
-			104, 108, 142, 1, 43, 160, 126, 192, 116, 59, 48, 50, 208, 162,
-			131, 243, 86, 176, 108, 42, 55, 166, 111, 204, 74, 190, 13, 65,
-			238, 248, 91, 162, 222, 112, 118, 82, 218, 48, 187, 182, 137, 127,
-			102, 65, 233, 151, 53, 183, 101, 61, 233, 64, 194, 60, 56, 100,
-			69, 242, 76, 225, 49, 89, 29, 11, 201, 74, 147, 179, 190, 160,
-			128, 93, 202, 66, 7, 212, 63, 244, 135, 82, 238, 23, 248, 61,
-			49, 198, 251, 248, 200, 130, 161, 131, 195, 209, 237, 97, 72, 132,
-			182, 135, 228, 33, 16, 92, 248, 200, 251, 248, 112, 96, 188, 56,
-			156, 211, 91, 224, 60, 36, 152, 241, 211, 113, 64, 64, 37, 0,
-			181, 168, 82, 7, 52, 48, 125, 87, 247, 207, 241, 96, 251, 163,
-			234, 170, 238, 247, 209, 52, 169, 9, 143, 116, 228, 12, 87, 123,
-			214, 124, 208, 70, 110, 181, 166, 18, 6, 49, 143, 124, 184, 26,
-			172, 147, 249, 34, 129, 24, 254, 36, 226, 48, 186, 139, 235, 149,
-			240, 6, 79, 236, 94, 45, 12, 255, 231, 150, 190, 72, 155, 36,
-			124, 210, 198, 189, 218, 239, 43, 107, 70, 220, 171, 253, 62, 90,
-			51, 159, 178, 212, 77, 170, 15, 218, 246, 94, 247, 227, 86, 228,
-			79, 23, 207, 190, 25, 248, 199, 184, 204, 120, 204, 140, 216, 37,
-			114, 67, 2, 209, 84, 197, 105, 116, 89, 146, 218, 211, 54, 169,
-			206, 124, 150, 190, 65, 211, 119, 151, 37, 215, 36, 221, 138, 194,
-			133, 32, 87, 118, 155, 127, 62, 154, 49, 170, 115, 192, 249, 251,
-			122, 198, 232, 188, 131, 138, 180, 113, 63, 233, 131, 112, 54, 52,
-			238, 39, 125, 208, 30, 28, 98, 183, 105, 194, 32, 253, 94, 177,
-			237, 221, 238, 13, 161, 57, 105, 215, 60, 138, 15, 47, 238, 183,
-			19, 23, 191, 13, 191, 93, 146, 68, 16, 140, 240, 65, 123, 175,
-			68, 0, 69, 16, 84, 164, 84, 17, 159, 50, 177, 165, 187, 43,
-			73, 34, 232, 21, 187, 175, 159, 109, 16, 122, 80, 122, 21, 112,
-			116, 95, 120, 144, 108, 126, 40, 71, 151, 240, 115, 177, 135, 112,
-			116, 37, 73, 219, 193, 208, 175, 216, 187, 37, 102, 168, 235, 94,
-			141, 200, 138, 154, 238, 85, 91, 138, 206, 36, 233, 185, 87, 109,
-			16, 157, 13, 194, 27, 20, 210, 135, 144, 143, 202, 145, 187, 209,
-			92, 96, 74, 151, 83, 142, 120, 62, 31, 25, 16, 177, 31, 213,
-			171, 199, 69, 0, 139, 63, 136, 104, 4, 171, 4, 198, 120, 242,
-			128, 65, 95, 181, 213, 82, 163, 107, 238, 67, 17, 165, 241, 8,
-			241, 33, 187, 83, 127, 77, 96, 17, 24, 225, 239, 10, 214, 135,
-			198, 31, 182, 109, 215, 253, 113, 227, 215, 6, 219, 200, 172, 127,
-			88, 78, 221, 115, 37, 82, 211, 54, 109, 213, 149, 26, 146, 146,
-			84, 198, 147, 25, 189, 189, 214, 104, 96, 194, 238, 178, 124, 207,
-			157, 126, 84, 78, 108, 97, 35, 23, 2, 179, 8, 85, 140, 89,
-			76, 8, 61, 106, 128, 210, 135, 52, 235, 36, 5, 142, 106, 66,
-			40, 120, 62, 108, 119, 42, 190, 71, 143, 218, 135, 237, 225, 125,
-			236, 3, 98, 66, 96, 5, 252, 162, 109, 239, 113, 55, 185, 120,
-			230, 151, 120, 251, 169, 75, 124, 34, 71, 108, 75, 191, 173, 26,
-			73, 30, 252, 1, 53, 249, 18, 44, 28, 24, 95, 172, 212, 227,
-			174, 5, 225, 147, 100, 34, 14, 76, 50, 106, 155, 100, 57, 212,
-			127, 117, 79, 60, 239, 106, 204, 3, 61, 110, 128, 201, 135, 109,
-			117, 155, 31, 61, 110, 80, 209, 169, 138, 22, 22, 211, 187, 84,
-			49, 129, 197, 221, 25, 246, 89, 49, 143, 206, 140, 243, 113, 219,
-			30, 118, 127, 217, 218, 33, 155, 80, 176, 200, 54, 14, 210, 39,
-			105, 178, 143, 230, 18, 109, 247, 136, 178, 31, 216, 37, 154, 36,
-			23, 27, 160, 254, 139, 246, 30, 57, 181, 206, 36, 205, 69, 77,
-			28, 93, 108, 31, 183, 211, 250, 107, 2, 139, 96, 43, 22, 24,
-			94, 130, 77, 189, 102, 119, 124, 213, 198, 208, 184, 121, 25, 224,
-			97, 79, 209, 237, 230, 7, 106, 42, 36, 244, 107, 118, 122, 128,
-			253, 14, 82, 54, 133, 170, 234, 117, 27, 84, 213, 103, 172, 184,
-			174, 106, 147, 120, 177, 67, 115, 91, 94, 61, 229, 188, 171, 129,
-			53, 128, 74, 116, 255, 159, 152, 107, 21, 42, 240, 54, 237, 166,
-			145, 19, 65, 1, 64, 41, 206, 212, 203, 130, 209, 102, 23, 253,
-			104, 173, 84, 64, 81, 65, 247, 171, 254, 154, 161, 1, 82, 164,
-			243, 96, 30, 175, 201, 55, 191, 82, 164, 243, 94, 87, 162, 42,
-			69, 58, 239, 117, 91, 234, 188, 20, 233, 188, 215, 49, 191, 253,
-			113, 34, 2, 250, 103, 81, 84, 141, 16, 13, 52, 166, 82, 237,
-			145, 69, 238, 133, 145, 253, 170, 71, 69, 189, 3, 29, 95, 151,
-			190, 154, 20, 233, 157, 55, 162, 81, 45, 2, 44, 245, 78, 138,
-			244, 206, 27, 40, 110, 190, 46, 104, 15, 138, 231, 211, 40, 217,
-			127, 247, 209, 204, 238, 152, 149, 240, 195, 88, 221, 179, 2, 208,
-			155, 110, 116, 167, 72, 227, 193, 212, 222, 144, 98, 43, 69, 26,
-			239, 211, 17, 97, 144, 226, 159, 86, 154, 35, 69, 26, 239, 211,
-			168, 57, 104, 57, 208, 161, 242, 171, 182, 253, 101, 59, 225, 158,
-			144, 39, 53, 241, 186, 72, 69, 134, 167, 226, 38, 152, 74, 175,
-			77, 145, 15, 229, 87, 109, 182, 135, 245, 195, 129, 15, 138, 14,
-			0, 250, 140, 237, 252, 134, 157, 164, 179, 24, 213, 160, 163, 22,
-			7, 238, 103, 105, 81, 129, 59, 224, 215, 236, 84, 31, 156, 241,
-			186, 84, 141, 69, 85, 204, 172, 178, 177, 170, 119, 151, 209, 15,
-			26, 125, 214, 78, 237, 54, 26, 89, 162, 170, 199, 172, 178, 177,
-			10, 116, 121, 212, 15, 106, 126, 29, 4, 179, 209, 8, 65, 65,
-			85, 175, 89, 69, 173, 128, 34, 31, 176, 196, 92, 16, 205, 207,
-			217, 206, 128, 187, 78, 47, 102, 41, 17, 160, 126, 207, 144, 86,
-			37, 174, 44, 243, 156, 63, 135, 239, 11, 67, 197, 18, 185, 75,
-			163, 21, 214, 130, 3, 175, 153, 153, 57, 74, 244, 43, 143, 8,
-			87, 218, 55, 237, 142, 166, 148, 116, 52, 125, 46, 70, 85, 218,
-			108, 80, 197, 162, 10, 11, 43, 164, 163, 41, 37, 29, 77, 159,
-			179, 51, 123, 88, 78, 78, 7, 26, 252, 38, 112, 137, 123, 64,
-			60, 113, 176, 89, 215, 41, 162, 209, 4, 162, 49, 113, 163, 65,
-			243, 207, 217, 3, 26, 34, 158, 112, 161, 42, 29, 85, 16, 72,
-			88, 90, 93, 145, 192, 10, 160, 225, 47, 216, 114, 80, 32, 234,
-			231, 109, 231, 160, 251, 183, 237, 31, 206, 185, 165, 94, 102, 102,
-			194, 138, 193, 31, 81, 129, 18, 198, 112, 141, 247, 86, 185, 184,
-			156, 22, 155, 207, 95, 86, 159, 88, 74, 250, 88, 128, 58, 191,
-			105, 103, 52, 5, 209, 126, 128, 42, 163, 194, 194, 10, 233, 19,
-			75, 73, 31, 203, 231, 237, 253, 7, 216, 207, 41, 26, 67, 197,
-			31, 216, 206, 73, 247, 3, 182, 137, 129, 36, 244, 35, 120, 200,
-			126, 112, 26, 191, 105, 142, 181, 170, 33, 31, 127, 96, 191, 90,
-			68, 96, 52, 167, 129, 52, 159, 183, 15, 106, 242, 37, 82, 68,
-			45, 163, 194, 194, 10, 233, 196, 74, 73, 231, 209, 31, 216, 199,
-			79, 80, 252, 46, 133, 212, 253, 138, 109, 31, 147, 114, 19, 239,
-			207, 124, 69, 189, 202, 144, 34, 104, 95, 177, 187, 7, 84, 209,
-			194, 226, 224, 97, 85, 164, 190, 217, 163, 152, 16, 155, 2, 201,
-			248, 53, 27, 47, 19, 186, 63, 34, 222, 228, 151, 11, 132, 118,
-			195, 216, 178, 87, 146, 119, 85, 228, 209, 146, 182, 194, 75, 49,
-			159, 60, 186, 207, 238, 86, 74, 242, 169, 201, 20, 110, 248, 175,
-			217, 233, 30, 124, 54, 55, 37, 158, 56, 178, 237, 156, 251, 118,
-			74, 158, 85, 199, 40, 177, 153, 148, 227, 130, 50, 7, 100, 6,
-			150, 240, 144, 183, 191, 158, 148, 146, 143, 16, 217, 169, 46, 85,
-			180, 177, 200, 6, 84, 17, 95, 36, 178, 15, 159, 194, 88, 72,
-			138, 100, 202, 191, 179, 237, 188, 59, 35, 242, 214, 163, 211, 91,
-			60, 83, 189, 109, 199, 223, 55, 75, 93, 140, 99, 17, 228, 148,
-			46, 218, 88, 236, 30, 82, 197, 4, 22, 143, 228, 128, 176, 136,
-			5, 124, 252, 134, 109, 159, 5, 194, 42, 175, 150, 64, 163, 205,
-			117, 31, 26, 153, 100, 109, 9, 24, 134, 103, 47, 37, 246, 220,
-			55, 236, 84, 183, 42, 18, 252, 158, 97, 85, 76, 96, 241, 232,
-			132, 28, 28, 93, 158, 182, 61, 142, 171, 42, 152, 119, 135, 177,
-			27, 65, 128, 35, 97, 98, 153, 180, 219, 112, 59, 104, 203, 71,
-			15, 142, 60, 244, 205, 104, 230, 9, 244, 120, 71, 51, 79, 208,
-			112, 71, 198, 212, 67, 31, 255, 47, 0, 0, 255, 255, 200, 236,
-			65, 35, 109, 152, 0, 0},
+			8, 0, 0, 0, 0, 0, 2, 255, 236, 189,
+			11, 112, 100, 73, 149, 40, 166, 204, 123, 171,
+			84, 74, 169, 91, 210, 213, 167, 213, 183, 127,
+			217, 53, 61, 221, 82, 119, 169, 164, 233, 158,
+			15, 211, 61, 61, 70, 221, 82, 119, 215, 208,
+			35, 105, 74, 234, 25, 166, 249, 116, 95, 85,
+			165, 164, 59, 83, 117, 111, 205, 189, 183, 90,
+			210, 12, 172, 217, 176, 189, 11, 107, 79, 132,
+			199, 11, 187, 176, 11, 24, 179, 225, 9, 195,
+			236, 24, 131, 89, 150, 197, 97, 2, 199, 126,
+			136, 5, 214, 44, 224, 0, 214, 246, 18, 6,
+			54, 76, 152, 96, 253, 94, 240, 226, 197, 123,
+			177, 188, 23, 188, 56, 39, 51, 239, 167, 36,
+			245, 7, 102, 35, 118, 95, 48, 1, 51, 58,
+			121, 51, 79, 158, 60, 121, 242, 228, 201, 115,
+			78, 102, 177, 247, 158, 96, 71, 214, 124, 127,
+			173, 33, 166, 90, 129, 31, 249, 43, 237, 213,
+			169, 200, 109, 138, 48, 114, 154, 173, 50, 22,
+			89, 253, 178, 66, 89, 87, 40, 158, 99, 61,
+			203, 186, 142, 53, 198, 186, 67, 81, 243, 189,
+			122, 56, 70, 56, 25, 55, 170, 26, 180, 134,
+			89, 206, 115, 60, 63, 28, 163, 156, 140, 231,
+			170, 18, 184, 176, 204, 134, 106, 126, 179, 220,
+			129, 243, 194, 222, 24, 227, 34, 20, 45, 146,
+			143, 17, 242, 111, 9, 249, 56, 53, 46, 47,
+			94, 248, 36, 61, 124, 89, 214, 95, 84, 245,
+			203, 207, 136, 70, 227, 45, 158, 191, 225, 45,
+			111, 181, 68, 248, 196, 231, 239, 103, 121, 203,
+			60, 220, 213, 36, 236, 107, 125, 140, 244, 89,
+			198, 225, 46, 235, 244, 255, 214, 199, 177, 65,
+			205, 111, 240, 11, 237, 213, 85, 17, 132, 124,
+			146, 75, 84, 39, 66, 94, 119, 34, 135, 187,
+			94, 36, 130, 218, 186, 227, 173, 9, 190, 234,
+			7, 77, 39, 98, 252, 162, 223, 218, 10, 220,
+			181, 245, 136, 159, 158, 158, 126, 147, 106, 192,
+			43, 94, 173, 204, 249, 76, 163, 193, 241, 91,
+			200, 3, 17, 138, 224, 150, 168, 151, 25, 95,
+			143, 162, 86, 120, 118, 106, 170, 46, 110, 137,
+			134, 223, 18, 65, 168, 71, 88, 243, 155, 146,
+			181, 53, 191, 49, 185, 34, 137, 152, 98, 140,
+			87, 69, 221, 13, 163, 192, 93, 105, 71, 174,
+			239, 113, 199, 171, 243, 118, 40, 184, 235, 241,
+			208, 111, 7, 53, 129, 37, 43, 174, 231, 4,
+			91, 72, 87, 88, 226, 27, 110, 180, 206, 253,
+			0, 255, 235, 183, 35, 198, 155, 126, 221, 93,
+			117, 107, 14, 96, 40, 113, 39, 16, 188, 37,
+			130, 166, 27, 69, 162, 206, 91, 129, 127, 203,
+			173, 139, 58, 143, 214, 157, 136, 71, 235, 48,
+			186, 70, 195, 223, 112, 189, 53, 14, 243, 227,
+			66, 163, 16, 26, 49, 222, 20, 209, 89, 198,
+			56, 252, 115, 178, 131, 176, 144, 251, 171, 154,
+			162, 154, 95, 23, 188, 217, 14, 35, 30, 136,
+			200, 113, 61, 196, 234, 172, 248, 183, 224, 147,
+			226, 24, 227, 158, 31, 185, 53, 81, 226, 209,
+			186, 27, 242, 134, 27, 70, 128, 33, 221, 163,
+			87, 239, 32, 167, 238, 134, 181, 134, 227, 54,
+			69, 80, 222, 141, 8, 215, 75, 243, 66, 19,
+			209, 10, 252, 122, 187, 38, 18, 58, 88, 66,
+			200, 47, 69, 7, 227, 106, 116, 117, 191, 214,
+			110, 10, 47, 114, 244, 36, 77, 249, 1, 247,
+			163, 117, 17, 240, 166, 19, 137, 192, 117, 26,
+			97, 194, 106, 156, 160, 104, 93, 48, 158, 166,
+			62, 30, 212, 188, 112, 177, 37, 32, 246, 156,
+			166, 0, 130, 210, 178, 229, 249, 201, 55, 228,
+			187, 27, 133, 48, 34, 79, 162, 242, 131, 144,
+			55, 157, 45, 190, 34, 64, 82, 234, 60, 242,
+			185, 240, 234, 126, 16, 10, 16, 138, 86, 224,
+			55, 253, 72, 112, 201, 147, 40, 228, 117, 17,
+			184, 183, 68, 157, 175, 6, 126, 147, 73, 46,
+			132, 254, 106, 180, 1, 98, 162, 36, 136, 135,
+			45, 81, 3, 9, 226, 173, 192, 5, 193, 10,
+			64, 118, 60, 41, 69, 97, 136, 180, 51, 190,
+			124, 165, 178, 196, 151, 22, 46, 45, 63, 51,
+			83, 157, 227, 149, 37, 190, 88, 93, 120, 186,
+			50, 59, 55, 203, 47, 60, 203, 151, 175, 204,
+			241, 139, 11, 139, 207, 86, 43, 151, 175, 44,
+			243, 43, 11, 87, 103, 231, 170, 75, 124, 102,
+			126, 150, 95, 92, 152, 95, 174, 86, 46, 92,
+			91, 94, 168, 46, 49, 94, 156, 89, 226, 149,
+			165, 34, 126, 153, 153, 127, 150, 207, 189, 117,
+			177, 58, 183, 180, 196, 23, 170, 188, 242, 228,
+			226, 213, 202, 220, 44, 127, 102, 166, 90, 157,
+			153, 95, 174, 204, 45, 149, 120, 101, 254, 226,
+			213, 107, 179, 149, 249, 203, 37, 126, 225, 218,
+			50, 159, 95, 88, 102, 252, 106, 229, 201, 202,
+			242, 220, 44, 95, 94, 40, 97, 183, 219, 219,
+			241, 133, 75, 252, 201, 185, 234, 197, 43, 51,
+			243, 203, 51, 23, 42, 87, 43, 203, 207, 98,
+			135, 151, 42, 203, 243, 208, 217, 165, 133, 42,
+			227, 51, 124, 113, 166, 186, 92, 185, 120, 237,
+			234, 76, 149, 47, 94, 171, 46, 46, 44, 205,
+			113, 24, 217, 108, 101, 233, 226, 213, 153, 202,
+			147, 115, 179, 101, 94, 153, 231, 243, 11, 124,
+			238, 233, 185, 249, 101, 190, 116, 101, 230, 234,
+			213, 236, 64, 25, 95, 120, 102, 126, 174, 10,
+			212, 167, 135, 201, 47, 204, 241, 171, 149, 153,
+			11, 87, 231, 160, 43, 28, 231, 108, 165, 58,
+			119, 113, 25, 6, 148, 252, 117, 177, 50, 59,
+			55, 191, 60, 115, 181, 196, 248, 210, 226, 220,
+			197, 202, 204, 213, 18, 159, 123, 235, 220, 147,
+			139, 87, 103, 170, 207, 150, 20, 210, 165, 185,
+			167, 174, 205, 205, 47, 87, 102, 174, 242, 217,
+			153, 39, 103, 46, 207, 45, 241, 241, 59, 113,
+			101, 177, 186, 112, 241, 90, 117, 238, 73, 160,
+			122, 225, 18, 95, 186, 118, 97, 105, 185, 178,
+			124, 109, 121, 142, 95, 94, 88, 152, 69, 102,
+			47, 205, 85, 159, 174, 92, 156, 91, 58, 199,
+			175, 46, 44, 33, 195, 174, 45, 205, 149, 24,
+			159, 157, 89, 158, 193, 174, 23, 171, 11, 151,
+			42, 203, 75, 231, 224, 239, 11, 215, 150, 42,
+			200, 184, 202, 252, 242, 92, 181, 122, 109, 113,
+			185, 178, 48, 63, 193, 175, 44, 60, 51, 247,
+			244, 92, 149, 95, 156, 185, 182, 52, 55, 139,
+			28, 94, 152, 135, 209, 130, 172, 204, 45, 84,
+			159, 5, 180, 87, 43, 106, 6, 74, 252, 153,
+			43, 115, 203, 87, 230, 170, 192, 84, 228, 214,
+			12, 176, 97, 105, 185, 90, 185, 184, 156, 174,
+			182, 80, 229, 203, 11, 213, 101, 150, 26, 39,
+			159, 159, 187, 124, 181, 114, 121, 110, 254, 226,
+			28, 124, 94, 0, 52, 207, 84, 150, 230, 38,
+			248, 76, 181, 178, 4, 21, 42, 216, 49, 127,
+			102, 230, 89, 190, 112, 13, 71, 13, 19, 117,
+			109, 105, 142, 201, 191, 83, 162, 91, 194, 249,
+			228, 149, 75, 124, 102, 246, 233, 10, 80, 174,
+			106, 47, 46, 44, 45, 85, 148, 184, 32, 219,
+			46, 94, 81, 60, 47, 51, 86, 96, 132, 90,
+			6, 47, 236, 131, 191, 10, 150, 81, 236, 58,
+			199, 122, 153, 89, 248, 81, 119, 151, 4, 250,
+			88, 14, 0, 106, 25, 197, 238, 125, 108, 15,
+			203, 35, 212, 37, 193, 189, 172, 91, 130, 68,
+			194, 170, 114, 183, 101, 20, 237, 179, 10, 227,
+			125, 93, 71, 20, 70, 34, 1, 89, 9, 186,
+			189, 47, 198, 72, 104, 151, 4, 37, 70, 130,
+			24, 239, 139, 49, 18, 195, 50, 238, 179, 15,
+			43, 140, 199, 186, 74, 10, 35, 149, 128, 172,
+			68, 1, 234, 30, 82, 24, 41, 237, 146, 160,
+			196, 72, 17, 35, 192, 170, 114, 183, 101, 28,
+			27, 61, 165, 48, 222, 223, 53, 165, 48, 26,
+			18, 144, 149, 12, 106, 25, 247, 119, 31, 80,
+			24, 13, 218, 37, 65, 137, 209, 64, 140, 0,
+			171, 202, 221, 150, 113, 255, 225, 178, 194, 120,
+			188, 171, 168, 48, 154, 18, 144, 149, 76, 106,
+			25, 199, 187, 109, 133, 209, 164, 93, 18, 148,
+			24, 77, 196, 8, 176, 170, 108, 88, 198, 241,
+			67, 71, 21, 198, 19, 241, 168, 115, 18, 144,
+			149, 114, 212, 50, 78, 116, 31, 83, 24, 115,
+			180, 75, 130, 18, 99, 14, 49, 2, 172, 42,
+			27, 150, 113, 226, 132, 30, 245, 120, 215, 81,
+			133, 49, 47, 1, 89, 41, 79, 45, 99, 188,
+			123, 76, 97, 204, 211, 46, 9, 74, 140, 121,
+			196, 8, 176, 170, 220, 109, 25, 227, 7, 56,
+			123, 121, 128, 81, 179, 203, 50, 157, 174, 38,
+			177, 223, 51, 192, 103, 120, 108, 241, 224, 78,
+			38, 66, 225, 69, 33, 119, 120, 203, 119, 189,
+			8, 247, 31, 183, 41, 184, 235, 213, 69, 75,
+			120, 117, 225, 225, 254, 229, 120, 91, 178, 252,
+			69, 223, 19, 140, 251, 1, 175, 57, 13, 225,
+			213, 157, 160, 148, 96, 17, 117, 238, 132, 92,
+			153, 97, 184, 207, 173, 6, 78, 45, 217, 205,
+			245, 135, 136, 113, 180, 201, 16, 230, 129, 8,
+			253, 134, 52, 70, 92, 143, 95, 91, 190, 200,
+			231, 90, 126, 109, 29, 187, 43, 243, 74, 196,
+			221, 144, 11, 15, 108, 0, 176, 84, 96, 191,
+			196, 157, 110, 49, 240, 27, 162, 21, 185, 53,
+			126, 57, 16, 107, 126, 224, 58, 30, 191, 168,
+			104, 226, 27, 235, 110, 109, 157, 139, 205, 72,
+			64, 135, 176, 183, 37, 149, 52, 225, 140, 175,
+			56, 181, 231, 55, 156, 0, 106, 248, 124, 75,
+			56, 1, 247, 189, 109, 93, 58, 97, 216, 110,
+			66, 175, 78, 163, 193, 155, 174, 215, 142, 4,
+			90, 47, 252, 225, 105, 22, 15, 169, 225, 123,
+			107, 37, 238, 150, 69, 153, 55, 132, 211, 74,
+			134, 26, 8, 94, 12, 155, 194, 9, 68, 189,
+			200, 67, 95, 26, 69, 158, 159, 174, 197, 120,
+			228, 172, 52, 4, 244, 233, 9, 1, 93, 174,
+			250, 129, 52, 15, 91, 96, 239, 224, 86, 206,
+			171, 104, 40, 186, 161, 218, 86, 167, 167, 167,
+			31, 152, 196, 255, 45, 79, 79, 159, 197, 255,
+			93, 135, 81, 60, 250, 232, 163, 143, 78, 62,
+			112, 122, 242, 204, 3, 203, 167, 207, 156, 125,
+			232, 209, 179, 15, 61, 90, 126, 84, 255, 115,
+			189, 204, 248, 133, 45, 96, 120, 20, 184, 181,
+			8, 89, 169, 72, 10, 0, 125, 137, 111, 8,
+			46, 188, 176, 29, 8, 89, 186, 33, 120, 13,
+			56, 230, 123, 183, 68, 16, 241, 200, 103, 106,
+			86, 253, 38, 231, 213, 75, 23, 249, 153, 51,
+			103, 30, 5, 115, 86, 112, 64, 233, 173, 133,
+			101, 198, 151, 132, 224, 111, 211, 118, 233, 198,
+			198, 70, 217, 21, 209, 106, 217, 15, 214, 166,
+			130, 213, 26, 252, 31, 26, 149, 163, 205, 232,
+			29, 227, 119, 83, 107, 162, 204, 24, 159, 219,
+			116, 154, 173, 134, 224, 15, 156, 229, 23, 253,
+			102, 171, 29, 137, 148, 20, 35, 57, 139, 11,
+			75, 149, 183, 242, 155, 32, 52, 227, 19, 55,
+			203, 202, 170, 76, 42, 197, 135, 139, 115, 242,
+			75, 12, 151, 67, 17, 221, 80, 243, 53, 142,
+			205, 231, 175, 93, 189, 58, 49, 177, 99, 61,
+			20, 219, 241, 233, 137, 115, 41, 154, 78, 223,
+			137, 166, 53, 17, 1, 22, 127, 181, 238, 108,
+			165, 104, 11, 163, 160, 93, 139, 176, 131, 91,
+			78, 131, 71, 183, 84, 143, 153, 234, 199, 163,
+			91, 37, 142, 4, 157, 251, 69, 135, 116, 171,
+			28, 221, 2, 232, 118, 35, 146, 149, 218, 161,
+			168, 241, 147, 252, 129, 233, 233, 236, 8, 207,
+			236, 58, 194, 103, 92, 239, 204, 105, 126, 243,
+			178, 136, 150, 182, 194, 72, 52, 225, 243, 76,
+			120, 201, 109, 136, 229, 236, 68, 92, 170, 92,
+			157, 91, 174, 60, 57, 199, 87, 35, 69, 198,
+			110, 109, 142, 175, 70, 154, 210, 107, 149, 249,
+			229, 135, 31, 228, 145, 91, 123, 62, 228, 231,
+			249, 248, 248, 184, 44, 153, 88, 141, 202, 245,
+			141, 43, 238, 218, 250, 172, 19, 97, 171, 9,
+			254, 216, 99, 252, 204, 233, 9, 254, 46, 142,
+			223, 174, 250, 27, 250, 147, 230, 219, 212, 20,
+			159, 1, 122, 235, 254, 70, 136, 40, 97, 49,
+			61, 48, 61, 157, 82, 69, 97, 57, 174, 32,
+			80, 5, 61, 240, 240, 246, 85, 22, 99, 131,
+			230, 15, 60, 252, 224, 131, 15, 62, 114, 230,
+			225, 233, 233, 120, 201, 175, 136, 85, 63, 16,
+			252, 154, 231, 110, 106, 44, 143, 62, 50, 221,
+			137, 165, 252, 139, 77, 230, 184, 28, 63, 31,
+			31, 151, 76, 153, 194, 201, 130, 127, 38, 248,
+			100, 154, 156, 59, 72, 48, 224, 57, 115, 58,
+			193, 115, 127, 10, 15, 10, 192, 68, 70, 0,
+			30, 220, 85, 0, 158, 112, 110, 57, 252, 166,
+			156, 200, 114, 173, 29, 4, 194, 139, 160, 202,
+			147, 110, 163, 225, 134, 41, 1, 0, 13, 201,
+			155, 88, 202, 207, 243, 221, 27, 220, 70, 204,
+			249, 249, 164, 180, 236, 137, 141, 11, 109, 183,
+			81, 23, 193, 248, 4, 12, 108, 73, 113, 72,
+			117, 33, 25, 51, 33, 113, 193, 63, 80, 103,
+			94, 142, 221, 245, 34, 24, 185, 170, 41, 135,
+			174, 134, 141, 28, 152, 40, 175, 0, 102, 164,
+			37, 225, 193, 67, 187, 242, 64, 141, 66, 239,
+			155, 124, 113, 43, 90, 151, 39, 24, 248, 199,
+			243, 55, 248, 121, 252, 86, 150, 202, 73, 22,
+			107, 113, 57, 15, 154, 126, 220, 243, 55, 84,
+			57, 206, 143, 42, 133, 98, 62, 169, 171, 74,
+			18, 79, 158, 124, 116, 162, 99, 94, 211, 124,
+			25, 87, 149, 207, 171, 255, 150, 36, 194, 243,
+			248, 239, 9, 134, 255, 24, 38, 88, 10, 78,
+			97, 144, 253, 119, 132, 153, 38, 218, 140, 171,
+			116, 216, 254, 109, 194, 171, 137, 65, 160, 9,
+			244, 87, 113, 79, 198, 193, 133, 174, 87, 75,
+			139, 54, 219, 89, 182, 249, 147, 112, 76, 94,
+			17, 146, 61, 248, 175, 93, 246, 43, 182, 211,
+			134, 117, 157, 187, 94, 173, 209, 14, 221, 91,
+			162, 204, 216, 30, 150, 3, 18, 77, 203, 92,
+			165, 14, 26, 137, 0, 230, 128, 228, 110, 13,
+			17, 203, 88, 45, 244, 107, 200, 176, 140, 85,
+			107, 136, 253, 157, 28, 28, 177, 140, 6, 181,
+			236, 111, 19, 62, 239, 123, 147, 158, 88, 115,
+			34, 247, 150, 200, 90, 38, 142, 26, 45, 135,
+			205, 121, 39, 203, 164, 204, 231, 85, 67, 189,
+			231, 243, 91, 78, 163, 45, 66, 121, 244, 78,
+			144, 161, 131, 32, 140, 220, 70, 131, 175, 59,
+			183, 4, 247, 210, 125, 202, 185, 149, 13, 153,
+			220, 97, 107, 126, 219, 139, 96, 195, 7, 59,
+			68, 27, 95, 29, 12, 156, 86, 27, 123, 73,
+			253, 159, 237, 192, 31, 98, 90, 102, 131, 174,
+			14, 43, 30, 144, 28, 140, 90, 243, 135, 0,
+			15, 10, 123, 52, 100, 88, 70, 99, 96, 112,
+			37, 143, 222, 161, 51, 236, 229, 97, 214, 27,
+			70, 78, 164, 156, 98, 86, 190, 225, 175, 213,
+			253, 53, 251, 78, 254, 185, 226, 111, 27, 108,
+			239, 85, 127, 109, 41, 10, 132, 211, 92, 2,
+			12, 214, 125, 108, 15, 126, 187, 113, 75, 4,
+			112, 140, 71, 215, 92, 79, 181, 15, 11, 159,
+			150, 101, 214, 131, 172, 187, 22, 8, 39, 18,
+			117, 244, 208, 245, 158, 182, 59, 189, 114, 229,
+			88, 158, 171, 186, 170, 117, 63, 219, 27, 129,
+			123, 192, 115, 26, 55, 192, 56, 221, 28, 51,
+			208, 237, 183, 71, 151, 86, 160, 208, 122, 140,
+			117, 59, 65, 109, 221, 189, 37, 198, 76, 68,
+			94, 44, 203, 241, 148, 179, 164, 150, 103, 100,
+			173, 138, 183, 234, 87, 117, 19, 107, 148, 229,
+			91, 237, 96, 77, 212, 199, 114, 156, 140, 23,
+			170, 10, 178, 255, 7, 194, 122, 83, 13, 172,
+			3, 172, 7, 105, 184, 209, 14, 26, 106, 140,
+			5, 44, 184, 22, 52, 172, 67, 140, 133, 216,
+			17, 126, 165, 248, 181, 71, 150, 192, 231, 253,
+			172, 0, 14, 64, 252, 104, 224, 199, 110, 128,
+			225, 147, 205, 10, 53, 31, 180, 77, 36, 169,
+			47, 84, 99, 216, 58, 206, 250, 27, 254, 218,
+			13, 225, 69, 193, 214, 13, 20, 27, 164, 209,
+			168, 238, 105, 248, 107, 115, 80, 122, 17, 10,
+			159, 248, 220, 0, 120, 36, 205, 174, 105, 194,
+			62, 73, 208, 35, 105, 118, 89, 167, 255, 91,
+			146, 113, 46, 62, 240, 48, 95, 94, 23, 252,
+			226, 122, 224, 55, 221, 118, 147, 207, 180, 163,
+			117, 63, 8, 203, 187, 120, 25, 175, 133, 232,
+			51, 82, 190, 156, 196, 39, 231, 134, 124, 205,
+			191, 37, 2, 79, 212, 249, 202, 22, 119, 248,
+			133, 165, 217, 201, 48, 218, 106, 8, 222, 112,
+			107, 194, 11, 149, 29, 9, 70, 228, 138, 96,
+			124, 213, 111, 123, 117, 237, 226, 186, 90, 185,
+			56, 55, 191, 52, 199, 87, 221, 134, 136, 207,
+			187, 249, 194, 94, 214, 195, 168, 209, 101, 25,
+			133, 238, 113, 246, 81, 34, 15, 47, 123, 186,
+			166, 137, 253, 10, 225, 217, 57, 132, 254, 29,
+			190, 226, 214, 221, 64, 224, 250, 115, 26, 28,
+			37, 89, 174, 49, 233, 173, 130, 51, 69, 11,
+			76, 84, 217, 16, 204, 255, 70, 8, 202, 121,
+			59, 46, 209, 92, 17, 245, 186, 52, 198, 61,
+			62, 231, 213, 241, 72, 4, 156, 120, 161, 45,
+			194, 104, 42, 16, 97, 203, 135, 65, 73, 211,
+			45, 44, 39, 218, 116, 79, 97, 148, 205, 106,
+			101, 218, 95, 56, 106, 63, 194, 23, 83, 50,
+			15, 216, 97, 204, 90, 192, 185, 90, 31, 104,
+			234, 75, 182, 34, 41, 25, 125, 215, 95, 216,
+			51, 150, 210, 119, 253, 133, 189, 41, 125, 215,
+			223, 127, 48, 165, 239, 250, 143, 112, 182, 168,
+			213, 157, 85, 40, 219, 23, 113, 126, 81, 105,
+			111, 172, 11, 201, 240, 134, 191, 166, 186, 225,
+			27, 14, 140, 106, 205, 13, 35, 17, 164, 252,
+			135, 252, 162, 239, 7, 117, 215, 115, 34, 63,
+			200, 168, 22, 171, 208, 127, 84, 171, 143, 60,
+			244, 112, 52, 165, 90, 172, 226, 68, 74, 181,
+			88, 165, 73, 118, 11, 73, 161, 150, 49, 90,
+			56, 106, 187, 72, 138, 234, 24, 23, 137, 148,
+			165, 52, 65, 39, 66, 174, 151, 49, 111, 138,
+			48, 116, 214, 224, 28, 38, 107, 201, 185, 116,
+			67, 62, 249, 64, 137, 197, 237, 220, 80, 169,
+			89, 137, 192, 245, 214, 98, 130, 169, 105, 153,
+			163, 5, 171, 172, 136, 162, 57, 160, 67, 107,
+			63, 96, 208, 232, 94, 205, 59, 106, 88, 198,
+			232, 17, 206, 174, 0, 193, 70, 151, 101, 238,
+			167, 227, 134, 125, 150, 167, 214, 58, 250, 65,
+			29, 23, 93, 214, 88, 200, 235, 34, 114, 220,
+			70, 168, 230, 46, 61, 140, 50, 147, 120, 13,
+			152, 161, 253, 108, 132, 61, 197, 242, 0, 129,
+			80, 28, 48, 247, 219, 23, 144, 21, 202, 245,
+			186, 20, 249, 129, 179, 38, 248, 181, 234, 85,
+			152, 163, 64, 108, 231, 137, 228, 150, 27, 119,
+			93, 47, 51, 214, 207, 186, 37, 74, 211, 50,
+			15, 152, 251, 209, 49, 35, 11, 114, 208, 9,
+			75, 96, 98, 25, 7, 122, 135, 19, 216, 176,
+			140, 3, 251, 198, 216, 219, 20, 77, 196, 50,
+			14, 153, 182, 125, 245, 30, 105, 10, 156, 13,
+			5, 168, 56, 198, 142, 212, 129, 208, 28, 50,
+			15, 236, 143, 123, 135, 29, 233, 80, 138, 58,
+			16, 156, 67, 189, 35, 9, 108, 88, 198, 161,
+			177, 253, 236, 186, 162, 142, 90, 198, 17, 115,
+			204, 126, 203, 61, 82, 231, 132, 161, 104, 174,
+			52, 68, 253, 118, 196, 129, 128, 28, 49, 15,
+			217, 113, 231, 32, 34, 71, 82, 196, 1, 111,
+			142, 244, 14, 37, 176, 97, 25, 71, 70, 247,
+			177, 191, 37, 138, 58, 195, 50, 142, 153, 163,
+			246, 95, 17, 20, 210, 160, 45, 74, 232, 30,
+			0, 82, 64, 65, 187, 2, 206, 1, 209, 134,
+			16, 30, 159, 150, 14, 255, 204, 214, 197, 55,
+			128, 248, 152, 50, 94, 89, 101, 124, 213, 105,
+			132, 58, 106, 224, 122, 117, 136, 174, 136, 48,
+			9, 162, 116, 44, 94, 207, 143, 184, 222, 26,
+			26, 91, 188, 225, 59, 117, 212, 117, 145, 207,
+			56, 186, 14, 154, 162, 238, 202, 115, 185, 228,
+			89, 172, 5, 100, 175, 78, 67, 86, 131, 63,
+			196, 102, 203, 13, 50, 12, 50, 76, 203, 60,
+			102, 30, 25, 139, 25, 96, 228, 96, 192, 133,
+			4, 6, 151, 93, 207, 96, 2, 3, 67, 134,
+			71, 216, 125, 138, 63, 166, 101, 156, 48, 15,
+			219, 195, 56, 123, 94, 187, 185, 34, 2, 88,
+			244, 48, 136, 164, 23, 211, 180, 204, 19, 230,
+			177, 209, 24, 139, 9, 254, 51, 179, 39, 129,
+			193, 69, 198, 18, 25, 2, 183, 219, 137, 131,
+			135, 152, 131, 234, 197, 176, 140, 83, 212, 182,
+			151, 97, 10, 208, 204, 114, 27, 165, 78, 86,
+			165, 230, 191, 164, 2, 47, 224, 66, 113, 69,
+			163, 222, 185, 174, 157, 6, 211, 43, 59, 214,
+			36, 192, 135, 83, 116, 220, 80, 218, 194, 200,
+			67, 151, 90, 147, 0, 15, 78, 237, 29, 209,
+			16, 144, 51, 182, 159, 253, 167, 72, 155, 105,
+			25, 83, 133, 49, 59, 224, 149, 212, 76, 10,
+			46, 173, 9, 181, 71, 161, 221, 217, 240, 215,
+			202, 124, 6, 254, 35, 167, 122, 221, 1, 201,
+			17, 158, 174, 234, 134, 220, 247, 26, 91, 140,
+			59, 181, 231, 61, 127, 163, 33, 234, 107, 50,
+			2, 227, 212, 155, 174, 7, 193, 30, 105, 90,
+			214, 26, 46, 88, 237, 49, 229, 192, 219, 169,
+			194, 41, 91, 81, 7, 156, 157, 42, 244, 105,
+			136, 88, 198, 212, 30, 109, 75, 3, 87, 167,
+			70, 247, 197, 246, 224, 191, 58, 202, 14, 119,
+			154, 126, 245, 118, 32, 253, 80, 187, 68, 102,
+			207, 178, 194, 172, 170, 114, 207, 129, 217, 197,
+			157, 3, 179, 123, 52, 194, 36, 46, 123, 183,
+			65, 217, 79, 112, 25, 148, 189, 241, 171, 160,
+			236, 175, 130, 178, 191, 10, 202, 254, 42, 40,
+			251, 171, 160, 236, 175, 130, 178, 255, 108, 130,
+			178, 165, 116, 80, 182, 148, 9, 202, 14, 101,
+			131, 178, 67, 29, 65, 89, 29, 66, 37, 221,
+			150, 113, 95, 28, 66, 61, 214, 85, 78, 7,
+			101, 203, 153, 160, 236, 129, 108, 80, 246, 64,
+			71, 80, 246, 64, 58, 40, 123, 120, 50, 14,
+			202, 22, 211, 65, 217, 98, 38, 40, 107, 103,
+			131, 178, 118, 71, 80, 86, 135, 80, 193, 94,
+			186, 63, 14, 161, 30, 239, 42, 165, 131, 178,
+			165, 76, 80, 246, 88, 54, 40, 123, 172, 35,
+			40, 123, 44, 29, 148, 141, 67, 168, 39, 226,
+			16, 106, 78, 2, 233, 160, 236, 88, 54, 40,
+			59, 214, 17, 148, 213, 33, 212, 92, 183, 101,
+			156, 56, 192, 217, 15, 153, 244, 66, 84, 187,
+			110, 16, 251, 219, 160, 52, 180, 109, 146, 141,
+			160, 134, 238, 154, 7, 134, 230, 170, 187, 41,
+			234, 147, 13, 225, 173, 69, 235, 60, 108, 57,
+			30, 30, 120, 225, 44, 158, 10, 149, 50, 238,
+			64, 27, 233, 0, 76, 71, 71, 119, 15, 155,
+			166, 124, 147, 44, 227, 156, 148, 17, 203, 29,
+			66, 182, 58, 214, 137, 88, 107, 190, 87, 19,
+			173, 8, 246, 191, 231, 5, 47, 214, 157, 173,
+			34, 70, 114, 139, 77, 223, 139, 214, 139, 26,
+			77, 32, 26, 78, 36, 119, 148, 196, 209, 237,
+			122, 137, 233, 80, 119, 193, 110, 17, 94, 77,
+			232, 67, 14, 227, 209, 70, 186, 182, 242, 141,
+			162, 123, 38, 102, 21, 144, 224, 106, 95, 16,
+			119, 234, 176, 71, 250, 1, 15, 219, 43, 17,
+			12, 23, 56, 130, 238, 78, 39, 65, 148, 10,
+			123, 58, 173, 86, 224, 111, 186, 176, 207, 54,
+			182, 248, 169, 201, 7, 166, 75, 211, 211, 211,
+			24, 181, 13, 119, 137, 16, 198, 61, 35, 218,
+			12, 133, 192, 44, 222, 10, 69, 187, 238, 163,
+			33, 179, 61, 94, 24, 70, 78, 16, 241, 243,
+			188, 92, 46, 159, 235, 252, 38, 188, 122, 230,
+			75, 220, 145, 54, 147, 245, 87, 249, 89, 151,
+			150, 19, 159, 191, 240, 234, 49, 52, 41, 251,
+			210, 240, 185, 142, 70, 58, 32, 0, 77, 228,
+			223, 186, 1, 66, 186, 19, 119, 149, 143, 111,
+			235, 232, 49, 62, 205, 143, 31, 239, 196, 245,
+			56, 159, 158, 224, 47, 201, 102, 59, 80, 119,
+			234, 60, 127, 224, 220, 182, 175, 170, 235, 243,
+			113, 160, 104, 122, 90, 85, 122, 55, 23, 141,
+			80, 100, 8, 8, 99, 100, 143, 239, 72, 193,
+			99, 183, 167, 96, 242, 54, 20, 156, 218, 137,
+			130, 187, 138, 198, 38, 224, 169, 100, 198, 238,
+			93, 12, 118, 157, 236, 221, 133, 68, 126, 74,
+			207, 249, 249, 236, 156, 243, 83, 219, 152, 112,
+			46, 105, 164, 37, 32, 53, 235, 233, 6, 219,
+			196, 32, 105, 147, 229, 115, 70, 232, 210, 44,
+			78, 26, 156, 186, 253, 252, 38, 21, 31, 79,
+			87, 220, 165, 143, 83, 59, 247, 49, 185, 243,
+			12, 198, 174, 212, 106, 97, 128, 181, 180, 43,
+			245, 105, 58, 108, 215, 248, 18, 42, 214, 116,
+			72, 10, 244, 80, 90, 179, 118, 132, 76, 38,
+			207, 60, 240, 80, 233, 161, 71, 30, 6, 29,
+			1, 255, 103, 60, 242, 249, 169, 142, 194, 93,
+			194, 76, 79, 211, 170, 149, 114, 187, 62, 157,
+			9, 51, 61, 157, 9, 51, 61, 109, 13, 177,
+			255, 204, 208, 126, 215, 119, 82, 203, 254, 215,
+			84, 19, 123, 111, 1, 166, 244, 152, 88, 50,
+			40, 45, 108, 33, 111, 136, 16, 189, 65, 30,
+			247, 61, 17, 99, 11, 50, 91, 138, 60, 114,
+			56, 124, 154, 241, 155, 138, 87, 55, 149, 179,
+			3, 148, 175, 195, 91, 126, 232, 162, 187, 192,
+			15, 120, 28, 149, 186, 137, 4, 169, 138, 101,
+			126, 201, 15, 98, 217, 10, 145, 148, 84, 135,
+			126, 192, 155, 126, 0, 222, 46, 116, 185, 188,
+			40, 2, 95, 249, 103, 181, 51, 52, 131, 77,
+			30, 205, 86, 4, 139, 135, 231, 96, 20, 113,
+			205, 227, 142, 244, 136, 116, 208, 217, 57, 141,
+			153, 168, 23, 76, 97, 170, 96, 151, 40, 216,
+			59, 233, 211, 233, 40, 216, 59, 51, 81, 176,
+			119, 102, 162, 96, 239, 76, 69, 193, 254, 229,
+			91, 216, 185, 53, 55, 90, 111, 175, 224, 33,
+			189, 209, 174, 185, 248, 175, 201, 53, 127, 170,
+			230, 55, 155, 190, 39, 15, 238, 83, 50, 154,
+			4, 255, 105, 173, 192, 191, 149, 75, 36, 135,
+			5, 119, 12, 154, 217, 119, 112, 173, 20, 255,
+			158, 178, 161, 56, 50, 49, 43, 194, 90, 224,
+			182, 34, 63, 192, 200, 84, 32, 86, 221, 77,
+			21, 110, 82, 144, 101, 49, 19, 78, 136, 42,
+			204, 132, 127, 91, 167, 33, 160, 135, 1, 168,
+			104, 171, 37, 48, 200, 180, 247, 244, 96, 25,
+			41, 44, 75, 204, 224, 40, 169, 178, 48, 254,
+			219, 58, 202, 250, 224, 108, 41, 188, 72, 54,
+			50, 17, 95, 175, 42, 195, 42, 111, 98, 61,
+			241, 104, 198, 114, 119, 140, 220, 37, 149, 173,
+			55, 49, 51, 114, 214, 194, 177, 60, 55, 198,
+			123, 79, 31, 83, 148, 236, 48, 204, 242, 178,
+			179, 22, 98, 48, 171, 138, 45, 32, 234, 37,
+			143, 250, 55, 32, 76, 116, 67, 108, 70, 99,
+			221, 72, 217, 30, 89, 12, 105, 35, 115, 155,
+			145, 253, 8, 235, 137, 155, 90, 3, 204, 120,
+			94, 108, 41, 70, 193, 159, 224, 121, 66, 49,
+			85, 108, 146, 192, 89, 250, 38, 82, 124, 142,
+			153, 203, 98, 51, 178, 142, 179, 92, 195, 245,
+			4, 248, 172, 128, 198, 1, 69, 35, 124, 43,
+			95, 117, 61, 81, 149, 159, 237, 179, 204, 4,
+			48, 193, 72, 82, 24, 173, 131, 172, 167, 46,
+			26, 110, 211, 141, 68, 160, 35, 127, 113, 65,
+			241, 65, 150, 191, 128, 84, 195, 108, 250, 171,
+			171, 161, 136, 176, 185, 89, 85, 16, 204, 38,
+			248, 161, 176, 105, 95, 21, 255, 46, 254, 30,
+			97, 133, 89, 39, 114, 214, 2, 167, 25, 87,
+			32, 73, 5, 235, 1, 214, 221, 114, 130, 200,
+			117, 26, 42, 158, 186, 79, 17, 175, 91, 149,
+			23, 229, 231, 170, 174, 103, 95, 102, 221, 170,
+			12, 6, 34, 195, 169, 128, 114, 79, 85, 2,
+			208, 79, 232, 190, 40, 249, 101, 86, 241, 111,
+			40, 107, 56, 97, 132, 242, 84, 168, 226, 223,
+			197, 255, 145, 178, 194, 85, 21, 127, 180, 206,
+			178, 94, 152, 243, 27, 169, 161, 245, 158, 222,
+			191, 77, 68, 180, 82, 171, 50, 168, 189, 32,
+			71, 126, 148, 245, 73, 137, 86, 193, 93, 217,
+			113, 175, 44, 147, 161, 221, 163, 172, 79, 137,
+			117, 18, 255, 53, 171, 74, 212, 101, 21, 155,
+			21, 66, 8, 214, 121, 53, 41, 193, 102, 53,
+			134, 173, 163, 204, 140, 64, 126, 24, 146, 213,
+			155, 154, 224, 43, 93, 85, 252, 100, 157, 96,
+			121, 41, 86, 99, 189, 88, 105, 143, 170, 36,
+			103, 237, 74, 87, 85, 125, 182, 38, 101, 12,
+			23, 152, 59, 214, 135, 85, 251, 59, 120, 126,
+			165, 171, 26, 87, 185, 208, 195, 186, 213, 66,
+			42, 190, 38, 25, 38, 201, 45, 51, 179, 46,
+			194, 154, 226, 148, 189, 251, 186, 168, 98, 61,
+			107, 138, 117, 171, 240, 194, 24, 69, 49, 29,
+			73, 154, 32, 198, 178, 92, 59, 186, 150, 253,
+			191, 18, 150, 147, 115, 179, 155, 196, 165, 57,
+			70, 183, 113, 44, 59, 39, 198, 157, 231, 196,
+			220, 62, 39, 29, 82, 145, 187, 7, 169, 56,
+			57, 205, 88, 162, 175, 172, 2, 51, 151, 231,
+			222, 186, 60, 208, 101, 49, 150, 191, 80, 153,
+			159, 169, 62, 59, 64, 172, 62, 86, 0, 127,
+			197, 229, 234, 204, 147, 3, 244, 137, 143, 189,
+			153, 117, 91, 57, 179, 235, 125, 244, 182, 241,
+			239, 135, 254, 201, 198, 191, 247, 164, 227, 223,
+			240, 39, 177, 140, 158, 238, 19, 140, 51, 154,
+			235, 178, 204, 190, 174, 1, 98, 15, 171, 144,
+			129, 100, 51, 7, 133, 93, 230, 96, 64, 229,
+			192, 70, 233, 203, 245, 195, 209, 55, 135, 6,
+			212, 30, 218, 11, 59, 94, 78, 154, 47, 123,
+			104, 94, 67, 212, 50, 246, 244, 48, 85, 145,
+			88, 198, 94, 25, 216, 200, 201, 141, 114, 47,
+			45, 104, 136, 90, 198, 222, 222, 62, 85, 145,
+			90, 70, 63, 237, 87, 159, 160, 89, 63, 101,
+			26, 130, 111, 123, 246, 178, 23, 228, 97, 121,
+			180, 235, 45, 196, 22, 39, 49, 206, 30, 7,
+			12, 99, 129, 70, 119, 123, 153, 47, 99, 180,
+			75, 154, 2, 171, 109, 136, 230, 10, 60, 187,
+			186, 158, 244, 190, 131, 101, 4, 28, 100, 170,
+			233, 138, 8, 101, 208, 100, 205, 245, 52, 214,
+			84, 20, 126, 180, 112, 128, 253, 63, 113, 78,
+			211, 17, 58, 108, 127, 147, 176, 84, 248, 249,
+			68, 200, 165, 240, 242, 113, 136, 233, 67, 212,
+			107, 66, 165, 2, 132, 220, 15, 220, 53, 8,
+			125, 3, 102, 121, 98, 212, 6, 203, 133, 118,
+			212, 16, 1, 119, 189, 48, 114, 224, 212, 187,
+			129, 113, 231, 117, 39, 16, 220, 225, 139, 136,
+			16, 176, 204, 128, 41, 228, 214, 117, 23, 113,
+			220, 218, 225, 82, 134, 231, 1, 151, 30, 7,
+			136, 193, 217, 36, 84, 112, 7, 211, 67, 25,
+			29, 48, 209, 97, 218, 86, 61, 66, 71, 15,
+			165, 108, 213, 35, 180, 160, 33, 136, 96, 246,
+			164, 109, 213, 35, 214, 16, 251, 73, 156, 18,
+			53, 65, 45, 251, 123, 138, 55, 153, 0, 42,
+			186, 162, 179, 220, 209, 147, 164, 253, 204, 109,
+			207, 125, 161, 13, 167, 112, 23, 92, 13, 238,
+			234, 22, 119, 82, 56, 208, 8, 85, 34, 30,
+			214, 252, 150, 136, 125, 215, 173, 109, 156, 194,
+			206, 254, 177, 249, 4, 70, 225, 4, 61, 146,
+			54, 10, 39, 98, 62, 129, 172, 79, 244, 164,
+			141, 194, 137, 129, 65, 246, 38, 157, 191, 80,
+			162, 135, 236, 83, 219, 153, 164, 148, 57, 46,
+			188, 52, 179, 120, 42, 3, 161, 68, 39, 244,
+			49, 130, 230, 1, 83, 95, 42, 3, 161, 20,
+			103, 118, 64, 24, 179, 116, 224, 32, 251, 30,
+			209, 65, 205, 135, 168, 109, 255, 117, 167, 216,
+			238, 214, 163, 158, 30, 101, 104, 115, 199, 227,
+			87, 150, 151, 23, 249, 69, 89, 127, 18, 244,
+			166, 228, 176, 118, 239, 52, 157, 186, 224, 206,
+			45, 199, 109, 96, 162, 75, 228, 131, 244, 207,
+			250, 107, 76, 199, 16, 101, 168, 248, 133, 182,
+			8, 182, 146, 69, 198, 155, 34, 114, 228, 154,
+			173, 68, 114, 1, 56, 141, 208, 199, 46, 91,
+			173, 134, 171, 130, 146, 42, 214, 202, 116, 124,
+			6, 184, 134, 173, 210, 17, 213, 135, 104, 73,
+			11, 45, 196, 149, 31, 138, 39, 3, 84, 221,
+			67, 61, 233, 136, 234, 67, 99, 251, 217, 255,
+			66, 116, 72, 245, 60, 61, 105, 191, 190, 147,
+			208, 174, 56, 161, 72, 229, 67, 238, 192, 31,
+			207, 215, 49, 88, 121, 162, 135, 202, 219, 211,
+			80, 164, 254, 87, 246, 140, 43, 66, 8, 141,
+			7, 242, 224, 37, 220, 128, 165, 186, 112, 66,
+			222, 116, 107, 129, 62, 200, 201, 189, 45, 212,
+			122, 67, 199, 152, 51, 225, 216, 243, 244, 161,
+			56, 28, 155, 135, 193, 28, 72, 133, 99, 207,
+			31, 188, 63, 21, 142, 61, 63, 62, 193, 126,
+			91, 14, 59, 103, 25, 179, 244, 136, 253, 95,
+			192, 176, 29, 76, 123, 113, 60, 238, 4, 43,
+			110, 20, 0, 131, 159, 23, 91, 83, 114, 1,
+			69, 206, 26, 119, 194, 208, 175, 185, 78, 148,
+			68, 159, 220, 48, 61, 60, 169, 234, 102, 253,
+			181, 120, 174, 107, 142, 154, 106, 60, 192, 37,
+			85, 37, 79, 235, 28, 244, 175, 179, 166, 28,
+			122, 241, 112, 114, 166, 101, 206, 210, 243, 39,
+			21, 201, 185, 60, 16, 169, 231, 45, 71, 44,
+			99, 118, 84, 15, 21, 46, 182, 204, 30, 58,
+			204, 62, 36, 135, 147, 183, 140, 39, 232, 33,
+			251, 191, 36, 12, 194, 246, 161, 136, 74, 106,
+			82, 148, 234, 104, 52, 64, 164, 158, 243, 93,
+			15, 37, 106, 77, 96, 140, 172, 222, 14, 240,
+			6, 134, 78, 93, 136, 124, 30, 8, 153, 24,
+			200, 49, 194, 166, 116, 183, 206, 6, 194, 227,
+			110, 135, 160, 59, 17, 127, 76, 106, 160, 199,
+			167, 78, 77, 61, 6, 170, 231, 241, 50, 88,
+			247, 122, 80, 121, 211, 50, 159, 160, 179, 71,
+			20, 225, 249, 28, 144, 170, 69, 51, 79, 44,
+			227, 137, 30, 189, 104, 243, 134, 101, 60, 113,
+			224, 32, 43, 50, 152, 60, 115, 190, 235, 29,
+			196, 30, 229, 96, 74, 106, 2, 212, 122, 149,
+			187, 178, 9, 90, 102, 190, 208, 199, 206, 49,
+			211, 36, 144, 102, 180, 72, 223, 102, 216, 147,
+			184, 74, 221, 181, 182, 223, 14, 57, 216, 159,
+			28, 143, 24, 58, 22, 233, 6, 60, 62, 58,
+			132, 101, 142, 93, 19, 204, 44, 90, 100, 123,
+			217, 37, 150, 7, 8, 246, 185, 170, 57, 98,
+			63, 34, 23, 133, 235, 137, 19, 10, 151, 162,
+			160, 4, 162, 47, 143, 202, 117, 224, 161, 27,
+			133, 9, 218, 50, 199, 100, 12, 162, 210, 137,
+			170, 230, 226, 0, 219, 171, 11, 114, 128, 153,
+			37, 48, 184, 102, 122, 83, 223, 13, 203, 168,
+			14, 13, 179, 255, 153, 40, 74, 136, 101, 92,
+			55, 247, 219, 127, 64, 88, 154, 150, 184, 47,
+			117, 67, 4, 100, 176, 162, 76, 41, 41, 204,
+			162, 217, 138, 182, 212, 215, 56, 64, 235, 225,
+			142, 0, 99, 112, 189, 182, 136, 109, 38, 15,
+			89, 140, 246, 41, 28, 94, 24, 214, 212, 153,
+			29, 113, 159, 218, 128, 213, 153, 39, 117, 95,
+			160, 6, 224, 78, 253, 22, 108, 224, 42, 211,
+			133, 168, 60, 165, 235, 102, 117, 36, 30, 22,
+			108, 15, 215, 83, 195, 134, 169, 187, 222, 59,
+			156, 192, 134, 101, 92, 223, 55, 6, 230, 144,
+			137, 97, 152, 183, 83, 41, 234, 4, 88, 104,
+			188, 157, 50, 13, 229, 45, 227, 237, 189, 253,
+			26, 34, 150, 241, 246, 129, 17, 13, 25, 150,
+			241, 246, 177, 253, 236, 24, 163, 38, 181, 204,
+			155, 93, 130, 216, 99, 92, 158, 50, 118, 150,
+			32, 224, 238, 205, 194, 94, 118, 153, 153, 38,
+			198, 106, 86, 232, 176, 125, 22, 25, 189, 178,
+			21, 9, 165, 128, 52, 155, 20, 10, 165, 223,
+			86, 221, 32, 140, 116, 53, 101, 120, 161, 204,
+			83, 156, 245, 21, 122, 115, 0, 233, 162, 56,
+			231, 43, 74, 230, 101, 8, 104, 69, 217, 16,
+			20, 105, 94, 177, 134, 216, 56, 82, 64, 44,
+			163, 78, 7, 237, 3, 146, 130, 52, 225, 39,
+			194, 108, 23, 192, 225, 58, 93, 25, 86, 104,
+			128, 191, 117, 229, 147, 161, 200, 221, 186, 202,
+			75, 161, 200, 219, 122, 255, 0, 59, 197, 64,
+			11, 154, 235, 93, 191, 70, 236, 35, 92, 159,
+			168, 58, 24, 147, 178, 122, 77, 216, 56, 214,
+			11, 3, 172, 200, 76, 19, 227, 78, 207, 209,
+			65, 123, 68, 106, 126, 125, 8, 75, 83, 101,
+			224, 192, 159, 163, 235, 114, 135, 54, 112, 224,
+			207, 41, 170, 100, 164, 234, 57, 69, 149, 129,
+			3, 127, 174, 127, 128, 189, 7, 52, 152, 1,
+			171, 183, 69, 223, 101, 216, 65, 70, 142, 81,
+			186, 184, 58, 94, 199, 125, 150, 82, 123, 129,
+			84, 110, 114, 33, 10, 30, 8, 149, 169, 182,
+			5, 194, 203, 84, 74, 80, 103, 102, 33, 154,
+			196, 26, 153, 74, 46, 52, 80, 5, 180, 216,
+			32, 115, 88, 30, 32, 24, 111, 219, 28, 177,
+			171, 114, 221, 225, 57, 172, 36, 183, 57, 88,
+			240, 184, 17, 128, 163, 174, 20, 31, 97, 98,
+			150, 172, 6, 206, 90, 83, 120, 177, 220, 64,
+			127, 44, 221, 33, 44, 19, 67, 105, 135, 182,
+			217, 146, 25, 113, 134, 210, 14, 109, 147, 37,
+			48, 177, 140, 118, 239, 64, 2, 27, 150, 209,
+			30, 26, 102, 101, 69, 35, 177, 140, 77, 115,
+			216, 62, 34, 173, 26, 247, 197, 120, 243, 205,
+			12, 145, 199, 29, 130, 212, 108, 154, 237, 145,
+			24, 33, 200, 205, 102, 170, 67, 144, 156, 205,
+			222, 254, 4, 54, 44, 99, 211, 26, 98, 203,
+			170, 67, 106, 25, 47, 153, 150, 61, 151, 36,
+			232, 69, 169, 179, 6, 248, 46, 182, 205, 150,
+			230, 2, 156, 232, 156, 52, 227, 19, 178, 192,
+			176, 123, 201, 220, 28, 142, 187, 133, 253, 249,
+			37, 179, 144, 192, 196, 50, 94, 234, 217, 147,
+			192, 134, 101, 188, 52, 48, 136, 234, 194, 128,
+			143, 239, 166, 163, 74, 4, 97, 136, 239, 166,
+			239, 50, 148, 152, 65, 94, 237, 187, 105, 143,
+			134, 160, 42, 27, 212, 144, 97, 25, 239, 30,
+			30, 97, 95, 132, 116, 104, 211, 202, 255, 6,
+			233, 250, 19, 66, 236, 79, 145, 147, 140, 207,
+			120, 48, 229, 238, 45, 183, 222, 118, 146, 12,
+			196, 173, 216, 242, 137, 211, 220, 80, 49, 180,
+			33, 27, 73, 158, 177, 162, 192, 241, 66, 204,
+			236, 128, 237, 49, 182, 212, 120, 37, 74, 204,
+			77, 148, 220, 144, 241, 112, 221, 111, 55, 234,
+			176, 53, 199, 247, 18, 19, 165, 140, 149, 55,
+			163, 29, 179, 122, 51, 139, 21, 78, 155, 134,
+			9, 59, 230, 111, 144, 194, 0, 123, 13, 22,
+			20, 4, 117, 205, 151, 9, 61, 101, 127, 56,
+			99, 243, 106, 11, 13, 213, 90, 156, 37, 173,
+			149, 162, 30, 156, 250, 238, 134, 42, 158, 24,
+			249, 219, 73, 64, 227, 176, 24, 155, 110, 69,
+			105, 61, 132, 126, 227, 150, 50, 30, 162, 36,
+			78, 164, 251, 137, 83, 97, 46, 198, 29, 246,
+			179, 28, 16, 107, 90, 249, 151, 9, 253, 13,
+			98, 225, 44, 154, 160, 230, 129, 124, 91, 131,
+			4, 192, 3, 199, 53, 104, 0, 56, 113, 82,
+			154, 247, 160, 197, 205, 223, 33, 212, 182, 191,
+			174, 198, 170, 114, 157, 185, 202, 10, 77, 78,
+			78, 139, 59, 29, 83, 245, 65, 44, 62, 49,
+			201, 147, 152, 28, 181, 27, 106, 195, 117, 139,
+			59, 96, 153, 202, 25, 135, 141, 50, 16, 250,
+			92, 173, 252, 66, 12, 79, 175, 234, 42, 109,
+			194, 41, 117, 148, 85, 7, 5, 125, 220, 171,
+			11, 112, 216, 59, 145, 224, 109, 207, 105, 174,
+			40, 123, 165, 1, 39, 6, 63, 168, 11, 181,
+			169, 75, 246, 16, 211, 202, 255, 14, 161, 47,
+			147, 83, 138, 1, 36, 135, 35, 46, 104, 16,
+			25, 208, 51, 162, 65, 3, 192, 177, 253, 236,
+			111, 36, 123, 168, 101, 126, 20, 216, 243, 149,
+			219, 177, 199, 141, 66, 117, 80, 220, 137, 61,
+			157, 188, 81, 172, 128, 245, 172, 6, 159, 29,
+			59, 156, 53, 21, 179, 193, 138, 144, 136, 25,
+			135, 51, 232, 93, 51, 34, 230, 67, 230, 236,
+			171, 77, 110, 201, 25, 106, 90, 249, 143, 18,
+			250, 59, 68, 75, 10, 205, 225, 96, 53, 103,
+			64, 52, 62, 154, 112, 134, 26, 0, 142, 237,
+			103, 127, 69, 145, 51, 134, 101, 126, 130, 208,
+			81, 251, 139, 84, 45, 146, 14, 19, 39, 179,
+			213, 235, 69, 135, 138, 64, 174, 210, 148, 116,
+			32, 171, 196, 102, 116, 54, 227, 125, 145, 38,
+			87, 58, 59, 95, 226, 82, 27, 86, 29, 43,
+			148, 249, 85, 85, 205, 173, 97, 138, 243, 154,
+			235, 201, 0, 158, 19, 225, 30, 3, 23, 140,
+			209, 24, 200, 34, 79, 155, 40, 219, 141, 18,
+			197, 176, 184, 39, 121, 96, 140, 247, 253, 44,
+			170, 12, 137, 137, 134, 94, 142, 81, 234, 50,
+			76, 165, 197, 218, 146, 66, 73, 158, 154, 13,
+			195, 180, 242, 159, 32, 244, 163, 241, 108, 24,
+			57, 100, 176, 158, 13, 131, 0, 216, 51, 168,
+			65, 100, 255, 240, 8, 139, 96, 50, 10, 93,
+			86, 254, 83, 132, 126, 158, 24, 118, 93, 206,
+			134, 102, 184, 34, 43, 190, 193, 32, 169, 130,
+			205, 31, 221, 128, 110, 200, 91, 126, 171, 45,
+			211, 43, 48, 127, 29, 142, 237, 140, 55, 157,
+			168, 182, 174, 21, 215, 137, 144, 223, 76, 69,
+			111, 110, 170, 243, 137, 89, 0, 213, 242, 41,
+			82, 232, 103, 83, 40, 17, 166, 101, 126, 154,
+			152, 67, 246, 81, 156, 76, 37, 184, 103, 113,
+			130, 66, 157, 107, 13, 106, 89, 153, 60, 38,
+			28, 58, 161, 133, 30, 34, 168, 225, 79, 147,
+			158, 61, 26, 52, 0, 28, 176, 88, 9, 177,
+			231, 44, 243, 179, 196, 220, 103, 31, 206, 90,
+			165, 103, 145, 189, 60, 20, 104, 54, 196, 168,
+			115, 121, 172, 206, 52, 72, 0, 236, 213, 220,
+			203, 25, 0, 14, 143, 178, 83, 136, 58, 111,
+			153, 127, 76, 204, 3, 246, 161, 78, 203, 238,
+			108, 92, 16, 198, 152, 243, 178, 118, 159, 6,
+			9, 128, 123, 244, 42, 201, 27, 0, 142, 217,
+			236, 135, 148, 65, 142, 115, 254, 207, 8, 56,
+			126, 237, 255, 131, 74, 191, 99, 37, 190, 63,
+			225, 233, 187, 20, 94, 228, 3, 228, 68, 147,
+			129, 8, 35, 181, 83, 96, 138, 188, 84, 33,
+			233, 205, 3, 53, 2, 128, 178, 173, 19, 8,
+			190, 38, 60, 17, 224, 252, 173, 108, 225, 140,
+			201, 155, 34, 110, 24, 117, 30, 82, 209, 207,
+			229, 197, 46, 145, 206, 172, 116, 30, 10, 84,
+			70, 50, 13, 85, 159, 2, 99, 13, 190, 26,
+			64, 218, 103, 57, 177, 232, 64, 74, 90, 202,
+			249, 121, 2, 181, 142, 91, 147, 251, 125, 156,
+			200, 0, 138, 81, 246, 80, 82, 190, 54, 117,
+			50, 114, 155, 2, 151, 119, 228, 115, 116, 196,
+			33, 114, 216, 25, 229, 212, 102, 207, 14, 59,
+			17, 188, 210, 240, 87, 212, 238, 13, 115, 251,
+			103, 176, 123, 127, 29, 84, 54, 36, 80, 153,
+			95, 37, 244, 136, 253, 37, 165, 178, 119, 8,
+			94, 36, 219, 106, 10, 101, 167, 234, 214, 43,
+			27, 38, 35, 246, 212, 168, 125, 105, 39, 156,
+			250, 45, 5, 71, 185, 50, 192, 147, 193, 56,
+			228, 200, 111, 115, 38, 67, 175, 218, 125, 5,
+			211, 86, 247, 55, 60, 184, 48, 161, 143, 195,
+			216, 177, 82, 13, 57, 220, 225, 191, 74, 232,
+			159, 169, 29, 62, 135, 59, 252, 87, 9, 29,
+			209, 32, 1, 112, 212, 214, 160, 1, 224, 161,
+			195, 236, 191, 71, 126, 24, 93, 86, 254, 91,
+			132, 254, 59, 98, 216, 239, 39, 140, 203, 201,
+			148, 243, 237, 122, 107, 13, 61, 202, 148, 137,
+			38, 146, 91, 62, 112, 161, 195, 71, 175, 203,
+			106, 70, 64, 212, 198, 85, 226, 194, 169, 173,
+			243, 154, 31, 200, 139, 104, 117, 245, 128, 131,
+			195, 82, 71, 98, 30, 122, 78, 43, 92, 247,
+			113, 228, 219, 29, 92, 114, 137, 230, 224, 12,
+			97, 126, 139, 176, 126, 246, 235, 112, 124, 207,
+			225, 41, 194, 252, 46, 49, 71, 237, 23, 216,
+			110, 167, 74, 161, 146, 201, 19, 116, 186, 131,
+			170, 168, 249, 65, 189, 178, 32, 199, 165, 143,
+			47, 44, 17, 231, 109, 52, 163, 56, 232, 237,
+			104, 144, 117, 75, 18, 76, 43, 255, 93, 98,
+			126, 139, 12, 178, 126, 93, 148, 67, 178, 88,
+			82, 64, 160, 160, 55, 85, 195, 128, 2, 48,
+			142, 169, 26, 9, 177, 204, 31, 16, 115, 204,
+			126, 253, 158, 247, 202, 55, 108, 107, 148, 91,
+			14, 238, 143, 255, 124, 182, 70, 61, 13, 96,
+			196, 253, 128, 152, 223, 37, 163, 49, 147, 193,
+			140, 251, 65, 122, 26, 8, 50, 185, 119, 40,
+			41, 48, 160, 96, 116, 31, 251, 67, 45, 80,
+			212, 50, 127, 76, 204, 131, 246, 239, 167, 60,
+			182, 146, 68, 149, 73, 136, 143, 172, 128, 116,
+			36, 177, 157, 219, 4, 30, 86, 182, 98, 167,
+			101, 228, 167, 67, 15, 177, 173, 30, 75, 155,
+			178, 194, 28, 165, 0, 88, 236, 211, 212, 150,
+			95, 42, 106, 163, 7, 13, 246, 217, 143, 137,
+			249, 3, 50, 22, 15, 9, 54, 193, 31, 167,
+			7, 13, 146, 245, 99, 210, 187, 47, 41, 48,
+			160, 192, 62, 192, 94, 209, 131, 54, 44, 243,
+			167, 48, 232, 247, 168, 65, 167, 79, 49, 250,
+			248, 29, 159, 209, 222, 232, 225, 162, 77, 30,
+			47, 116, 61, 50, 176, 117, 126, 74, 204, 31,
+			147, 131, 49, 221, 96, 237, 252, 52, 61, 50,
+			176, 119, 126, 154, 30, 153, 129, 3, 177, 15,
+			176, 255, 95, 143, 204, 180, 204, 159, 17, 115,
+			210, 254, 219, 187, 25, 89, 9, 36, 55, 229,
+			32, 15, 211, 227, 203, 28, 213, 146, 144, 224,
+			137, 48, 115, 74, 83, 118, 83, 106, 236, 168,
+			82, 226, 225, 199, 85, 211, 189, 103, 76, 246,
+			221, 88, 200, 118, 224, 225, 138, 124, 222, 40,
+			197, 54, 56, 103, 255, 140, 152, 63, 77, 177,
+			13, 44, 168, 159, 17, 51, 85, 64, 160, 224,
+			208, 120, 82, 96, 64, 193, 169, 18, 251, 91,
+			138, 187, 35, 177, 204, 247, 82, 122, 200, 254,
+			223, 41, 227, 51, 41, 141, 238, 132, 53, 129,
+			186, 112, 18, 143, 14, 162, 174, 118, 10, 101,
+			57, 134, 73, 86, 23, 134, 96, 148, 74, 151,
+			77, 61, 182, 211, 30, 13, 12, 126, 70, 159,
+			62, 224, 4, 43, 167, 37, 139, 86, 58, 219,
+			139, 114, 214, 138, 37, 94, 76, 135, 247, 139,
+			37, 198, 139, 233, 96, 126, 81, 154, 15, 197,
+			84, 244, 94, 77, 75, 24, 123, 234, 227, 129,
+			232, 205, 108, 53, 144, 218, 118, 107, 123, 239,
+			218, 79, 86, 23, 171, 224, 222, 63, 199, 93,
+			57, 87, 45, 45, 11, 177, 45, 197, 120, 43,
+			240, 107, 24, 120, 241, 121, 109, 221, 247, 67,
+			193, 157, 4, 181, 222, 197, 208, 133, 242, 94,
+			74, 99, 48, 15, 96, 239, 128, 6, 145, 251,
+			131, 99, 26, 52, 0, 60, 112, 48, 78, 249,
+			250, 217, 127, 69, 24, 107, 248, 107, 97, 199,
+			195, 7, 233, 215, 16, 236, 95, 38, 41, 236,
+			206, 79, 40, 252, 37, 97, 236, 178, 136, 170,
+			242, 130, 57, 92, 157, 107, 5, 254, 115, 162,
+			22, 169, 180, 34, 13, 66, 238, 77, 203, 137,
+			214, 117, 154, 23, 252, 13, 153, 59, 72, 169,
+			74, 200, 145, 64, 146, 207, 99, 226, 229, 59,
+			9, 192, 155, 4, 176, 161, 164, 30, 14, 200,
+			85, 123, 160, 4, 31, 13, 128, 247, 12, 224,
+			113, 1, 249, 53, 143, 95, 11, 13, 127, 77,
+			126, 188, 159, 237, 245, 124, 239, 70, 98, 166,
+			98, 10, 86, 161, 186, 199, 243, 189, 36, 130,
+			81, 124, 138, 245, 46, 59, 110, 227, 13, 28,
+			75, 241, 147, 132, 245, 34, 123, 228, 189, 251,
+			219, 224, 44, 233, 246, 50, 3, 106, 116, 231,
+			71, 31, 52, 143, 116, 222, 141, 113, 151, 121,
+			55, 247, 49, 19, 228, 100, 204, 228, 70, 42,
+			213, 71, 43, 143, 42, 126, 44, 254, 110, 142,
+			245, 61, 5, 161, 180, 55, 120, 54, 81, 82,
+			212, 115, 16, 18, 128, 246, 16, 9, 25, 203,
+			169, 164, 63, 200, 93, 58, 194, 122, 155, 206,
+			230, 141, 64, 132, 237, 70, 20, 170, 73, 100,
+			77, 103, 179, 42, 75, 182, 101, 248, 177, 237,
+			25, 126, 151, 178, 137, 131, 50, 9, 234, 126,
+			205, 203, 244, 224, 82, 105, 132, 151, 220, 70,
+			36, 130, 76, 50, 225, 52, 203, 121, 98, 67,
+			4, 99, 125, 119, 204, 18, 148, 21, 161, 133,
+			15, 143, 249, 140, 237, 185, 115, 11, 172, 184,
+			253, 169, 145, 189, 59, 60, 53, 114, 90, 37,
+			30, 246, 227, 196, 29, 222, 113, 36, 157, 41,
+			135, 15, 198, 111, 128, 12, 96, 226, 228, 193,
+			157, 91, 5, 104, 204, 197, 47, 132, 156, 99,
+			3, 157, 44, 177, 78, 164, 115, 4, 119, 204,
+			192, 148, 223, 127, 241, 236, 197, 99, 172, 91,
+			17, 2, 201, 81, 23, 22, 150, 175, 12, 116,
+			89, 221, 204, 120, 118, 110, 105, 128, 88, 121,
+			70, 231, 23, 6, 104, 241, 21, 202, 246, 40,
+			226, 239, 184, 144, 30, 102, 221, 202, 90, 82,
+			41, 102, 157, 195, 151, 24, 212, 32, 170, 186,
+			114, 44, 146, 70, 34, 146, 246, 135, 8, 203,
+			203, 122, 177, 196, 147, 148, 196, 255, 227, 174,
+			217, 67, 140, 193, 127, 111, 36, 203, 167, 15,
+			114, 49, 195, 26, 94, 2, 46, 254, 11, 194,
+			122, 175, 186, 225, 93, 168, 222, 3, 172, 7,
+			200, 189, 1, 126, 100, 53, 3, 5, 40, 184,
+			224, 132, 98, 151, 85, 171, 153, 97, 102, 215,
+			167, 90, 91, 112, 161, 91, 189, 47, 163, 22,
+			205, 130, 215, 216, 2, 53, 171, 236, 252, 27,
+			74, 254, 242, 82, 205, 170, 210, 69, 44, 76,
+			37, 242, 117, 227, 18, 87, 80, 231, 250, 47,
+			116, 174, 255, 226, 191, 167, 172, 79, 142, 248,
+			142, 66, 112, 219, 33, 239, 48, 211, 214, 227,
+			140, 225, 225, 213, 3, 107, 107, 204, 204, 174,
+			182, 116, 167, 229, 139, 186, 90, 53, 213, 194,
+			254, 62, 97, 61, 241, 151, 56, 167, 153, 164,
+			114, 154, 31, 97, 38, 234, 36, 138, 75, 233,
+			190, 219, 227, 46, 227, 226, 194, 6, 137, 148,
+			25, 247, 34, 101, 230, 221, 73, 89, 113, 130,
+			153, 58, 53, 113, 113, 6, 87, 31, 99, 249,
+			165, 229, 234, 220, 204, 147, 3, 196, 234, 101,
+			221, 139, 213, 133, 39, 230, 46, 46, 15, 208,
+			226, 31, 82, 182, 103, 73, 128, 233, 246, 139,
+			109, 16, 150, 202, 95, 85, 124, 143, 148, 80,
+			185, 107, 158, 31, 136, 27, 53, 152, 42, 185,
+			73, 48, 89, 116, 49, 61, 89, 247, 180, 83,
+			168, 10, 232, 150, 20, 225, 88, 119, 92, 225,
+			73, 89, 146, 232, 119, 118, 207, 250, 189, 247,
+			46, 245, 59, 36, 202, 238, 213, 236, 186, 163,
+			192, 62, 210, 169, 181, 14, 233, 105, 206, 162,
+			184, 43, 181, 245, 56, 203, 225, 64, 225, 35,
+			28, 237, 213, 51, 6, 248, 247, 182, 132, 87,
+			138, 223, 210, 9, 175, 118, 112, 91, 173, 247,
+			48, 235, 214, 140, 237, 80, 176, 29, 164, 34,
+			13, 85, 93, 25, 82, 203, 163, 160, 237, 213,
+			240, 221, 44, 169, 109, 146, 130, 211, 191, 69,
+			153, 9, 89, 140, 86, 153, 25, 151, 33, 145,
+			92, 35, 77, 172, 75, 123, 40, 83, 166, 120,
+			58, 205, 76, 176, 218, 172, 248, 99, 202, 134,
+			219, 185, 197, 131, 44, 135, 91, 129, 53, 188,
+			211, 198, 104, 143, 236, 184, 95, 88, 15, 64,
+			222, 124, 24, 37, 253, 164, 148, 175, 61, 188,
+			211, 114, 182, 30, 97, 121, 201, 18, 107, 164,
+			147, 69, 178, 217, 232, 206, 156, 123, 226, 247,
+			219, 50, 7, 248, 7, 244, 159, 255, 27, 88,
+			195, 73, 14, 240, 44, 254, 73, 45, 131, 117,
+			143, 179, 63, 167, 50, 205, 118, 168, 235, 2,
+			177, 63, 79, 121, 50, 207, 218, 29, 164, 94,
+			173, 82, 143, 85, 181, 131, 228, 142, 78, 59,
+			20, 1, 52, 224, 66, 61, 113, 21, 39, 229,
+			196, 173, 178, 110, 62, 177, 233, 134, 81, 88,
+			226, 142, 202, 219, 76, 117, 38, 115, 97, 219,
+			181, 154, 16, 120, 41, 117, 205, 9, 234, 120,
+			87, 201, 95, 133, 68, 194, 248, 137, 131, 44,
+			94, 124, 110, 22, 31, 178, 137, 19, 180, 128,
+			134, 121, 63, 18, 25, 239, 144, 36, 15, 31,
+			58, 8, 68, 212, 14, 60, 190, 10, 234, 4,
+			112, 168, 219, 80, 9, 222, 186, 140, 132, 202,
+			103, 21, 152, 70, 236, 54, 220, 104, 139, 235,
+			7, 117, 61, 167, 1, 30, 191, 48, 10, 28,
+			215, 203, 60, 224, 53, 84, 176, 88, 89, 103,
+			14, 143, 208, 17, 136, 171, 164, 152, 168, 20,
+			141, 140, 29, 99, 81, 230, 206, 216, 8, 29,
+			26, 78, 229, 225, 142, 100, 242, 112, 71, 122,
+			6, 82, 121, 184, 35, 67, 195, 236, 179, 84,
+			231, 225, 30, 166, 150, 253, 42, 197, 174, 64,
+			59, 108, 247, 216, 66, 143, 107, 34, 137, 120,
+			131, 24, 201, 33, 226, 241, 91, 231, 243, 169,
+			202, 18, 135, 228, 248, 210, 149, 153, 211, 15,
+			61, 12, 190, 190, 117, 233, 80, 150, 85, 89,
+			186, 46, 160, 93, 242, 155, 130, 183, 35, 96,
+			148, 43, 228, 163, 18, 171, 174, 87, 231, 45,
+			39, 12, 101, 64, 3, 37, 214, 145, 62, 117,
+			213, 31, 118, 20, 97, 254, 103, 13, 189, 170,
+			161, 223, 20, 76, 207, 129, 27, 133, 92, 94,
+			127, 70, 247, 227, 22, 82, 237, 183, 144, 125,
+			128, 86, 227, 4, 50, 145, 62, 215, 11, 35,
+			225, 212, 101, 46, 76, 32, 162, 192, 21, 183,
+			132, 156, 101, 249, 228, 179, 27, 101, 46, 121,
+			29, 166, 35, 35, 169, 124, 222, 195, 153, 124,
+			222, 195, 153, 124, 222, 195, 3, 131, 172, 162,
+			243, 121, 143, 210, 65, 251, 177, 36, 37, 68,
+			77, 229, 142, 207, 44, 65, 30, 153, 126, 24,
+			78, 202, 158, 168, 103, 158, 24, 59, 74, 15,
+			91, 169, 39, 198, 142, 210, 188, 134, 136, 101,
+			28, 237, 238, 75, 37, 248, 30, 237, 31, 80,
+			57, 197, 240, 78, 18, 181, 84, 78, 177, 235,
+			185, 232, 178, 79, 135, 134, 164, 131, 212, 143,
+			153, 144, 201, 156, 61, 70, 143, 14, 166, 50,
+			103, 143, 197, 119, 219, 240, 61, 166, 194, 158,
+			84, 230, 236, 177, 129, 65, 246, 255, 82, 157,
+			57, 91, 162, 251, 236, 239, 74, 49, 107, 58,
+			155, 110, 179, 221, 76, 57, 195, 193, 13, 16,
+			170, 62, 219, 129, 87, 214, 15, 42, 201, 164,
+			33, 233, 164, 209, 73, 190, 176, 98, 89, 106,
+			9, 65, 51, 76, 240, 67, 238, 101, 94, 122,
+			82, 92, 149, 119, 20, 99, 254, 169, 188, 13,
+			175, 161, 87, 116, 24, 191, 99, 133, 141, 202,
+			124, 70, 63, 184, 13, 32, 54, 143, 117, 4,
+			82, 3, 26, 135, 169, 198, 220, 137, 120, 67,
+			56, 97, 132, 151, 20, 129, 143, 227, 226, 150,
+			240, 184, 187, 10, 53, 111, 185, 126, 35, 126,
+			123, 9, 147, 142, 18, 194, 39, 228, 149, 200,
+			144, 241, 38, 92, 148, 119, 234, 242, 37, 24,
+			57, 27, 33, 140, 83, 221, 85, 215, 175, 163,
+			136, 77, 80, 113, 50, 111, 192, 13, 83, 152,
+			50, 73, 190, 37, 122, 204, 74, 189, 185, 84,
+			162, 221, 169, 36, 223, 82, 193, 74, 37, 249,
+			150, 70, 70, 217, 127, 67, 117, 146, 239, 25,
+			58, 106, 255, 231, 187, 205, 16, 170, 76, 140,
+			177, 132, 89, 13, 20, 167, 160, 197, 105, 15,
+			114, 210, 60, 95, 61, 44, 144, 154, 169, 216,
+			101, 39, 167, 178, 156, 109, 203, 160, 113, 114,
+			201, 52, 70, 147, 126, 210, 76, 99, 136, 167,
+			51, 81, 73, 201, 51, 52, 140, 175, 10, 8,
+			103, 55, 118, 186, 246, 17, 198, 236, 196, 74,
+			168, 94, 188, 173, 244, 248, 50, 57, 198, 103,
+			104, 105, 159, 206, 35, 70, 30, 117, 167, 114,
+			140, 207, 20, 6, 83, 57, 198, 103, 134, 71,
+			216, 123, 76, 157, 99, 60, 67, 109, 251, 167,
+			70, 230, 113, 54, 124, 246, 121, 93, 168, 173,
+			71, 235, 245, 88, 234, 81, 226, 83, 145, 216,
+			132, 28, 62, 195, 51, 229, 178, 225, 120, 93,
+			172, 58, 237, 70, 52, 33, 185, 226, 70, 24,
+			22, 214, 47, 185, 134, 169, 27, 36, 109, 201,
+			111, 198, 101, 52, 26, 196, 46, 140, 252, 22,
+			200, 104, 234, 9, 32, 129, 89, 228, 169, 8,
+			177, 240, 112, 6, 209, 57, 44, 53, 122, 128,
+			19, 236, 48, 142, 73, 91, 94, 86, 103, 148,
+			249, 12, 159, 79, 251, 227, 98, 74, 145, 190,
+			64, 52, 253, 91, 234, 189, 60, 39, 138, 68,
+			192, 146, 215, 143, 96, 140, 112, 235, 87, 200,
+			43, 244, 37, 30, 58, 91, 157, 187, 16, 200,
+			17, 216, 0, 220, 95, 61, 203, 248, 219, 206,
+			148, 248, 131, 37, 254, 112, 137, 63, 242, 142,
+			221, 24, 4, 19, 173, 134, 124, 70, 211, 0,
+			140, 62, 43, 91, 191, 163, 132, 92, 104, 193,
+			56, 86, 68, 205, 105, 135, 130, 241, 135, 96,
+			128, 106, 116, 48, 160, 109, 115, 146, 25, 17,
+			78, 91, 154, 148, 76, 42, 247, 12, 61, 51,
+			154, 74, 229, 158, 161, 249, 84, 42, 247, 76,
+			247, 72, 42, 149, 123, 102, 108, 63, 251, 61,
+			34, 115, 185, 47, 117, 93, 35, 246, 203, 132,
+			167, 204, 224, 187, 52, 168, 160, 69, 98, 81,
+			241, 74, 164, 21, 28, 75, 82, 14, 101, 12,
+			140, 59, 124, 205, 5, 53, 149, 98, 176, 218,
+			127, 210, 247, 18, 146, 101, 174, 115, 200, 47,
+			21, 134, 88, 89, 39, 30, 95, 185, 123, 35,
+			5, 115, 147, 205, 43, 244, 82, 156, 128, 156,
+			131, 246, 133, 84, 114, 242, 149, 158, 129, 84,
+			114, 242, 21, 109, 164, 16, 216, 204, 158, 250,
+			149, 145, 114, 111, 70, 10, 65, 35, 229, 41,
+			122, 69, 243, 27, 140, 148, 167, 98, 126, 195,
+			84, 62, 165, 140, 20, 130, 70, 202, 83, 202,
+			72, 33, 96, 164, 44, 191, 33, 70, 10, 65,
+			35, 101, 153, 62, 101, 169, 126, 96, 151, 89,
+			86, 171, 128, 160, 145, 178, 172, 140, 20, 130,
+			70, 202, 114, 255, 0, 155, 151, 9, 233, 111,
+			237, 106, 16, 251, 2, 79, 157, 250, 146, 53,
+			144, 121, 2, 247, 78, 167, 10, 157, 186, 254,
+			214, 194, 16, 155, 211, 169, 235, 215, 233, 136,
+			253, 38, 190, 168, 132, 53, 121, 25, 23, 65,
+			188, 18, 166, 71, 26, 42, 246, 174, 8, 124,
+			62, 62, 242, 203, 44, 149, 184, 126, 157, 190,
+			117, 36, 149, 184, 126, 61, 147, 184, 126, 189,
+			103, 32, 149, 184, 126, 125, 104, 152, 125, 151,
+			232, 204, 245, 155, 244, 128, 253, 21, 210, 153,
+			236, 147, 236, 82, 74, 71, 43, 125, 222, 241,
+			62, 47, 44, 108, 181, 227, 37, 103, 143, 149,
+			45, 30, 138, 40, 210, 89, 133, 234, 195, 9,
+			72, 84, 68, 44, 58, 150, 9, 51, 170, 54,
+			94, 249, 19, 38, 145, 175, 62, 186, 161, 154,
+			110, 92, 21, 208, 234, 68, 200, 211, 57, 52,
+			73, 14, 185, 231, 71, 50, 19, 87, 38, 5,
+			196, 60, 1, 153, 187, 73, 175, 107, 158, 64,
+			66, 241, 77, 58, 144, 202, 180, 191, 57, 56,
+			154, 202, 180, 191, 185, 223, 102, 255, 70, 242,
+			132, 90, 198, 58, 189, 223, 254, 255, 36, 79,
+			196, 102, 203, 241, 32, 68, 187, 83, 222, 77,
+			236, 176, 137, 31, 29, 138, 132, 167, 127, 180,
+			228, 137, 165, 133, 121, 105, 29, 182, 155, 45,
+			189, 181, 200, 209, 166, 206, 140, 39, 194, 206,
+			145, 167, 223, 62, 213, 70, 70, 156, 41, 119,
+			142, 201, 55, 246, 54, 220, 80, 177, 39, 196,
+			167, 246, 220, 23, 69, 61, 38, 39, 110, 166,
+			95, 173, 83, 33, 197, 132, 114, 236, 146, 101,
+			238, 101, 81, 92, 34, 235, 244, 230, 1, 197,
+			22, 48, 29, 214, 105, 12, 193, 173, 130, 131,
+			92, 67, 134, 101, 172, 223, 119, 140, 253, 39,
+			76, 166, 103, 26, 207, 211, 251, 236, 211, 192,
+			163, 244, 35, 80, 146, 60, 12, 189, 106, 13,
+			81, 239, 48, 111, 36, 58, 195, 4, 12, 49,
+			148, 183, 140, 231, 123, 247, 107, 136, 88, 198,
+			243, 246, 97, 13, 65, 95, 71, 139, 236, 41,
+			188, 21, 145, 243, 187, 190, 76, 136, 61, 203,
+			211, 78, 151, 187, 220, 161, 176, 73, 231, 242,
+			132, 206, 252, 194, 48, 59, 3, 25, 234, 224,
+			81, 120, 129, 110, 26, 246, 253, 92, 197, 21,
+			178, 47, 92, 69, 170, 80, 222, 40, 148, 212,
+			203, 243, 243, 11, 221, 123, 217, 28, 228, 222,
+			203, 19, 116, 104, 238, 177, 31, 230, 23, 252,
+			104, 61, 121, 116, 4, 86, 85, 252, 234, 136,
+			242, 62, 110, 183, 41, 85, 138, 191, 58, 59,
+			135, 102, 33, 129, 169, 101, 132, 189, 125, 236,
+			77, 170, 27, 184, 114, 96, 246, 217, 19, 28,
+			220, 231, 73, 55, 119, 129, 153, 96, 211, 238,
+			4, 166, 150, 209, 102, 189, 49, 102, 106, 25,
+			27, 102, 175, 198, 124, 47, 52, 3, 81, 27,
+			102, 62, 129, 1, 85, 15, 99, 101, 125, 51,
+			229, 165, 187, 223, 181, 229, 45, 149, 151, 232,
+			166, 145, 186, 165, 242, 18, 45, 164, 110, 169,
+			188, 212, 51, 144, 186, 165, 242, 210, 208, 48,
+			251, 191, 11, 234, 166, 129, 249, 126, 66, 45,
+			251, 175, 10, 216, 151, 188, 226, 215, 114, 32,
+			241, 47, 18, 129, 14, 214, 227, 6, 169, 238,
+			56, 186, 190, 135, 219, 106, 216, 94, 9, 35,
+			55, 106, 71, 130, 59, 124, 173, 225, 175, 240,
+			241, 226, 201, 226, 4, 74, 82, 42, 251, 4,
+			154, 50, 30, 187, 240, 97, 11, 197, 12, 215,
+			18, 26, 244, 218, 44, 128, 50, 229, 192, 84,
+			2, 218, 116, 92, 79, 217, 174, 74, 68, 95,
+			104, 59, 13, 119, 21, 179, 210, 179, 238, 35,
+			216, 160, 213, 185, 70, 189, 62, 150, 244, 238,
+			135, 250, 184, 166, 23, 52, 159, 241, 120, 219,
+			91, 129, 45, 27, 175, 63, 54, 234, 53, 39,
+			168, 227, 144, 156, 86, 75, 56, 129, 126, 111,
+			77, 81, 172, 45, 46, 101, 120, 175, 248, 250,
+			181, 206, 86, 146, 13, 142, 155, 145, 228, 93,
+			220, 46, 44, 243, 226, 201, 147, 197, 120, 88,
+			78, 163, 145, 26, 86, 170, 90, 162, 250, 244,
+			17, 64, 242, 91, 226, 139, 239, 164, 169, 251,
+			25, 120, 123, 40, 90, 231, 161, 128, 89, 2,
+			77, 59, 94, 60, 85, 156, 72, 157, 120, 87,
+			4, 143, 228, 111, 33, 224, 233, 116, 85, 109,
+			155, 72, 172, 27, 34, 81, 169, 135, 208, 194,
+			179, 140, 115, 62, 201, 231, 240, 118, 221, 120,
+			177, 56, 145, 49, 155, 157, 248, 229, 244, 176,
+			44, 43, 158, 60, 57, 117, 106, 234, 228, 201,
+			59, 212, 90, 245, 253, 169, 21, 39, 184, 77,
+			197, 228, 49, 249, 162, 170, 92, 140, 111, 126,
+			119, 160, 152, 58, 53, 181, 226, 188, 184, 43,
+			34, 76, 159, 243, 226, 59, 76, 25, 148, 128,
+			138, 119, 78, 85, 157, 23, 87, 156, 23, 139,
+			124, 92, 148, 215, 202, 165, 184, 242, 212, 11,
+			237, 205, 169, 134, 223, 144, 221, 21, 39, 178,
+			100, 156, 186, 227, 88, 156, 219, 141, 228, 78,
+			131, 80, 24, 162, 13, 127, 50, 17, 61, 69,
+			247, 198, 186, 31, 10, 57, 18, 153, 160, 23,
+			159, 226, 161, 195, 34, 142, 11, 235, 72, 65,
+			132, 114, 24, 64, 150, 143, 187, 247, 156, 109,
+			169, 135, 160, 167, 123, 234, 78, 51, 152, 161,
+			216, 195, 116, 100, 208, 115, 234, 234, 82, 238,
+			253, 132, 190, 52, 162, 175, 50, 229, 80, 221,
+			20, 52, 136, 218, 71, 165, 150, 227, 237, 37,
+			243, 253, 100, 96, 144, 213, 81, 53, 81, 203,
+			252, 32, 161, 131, 246, 211, 105, 19, 87, 122,
+			130, 98, 11, 87, 81, 114, 66, 229, 241, 118,
+			154, 184, 177, 41, 238, 175, 242, 231, 48, 133,
+			30, 116, 197, 162, 60, 43, 244, 99, 167, 144,
+			207, 247, 65, 66, 223, 175, 210, 120, 13, 204,
+			230, 251, 32, 161, 121, 13, 18, 0, 187, 251,
+			52, 104, 0, 216, 63, 192, 222, 139, 183, 252,
+			0, 252, 48, 16, 249, 98, 66, 36, 158, 81,
+			195, 14, 103, 182, 36, 77, 158, 49, 98, 234,
+			156, 112, 39, 19, 133, 233, 39, 166, 99, 210,
+			235, 34, 85, 13, 108, 167, 68, 43, 134, 241,
+			64, 32, 125, 239, 195, 132, 126, 144, 12, 42,
+			82, 33, 121, 239, 195, 201, 64, 32, 117, 239,
+			195, 201, 64, 12, 164, 188, 127, 128, 253, 26,
+			142, 195, 180, 204, 143, 193, 62, 208, 226, 243,
+			98, 51, 42, 129, 218, 0, 165, 131, 119, 110,
+			75, 219, 223, 153, 119, 67, 165, 158, 212, 133,
+			47, 125, 1, 87, 43, 75, 52, 28, 88, 234,
+			241, 253, 86, 32, 110, 185, 126, 91, 55, 107,
+			136, 85, 176, 125, 86, 99, 234, 33, 139, 238,
+			99, 132, 126, 56, 166, 222, 204, 33, 69, 90,
+			84, 224, 200, 253, 177, 68, 84, 32, 127, 238,
+			99, 32, 42, 127, 35, 167, 33, 103, 153, 175,
+			18, 58, 6, 214, 250, 147, 113, 124, 82, 91,
+			59, 219, 253, 100, 146, 8, 189, 77, 39, 30,
+			77, 169, 138, 179, 24, 98, 15, 87, 187, 213,
+			130, 115, 42, 158, 245, 244, 206, 174, 25, 83,
+			47, 243, 43, 254, 134, 184, 37, 130, 146, 220,
+			28, 226, 57, 150, 157, 40, 47, 91, 252, 99,
+			20, 43, 160, 236, 87, 244, 118, 190, 75, 200,
+			65, 114, 38, 103, 90, 249, 87, 9, 253, 88,
+			44, 160, 57, 57, 216, 110, 13, 18, 0, 11,
+			67, 26, 52, 0, 28, 221, 199, 214, 145, 49,
+			121, 203, 252, 36, 161, 7, 236, 235, 250, 134,
+			26, 68, 161, 59, 167, 87, 255, 208, 95, 152,
+			102, 201, 54, 53, 157, 56, 168, 58, 46, 235,
+			73, 58, 243, 166, 149, 255, 36, 161, 175, 146,
+			49, 69, 73, 62, 135, 125, 235, 25, 132, 43,
+			25, 159, 212, 23, 151, 12, 188, 146, 241, 73,
+			184, 146, 49, 165, 110, 203, 230, 95, 39, 244,
+			127, 34, 134, 190, 250, 153, 188, 88, 195, 241,
+			4, 208, 136, 96, 234, 60, 109, 242, 96, 98,
+			250, 235, 132, 217, 236, 193, 248, 118, 171, 249,
+			41, 98, 30, 177, 143, 97, 251, 36, 119, 70,
+			41, 203, 14, 36, 131, 201, 133, 213, 252, 167,
+			136, 249, 186, 202, 222, 196, 162, 60, 98, 26,
+			78, 10, 240, 66, 205, 136, 157, 20, 24, 80,
+			112, 8, 12, 111, 224, 113, 55, 220, 136, 161,
+			199, 20, 31, 186, 77, 43, 255, 105, 28, 138,
+			26, 105, 55, 222, 167, 161, 122, 250, 186, 241,
+			62, 205, 208, 97, 13, 226, 125, 154, 163, 247,
+			177, 101, 196, 85, 128, 43, 48, 244, 132, 125,
+			137, 207, 99, 0, 235, 182, 83, 83, 211, 219,
+			252, 106, 164, 188, 131, 202, 240, 145, 25, 224,
+			201, 220, 20, 76, 43, 255, 89, 66, 63, 77,
+			142, 169, 94, 11, 120, 17, 135, 30, 208, 32,
+			94, 196, 57, 120, 84, 131, 120, 17, 231, 216,
+			113, 118, 13, 105, 234, 177, 204, 207, 1, 77,
+			151, 249, 2, 68, 220, 239, 142, 38, 245, 243,
+			118, 183, 33, 170, 199, 180, 242, 159, 35, 244,
+			179, 228, 132, 234, 182, 39, 143, 29, 105, 162,
+			122, 8, 128, 49, 81, 61, 6, 128, 199, 142,
+			179, 23, 145, 40, 102, 153, 95, 32, 244, 160,
+			221, 224, 149, 140, 44, 199, 75, 40, 246, 67,
+			104, 10, 35, 220, 231, 228, 110, 183, 237, 247,
+			117, 228, 179, 2, 44, 99, 132, 198, 230, 152,
+			170, 20, 83, 206, 76, 43, 255, 5, 66, 63,
+			23, 83, 206, 114, 72, 141, 22, 117, 70, 0,
+			236, 209, 23, 120, 153, 1, 224, 254, 3, 236,
+			251, 20, 73, 239, 181, 204, 63, 37, 148, 219,
+			223, 164, 240, 84, 71, 172, 166, 116, 130, 180,
+			179, 38, 211, 89, 245, 64, 240, 163, 212, 88,
+			176, 22, 165, 167, 25, 222, 248, 144, 185, 225,
+			96, 184, 198, 62, 180, 179, 140, 79, 242, 153,
+			212, 107, 31, 216, 14, 20, 184, 250, 169, 211,
+			26, 190, 128, 146, 98, 140, 19, 164, 162, 29,
+			210, 67, 1, 174, 20, 198, 185, 98, 85, 228,
+			172, 105, 23, 130, 82, 255, 9, 246, 150, 227,
+			6, 229, 184, 75, 101, 195, 120, 177, 223, 121,
+			220, 115, 27, 19, 114, 253, 221, 129, 4, 232,
+			46, 165, 252, 52, 21, 250, 183, 46, 110, 105,
+			87, 143, 179, 6, 29, 149, 118, 59, 0, 196,
+			51, 212, 107, 90, 249, 63, 37, 244, 11, 228,
+			160, 154, 131, 222, 60, 50, 93, 107, 159, 94,
+			2, 160, 186, 156, 99, 208, 94, 3, 192, 67,
+			71, 216, 51, 56, 65, 125, 150, 249, 23, 112,
+			137, 178, 194, 101, 46, 85, 74, 226, 147, 185,
+			72, 201, 124, 66, 165, 31, 224, 127, 189, 19,
+			81, 250, 215, 57, 98, 178, 250, 76, 43, 255,
+			23, 132, 254, 41, 209, 207, 11, 244, 229, 177,
+			171, 30, 13, 18, 0, 217, 128, 6, 13, 0,
+			135, 70, 216, 178, 188, 206, 253, 21, 210, 245,
+			247, 132, 216, 151, 120, 38, 101, 226, 110, 93,
+			110, 219, 78, 245, 250, 146, 245, 87, 72, 97,
+			132, 85, 244, 29, 235, 175, 17, 58, 98, 159,
+			187, 179, 219, 77, 158, 103, 100, 151, 89, 207,
+			91, 114, 3, 250, 107, 132, 126, 133, 236, 211,
+			119, 156, 115, 136, 188, 144, 186, 1, 253, 53,
+			210, 51, 144, 186, 1, 253, 53, 50, 52, 204,
+			46, 2, 33, 176, 31, 124, 157, 208, 191, 35,
+			134, 125, 70, 233, 242, 206, 39, 180, 241, 150,
+			84, 188, 145, 199, 35, 79, 46, 47, 153, 184,
+			71, 124, 29, 216, 9, 175, 11, 152, 114, 143,
+			248, 6, 49, 135, 237, 195, 104, 3, 234, 177,
+			53, 178, 46, 103, 181, 59, 152, 106, 119, 248,
+			6, 49, 191, 78, 44, 214, 175, 139, 114, 136,
+			131, 37, 5, 4, 10, 122, 251, 147, 2, 3,
+			10, 228, 15, 11, 202, 110, 137, 101, 126, 135,
+			152, 135, 236, 111, 17, 30, 255, 134, 88, 244,
+			31, 141, 83, 81, 51, 11, 174, 3, 125, 135,
+			152, 223, 32, 195, 49, 43, 32, 209, 254, 59,
+			196, 76, 184, 71, 144, 21, 67, 99, 73, 129,
+			1, 5, 7, 14, 178, 31, 81, 197, 44, 106,
+			153, 223, 39, 230, 9, 251, 219, 50, 144, 0,
+			230, 238, 100, 203, 169, 61, 47, 234, 187, 240,
+			43, 246, 52, 162, 98, 76, 81, 45, 58, 174,
+			148, 133, 218, 217, 135, 147, 159, 216, 53, 142,
+			250, 25, 235, 205, 212, 81, 92, 11, 214, 78,
+			172, 148, 183, 38, 74, 29, 215, 82, 180, 77,
+			200, 18, 143, 164, 102, 246, 46, 110, 204, 217,
+			109, 109, 119, 115, 102, 118, 120, 39, 183, 87,
+			79, 141, 38, 57, 41, 36, 180, 177, 52, 147,
+			244, 124, 193, 201, 231, 251, 196, 252, 14, 57,
+			20, 207, 6, 152, 139, 223, 39, 102, 170, 128,
+			64, 193, 225, 98, 82, 96, 64, 193, 253, 199,
+			217, 155, 213, 116, 25, 150, 249, 67, 184, 68,
+			55, 205, 151, 179, 189, 167, 38, 107, 118, 199,
+			201, 210, 116, 192, 193, 229, 135, 196, 252, 62,
+			57, 17, 247, 2, 71, 151, 31, 18, 179, 39,
+			41, 32, 80, 192, 18, 201, 50, 176, 223, 209,
+			125, 104, 54, 225, 123, 9, 63, 34, 244, 176,
+			125, 73, 61, 41, 36, 127, 183, 37, 163, 159,
+			241, 167, 180, 212, 229, 30, 149, 161, 144, 108,
+			66, 220, 79, 77, 187, 214, 31, 232, 40, 255,
+			17, 161, 49, 152, 7, 48, 190, 174, 76, 176,
+			83, 107, 127, 234, 141, 130, 31, 145, 131, 135,
+			216, 255, 25, 191, 81, 240, 19, 56, 81, 125,
+			149, 116, 88, 40, 219, 127, 180, 11, 127, 227,
+			75, 224, 139, 201, 29, 71, 147, 84, 214, 19,
+			140, 44, 76, 54, 205, 157, 180, 64, 32, 90,
+			194, 137, 245, 192, 83, 105, 17, 78, 253, 202,
+			140, 124, 202, 0, 150, 64, 242, 228, 51, 154,
+			58, 91, 113, 210, 66, 202, 155, 38, 240, 64,
+			24, 123, 207, 146, 71, 10, 126, 66, 232, 143,
+			200, 225, 212, 35, 5, 63, 201, 62, 82, 240,
+			147, 228, 206, 56, 8, 201, 79, 224, 180, 246,
+			170, 41, 175, 95, 255, 3, 233, 250, 3, 74,
+			236, 223, 53, 121, 42, 187, 239, 46, 157, 211,
+			208, 34, 189, 139, 201, 219, 196, 233, 66, 46,
+			60, 96, 89, 200, 29, 46, 127, 241, 209, 15,
+			182, 38, 163, 64, 8, 46, 211, 237, 162, 192,
+			1, 139, 206, 105, 232, 89, 87, 155, 27, 139,
+			239, 113, 43, 185, 13, 91, 78, 77, 108, 139,
+			122, 187, 171, 186, 1, 47, 54, 183, 224, 207,
+			34, 95, 119, 234, 177, 180, 21, 29, 229, 64,
+			42, 113, 7, 69, 17, 61, 83, 112, 76, 214,
+			199, 66, 180, 210, 212, 168, 207, 106, 100, 231,
+			139, 197, 18, 238, 63, 248, 135, 222, 220, 207,
+			242, 151, 100, 31, 239, 230, 227, 139, 169, 61,
+			56, 156, 216, 25, 135, 34, 104, 103, 76, 14,
+			32, 129, 137, 79, 188, 143, 119, 135, 198, 201,
+			226, 57, 181, 13, 207, 93, 162, 153, 58, 149,
+			69, 180, 226, 188, 248, 110, 62, 174, 127, 112,
+			51, 65, 22, 223, 32, 255, 7, 56, 216, 190,
+			75, 95, 32, 255, 57, 152, 38, 158, 244, 72,
+			39, 206, 112, 189, 102, 211, 207, 44, 169, 165,
+			22, 105, 101, 144, 241, 200, 135, 235, 254, 6,
+			154, 47, 10, 73, 178, 16, 165, 132, 225, 13,
+			113, 167, 6, 247, 202, 50, 183, 189, 127, 78,
+			232, 63, 144, 248, 122, 119, 14, 233, 41, 164,
+			110, 123, 255, 92, 91, 51, 242, 182, 247, 207,
+			193, 154, 249, 52, 209, 247, 251, 222, 71, 233,
+			62, 251, 227, 36, 241, 167, 203, 199, 8, 83,
+			244, 103, 164, 44, 17, 195, 16, 197, 37, 113,
+			67, 174, 56, 47, 234, 130, 83, 224, 178, 196,
+			109, 47, 182, 73, 227, 124, 124, 229, 27, 76,
+			251, 238, 138, 232, 154, 196, 187, 122, 48, 17,
+			232, 202, 238, 240, 207, 39, 35, 134, 237, 252,
+			125, 148, 254, 60, 30, 49, 56, 239, 222, 71,
+			227, 17, 19, 28, 83, 143, 149, 186, 53, 247,
+			62, 58, 50, 202, 110, 224, 128, 169, 101, 190,
+			76, 233, 160, 253, 148, 220, 57, 113, 213, 220,
+			139, 15, 47, 235, 183, 147, 207, 17, 164, 252,
+			118, 57, 84, 65, 47, 83, 250, 62, 186, 79,
+			17, 0, 42, 232, 101, 170, 220, 93, 57, 84,
+			65, 47, 83, 229, 238, 202, 161, 10, 122, 153,
+			246, 15, 176, 77, 36, 207, 176, 204, 87, 40,
+			181, 236, 231, 120, 229, 13, 112, 116, 73, 63,
+			23, 187, 11, 71, 87, 14, 119, 187, 87, 40,
+			125, 153, 14, 42, 202, 96, 175, 123, 37, 97,
+			43, 236, 116, 175, 80, 165, 58, 115, 184, 207,
+			189, 66, 7, 6, 89, 128, 116, 155, 150, 249,
+			1, 144, 163, 122, 226, 110, 76, 79, 48, 166,
+			203, 105, 71, 60, 95, 72, 12, 136, 204, 79,
+			61, 182, 178, 42, 128, 101, 159, 233, 76, 5,
+			171, 36, 197, 112, 242, 248, 0, 165, 175, 80,
+			61, 213, 224, 154, 251, 64, 194, 105, 56, 66,
+			124, 128, 118, 199, 95, 13, 0, 71, 70, 217,
+			127, 45, 69, 63, 103, 153, 31, 162, 212, 182,
+			127, 61, 245, 27, 152, 29, 108, 142, 127, 238,
+			80, 223, 190, 70, 86, 227, 50, 109, 183, 244,
+			54, 164, 52, 169, 138, 39, 131, 174, 230, 34,
+			8, 252, 0, 36, 75, 254, 202, 0, 254, 212,
+			161, 92, 194, 169, 92, 8, 55, 228, 190, 142,
+			49, 203, 1, 129, 71, 237, 67, 148, 126, 32,
+			22, 157, 156, 164, 81, 15, 8, 20, 207, 135,
+			104, 183, 150, 123, 240, 168, 125, 136, 142, 237,
+			103, 191, 41, 7, 148, 183, 204, 143, 80, 58,
+			100, 111, 113, 249, 248, 52, 202, 246, 227, 231,
+			249, 116, 9, 197, 22, 127, 241, 55, 166, 152,
+			251, 45, 161, 126, 254, 35, 242, 121, 248, 188,
+			219, 202, 186, 22, 164, 79, 146, 201, 56, 48,
+			234, 168, 29, 146, 229, 96, 255, 107, 57, 242,
+			209, 225, 212, 56, 192, 227, 246, 17, 74, 63,
+			68, 245, 27, 19, 224, 113, 251, 8, 85, 158,
+			193, 28, 122, 220, 62, 66, 11, 123, 53, 104,
+			0, 56, 104, 177, 207, 203, 113, 116, 91, 230,
+			199, 41, 29, 179, 63, 65, 118, 201, 38, 148,
+			34, 178, 131, 131, 244, 49, 28, 236, 189, 185,
+			68, 59, 61, 162, 236, 23, 118, 137, 230, 208,
+			197, 246, 113, 74, 63, 66, 135, 212, 208, 186,
+			115, 56, 22, 61, 112, 112, 177, 125, 156, 22,
+			226, 175, 6, 128, 163, 251, 88, 149, 193, 213,
+			236, 252, 171, 180, 235, 91, 20, 66, 227, 233,
+			91, 4, 119, 123, 138, 238, 52, 63, 96, 167,
+			2, 70, 191, 74, 11, 195, 236, 207, 129, 179,
+			121, 216, 170, 94, 163, 116, 196, 254, 28, 201,
+			238, 85, 29, 26, 47, 115, 104, 238, 200, 171,
+			199, 156, 119, 221, 113, 140, 192, 77, 94, 165,
+			64, 225, 90, 119, 69, 0, 55, 26, 182, 82,
+			57, 17, 24, 0, 84, 234, 76, 191, 119, 153,
+			44, 118, 217, 14, 234, 197, 1, 69, 141, 93,
+			52, 68, 51, 181, 3, 228, 113, 207, 123, 141,
+			210, 87, 213, 75, 116, 121, 220, 243, 94, 211,
+			170, 42, 143, 123, 222, 107, 84, 237, 121, 121,
+			220, 243, 94, 131, 252, 246, 135, 145, 9, 224,
+			159, 5, 85, 53, 142, 60, 136, 41, 85, 219,
+			30, 90, 228, 78, 152, 216, 175, 113, 175, 176,
+			239, 188, 78, 233, 107, 202, 87, 147, 199, 125,
+			231, 245, 164, 87, 130, 136, 213, 190, 147, 199,
+			125, 231, 117, 80, 55, 223, 149, 188, 167, 150,
+			249, 25, 208, 236, 127, 121, 111, 102, 119, 198,
+			74, 248, 101, 172, 238, 171, 18, 209, 27, 110,
+			116, 231, 113, 199, 251, 12, 165, 175, 43, 181,
+			149, 199, 29, 239, 51, 9, 99, 128, 227, 159,
+			209, 59, 71, 30, 119, 188, 207, 192, 206, 129,
+			211, 1, 14, 149, 63, 162, 244, 27, 212, 176,
+			143, 171, 147, 154, 124, 243, 198, 85, 225, 169,
+			172, 9, 166, 211, 107, 243, 232, 67, 249, 35,
+			202, 134, 216, 0, 203, 3, 104, 118, 89, 249,
+			207, 81, 243, 11, 52, 135, 103, 49, 44, 1,
+			71, 45, 116, 60, 192, 10, 178, 0, 86, 192,
+			31, 211, 124, 63, 27, 100, 61, 186, 132, 96,
+			17, 75, 23, 81, 40, 218, 179, 55, 213, 142,
+			88, 230, 231, 105, 126, 48, 85, 137, 200, 162,
+			190, 116, 17, 133, 162, 254, 129, 84, 59, 106,
+			153, 127, 66, 243, 86, 170, 18, 160, 250, 19,
+			154, 223, 147, 46, 194, 90, 3, 131, 236, 55,
+			137, 28, 11, 144, 249, 69, 106, 14, 219, 27,
+			248, 142, 155, 86, 1, 250, 87, 54, 113, 86,
+			178, 155, 101, 153, 243, 103, 214, 133, 7, 5,
+			43, 232, 46, 77, 102, 56, 86, 28, 112, 249,
+			49, 181, 30, 229, 111, 143, 38, 111, 13, 109,
+			115, 52, 229, 149, 163, 233, 139, 25, 174, 226,
+			98, 251, 34, 53, 89, 82, 64, 160, 160, 183,
+			63, 41, 48, 160, 192, 26, 98, 37, 53, 28,
+			98, 153, 95, 162, 166, 101, 31, 196, 25, 198,
+			216, 137, 210, 22, 201, 0, 146, 62, 97, 161,
+			125, 137, 154, 95, 164, 195, 49, 70, 56, 225,
+			126, 137, 154, 133, 164, 0, 81, 246, 236, 73,
+			10, 12, 40, 24, 24, 100, 191, 79, 85, 167,
+			212, 50, 191, 76, 205, 67, 246, 111, 209, 95,
+			206, 185, 165, 223, 11, 103, 210, 138, 89, 17,
+			16, 175, 105, 136, 58, 119, 19, 179, 222, 225,
+			242, 202, 100, 102, 60, 255, 84, 125, 98, 121,
+			229, 99, 249, 50, 53, 191, 68, 173, 152, 131,
+			96, 63, 124, 153, 154, 169, 2, 2, 5, 67,
+			99, 73, 129, 1, 5, 7, 14, 178, 15, 106,
+			30, 27, 150, 249, 215, 212, 60, 97, 255, 38,
+			77, 83, 160, 24, 125, 15, 30, 178, 95, 156,
+			199, 111, 152, 99, 173, 145, 210, 143, 191, 176,
+			95, 45, 97, 48, 152, 211, 127, 77, 205, 47,
+			211, 67, 49, 251, 140, 60, 114, 43, 85, 64,
+			160, 224, 112, 49, 41, 64, 126, 222, 127, 28,
+			227, 119, 121, 224, 238, 55, 41, 61, 166, 244,
+			38, 220, 159, 249, 166, 126, 43, 36, 143, 216,
+			190, 73, 123, 135, 53, 72, 0, 28, 57, 162,
+			65, 108, 91, 188, 15, 18, 98, 243, 93, 86,
+			254, 219, 20, 46, 19, 218, 111, 150, 191, 20,
+			225, 134, 177, 221, 48, 185, 234, 212, 212, 93,
+			21, 117, 180, 196, 165, 240, 66, 198, 39, 207,
+			225, 18, 161, 91, 83, 15, 160, 230, 97, 193,
+			127, 155, 22, 250, 224, 49, 231, 188, 124, 120,
+			139, 210, 146, 253, 40, 38, 207, 234, 99, 148,
+			92, 76, 218, 113, 129, 153, 3, 42, 3, 75,
+			122, 200, 59, 223, 244, 202, 171, 167, 177, 104,
+			190, 71, 131, 20, 64, 54, 172, 65, 120, 39,
+			139, 30, 57, 9, 177, 144, 60, 234, 148, 255,
+			139, 210, 178, 93, 145, 121, 235, 201, 233, 45,
+			155, 169, 222, 177, 226, 111, 155, 165, 46, 251,
+			33, 136, 57, 31, 131, 20, 192, 222, 81, 13,
+			26, 0, 30, 45, 177, 121, 164, 130, 90, 230,
+			247, 40, 61, 109, 191, 57, 246, 106, 73, 50,
+			58, 92, 247, 97, 42, 147, 172, 35, 1, 35,
+			229, 217, 203, 203, 53, 247, 61, 154, 239, 213,
+			32, 226, 239, 27, 211, 160, 1, 224, 125, 211,
+			170, 115, 112, 121, 82, 58, 5, 179, 42, 133,
+			119, 151, 190, 3, 223, 135, 158, 32, 177, 76,
+			217, 109, 176, 28, 98, 203, 39, 238, 28, 100,
+			232, 251, 201, 200, 13, 240, 120, 39, 35, 55,
+			176, 187, 163, 147, 250, 249, 153, 255, 48, 0,
+			93, 3, 255, 79, 3, 155, 0, 0},
 	)
 }
@@ -10,242 +10,342 @@ func init() {
 			"logdog.Registration",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 164, 89, 205, 114, 27, 199,
-			241, 39, 62, 248, 161, 145, 40, 211, 160, 100, 203, 208, 159, 116,
-			11, 166, 37, 202, 4, 33, 74, 46, 251, 31, 81, 150, 42, 32,
-			176, 162, 214, 6, 1, 100, 23, 164, 172, 28, 44, 47, 176, 3,
-			112, 227, 197, 238, 102, 119, 65, 138, 78, 229, 228, 55, 200, 11,
-			196, 149, 170, 28, 226, 99, 94, 32, 241, 61, 149, 99, 94, 32,
-			135, 228, 158, 202, 61, 221, 51, 179, 192, 2, 164, 236, 74, 133,
-			85, 162, 182, 231, 163, 251, 215, 31, 211, 221, 51, 100, 255, 186,
-			197, 214, 7, 190, 63, 112, 249, 189, 32, 244, 99, 191, 59, 234,
-			223, 179, 71, 161, 21, 59, 190, 87, 17, 35, 133, 55, 228, 124,
-			37, 153, 47, 237, 178, 165, 186, 90, 82, 184, 193, 22, 35, 222,
-			243, 61, 59, 186, 145, 129, 204, 102, 206, 72, 200, 194, 53, 54,
-			239, 89, 158, 31, 221, 200, 226, 248, 188, 33, 137, 189, 54, 91,
-			237, 249, 195, 202, 12, 203, 189, 229, 132, 97, 155, 70, 218, 153,
-			111, 51, 153, 223, 101, 115, 251, 237, 189, 239, 178, 235, 251, 114,
-			109, 91, 173, 173, 60, 231, 174, 251, 153, 231, 159, 122, 157, 179,
-			128, 71, 159, 254, 1, 216, 66, 33, 191, 62, 247, 50, 195, 254,
-			114, 133, 101, 174, 20, 114, 235, 115, 133, 7, 223, 95, 1, 177,
-			161, 231, 187, 176, 55, 234, 247, 121, 24, 193, 54, 72, 86, 119,
-			34, 176, 173, 216, 2, 199, 139, 121, 216, 59, 182, 188, 1, 135,
-			190, 31, 14, 173, 152, 65, 205, 15, 206, 66, 103, 112, 28, 195,
-			131, 157, 157, 159, 168, 13, 160, 123, 189, 10, 64, 213, 117, 65,
-			204, 69, 16, 242, 136, 135, 39, 220, 174, 48, 56, 142, 227, 32,
-			218, 189, 119, 207, 230, 39, 220, 245, 3, 20, 148, 104, 135, 138,
-			74, 163, 34, 136, 237, 174, 4, 113, 143, 49, 48, 184, 237, 68,
-			113, 232, 116, 71, 164, 49, 88, 158, 13, 163, 136, 35, 28, 136,
-			252, 81, 216, 227, 98, 164, 235, 120, 86, 120, 38, 112, 69, 101,
-			56, 117, 226, 99, 240, 67, 241, 191, 63, 66, 156, 67, 223, 118,
-			250, 78, 79, 216, 172, 12, 86, 200, 1, 37, 15, 157, 56, 230,
-			54, 160, 204, 19, 199, 198, 143, 248, 216, 138, 241, 23, 105, 231,
-			186, 254, 169, 227, 13, 128, 124, 227, 208, 166, 136, 54, 33, 31,
-			30, 239, 34, 36, 250, 249, 96, 6, 88, 4, 126, 63, 65, 212,
-			243, 109, 14, 195, 81, 20, 163, 230, 177, 133, 72, 137, 171, 213,
-			245, 79, 104, 74, 89, 140, 129, 231, 199, 78, 143, 151, 113, 210,
-			137, 192, 69, 86, 196, 33, 45, 209, 179, 103, 224, 160, 188, 158,
-			107, 57, 67, 30, 86, 94, 7, 2, 133, 165, 108, 145, 128, 64,
-			29, 237, 17, 2, 27, 227, 96, 19, 32, 255, 19, 14, 6, 74,
-			59, 219, 239, 141, 134, 220, 139, 173, 196, 73, 247, 208, 254, 62,
-			206, 132, 128, 145, 194, 67, 199, 114, 163, 137, 169, 133, 131, 112,
-			146, 65, 26, 253, 88, 169, 38, 119, 196, 78, 98, 236, 89, 67,
-			78, 128, 210, 177, 229, 249, 147, 57, 97, 119, 39, 142, 72, 35,
-			79, 178, 242, 49, 122, 135, 214, 25, 116, 57, 69, 10, 130, 247,
-			129, 123, 54, 142, 114, 10, 10, 4, 49, 244, 99, 14, 210, 38,
-			24, 157, 54, 162, 195, 224, 132, 62, 78, 48, 105, 133, 200, 239,
-			199, 167, 20, 38, 42, 130, 32, 10, 120, 143, 34, 8, 119, 57,
-			20, 88, 33, 197, 142, 39, 163, 40, 138, 4, 118, 6, 157, 103,
-			186, 9, 102, 235, 105, 231, 121, 213, 208, 0, 191, 219, 70, 235,
-			72, 175, 107, 117, 216, 123, 129, 147, 26, 212, 90, 237, 23, 134,
-			190, 255, 172, 3, 207, 90, 141, 186, 102, 152, 80, 109, 214, 113,
-			180, 217, 49, 244, 189, 195, 78, 203, 48, 25, 148, 170, 38, 110,
-			45, 137, 153, 106, 243, 5, 104, 159, 183, 13, 205, 52, 161, 101,
-			128, 126, 208, 110, 232, 200, 13, 217, 27, 213, 102, 71, 215, 204,
-			50, 232, 205, 90, 227, 176, 174, 55, 247, 203, 128, 28, 160, 217,
-			234, 48, 104, 232, 7, 122, 7, 215, 117, 90, 101, 33, 246, 252,
-			62, 104, 61, 133, 3, 205, 168, 61, 67, 178, 186, 167, 55, 244,
-			206, 11, 33, 240, 169, 222, 105, 146, 176, 167, 45, 131, 65, 21,
-			218, 85, 163, 163, 215, 14, 27, 85, 3, 218, 135, 70, 187, 101,
-			106, 64, 154, 213, 117, 179, 214, 168, 234, 7, 90, 189, 130, 242,
-			81, 38, 104, 71, 90, 179, 3, 230, 179, 106, 163, 49, 173, 40,
-			131, 214, 243, 166, 102, 16, 250, 180, 154, 176, 167, 33, 202, 234,
-			94, 67, 35, 81, 66, 207, 186, 110, 104, 181, 14, 41, 52, 249,
-			170, 161, 241, 16, 96, 163, 204, 192, 108, 107, 53, 29, 191, 208,
-			30, 26, 170, 83, 53, 94, 148, 21, 83, 83, 251, 217, 33, 174,
-			194, 73, 168, 87, 15, 170, 251, 168, 221, 230, 143, 89, 5, 29,
-			83, 59, 52, 180, 3, 66, 141, 166, 48, 15, 247, 204, 142, 222,
-			57, 236, 104, 176, 223, 106, 213, 133, 177, 77, 205, 56, 210, 107,
-			154, 249, 8, 26, 45, 83, 24, 236, 208, 212, 16, 72, 189, 218,
-			169, 10, 209, 200, 3, 205, 133, 243, 248, 189, 119, 104, 234, 194,
-			112, 122, 179, 163, 25, 198, 97, 187, 163, 183, 154, 119, 209, 203,
-			207, 209, 50, 136, 178, 138, 123, 235, 194, 194, 173, 38, 105, 75,
-			177, 162, 181, 140, 23, 196, 150, 236, 32, 60, 80, 134, 231, 207,
-			52, 28, 55, 200, 168, 194, 90, 85, 50, 131, 137, 86, 171, 117,
-			210, 203, 80, 32, 26, 17, 85, 154, 232, 9, 77, 109, 191, 161,
-			239, 107, 205, 154, 70, 211, 45, 98, 243, 92, 55, 181, 187, 232,
-			48, 221, 164, 5, 186, 16, 140, 49, 128, 66, 15, 133, 214, 228,
-			40, 196, 197, 228, 119, 42, 116, 203, 194, 159, 160, 63, 133, 106,
-			253, 72, 39, 228, 106, 53, 70, 128, 169, 171, 112, 17, 102, 171,
-			61, 83, 54, 199, 240, 95, 98, 153, 108, 33, 7, 75, 111, 211,
-			215, 82, 33, 87, 154, 123, 196, 46, 179, 252, 210, 63, 22, 231,
-			36, 113, 133, 205, 19, 129, 139, 74, 139, 111, 179, 101, 182, 32,
-			168, 57, 73, 94, 101, 139, 146, 204, 72, 90, 45, 94, 68, 170,
-			184, 171, 56, 190, 55, 87, 86, 28, 51, 146, 144, 139, 72, 236,
-			123, 139, 171, 138, 99, 134, 56, 18, 41, 57, 102, 4, 71, 162,
-			213, 98, 228, 248, 222, 91, 91, 138, 227, 198, 92, 69, 113, 204,
-			74, 66, 46, 202, 18, 181, 120, 83, 113, 204, 18, 71, 34, 37,
-			199, 172, 224, 72, 180, 90, 140, 28, 55, 214, 183, 21, 199, 247,
-			231, 74, 138, 99, 78, 18, 114, 81, 14, 57, 190, 191, 88, 84,
-			28, 115, 196, 145, 72, 201, 49, 39, 56, 18, 173, 22, 211, 214,
-			181, 91, 138, 227, 237, 177, 214, 121, 73, 200, 69, 121, 228, 120,
-			123, 113, 67, 113, 204, 19, 71, 34, 37, 199, 188, 224, 72, 180,
-			90, 140, 28, 111, 223, 73, 180, 190, 51, 119, 75, 113, 156, 151,
-			132, 92, 52, 143, 28, 239, 44, 222, 80, 28, 231, 137, 35, 145,
-			146, 227, 188, 224, 72, 180, 90, 140, 90, 223, 185, 9, 236, 239,
-			140, 101, 243, 115, 133, 188, 129, 29, 69, 241, 111, 148, 52, 146,
-			222, 68, 148, 29, 44, 252, 30, 166, 88, 11, 34, 103, 224, 113,
-			187, 12, 125, 231, 21, 183, 183, 93, 238, 13, 176, 2, 68, 129,
-			229, 81, 250, 142, 177, 148, 76, 150, 115, 155, 129, 69, 123, 122,
-			254, 200, 19, 197, 72, 53, 73, 162, 18, 245, 67, 171, 55, 169,
-			183, 201, 4, 86, 49, 106, 152, 4, 201, 168, 223, 240, 93, 89,
-			82, 64, 143, 193, 161, 178, 104, 243, 0, 139, 0, 151, 12, 45,
-			239, 12, 122, 22, 162, 176, 173, 80, 112, 197, 125, 61, 30, 196,
-			84, 255, 190, 226, 80, 178, 173, 179, 18, 163, 98, 81, 26, 98,
-			81, 57, 46, 37, 108, 66, 238, 90, 177, 172, 40, 29, 196, 28,
-			197, 214, 48, 144, 21, 80, 181, 14, 216, 102, 96, 223, 194, 145,
-			25, 86, 159, 248, 148, 115, 15, 43, 202, 105, 122, 245, 137, 229,
-			142, 176, 104, 57, 164, 224, 216, 84, 4, 193, 137, 17, 146, 71,
-			69, 203, 178, 169, 70, 162, 244, 104, 212, 141, 73, 93, 178, 8,
-			85, 39, 220, 50, 102, 84, 1, 67, 180, 98, 196, 40, 192, 90,
-			246, 202, 161, 58, 235, 158, 193, 214, 246, 253, 157, 242, 206, 206,
-			14, 156, 113, 11, 187, 43, 172, 75, 218, 43, 92, 143, 181, 243,
-			254, 46, 54, 108, 195, 96, 132, 197, 111, 44, 89, 176, 157, 66,
-			40, 122, 136, 32, 226, 35, 219, 23, 141, 76, 69, 53, 60, 19,
-			21, 240, 119, 24, 195, 99, 168, 84, 42, 143, 102, 231, 208, 164,
-			83, 51, 99, 65, 73, 155, 156, 204, 202, 233, 113, 243, 156, 120,
-			242, 49, 113, 24, 83, 219, 82, 86, 66, 63, 154, 217, 36, 124,
-			174, 182, 200, 239, 100, 131, 160, 18, 33, 78, 31, 54, 207, 9,
-			250, 4, 118, 224, 246, 237, 89, 94, 79, 96, 231, 46, 252, 74,
-			110, 187, 0, 221, 214, 99, 184, 255, 232, 220, 172, 18, 141, 115,
-			59, 201, 143, 90, 244, 107, 224, 46, 245, 170, 41, 0, 209, 152,
-			217, 147, 11, 17, 124, 242, 195, 8, 182, 127, 0, 193, 214, 69,
-			8, 82, 254, 127, 48, 241, 255, 196, 97, 34, 0, 38, 228, 214,
-			196, 99, 255, 125, 24, 188, 214, 217, 175, 15, 18, 57, 149, 246,
-			249, 227, 105, 159, 35, 162, 89, 35, 60, 154, 108, 74, 34, 32,
-			229, 245, 244, 134, 115, 97, 48, 217, 51, 109, 231, 169, 160, 75,
-			155, 120, 178, 225, 66, 235, 78, 252, 59, 89, 248, 36, 189, 240,
-			53, 50, 182, 46, 150, 113, 97, 12, 49, 250, 201, 229, 41, 255,
-			26, 75, 43, 44, 96, 249, 188, 40, 155, 71, 217, 107, 197, 30,
-			152, 34, 177, 142, 51, 33, 101, 83, 204, 67, 233, 204, 90, 129,
-			3, 186, 5, 96, 98, 17, 190, 222, 254, 240, 254, 71, 229, 143,
-			254, 255, 99, 202, 17, 244, 143, 81, 54, 219, 154, 25, 68, 231,
-			247, 220, 81, 132, 125, 49, 250, 125, 153, 205, 147, 196, 124, 33,
-			127, 148, 53, 10, 84, 0, 136, 156, 39, 4, 139, 9, 133, 224,
-			142, 150, 222, 72, 40, 44, 55, 71, 133, 85, 246, 77, 78, 96,
-			197, 185, 47, 178, 133, 226, 191, 179, 9, 216, 169, 28, 110, 41,
-			236, 211, 73, 60, 149, 195, 211, 58, 177, 137, 82, 73, 176, 97,
-			206, 230, 81, 68, 41, 24, 87, 122, 124, 204, 45, 156, 42, 41,
-			242, 202, 97, 1, 170, 251, 165, 178, 213, 151, 88, 140, 184, 107,
-			139, 228, 107, 65, 224, 71, 120, 223, 57, 17, 87, 4, 143, 15,
-			44, 241, 253, 165, 0, 164, 22, 86, 224, 41, 206, 141, 207, 177,
-			128, 146, 18, 136, 115, 67, 63, 196, 123, 148, 133, 247, 19, 111,
-			251, 107, 30, 250, 50, 217, 211, 77, 76, 40, 48, 197, 77, 94,
-			205, 186, 156, 141, 213, 163, 139, 14, 149, 73, 42, 126, 98, 249,
-			52, 206, 89, 55, 62, 124, 248, 176, 172, 254, 73, 23, 166, 6,
-			46, 112, 95, 6, 221, 247, 69, 246, 232, 154, 114, 81, 102, 158,
-			156, 146, 184, 47, 67, 46, 90, 90, 78, 40, 116, 223, 23, 43,
-			111, 118, 23, 196, 117, 252, 67, 246, 207, 235, 108, 153, 46, 240,
-			120, 75, 84, 143, 28, 11, 174, 63, 176, 253, 65, 241, 71, 30,
-			67, 74, 191, 207, 176, 235, 6, 31, 224, 29, 143, 135, 237, 144,
-			99, 241, 55, 248, 47, 177, 252, 197, 244, 18, 130, 75, 126, 193,
-			123, 177, 120, 9, 185, 100, 36, 100, 225, 45, 134, 114, 105, 169,
-			120, 10, 185, 100, 40, 170, 240, 46, 187, 44, 175, 215, 47, 29,
-			175, 239, 223, 200, 65, 14, 39, 153, 28, 210, 113, 164, 240, 144,
-			49, 254, 42, 112, 36, 128, 27, 12, 55, 95, 126, 240, 206, 236,
-			219, 73, 37, 9, 27, 35, 181, 184, 244, 115, 246, 214, 44, 204,
-			40, 64, 31, 115, 66, 131, 110, 192, 107, 188, 128, 121, 197, 80,
-			84, 97, 147, 173, 160, 13, 94, 118, 71, 158, 237, 242, 151, 177,
-			31, 56, 61, 133, 247, 42, 142, 239, 137, 225, 14, 141, 62, 120,
-			201, 174, 72, 222, 234, 13, 168, 197, 174, 78, 203, 42, 172, 85,
-			164, 57, 43, 23, 154, 170, 184, 254, 186, 105, 9, 241, 211, 239,
-			11, 244, 164, 147, 159, 123, 148, 97, 223, 101, 196, 147, 14, 54,
-			100, 15, 126, 155, 153, 122, 157, 185, 255, 49, 116, 48, 166, 106,
-			199, 24, 59, 206, 104, 8, 213, 17, 94, 108, 177, 61, 120, 205,
-			51, 205, 97, 196, 101, 92, 138, 203, 240, 228, 81, 3, 201, 129,
-			127, 194, 67, 58, 197, 221, 51, 12, 245, 61, 179, 190, 29, 197,
-			103, 88, 93, 92, 12, 15, 132, 35, 123, 33, 217, 201, 96, 215,
-			130, 45, 156, 157, 188, 17, 52, 240, 62, 133, 215, 53, 12, 103,
-			151, 143, 47, 12, 11, 75, 87, 217, 37, 150, 205, 97, 66, 91,
-			90, 188, 195, 218, 178, 157, 188, 60, 183, 158, 41, 214, 225, 66,
-			131, 32, 78, 57, 74, 45, 148, 199, 79, 65, 206, 142, 31, 21,
-			80, 111, 63, 180, 29, 207, 138, 253, 176, 50, 201, 157, 151, 151,
-			214, 216, 102, 146, 59, 151, 179, 215, 139, 55, 133, 69, 208, 182,
-			88, 61, 66, 110, 13, 239, 136, 7, 10, 138, 193, 169, 156, 183,
-			156, 189, 252, 110, 42, 231, 45, 103, 151, 82, 57, 111, 249, 210,
-			74, 42, 231, 45, 175, 94, 99, 247, 147, 148, 183, 130, 233, 121,
-			99, 70, 4, 200, 88, 166, 227, 154, 40, 49, 117, 64, 87, 178,
-			203, 215, 83, 7, 116, 101, 44, 139, 14, 232, 202, 165, 55, 82,
-			7, 116, 5, 243, 235, 79, 133, 44, 52, 226, 106, 182, 84, 252,
-			16, 90, 1, 5, 152, 229, 2, 29, 16, 122, 160, 19, 173, 101,
-			151, 222, 47, 200, 44, 137, 68, 122, 193, 177, 6, 152, 21, 81,
-			180, 228, 151, 205, 19, 139, 49, 133, 146, 87, 47, 191, 153, 80,
-			40, 121, 181, 176, 150, 80, 40, 121, 21, 110, 177, 111, 101, 102,
-			71, 106, 45, 187, 85, 252, 77, 78, 232, 169, 148, 155, 156, 43,
-			149, 169, 245, 62, 124, 242, 24, 118, 202, 2, 133, 50, 49, 189,
-			42, 242, 190, 53, 114, 99, 181, 141, 165, 247, 5, 136, 210, 167,
-			156, 141, 193, 73, 13, 113, 16, 184, 14, 197, 37, 75, 203, 73,
-			102, 123, 174, 31, 201, 112, 156, 113, 62, 88, 125, 84, 151, 30,
-			135, 206, 243, 198, 24, 111, 81, 151, 46, 55, 151, 69, 28, 41,
-			31, 133, 169, 211, 138, 132, 136, 185, 72, 74, 243, 124, 116, 38,
-			118, 221, 161, 64, 213, 163, 43, 131, 132, 133, 42, 70, 35, 137,
-			82, 61, 164, 201, 212, 31, 29, 251, 35, 204, 245, 252, 85, 143,
-			115, 249, 138, 70, 38, 33, 143, 168, 172, 239, 250, 120, 15, 129,
-			216, 138, 190, 42, 139, 94, 130, 225, 113, 27, 242, 100, 150, 198,
-			209, 82, 18, 216, 248, 81, 203, 14, 253, 32, 64, 118, 54, 10,
-			192, 72, 234, 91, 142, 75, 62, 77, 227, 30, 71, 85, 14, 163,
-			106, 45, 187, 90, 82, 254, 203, 45, 144, 199, 138, 9, 133, 190,
-			93, 187, 249, 126, 66, 145, 55, 55, 63, 96, 79, 240, 8, 102,
-			10, 249, 91, 115, 119, 51, 197, 7, 194, 224, 161, 74, 53, 128,
-			13, 92, 100, 13, 38, 21, 109, 250, 124, 130, 209, 174, 169, 3,
-			71, 241, 122, 107, 105, 157, 125, 147, 193, 56, 17, 87, 242, 141,
-			236, 106, 241, 4, 76, 145, 61, 41, 141, 196, 99, 71, 146, 130,
-			98, 184, 130, 194, 112, 70, 21, 70, 89, 195, 232, 38, 132, 249,
-			166, 139, 90, 13, 41, 143, 236, 141, 98, 151, 158, 29, 101, 226,
-			141, 72, 127, 43, 194, 180, 133, 6, 61, 245, 48, 37, 28, 59,
-			193, 56, 113, 73, 246, 202, 20, 25, 113, 152, 55, 178, 183, 64,
-			168, 155, 17, 135, 121, 67, 85, 64, 249, 74, 176, 129, 169, 72,
-			81, 104, 138, 141, 55, 11, 140, 11, 244, 56, 181, 153, 93, 47,
-			126, 46, 108, 145, 188, 71, 18, 254, 246, 168, 123, 207, 28, 117,
-			65, 164, 127, 130, 18, 140, 186, 174, 19, 29, 35, 58, 66, 41,
-			106, 206, 182, 60, 138, 212, 130, 72, 236, 148, 14, 210, 248, 199,
-			248, 40, 1, 108, 102, 55, 86, 21, 6, 74, 0, 155, 42, 1,
-			100, 68, 2, 216, 188, 244, 78, 66, 33, 190, 205, 255, 91, 99,
-			127, 202, 176, 236, 2, 166, 203, 10, 38, 255, 226, 31, 51, 144,
-			46, 52, 160, 74, 182, 188, 106, 54, 252, 65, 29, 211, 80, 250,
-			116, 96, 219, 25, 248, 142, 23, 203, 140, 45, 158, 247, 251, 86,
-			143, 71, 34, 145, 178, 100, 135, 2, 237, 120, 216, 98, 123, 52,
-			75, 237, 18, 247, 172, 46, 161, 159, 206, 106, 83, 7, 135, 150,
-			37, 206, 146, 203, 40, 70, 29, 15, 155, 44, 203, 117, 190, 78,
-			162, 20, 131, 101, 129, 108, 95, 89, 186, 198, 254, 154, 103, 249,
-			5, 145, 158, 119, 179, 7, 197, 63, 231, 199, 225, 101, 74, 49,
-			22, 189, 63, 147, 50, 51, 152, 210, 233, 20, 103, 83, 233, 118,
-			242, 206, 156, 174, 9, 112, 24, 144, 121, 70, 120, 126, 163, 168,
-			124, 46, 107, 136, 147, 142, 225, 56, 10, 189, 41, 149, 216, 116,
-			94, 69, 253, 38, 122, 5, 86, 136, 113, 33, 106, 18, 130, 33,
-			142, 18, 163, 76, 89, 84, 68, 101, 34, 160, 116, 133, 106, 140,
-			171, 231, 121, 69, 6, 147, 191, 23, 224, 177, 142, 207, 82, 97,
-			37, 218, 60, 43, 177, 54, 182, 195, 216, 143, 137, 152, 20, 44,
-			67, 121, 112, 142, 45, 236, 91, 159, 27, 122, 71, 19, 9, 42,
-			18, 128, 40, 5, 142, 19, 111, 226, 143, 132, 3, 230, 174, 169,
-			245, 136, 214, 243, 69, 90, 166, 214, 89, 165, 51, 97, 20, 202,
-			52, 41, 155, 150, 218, 227, 231, 239, 58, 247, 48, 245, 149, 96,
-			128, 41, 96, 124, 93, 172, 206, 196, 135, 74, 95, 137, 171, 184,
-			104, 249, 135, 62, 253, 193, 193, 35, 32, 85, 91, 254, 201, 193,
-			114, 103, 236, 62, 157, 132, 167, 80, 64, 169, 234, 162, 12, 251,
-			76, 123, 133, 27, 162, 105, 4, 120, 88, 22, 100, 157, 222, 93,
-			184, 150, 80, 88, 46, 119, 175, 127, 144, 80, 120, 144, 118, 63,
-			250, 44, 105, 117, 255, 19, 0, 0, 255, 255, 246, 212, 229, 122,
-			225, 27, 0, 0},
+			8, 0, 0, 0, 0, 0, 2, 255, 164, 89,
+			221, 142, 27, 71, 118, 102, 119, 115, 126, 84,
+			210, 200, 114, 143, 236, 213, 142, 35, 237, 209,
+			120, 44, 141, 61, 28, 106, 36, 195, 155, 88,
+			178, 140, 112, 200, 150, 166, 119, 41, 146, 233,
+			110, 206, 88, 55, 150, 155, 221, 69, 178, 178,
+			205, 174, 78, 85, 113, 164, 217, 32, 64, 128,
+			125, 128, 0, 121, 129, 44, 2, 228, 34, 123,
+			153, 23, 72, 246, 62, 200, 101, 94, 32, 23,
+			121, 128, 32, 247, 65, 253, 52, 217, 28, 73,
+			94, 4, 107, 64, 48, 79, 215, 169, 175, 190,
+			243, 83, 231, 84, 213, 160, 255, 185, 139, 238,
+			76, 40, 157, 100, 248, 65, 193, 168, 160, 163,
+			249, 248, 65, 58, 103, 177, 32, 52, 111, 170,
+			47, 238, 7, 122, 188, 89, 142, 239, 62, 70,
+			155, 29, 163, 226, 222, 66, 27, 28, 39, 52,
+			79, 249, 45, 11, 172, 125, 39, 40, 69, 247,
+			38, 90, 203, 227, 156, 242, 91, 54, 88, 251,
+			107, 129, 22, 142, 7, 104, 59, 161, 179, 230,
+			37, 200, 227, 173, 18, 112, 32, 191, 12, 172,
+			223, 90, 214, 63, 218, 206, 243, 193, 241, 239,
+			236, 59, 207, 181, 238, 192, 232, 54, 207, 112,
+			150, 253, 50, 167, 175, 243, 232, 162, 192, 252,
+			23, 255, 12, 104, 221, 173, 223, 169, 189, 178,
+			208, 191, 95, 67, 214, 53, 215, 185, 83, 115,
+			31, 253, 254, 26, 168, 9, 9, 205, 224, 120,
+			62, 30, 99, 198, 225, 16, 52, 212, 125, 14,
+			105, 44, 98, 32, 185, 192, 44, 153, 198, 249,
+			4, 195, 152, 178, 89, 44, 16, 180, 105, 113,
+			193, 200, 100, 42, 224, 209, 209, 209, 159, 153,
+			9, 224, 231, 73, 19, 160, 149, 101, 160, 198,
+			56, 48, 204, 49, 59, 199, 105, 19, 193, 84,
+			136, 130, 63, 126, 240, 32, 197, 231, 56, 163,
+			5, 102, 188, 180, 46, 161, 51, 237, 212, 132,
+			102, 135, 35, 77, 226, 1, 66, 16, 224, 148,
+			112, 193, 200, 104, 46, 45, 134, 56, 79, 97,
+			206, 49, 144, 28, 56, 157, 179, 4, 171, 47,
+			35, 146, 199, 236, 66, 241, 226, 13, 120, 77,
+			196, 20, 40, 83, 255, 167, 115, 129, 96, 70,
+			83, 50, 38, 137, 242, 89, 3, 98, 134, 161,
+			192, 108, 70, 132, 192, 41, 20, 140, 158, 147,
+			20, 167, 32, 166, 177, 0, 49, 149, 214, 101,
+			25, 125, 77, 242, 9, 200, 216, 16, 57, 137,
+			203, 73, 8, 102, 88, 60, 70, 8, 228, 127,
+			95, 92, 34, 198, 129, 142, 75, 70, 9, 77,
+			49, 204, 230, 92, 0, 195, 34, 38, 185, 66,
+			141, 71, 244, 92, 14, 25, 143, 33, 200, 169,
+			32, 9, 110, 128, 152, 18, 14, 25, 225, 66,
+			34, 84, 87, 204, 211, 75, 116, 82, 194, 147,
+			44, 38, 51, 204, 154, 239, 35, 65, 242, 170,
+			47, 74, 18, 5, 163, 233, 60, 193, 75, 30,
+			104, 73, 228, 143, 226, 129, 192, 88, 151, 210,
+			100, 62, 195, 185, 136, 203, 32, 61, 160, 12,
+			168, 152, 98, 6, 179, 88, 96, 70, 226, 140,
+			47, 93, 173, 2, 36, 166, 24, 65, 149, 253,
+			194, 168, 30, 38, 106, 166, 4, 206, 227, 25,
+			150, 132, 170, 185, 149, 211, 229, 152, 242, 59,
+			17, 92, 90, 148, 107, 40, 202, 56, 204, 226,
+			11, 24, 97, 153, 41, 41, 8, 10, 56, 79,
+			41, 227, 24, 40, 147, 36, 102, 84, 96, 208,
+			62, 17, 28, 82, 204, 200, 57, 78, 97, 204,
+			232, 12, 105, 47, 112, 58, 22, 175, 101, 154,
+			152, 12, 2, 94, 224, 68, 102, 16, 20, 140,
+			200, 196, 98, 50, 119, 114, 157, 69, 156, 43,
+			238, 8, 162, 19, 63, 132, 176, 255, 44, 58,
+			107, 5, 30, 248, 33, 12, 130, 254, 169, 223,
+			241, 58, 112, 252, 18, 162, 19, 15, 218, 253,
+			193, 203, 192, 127, 126, 18, 193, 73, 191, 219,
+			241, 130, 16, 90, 189, 14, 180, 251, 189, 40,
+			240, 143, 135, 81, 63, 8, 17, 236, 182, 66,
+			240, 195, 93, 53, 210, 234, 189, 4, 239, 187,
+			65, 224, 133, 33, 244, 3, 240, 95, 12, 186,
+			190, 215, 129, 179, 86, 16, 180, 122, 145, 239,
+			133, 13, 240, 123, 237, 238, 176, 227, 247, 158,
+			55, 224, 120, 24, 65, 175, 31, 33, 232, 250,
+			47, 252, 200, 235, 64, 212, 111, 168, 101, 223,
+			158, 7, 253, 103, 240, 194, 11, 218, 39, 173,
+			94, 212, 58, 246, 187, 126, 244, 82, 45, 248,
+			204, 143, 122, 114, 177, 103, 253, 0, 65, 11,
+			6, 173, 32, 242, 219, 195, 110, 43, 128, 193,
+			48, 24, 244, 67, 15, 164, 101, 29, 63, 108,
+			119, 91, 254, 11, 175, 211, 4, 191, 7, 189,
+			62, 120, 167, 94, 47, 130, 240, 164, 213, 237,
+			174, 26, 138, 160, 127, 214, 243, 2, 201, 190,
+			106, 38, 28, 123, 208, 245, 91, 199, 93, 79,
+			46, 165, 236, 236, 248, 129, 215, 142, 164, 65,
+			203, 95, 109, 191, 227, 245, 162, 86, 183, 129,
+			32, 28, 120, 109, 191, 213, 109, 128, 247, 157,
+			247, 98, 208, 109, 5, 47, 27, 6, 52, 244,
+			254, 98, 232, 245, 34, 191, 213, 133, 78, 235,
+			69, 235, 185, 23, 194, 254, 31, 242, 202, 32,
+			232, 183, 135, 129, 247, 66, 178, 238, 63, 131,
+			112, 120, 28, 70, 126, 52, 140, 60, 120, 222,
+			239, 119, 148, 179, 67, 47, 56, 245, 219, 94,
+			248, 4, 186, 253, 80, 57, 108, 24, 122, 13,
+			4, 157, 86, 212, 82, 75, 15, 130, 254, 51,
+			63, 10, 159, 200, 223, 199, 195, 208, 87, 142,
+			243, 123, 145, 23, 4, 195, 65, 228, 247, 123,
+			159, 195, 73, 255, 204, 59, 245, 2, 104, 183,
+			134, 161, 215, 81, 30, 238, 247, 164, 181, 50,
+			87, 188, 126, 240, 82, 194, 118, 125, 19, 129,
+			6, 156, 157, 120, 209, 137, 23, 72, 167, 42,
+			111, 181, 164, 27, 194, 40, 240, 219, 81, 85,
+			173, 31, 64, 212, 15, 34, 84, 177, 19, 122,
+			222, 243, 174, 255, 220, 235, 181, 61, 57, 220,
+			151, 48, 103, 126, 232, 125, 14, 173, 192, 15,
+			165, 130, 175, 22, 134, 179, 214, 75, 232, 15,
+			149, 213, 50, 80, 195, 208, 67, 250, 119, 37,
+			117, 27, 42, 158, 224, 63, 131, 86, 231, 212,
+			151, 204, 141, 246, 160, 31, 134, 190, 73, 23,
+			229, 182, 246, 137, 241, 121, 19, 161, 77, 100,
+			217, 174, 3, 155, 63, 145, 191, 54, 93, 103,
+			183, 246, 4, 93, 69, 245, 205, 255, 222, 168,
+			105, 225, 26, 90, 147, 130, 237, 58, 187, 27,
+			63, 65, 91, 104, 93, 73, 53, 45, 94, 71,
+			27, 90, 180, 180, 108, 148, 55, 92, 103, 119,
+			231, 177, 65, 252, 180, 214, 48, 136, 150, 22,
+			180, 146, 92, 246, 211, 141, 109, 131, 104, 217,
+			53, 45, 106, 68, 75, 33, 74, 217, 40, 111,
+			184, 206, 167, 31, 31, 24, 196, 189, 90, 211,
+			32, 218, 90, 208, 74, 182, 148, 54, 62, 49,
+			136, 182, 93, 211, 162, 70, 180, 21, 162, 148,
+			141, 242, 134, 235, 236, 221, 57, 52, 136, 159,
+			213, 118, 13, 162, 163, 5, 173, 228, 216, 174,
+			243, 217, 198, 142, 65, 116, 236, 154, 22, 53,
+			162, 163, 16, 165, 108, 148, 229, 212, 219, 119,
+			13, 226, 189, 133, 213, 117, 45, 104, 165, 186,
+			237, 58, 247, 54, 246, 12, 98, 221, 174, 105,
+			81, 35, 214, 21, 162, 148, 141, 178, 227, 58,
+			247, 238, 151, 86, 223, 175, 221, 53, 136, 107,
+			90, 208, 74, 107, 182, 235, 220, 223, 184, 101,
+			16, 215, 236, 154, 22, 53, 226, 154, 66, 148,
+			178, 81, 222, 112, 157, 251, 159, 0, 250, 47,
+			132, 236, 122, 205, 173, 7, 181, 87, 214, 206,
+			127, 202, 162, 81, 158, 77, 84, 219, 193, 28,
+			231, 130, 67, 12, 156, 76, 114, 156, 54, 96,
+			76, 222, 224, 244, 48, 195, 249, 68, 76, 129,
+			23, 113, 14, 116, 12, 130, 204, 240, 82, 29,
+			167, 8, 98, 57, 39, 161, 243, 92, 53, 35,
+			115, 72, 82, 157, 104, 204, 226, 100, 217, 111,
+			203, 1, 1, 234, 192, 164, 68, 4, 12, 115,
+			154, 233, 150, 2, 190, 0, 34, 219, 98, 138,
+			11, 156, 167, 88, 3, 198, 249, 5, 36, 113,
+			134, 243, 52, 102, 10, 53, 161, 121, 130, 11,
+			33, 251, 223, 175, 48, 236, 166, 241, 197, 46,
+			2, 202, 96, 119, 70, 115, 49, 221, 45, 97,
+			24, 206, 98, 161, 59, 74, 68, 102, 152, 139,
+			120, 86, 232, 14, 104, 142, 14, 41, 145, 231,
+			22, 156, 39, 24, 70, 88, 188, 198, 56, 71,
+			32, 94, 87, 181, 207, 227, 108, 142, 185, 4,
+			139, 151, 174, 146, 20, 136, 128, 36, 206, 101,
+			211, 138, 83, 217, 35, 41, 3, 62, 31, 9,
+			105, 174, 244, 136, 236, 78, 16, 47, 129, 154,
+			16, 168, 163, 152, 4, 42, 10, 70, 223, 16,
+			217, 103, 179, 11, 56, 56, 124, 120, 212, 56,
+			58, 58, 130, 11, 28, 51, 46, 251, 146, 247,
+			38, 158, 21, 25, 134, 135, 143, 161, 77, 103,
+			197, 92, 224, 229, 202, 10, 118, 133, 161, 116,
+			22, 20, 28, 207, 83, 170, 14, 50, 77, 115,
+			224, 89, 154, 192, 69, 204, 4, 60, 133, 102,
+			179, 249, 228, 242, 24, 206, 211, 149, 145, 197,
+			66, 229, 49, 185, 28, 213, 195, 229, 215, 102,
+			25, 201, 167, 18, 97, 33, 29, 234, 181, 74,
+			249, 201, 165, 73, 42, 230, 102, 138, 254, 93,
+			78, 80, 82, 185, 8, 25, 195, 254, 91, 11,
+			125, 3, 71, 112, 239, 222, 101, 172, 111, 225,
+			232, 115, 248, 107, 61, 237, 29, 236, 14, 158,
+			194, 195, 39, 111, 141, 154, 165, 159, 194, 195,
+			163, 242, 63, 163, 244, 55, 128, 51, 142, 87,
+			8, 240, 5, 216, 183, 239, 100, 240, 205, 143,
+			51, 56, 252, 17, 6, 7, 239, 98, 80, 137,
+			255, 163, 101, 252, 151, 1, 83, 9, 176, 20,
+			15, 150, 17, 251, 255, 167, 193, 123, 131, 253,
+			254, 36, 209, 67, 213, 152, 63, 93, 141, 57,
+			28, 188, 229, 132, 39, 203, 73, 101, 6, 84,
+			162, 94, 157, 240, 86, 26, 44, 231, 172, 250,
+			121, 37, 233, 170, 46, 94, 78, 56, 248, 241,
+			248, 46, 21, 191, 173, 42, 190, 103, 141, 131,
+			119, 175, 113, 248, 238, 8, 34, 132, 156, 186,
+			172, 191, 193, 230, 13, 84, 160, 122, 93, 181,
+			205, 83, 251, 230, 78, 2, 161, 42, 172, 139,
+			74, 40, 171, 233, 20, 175, 84, 214, 38, 188,
+			144, 183, 128, 17, 214, 177, 62, 252, 242, 225,
+			87, 141, 175, 254, 244, 231, 178, 70, 200, 127,
+			8, 4, 133, 131, 75, 31, 129, 228, 73, 54,
+			231, 228, 92, 198, 125, 11, 173, 201, 21, 235,
+			110, 253, 212, 14, 92, 116, 77, 139, 107, 146,
+			193, 70, 41, 89, 174, 115, 186, 249, 65, 41,
+			57, 174, 115, 234, 110, 163, 223, 56, 138, 171,
+			229, 58, 223, 219, 238, 206, 255, 218, 37, 217,
+			149, 26, 30, 27, 238, 171, 69, 188, 82, 195,
+			171, 54, 161, 165, 81, 101, 178, 113, 200, 48,
+			231, 178, 4, 231, 64, 115, 188, 64, 99, 43,
+			45, 69, 95, 57, 98, 56, 66, 240, 131, 241,
+			213, 15, 48, 38, 56, 75, 85, 241, 141, 161,
+			160, 156, 8, 114, 174, 174, 8, 57, 158, 196,
+			234, 247, 15, 138, 144, 81, 108, 194, 51, 202,
+			22, 185, 197, 21, 149, 202, 130, 148, 193, 140,
+			50, 220, 128, 24, 114, 154, 31, 254, 26, 51,
+			170, 139, 189, 188, 137, 41, 3, 86, 208, 244,
+			213, 108, 132, 209, 194, 60, 121, 209, 145, 109,
+			18, 98, 174, 213, 87, 121, 94, 14, 227, 215,
+			95, 127, 221, 48, 255, 116, 8, 43, 31, 222,
+			17, 62, 171, 238, 214, 191, 183, 79, 111, 154,
+			16, 89, 107, 50, 40, 101, 248, 44, 25, 162,
+			205, 173, 82, 114, 92, 231, 251, 27, 31, 142,
+			214, 213, 117, 252, 75, 244, 119, 31, 163, 45,
+			121, 129, 39, 137, 121, 131, 112, 215, 51, 58,
+			73, 233, 100, 231, 15, 60, 134, 236, 254, 147,
+			133, 62, 10, 240, 132, 112, 129, 217, 128, 225,
+			49, 121, 19, 224, 191, 154, 99, 46, 228, 75,
+			72, 193, 232, 95, 226, 68, 168, 151, 144, 43,
+			65, 41, 186, 31, 163, 245, 66, 169, 170, 167,
+			144, 43, 129, 145, 220, 159, 161, 171, 250, 122,
+			253, 138, 228, 99, 122, 203, 1, 103, 255, 74,
+			128, 244, 39, 63, 31, 83, 247, 107, 132, 240,
+			155, 130, 104, 2, 183, 16, 88, 251, 87, 31,
+			253, 244, 242, 219, 73, 179, 76, 155, 160, 162,
+			188, 251, 183, 22, 250, 248, 50, 79, 94, 208,
+			156, 99, 73, 135, 227, 132, 97, 205, 243, 90,
+			96, 36, 119, 31, 221, 200, 232, 228, 213, 104,
+			158, 167, 25, 126, 37, 104, 65, 18, 67, 248,
+			122, 70, 39, 199, 234, 115, 36, 191, 186, 123,
+			232, 122, 69, 115, 206, 178, 91, 142, 210, 187,
+			182, 208, 27, 178, 236, 209, 43, 116, 77, 51,
+			48, 79, 69, 125, 116, 125, 149, 145, 123, 187,
+			169, 189, 222, 124, 167, 71, 119, 238, 188, 111,
+			88, 27, 242, 139, 223, 187, 242, 229, 167, 94,
+			123, 98, 161, 223, 89, 234, 229, 167, 94, 115,
+			31, 253, 131, 181, 242, 136, 243, 240, 231, 16,
+			77, 49, 180, 167, 140, 206, 200, 124, 6, 173,
+			185, 152, 82, 198, 155, 239, 121, 205, 25, 114,
+			172, 211, 87, 221, 153, 151, 111, 31, 132, 195,
+			132, 158, 99, 38, 55, 251, 232, 2, 98, 56,
+			14, 59, 135, 92, 92, 100, 24, 50, 146, 224,
+			156, 99, 125, 100, 210, 7, 30, 4, 99, 58,
+			207, 83, 125, 144, 194, 208, 245, 219, 94, 47,
+			244, 96, 76, 50, 188, 184, 87, 172, 111, 94,
+			71, 87, 144, 237, 212, 92, 103, 115, 227, 62,
+			26, 232, 83, 231, 213, 218, 29, 107, 167, 3,
+			239, 116, 8, 48, 243, 149, 203, 29, 137, 95,
+			131, 30, 93, 188, 61, 64, 155, 82, 150, 146,
+			60, 22, 148, 53, 151, 37, 246, 234, 230, 109,
+			180, 95, 150, 216, 45, 251, 163, 157, 79, 148,
+			71, 50, 58, 1, 46, 24, 142, 103, 247, 57,
+			152, 84, 93, 41, 141, 91, 246, 213, 159, 85,
+			74, 227, 150, 189, 89, 41, 141, 91, 87, 110,
+			84, 74, 227, 214, 246, 77, 244, 176, 172, 140,
+			55, 236, 155, 59, 123, 151, 150, 0, 157, 242,
+			32, 232, 194, 136, 149, 125, 124, 195, 222, 250,
+			168, 178, 143, 111, 216, 155, 149, 125, 124, 227,
+			202, 7, 149, 125, 124, 195, 221, 70, 127, 174,
+			214, 178, 93, 103, 219, 222, 221, 249, 18, 250,
+			133, 76, 176, 56, 3, 146, 235, 119, 60, 117,
+			2, 29, 209, 185, 62, 194, 150, 43, 202, 135,
+			158, 120, 130, 115, 105, 166, 198, 179, 235, 18,
+			98, 33, 173, 185, 206, 246, 213, 15, 75, 201,
+			114, 157, 109, 247, 118, 41, 57, 174, 179, 13,
+			119, 209, 111, 117, 3, 112, 92, 231, 182, 125,
+			176, 243, 247, 142, 178, 211, 24, 183, 220, 126,
+			166, 160, 251, 99, 248, 230, 41, 28, 53, 20,
+			11, 227, 98, 249, 248, 136, 199, 241, 60, 19,
+			102, 26, 170, 206, 43, 48, 35, 84, 150, 246,
+			44, 83, 231, 230, 162, 200, 136, 204, 75, 84,
+			93, 167, 28, 77, 50, 202, 117, 58, 94, 10,
+			62, 196, 99, 129, 25, 16, 193, 223, 198, 110,
+			34, 232, 231, 73, 57, 185, 161, 242, 200, 196,
+			136, 85, 118, 43, 48, 157, 115, 92, 175, 150,
+			83, 200, 104, 62, 193, 76, 177, 74, 228, 205,
+			66, 211, 242, 199, 192, 231, 154, 165, 121, 111,
+			211, 29, 130, 79, 233, 60, 75, 1, 191, 73,
+			48, 214, 143, 109, 210, 37, 50, 34, 166, 57,
+			100, 52, 137, 51, 16, 49, 255, 85, 67, 29,
+			57, 16, 112, 58, 195, 229, 168, 252, 126, 159,
+			27, 98, 139, 183, 175, 148, 209, 162, 192, 41,
+			164, 115, 12, 130, 194, 56, 38, 153, 140, 105,
+			149, 247, 34, 171, 156, 186, 91, 191, 109, 111,
+			239, 154, 248, 57, 235, 50, 98, 59, 165, 100,
+			185, 206, 237, 79, 62, 43, 37, 25, 205, 253,
+			47, 208, 183, 200, 174, 91, 110, 253, 110, 237,
+			115, 107, 231, 145, 114, 56, 51, 165, 6, 102,
+			152, 243, 120, 178, 108, 124, 171, 251, 19, 130,
+			65, 219, 108, 56, 153, 175, 119, 55, 239, 160,
+			223, 88, 168, 94, 87, 55, 247, 61, 123, 123,
+			231, 28, 66, 85, 99, 129, 112, 147, 11, 114,
+			154, 52, 80, 125, 110, 66, 36, 61, 103, 250,
+			167, 110, 117, 242, 194, 116, 142, 217, 40, 22,
+			100, 6, 36, 135, 227, 185, 200, 48, 67, 160,
+			139, 46, 7, 65, 33, 230, 28, 51, 1, 244,
+			117, 142, 25, 159, 146, 98, 81, 184, 52, 188,
+			113, 133, 165, 54, 243, 158, 125, 23, 148, 185,
+			150, 218, 204, 123, 166, 81, 234, 199, 132, 189,
+			205, 235, 165, 228, 184, 206, 222, 135, 46, 194,
+			138, 189, 229, 58, 251, 246, 157, 157, 239, 32,
+			50, 79, 147, 101, 120, 6, 243, 209, 131, 112,
+			62, 2, 213, 36, 36, 149, 98, 62, 202, 8,
+			159, 194, 72, 177, 84, 173, 233, 80, 111, 69,
+			129, 83, 195, 93, 150, 131, 42, 255, 5, 63,
+			89, 0, 246, 237, 189, 109, 195, 65, 22, 128,
+			125, 83, 0, 44, 85, 0, 246, 175, 252, 180,
+			148, 28, 215, 217, 255, 147, 219, 232, 95, 45,
+			100, 175, 215, 220, 122, 179, 246, 196, 218, 249,
+			23, 11, 170, 141, 6, 76, 103, 215, 55, 210,
+			46, 157, 116, 232, 100, 101, 119, 224, 60, 45,
+			40, 201, 133, 174, 216, 234, 175, 0, 227, 56,
+			193, 92, 21, 82, 84, 206, 48, 164, 73, 206,
+			69, 156, 203, 81, 121, 170, 194, 121, 60, 202,
+			112, 153, 152, 229, 134, 92, 217, 56, 82, 173,
+			12, 150, 86, 147, 57, 74, 114, 34, 72, 156,
+			145, 95, 151, 89, 138, 144, 179, 46, 125, 223,
+			220, 188, 137, 254, 163, 142, 234, 235, 170, 60,
+			63, 182, 95, 236, 252, 91, 125, 145, 94, 161,
+			94, 38, 150, 207, 212, 210, 152, 75, 156, 170,
+			229, 20, 226, 106, 185, 93, 62, 71, 87, 123,
+			2, 12, 11, 233, 158, 121, 146, 96, 206, 27,
+			111, 85, 13, 181, 211, 25, 22, 115, 150, 175,
+			152, 132, 86, 235, 106, 158, 86, 236, 42, 98,
+			22, 207, 176, 234, 73, 130, 42, 68, 205, 81,
+			151, 44, 194, 203, 66, 32, 203, 85, 156, 101,
+			139, 238, 249, 182, 33, 147, 229, 159, 21, 72,
+			70, 196, 69, 37, 173, 212, 105, 48, 46, 189,
+			205, 139, 56, 193, 77, 149, 147, 10, 146, 233,
+			141, 51, 141, 207, 49, 156, 5, 126, 228, 169,
+			2, 197, 21, 33, 89, 2, 23, 133, 183, 140,
+			71, 137, 224, 143, 87, 245, 9, 135, 156, 10,
+			48, 39, 108, 83, 206, 148, 83, 100, 165, 169,
+			248, 116, 119, 176, 120, 37, 239, 224, 156, 224,
+			116, 23, 38, 193, 160, 189, 184, 85, 182, 46,
+			229, 135, 41, 95, 101, 168, 176, 186, 25, 204,
+			40, 23, 32, 31, 105, 154, 208, 74, 245, 95,
+			38, 226, 236, 146, 223, 87, 139, 240, 10, 11,
+			216, 109, 101, 12, 199, 233, 133, 247, 134, 112,
+			193, 87, 25, 92, 67, 107, 235, 186, 79, 63,
+			94, 191, 89, 74, 182, 235, 60, 254, 232, 139,
+			82, 114, 92, 231, 241, 87, 191, 44, 79, 196,
+			255, 55, 0, 216, 89, 135, 100, 8, 28, 0,
+			0},
 	)
 }
@@ -10,456 +10,646 @@ func init() {
 			"logdog.Services",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 124, 221, 111, 27, 73,
-			114, 184, 102, 248, 33, 178, 101, 203, 18, 245, 233, 145, 63, 122,
-			105, 175, 165, 181, 41, 89, 150, 189, 231, 221, 245, 122, 15, 148,
-			72, 91, 220, 149, 69, 153, 164, 228, 91, 28, 188, 242, 136, 28,
-			73, 243, 219, 209, 12, 61, 51, 148, 173, 187, 223, 2, 73, 46,
-			184, 135, 32, 192, 30, 144, 231, 195, 37, 121, 8, 146, 123, 200,
-			127, 144, 135, 188, 38, 1, 238, 45, 64, 30, 238, 41, 15, 121,
-			9, 16, 32, 65, 128, 32, 1, 130, 84, 85, 119, 15, 135, 148,
-			228, 143, 77, 94, 14, 231, 197, 249, 204, 154, 238, 174, 170, 174,
-			170, 174, 174, 170, 238, 54, 251, 21, 99, 67, 65, 104, 134, 214,
-			66, 219, 247, 66, 47, 151, 118, 188, 189, 150, 183, 151, 255, 115,
-			141, 13, 175, 121, 123, 245, 208, 183, 204, 131, 58, 118, 200, 93,
-			97, 103, 169, 207, 246, 161, 229, 7, 182, 231, 78, 107, 92, 155,
-			203, 214, 206, 208, 199, 45, 241, 45, 55, 201, 210, 129, 213, 244,
-			173, 112, 90, 135, 214, 51, 53, 9, 229, 222, 103, 195, 161, 229,
-			31, 216, 174, 233, 108, 219, 110, 203, 122, 57, 157, 128, 246, 68,
-			237, 172, 250, 90, 193, 143, 57, 131, 101, 76, 191, 185, 111, 31,
-			90, 173, 233, 36, 116, 200, 212, 34, 24, 81, 183, 59, 254, 30,
-			180, 164, 168, 69, 66, 159, 255, 126, 150, 165, 115, 201, 228, 192,
-			69, 141, 253, 82, 99, 218, 153, 92, 34, 57, 144, 91, 250, 133,
-			198, 87, 188, 246, 145, 111, 239, 237, 135, 124, 105, 241, 214, 247,
-			120, 99, 223, 226, 43, 251, 190, 119, 96, 119, 14, 120, 177, 19,
-			238, 123, 126, 176, 192, 139, 142, 195, 169, 83, 192, 125, 43, 176,
-			124, 160, 180, 192, 248, 102, 96, 113, 111, 151, 135, 251, 118, 192,
-			3, 175, 227, 55, 45, 222, 244, 90, 22, 7, 112, 207, 131, 249,
-			187, 86, 139, 239, 28, 113, 147, 47, 215, 75, 243, 65, 120, 228,
-			88, 220, 177, 155, 150, 11, 195, 194, 125, 51, 228, 77, 211, 229,
-			59, 22, 227, 187, 94, 199, 109, 113, 219, 133, 175, 22, 95, 171,
-			172, 148, 215, 235, 101, 190, 107, 59, 214, 2, 99, 25, 166, 233,
-			185, 68, 58, 51, 204, 26, 76, 7, 150, 147, 89, 152, 130, 177,
-			202, 123, 197, 142, 36, 113, 48, 232, 133, 7, 244, 153, 147, 190,
-			248, 161, 233, 116, 144, 171, 131, 131, 142, 107, 55, 225, 75, 139,
-			135, 30, 199, 25, 0, 35, 1, 224, 103, 12, 4, 161, 229, 18,
-			217, 204, 36, 43, 49, 16, 144, 62, 144, 75, 12, 233, 51, 198,
-			93, 190, 17, 83, 153, 34, 64, 106, 220, 233, 236, 114, 169, 94,
-			224, 221, 151, 2, 32, 178, 128, 241, 44, 75, 33, 150, 100, 46,
-			57, 164, 103, 167, 217, 25, 1, 166, 16, 107, 70, 65, 64, 112,
-			40, 59, 169, 160, 4, 64, 231, 13, 246, 115, 157, 232, 67, 219,
-			152, 62, 102, 124, 171, 147, 46, 186, 51, 154, 5, 26, 100, 38,
-			64, 131, 175, 123, 161, 20, 34, 114, 37, 190, 35, 143, 240, 87,
-			7, 229, 254, 30, 12, 6, 16, 254, 231, 125, 109, 30, 21, 120,
-			96, 187, 77, 75, 48, 106, 185, 173, 182, 103, 187, 212, 221, 115,
-			157, 35, 198, 205, 38, 200, 34, 176, 119, 64, 63, 32, 156, 208,
-			239, 4, 40, 167, 72, 72, 196, 135, 36, 33, 84, 6, 127, 53,
-			247, 133, 114, 85, 47, 24, 201, 80, 218, 118, 11, 197, 46, 117,
-			96, 187, 32, 158, 3, 51, 68, 65, 189, 176, 193, 150, 58, 33,
-			119, 45, 171, 101, 187, 123, 72, 169, 77, 127, 163, 193, 121, 158,
-			15, 31, 205, 16, 132, 105, 187, 12, 40, 132, 47, 44, 203, 229,
-			22, 144, 225, 157, 54, 162, 140, 4, 171, 129, 96, 199, 244, 161,
-			25, 41, 60, 45, 133, 226, 26, 84, 16, 10, 15, 108, 69, 66,
-			32, 216, 177, 209, 28, 59, 36, 185, 130, 25, 77, 129, 94, 109,
-			49, 29, 197, 32, 172, 39, 97, 195, 125, 162, 86, 43, 142, 31,
-			128, 104, 204, 61, 107, 129, 87, 68, 47, 97, 81, 32, 187, 249,
-			91, 5, 22, 141, 35, 3, 176, 97, 149, 8, 4, 48, 177, 136,
-			97, 29, 24, 158, 210, 199, 198, 36, 83, 122, 10, 249, 80, 12,
-			163, 182, 167, 50, 202, 18, 116, 96, 120, 10, 44, 225, 54, 49,
-			12, 128, 161, 143, 27, 215, 20, 229, 152, 113, 239, 155, 1, 8,
-			9, 36, 164, 214, 125, 68, 45, 1, 212, 12, 125, 74, 137, 39,
-			145, 66, 36, 105, 5, 1, 53, 99, 240, 156, 130, 144, 64, 110,
-			140, 221, 34, 106, 201, 92, 226, 130, 158, 51, 174, 190, 138, 154,
-			240, 37, 17, 173, 36, 208, 186, 160, 27, 227, 18, 95, 50, 133,
-			40, 20, 173, 36, 208, 186, 48, 120, 86, 65, 64, 235, 194, 200,
-			232, 78, 154, 22, 208, 109, 246, 111, 239, 177, 75, 123, 158, 183,
-			231, 88, 55, 213, 146, 186, 217, 234, 248, 100, 42, 210, 201, 158,
-			19, 237, 11, 170, 61, 255, 9, 203, 148, 100, 151, 220, 52, 27,
-			4, 139, 244, 220, 86, 64, 14, 54, 81, 83, 96, 110, 156, 165,
-			92, 211, 245, 2, 114, 173, 169, 154, 0, 150, 55, 216, 24, 56,
-			129, 133, 62, 148, 203, 103, 21, 66, 90, 235, 27, 218, 31, 107,
-			218, 159, 234, 137, 135, 27, 203, 191, 212, 47, 61, 20, 125, 55,
-			100, 223, 133, 39, 150, 227, 124, 225, 122, 47, 220, 198, 81, 219,
-			10, 62, 255, 11, 142, 14, 245, 210, 192, 182, 198, 254, 238, 12,
-			57, 212, 75, 224, 80, 255, 250, 140, 112, 27, 77, 207, 225, 203,
-			157, 221, 93, 240, 18, 124, 158, 11, 84, 96, 85, 96, 201, 38,
-			24, 29, 24, 87, 115, 223, 116, 247, 44, 46, 214, 7, 235, 241,
-			194, 139, 31, 201, 1, 188, 226, 54, 23, 248, 41, 206, 119, 63,
-			12, 219, 193, 39, 55, 111, 182, 172, 67, 203, 241, 218, 64, 72,
-			205, 14, 38, 42, 132, 10, 76, 204, 239, 8, 38, 110, 130, 207,
-			168, 193, 194, 3, 141, 218, 59, 29, 90, 144, 38, 248, 220, 14,
-			184, 98, 240, 187, 210, 121, 227, 151, 29, 48, 122, 255, 136, 248,
-			10, 10, 180, 104, 57, 44, 74, 185, 120, 25, 63, 240, 90, 246,
-			46, 58, 82, 192, 80, 0, 235, 3, 143, 136, 11, 37, 68, 135,
-			1, 52, 15, 237, 22, 122, 88, 229, 151, 118, 61, 199, 241, 94,
-			224, 42, 71, 221, 216, 56, 40, 192, 65, 128, 199, 10, 63, 1,
-			150, 240, 191, 235, 125, 140, 5, 184, 30, 227, 219, 201, 1, 248,
-			35, 116, 108, 166, 220, 33, 204, 29, 216, 92, 160, 73, 74, 140,
-			113, 215, 11, 193, 5, 21, 132, 123, 115, 0, 21, 98, 136, 83,
-			116, 91, 125, 236, 0, 189, 166, 99, 218, 7, 150, 191, 112, 26,
-			19, 64, 44, 38, 11, 197, 4, 204, 177, 213, 33, 79, 42, 249,
-			96, 93, 70, 254, 87, 124, 48, 181, 255, 181, 188, 102, 231, 192,
-			114, 67, 83, 41, 233, 38, 200, 223, 131, 22, 159, 131, 165, 88,
-			190, 109, 58, 65, 87, 212, 164, 32, 104, 100, 60, 206, 125, 52,
-			169, 117, 203, 166, 145, 136, 216, 53, 15, 104, 187, 142, 219, 150,
-			235, 117, 219, 72, 238, 118, 24, 224, 140, 92, 129, 10, 246, 126,
-			32, 122, 132, 30, 31, 44, 133, 182, 78, 216, 61, 224, 171, 133,
-			70, 1, 76, 28, 224, 54, 36, 100, 2, 214, 217, 2, 238, 192,
-			56, 249, 46, 52, 48, 21, 22, 236, 134, 47, 208, 76, 148, 251,
-			15, 218, 86, 19, 45, 8, 70, 217, 104, 88, 62, 218, 142, 43,
-			172, 40, 8, 136, 119, 198, 27, 171, 149, 58, 175, 87, 31, 52,
-			158, 20, 107, 101, 14, 191, 55, 106, 213, 173, 74, 169, 92, 226,
-			203, 95, 66, 99, 153, 175, 84, 55, 190, 172, 85, 30, 174, 54,
-			248, 106, 117, 173, 84, 174, 213, 121, 113, 189, 4, 95, 215, 27,
-			181, 202, 242, 102, 163, 90, 171, 51, 158, 47, 214, 97, 104, 158,
-			90, 138, 235, 95, 242, 242, 15, 54, 106, 229, 122, 157, 87, 107,
-			188, 242, 104, 99, 173, 2, 216, 0, 125, 173, 184, 222, 168, 148,
-			235, 5, 94, 89, 95, 89, 219, 44, 85, 214, 31, 22, 56, 96,
-			224, 235, 213, 6, 131, 88, 228, 81, 165, 1, 253, 26, 213, 2,
-			145, 61, 62, 142, 87, 31, 240, 71, 229, 218, 202, 42, 128, 197,
-			229, 202, 90, 165, 241, 37, 17, 124, 80, 105, 172, 35, 177, 7,
-			213, 26, 227, 69, 190, 81, 172, 53, 42, 43, 155, 107, 197, 26,
-			223, 216, 172, 109, 84, 33, 190, 193, 153, 149, 42, 245, 149, 181,
-			98, 229, 81, 185, 4, 219, 203, 58, 208, 228, 229, 173, 242, 122,
-			131, 215, 87, 139, 107, 107, 189, 19, 101, 188, 250, 100, 189, 92,
-			67, 238, 227, 211, 228, 203, 101, 224, 178, 184, 188, 86, 70, 82,
-			52, 207, 82, 165, 86, 94, 105, 224, 132, 186, 191, 86, 64, 120,
-			192, 224, 26, 236, 88, 245, 141, 242, 74, 5, 126, 129, 60, 202,
-			48, 157, 98, 237, 203, 130, 68, 90, 47, 63, 222, 132, 94, 208,
-			200, 75, 197, 71, 197, 135, 48, 187, 185, 215, 73, 5, 20, 179,
-			178, 89, 43, 63, 66, 174, 65, 20, 245, 205, 229, 122, 163, 210,
-			216, 108, 148, 249, 195, 106, 181, 68, 194, 174, 151, 107, 91, 16,
-			211, 213, 239, 241, 181, 106, 157, 4, 182, 89, 47, 3, 35, 165,
-			98, 163, 72, 164, 1, 7, 136, 11, 218, 225, 247, 242, 102, 189,
-			66, 130, 171, 172, 55, 202, 181, 218, 230, 70, 163, 82, 93, 255,
-			0, 180, 252, 4, 36, 3, 92, 22, 97, 108, 137, 36, 92, 93,
-			199, 217, 162, 173, 148, 171, 181, 47, 17, 45, 202, 129, 52, 80,
-			224, 79, 86, 203, 240, 189, 134, 66, 37, 105, 21, 81, 12, 117,
-			144, 218, 74, 35, 222, 13, 8, 130, 16, 97, 74, 221, 121, 242,
-			245, 242, 195, 181, 202, 195, 242, 250, 74, 25, 155, 171, 136, 230,
-			73, 165, 94, 254, 0, 20, 86, 169, 99, 135, 10, 17, 6, 27,
-			0, 162, 155, 52, 107, 84, 20, 240, 197, 196, 239, 152, 233, 22,
-			72, 159, 188, 242, 128, 23, 75, 91, 21, 228, 92, 246, 6, 11,
-			168, 87, 164, 185, 144, 216, 86, 86, 165, 204, 163, 144, 151, 103,
-			166, 240, 87, 38, 151, 200, 15, 220, 99, 67, 44, 153, 249, 167,
-			193, 1, 1, 192, 150, 138, 0, 116, 202, 15, 78, 193, 94, 156,
-			38, 104, 64, 128, 195, 108, 80, 128, 154, 128, 101, 231, 65, 128,
-			140, 79, 36, 198, 43, 3, 5, 137, 81, 19, 128, 232, 132, 100,
-			175, 12, 142, 73, 140, 26, 98, 68, 80, 96, 212, 8, 35, 194,
-			178, 51, 96, 188, 50, 121, 67, 98, 188, 58, 176, 32, 49, 234,
-			2, 16, 157, 48, 232, 186, 58, 56, 35, 49, 234, 136, 17, 65,
-			129, 81, 39, 140, 8, 203, 206, 128, 241, 234, 165, 121, 137, 241,
-			253, 129, 188, 196, 152, 16, 128, 232, 148, 0, 140, 239, 15, 26,
-			18, 99, 2, 49, 34, 40, 48, 38, 8, 35, 194, 178, 51, 14,
-			189, 248, 158, 196, 120, 45, 154, 117, 82, 0, 162, 83, 18, 48,
-			94, 27, 188, 42, 49, 38, 17, 35, 130, 2, 99, 146, 48, 34,
-			44, 59, 3, 198, 107, 179, 106, 214, 179, 3, 239, 73, 140, 41,
-			1, 136, 78, 41, 192, 56, 59, 56, 45, 49, 166, 16, 35, 130,
-			2, 99, 138, 48, 34, 44, 59, 195, 172, 103, 103, 56, 251, 71,
-			38, 242, 155, 26, 68, 20, 198, 223, 163, 211, 80, 177, 9, 109,
-			59, 176, 241, 187, 224, 98, 77, 136, 228, 247, 32, 190, 47, 64,
-			126, 244, 210, 106, 205, 59, 150, 187, 7, 59, 64, 208, 134, 184,
-			28, 195, 88, 216, 74, 186, 221, 173, 22, 196, 246, 56, 166, 9,
-			9, 22, 109, 70, 50, 72, 162, 157, 104, 215, 55, 155, 221, 253,
-			86, 53, 192, 46, 134, 1, 19, 129, 12, 227, 13, 207, 17, 91,
-			10, 175, 80, 198, 128, 17, 115, 27, 54, 1, 75, 32, 52, 221,
-			35, 72, 6, 128, 139, 150, 233, 19, 86, 24, 215, 180, 218, 33,
-			238, 127, 95, 91, 60, 223, 50, 143, 242, 12, 55, 139, 252, 1,
-			108, 42, 251, 121, 133, 198, 183, 28, 149, 140, 53, 128, 103, 72,
-			214, 14, 218, 98, 7, 148, 161, 3, 132, 25, 16, 183, 88, 152,
-			181, 200, 108, 0, 118, 148, 23, 241, 222, 20, 136, 83, 138, 99,
-			118, 69, 133, 44, 216, 81, 154, 98, 182, 112, 143, 4, 234, 65,
-			103, 39, 196, 233, 162, 68, 112, 119, 130, 33, 17, 162, 5, 94,
-			163, 80, 12, 17, 181, 97, 47, 123, 105, 227, 62, 235, 28, 241,
-			27, 243, 183, 22, 11, 139, 139, 139, 252, 200, 50, 125, 204, 21,
-			121, 249, 37, 244, 135, 189, 243, 214, 39, 16, 176, 29, 180, 59,
-			176, 249, 69, 148, 9, 109, 15, 135, 20, 67, 180, 3, 171, 211,
-			242, 40, 144, 89, 144, 1, 79, 119, 10, 240, 255, 126, 200, 239,
-			243, 133, 133, 133, 123, 253, 109, 32, 210, 158, 150, 136, 144, 10,
-			147, 85, 171, 104, 142, 130, 103, 165, 201, 251, 136, 33, 130, 230,
-			5, 45, 5, 223, 235, 27, 68, 58, 151, 67, 196, 111, 53, 128,
-			32, 69, 196, 222, 229, 115, 199, 8, 125, 202, 23, 249, 181, 107,
-			253, 184, 62, 227, 139, 31, 240, 31, 139, 97, 39, 112, 119, 227,
-			62, 191, 117, 239, 88, 171, 36, 13, 109, 139, 234, 63, 217, 233,
-			27, 110, 57, 24, 171, 198, 24, 8, 34, 100, 159, 157, 200, 193,
-			167, 175, 230, 96, 254, 21, 28, 220, 56, 137, 131, 152, 254, 151,
-			186, 250, 239, 42, 140, 12, 160, 11, 222, 232, 106, 236, 237, 205,
-			224, 84, 101, 159, 110, 36, 162, 41, 174, 243, 251, 189, 58, 7,
-			142, 250, 133, 112, 175, 59, 72, 89, 64, 76, 235, 241, 1, 199,
-			204, 160, 59, 166, 87, 206, 61, 70, 23, 23, 113, 119, 192, 137,
-			210, 237, 234, 183, 219, 241, 179, 120, 199, 83, 104, 220, 56, 153,
-			198, 137, 54, 196, 186, 229, 158, 90, 102, 132, 181, 85, 185, 103,
-			11, 178, 236, 38, 175, 147, 99, 141, 60, 161, 44, 10, 196, 61,
-			235, 2, 127, 132, 89, 0, 56, 22, 210, 245, 252, 237, 91, 31,
-			22, 62, 188, 251, 61, 244, 17, 248, 135, 161, 55, 187, 209, 247,
-			17, 148, 223, 116, 58, 1, 196, 197, 61, 165, 161, 45, 189, 150,
-			139, 149, 134, 182, 162, 130, 0, 50, 183, 149, 57, 23, 43, 13,
-			109, 65, 138, 254, 147, 132, 42, 13, 125, 5, 57, 250, 191, 235,
-			138, 217, 30, 31, 110, 74, 222, 123, 157, 120, 204, 135, 199, 231,
-			196, 186, 147, 82, 198, 6, 62, 219, 10, 176, 204, 133, 51, 118,
-			173, 8, 155, 223, 179, 165, 136, 148, 195, 228, 48, 221, 103, 82,
-			86, 207, 96, 51, 178, 156, 22, 57, 95, 147, 183, 189, 0, 242,
-			157, 67, 74, 17, 92, 107, 207, 164, 223, 207, 136, 33, 217, 113,
-			129, 63, 128, 182, 104, 29, 19, 43, 49, 130, 208, 118, 224, 249,
-			144, 71, 153, 144, 159, 184, 243, 63, 178, 124, 79, 86, 93, 118,
-			101, 186, 210, 131, 77, 164, 102, 88, 71, 84, 211, 195, 68, 7,
-			183, 73, 220, 252, 168, 123, 47, 159, 253, 106, 252, 248, 227, 143,
-			11, 242, 143, 80, 97, 236, 195, 9, 234, 195, 2, 212, 87, 250,
-			214, 120, 172, 0, 245, 85, 79, 1, 234, 171, 204, 217, 88, 1,
-			234, 171, 88, 213, 227, 175, 38, 216, 76, 127, 213, 195, 58, 104,
-			135, 71, 167, 149, 60, 6, 89, 170, 140, 237, 167, 213, 47, 24,
-			181, 118, 139, 23, 255, 241, 22, 245, 139, 113, 81, 191, 184, 61,
-			242, 174, 126, 241, 174, 126, 241, 174, 126, 241, 174, 126, 241, 174,
-			126, 241, 174, 126, 241, 219, 91, 191, 184, 17, 175, 95, 220, 248,
-			238, 245, 139, 235, 191, 165, 245, 11, 129, 113, 110, 224, 178, 196,
-			152, 22, 128, 232, 148, 6, 140, 115, 145, 174, 211, 136, 113, 46,
-			210, 117, 154, 48, 206, 69, 186, 78, 3, 143, 115, 198, 37, 246,
-			95, 58, 85, 68, 18, 183, 7, 70, 140, 127, 209, 193, 11, 237,
-			89, 46, 120, 232, 38, 167, 144, 73, 29, 204, 137, 221, 250, 200,
-			235, 80, 210, 239, 91, 243, 24, 19, 128, 163, 55, 15, 61, 187,
-			5, 46, 125, 215, 118, 105, 167, 234, 180, 29, 113, 0, 204, 122,
-			199, 211, 78, 9, 195, 125, 94, 220, 168, 224, 81, 55, 15, 143,
-			218, 208, 211, 225, 150, 204, 246, 240, 232, 215, 19, 161, 70, 168,
-			66, 74, 223, 122, 222, 129, 28, 140, 74, 27, 2, 14, 218, 30,
-			29, 108, 67, 112, 37, 10, 35, 136, 15, 227, 132, 125, 79, 6,
-			188, 182, 11, 201, 149, 219, 180, 84, 224, 32, 143, 78, 161, 205,
-			235, 230, 54, 126, 187, 201, 151, 77, 127, 174, 47, 210, 91, 160,
-			64, 239, 3, 121, 190, 27, 240, 83, 218, 99, 185, 42, 158, 114,
-			126, 94, 7, 47, 19, 197, 238, 178, 78, 1, 156, 60, 163, 222,
-			207, 112, 102, 66, 22, 212, 209, 219, 249, 127, 86, 51, 228, 207,
-			126, 252, 205, 179, 216, 193, 248, 237, 204, 217, 40, 126, 253, 155,
-			143, 217, 89, 201, 117, 239, 77, 8, 35, 126, 61, 194, 120, 205,
-			201, 158, 241, 170, 24, 56, 255, 51, 141, 141, 62, 180, 194, 21,
-			207, 221, 181, 247, 106, 82, 172, 185, 2, 203, 53, 233, 203, 182,
-			164, 191, 221, 241, 29, 121, 183, 98, 68, 180, 212, 69, 195, 166,
-			239, 228, 46, 50, 22, 245, 22, 119, 44, 178, 181, 172, 234, 21,
-			230, 22, 216, 152, 194, 34, 187, 181, 205, 112, 159, 238, 90, 100,
-			107, 163, 178, 73, 48, 176, 1, 13, 249, 223, 211, 216, 68, 205,
-			218, 131, 0, 197, 242, 197, 165, 131, 154, 80, 63, 30, 67, 2,
-			215, 40, 54, 201, 139, 2, 79, 189, 226, 113, 236, 126, 72, 226,
-			132, 251, 33, 57, 150, 108, 89, 65, 147, 46, 119, 156, 169, 209,
-			239, 252, 22, 155, 236, 231, 65, 202, 102, 152, 233, 118, 75, 210,
-			135, 95, 32, 171, 20, 105, 131, 40, 15, 45, 77, 46, 8, 29,
-			45, 244, 94, 153, 168, 137, 78, 249, 199, 108, 116, 205, 51, 91,
-			111, 58, 47, 65, 76, 143, 136, 41, 86, 19, 116, 219, 68, 176,
-			250, 103, 26, 203, 197, 113, 70, 58, 148, 124, 105, 111, 192, 87,
-			132, 88, 239, 202, 32, 119, 131, 37, 96, 197, 18, 173, 161, 165,
-			243, 253, 217, 208, 130, 74, 100, 107, 216, 43, 7, 222, 76, 156,
-			142, 155, 206, 246, 215, 214, 145, 20, 230, 144, 250, 246, 133, 117,
-			148, 255, 93, 141, 77, 54, 196, 65, 127, 104, 125, 87, 9, 116,
-			53, 157, 120, 205, 101, 158, 228, 9, 151, 121, 242, 255, 160, 179,
-			241, 162, 56, 197, 255, 174, 28, 92, 99, 231, 64, 148, 219, 176,
-			196, 253, 163, 109, 170, 245, 170, 123, 67, 240, 185, 140, 95, 87,
-			240, 227, 27, 114, 132, 39, 232, 150, 239, 123, 62, 221, 32, 202,
-			214, 4, 128, 107, 74, 92, 7, 160, 149, 199, 196, 154, 18, 95,
-			112, 201, 93, 198, 27, 82, 212, 28, 216, 63, 178, 166, 135, 8,
-			177, 28, 81, 135, 47, 185, 25, 150, 37, 154, 52, 124, 156, 134,
-			103, 232, 131, 92, 176, 162, 145, 6, 79, 208, 96, 209, 157, 198,
-			158, 103, 25, 204, 60, 105, 232, 37, 33, 11, 132, 113, 36, 160,
-			165, 38, 26, 120, 153, 6, 82, 95, 28, 183, 244, 175, 58, 203,
-			72, 183, 16, 228, 62, 99, 217, 200, 175, 228, 38, 143, 25, 15,
-			185, 68, 227, 188, 50, 202, 227, 46, 168, 202, 134, 123, 23, 96,
-			238, 162, 234, 124, 162, 115, 48, 46, 157, 214, 44, 17, 174, 48,
-			214, 93, 37, 185, 243, 221, 229, 208, 183, 26, 13, 227, 164, 38,
-			137, 164, 194, 206, 245, 89, 112, 46, 162, 123, 178, 105, 27, 167,
-			204, 61, 87, 102, 103, 123, 12, 49, 119, 65, 33, 58, 201, 62,
-			79, 67, 243, 249, 207, 231, 217, 96, 46, 149, 28, 248, 153, 246,
-			27, 123, 213, 44, 203, 244, 4, 4, 30, 153, 193, 113, 250, 137,
-			215, 195, 6, 103, 233, 39, 116, 96, 16, 76, 61, 21, 103, 53,
-			195, 3, 83, 154, 241, 152, 31, 179, 21, 117, 91, 44, 138, 10,
-			96, 29, 64, 134, 217, 241, 187, 37, 44, 8, 39, 32, 103, 206,
-			71, 67, 243, 209, 189, 172, 216, 222, 59, 156, 57, 207, 92, 85,
-			165, 28, 213, 185, 97, 146, 208, 48, 176, 216, 172, 173, 169, 122,
-			215, 142, 9, 20, 242, 78, 167, 105, 207, 55, 37, 46, 181, 73,
-			227, 101, 30, 218, 92, 11, 34, 55, 183, 118, 205, 142, 3, 81,
-			139, 10, 61, 16, 203, 11, 188, 182, 36, 51, 228, 158, 26, 229,
-			168, 62, 60, 19, 171, 81, 142, 246, 92, 95, 27, 205, 206, 196,
-			106, 148, 163, 151, 46, 179, 207, 84, 137, 114, 92, 159, 54, 110,
-			17, 163, 42, 101, 71, 218, 130, 53, 85, 206, 134, 125, 25, 131,
-			42, 7, 12, 154, 210, 237, 158, 234, 218, 184, 62, 202, 99, 213,
-			181, 241, 136, 48, 86, 215, 198, 179, 99, 177, 234, 218, 248, 228,
-			20, 91, 87, 215, 187, 38, 245, 247, 140, 34, 17, 198, 253, 92,
-			17, 14, 173, 151, 225, 124, 64, 133, 7, 240, 9, 209, 189, 182,
-			62, 134, 34, 35, 142, 95, 219, 154, 212, 199, 167, 99, 215, 182,
-			38, 35, 70, 112, 154, 147, 217, 11, 177, 107, 91, 147, 151, 57,
-			251, 255, 12, 111, 61, 37, 103, 6, 222, 215, 140, 54, 63, 209,
-			41, 40, 203, 192, 249, 99, 113, 197, 116, 28, 203, 159, 15, 58,
-			109, 136, 78, 129, 55, 170, 175, 73, 19, 97, 125, 24, 122, 110,
-			201, 169, 73, 244, 25, 13, 10, 104, 38, 115, 145, 205, 129, 72,
-			40, 255, 185, 168, 79, 24, 51, 199, 47, 18, 202, 157, 68, 78,
-			86, 35, 117, 95, 212, 103, 40, 92, 71, 48, 133, 35, 51, 10,
-			2, 164, 23, 179, 35, 10, 130, 201, 94, 28, 27, 103, 179, 68,
-			2, 154, 46, 235, 99, 134, 241, 138, 187, 138, 130, 2, 234, 245,
-			178, 126, 113, 66, 98, 65, 189, 94, 150, 85, 83, 141, 244, 122,
-			89, 94, 219, 211, 72, 175, 151, 71, 115, 236, 30, 81, 192, 44,
-			81, 159, 49, 196, 45, 196, 99, 215, 47, 177, 102, 4, 209, 253,
-			201, 183, 48, 53, 82, 98, 94, 191, 60, 38, 49, 163, 18, 243,
-			209, 188, 144, 249, 188, 188, 133, 169, 145, 18, 243, 231, 13, 246,
-			5, 81, 5, 224, 170, 62, 106, 124, 38, 239, 62, 70, 198, 19,
-			197, 42, 37, 136, 71, 124, 187, 29, 138, 58, 146, 96, 234, 52,
-			46, 240, 78, 222, 85, 61, 63, 35, 41, 225, 157, 188, 171, 209,
-			220, 209, 187, 92, 205, 156, 81, 16, 18, 62, 55, 2, 139, 73,
-			135, 188, 46, 57, 55, 48, 175, 25, 75, 196, 68, 228, 75, 84,
-			222, 163, 60, 73, 159, 149, 212, 54, 86, 164, 49, 224, 252, 230,
-			50, 151, 216, 29, 152, 17, 165, 174, 215, 245, 17, 99, 86, 184,
-			221, 152, 41, 85, 74, 199, 47, 64, 74, 214, 117, 50, 140, 235,
-			250, 156, 88, 142, 58, 25, 198, 117, 41, 64, 145, 254, 94, 207,
-			14, 41, 8, 88, 191, 62, 124, 142, 125, 72, 228, 160, 169, 0,
-			106, 155, 147, 183, 45, 241, 82, 168, 164, 34, 83, 39, 16, 230,
-			9, 244, 208, 76, 10, 250, 245, 17, 137, 83, 75, 35, 154, 8,
-			66, 164, 163, 147, 10, 2, 122, 5, 80, 216, 42, 195, 219, 133,
-			201, 197, 129, 143, 52, 227, 83, 126, 108, 247, 36, 31, 35, 22,
-			93, 179, 227, 251, 120, 110, 29, 177, 99, 246, 178, 128, 66, 67,
-			117, 44, 130, 219, 197, 21, 68, 217, 249, 210, 155, 172, 160, 4,
-			9, 106, 73, 95, 20, 58, 78, 144, 160, 150, 164, 160, 68, 86,
-			191, 36, 87, 80, 130, 4, 181, 4, 43, 232, 14, 145, 128, 166,
-			59, 145, 94, 122, 73, 160, 19, 235, 213, 84, 68, 14, 229, 116,
-			71, 95, 154, 144, 40, 113, 57, 221, 137, 200, 161, 156, 238, 72,
-			189, 36, 72, 78, 119, 64, 47, 159, 16, 57, 88, 78, 119, 129,
-			220, 60, 93, 243, 244, 59, 86, 65, 156, 112, 180, 172, 254, 75,
-			159, 173, 200, 192, 35, 162, 184, 154, 238, 234, 119, 212, 60, 112,
-			53, 221, 149, 247, 61, 19, 164, 241, 187, 131, 138, 40, 46, 160,
-			187, 68, 148, 110, 137, 222, 27, 168, 104, 114, 9, 159, 106, 199,
-			93, 205, 197, 108, 24, 111, 145, 222, 203, 24, 100, 84, 84, 218,
-			184, 255, 214, 70, 149, 36, 221, 220, 215, 239, 9, 119, 13, 96,
-			26, 209, 140, 40, 8, 40, 220, 151, 70, 149, 36, 221, 220, 199,
-			187, 216, 26, 17, 132, 182, 34, 184, 129, 111, 53, 20, 87, 68,
-			163, 32, 93, 120, 228, 21, 78, 22, 219, 137, 30, 139, 69, 97,
-			76, 183, 167, 216, 130, 15, 204, 176, 73, 181, 112, 158, 239, 201,
-			16, 243, 242, 152, 11, 130, 150, 188, 72, 217, 162, 137, 161, 21,
-			20, 245, 251, 51, 146, 121, 180, 130, 162, 116, 44, 73, 178, 130,
-			162, 116, 44, 73, 178, 130, 34, 56, 150, 15, 104, 94, 96, 5,
-			37, 253, 138, 113, 129, 88, 68, 37, 156, 230, 1, 146, 164, 244,
-			146, 94, 28, 149, 120, 244, 52, 14, 53, 20, 4, 52, 74, 51,
-			151, 20, 4, 52, 74, 239, 229, 217, 159, 8, 225, 1, 180, 170,
-			159, 55, 254, 72, 19, 84, 100, 222, 197, 33, 23, 59, 129, 156,
-			184, 118, 12, 98, 193, 230, 150, 103, 5, 238, 108, 24, 19, 9,
-			126, 197, 155, 225, 97, 28, 147, 84, 72, 33, 110, 1, 116, 19,
-			187, 211, 182, 252, 93, 167, 227, 117, 196, 153, 69, 176, 239, 117,
-			64, 128, 59, 24, 0, 57, 86, 24, 133, 57, 73, 242, 204, 171,
-			122, 233, 138, 156, 0, 122, 230, 213, 72, 128, 232, 10, 86, 51,
-			227, 10, 194, 233, 76, 77, 51, 159, 225, 69, 231, 228, 163, 129,
-			39, 154, 177, 203, 79, 14, 177, 223, 106, 151, 239, 67, 113, 218,
-			214, 158, 2, 110, 30, 129, 55, 71, 199, 68, 37, 185, 234, 155,
-			56, 166, 20, 25, 127, 85, 127, 36, 60, 120, 138, 28, 83, 85,
-			122, 10, 81, 202, 171, 74, 199, 148, 34, 227, 175, 74, 199, 148,
-			66, 213, 62, 126, 91, 199, 148, 34, 147, 124, 172, 87, 39, 36,
-			74, 52, 201, 199, 17, 57, 52, 201, 199, 210, 49, 165, 200, 36,
-			31, 131, 143, 152, 37, 114, 96, 146, 245, 55, 136, 36, 82, 100,
-			144, 117, 253, 177, 98, 26, 189, 80, 93, 234, 44, 69, 6, 89,
-			151, 145, 68, 138, 12, 178, 14, 145, 196, 34, 81, 192, 179, 116,
-			240, 30, 87, 136, 66, 116, 191, 191, 231, 9, 64, 143, 237, 167,
-			200, 60, 182, 244, 250, 152, 68, 151, 136, 157, 212, 167, 200, 60,
-			182, 228, 213, 253, 20, 153, 199, 22, 56, 14, 23, 204, 35, 157,
-			75, 254, 112, 224, 72, 51, 118, 248, 73, 137, 211, 91, 24, 71,
-			239, 120, 118, 154, 105, 164, 129, 147, 31, 102, 46, 144, 105, 80,
-			109, 245, 233, 155, 152, 70, 154, 76, 227, 169, 254, 67, 177, 124,
-			211, 100, 26, 79, 165, 174, 68, 77, 246, 169, 52, 141, 52, 153,
-			198, 83, 48, 141, 7, 68, 2, 154, 182, 193, 52, 62, 126, 163,
-			88, 66, 36, 96, 47, 240, 34, 90, 239, 99, 133, 52, 25, 203,
-			182, 254, 116, 66, 18, 65, 99, 217, 142, 24, 64, 99, 217, 150,
-			198, 146, 38, 99, 217, 6, 99, 249, 148, 24, 0, 99, 49, 245,
-			11, 198, 77, 145, 101, 116, 14, 118, 44, 31, 9, 35, 81, 172,
-			130, 216, 86, 32, 169, 90, 190, 117, 156, 44, 90, 144, 169, 111,
-			171, 185, 161, 5, 153, 82, 173, 105, 178, 32, 51, 51, 165, 32,
-			32, 107, 26, 51, 148, 220, 164, 17, 104, 130, 5, 137, 228, 102,
-			31, 146, 84, 17, 95, 236, 245, 62, 39, 57, 125, 190, 104, 79,
-			77, 221, 188, 32, 145, 163, 61, 53, 35, 194, 104, 79, 77, 105,
-			79, 105, 178, 167, 38, 216, 211, 175, 53, 162, 156, 204, 37, 108,
-			88, 29, 191, 162, 141, 200, 245, 194, 88, 86, 135, 135, 147, 72,
-			204, 237, 58, 70, 170, 216, 208, 65, 36, 122, 84, 177, 125, 80,
-			42, 77, 149, 108, 224, 146, 42, 225, 130, 81, 24, 71, 221, 185,
-			215, 132, 248, 8, 12, 112, 110, 199, 194, 144, 26, 175, 94, 88,
-			162, 232, 108, 226, 85, 64, 124, 54, 133, 54, 122, 132, 35, 196,
-			117, 12, 49, 81, 122, 245, 178, 239, 189, 224, 157, 182, 74, 172,
-			227, 38, 209, 103, 194, 209, 235, 35, 16, 91, 16, 9, 6, 99,
-			4, 91, 111, 206, 200, 201, 227, 75, 18, 59, 50, 4, 140, 1,
-			236, 236, 176, 130, 64, 48, 54, 172, 233, 239, 147, 92, 160, 163,
-			3, 123, 204, 82, 108, 139, 177, 226, 185, 113, 175, 35, 145, 91,
-			52, 172, 176, 136, 114, 10, 40, 59, 186, 61, 38, 177, 167, 8,
-			161, 162, 140, 62, 215, 201, 70, 109, 64, 217, 153, 156, 102, 31,
-			17, 101, 216, 7, 93, 160, 124, 67, 196, 34, 16, 6, 188, 49,
-			201, 52, 144, 116, 117, 231, 188, 68, 155, 78, 33, 38, 101, 5,
-			184, 150, 221, 140, 34, 137, 71, 33, 46, 144, 20, 230, 55, 152,
-			75, 60, 143, 114, 235, 215, 77, 86, 216, 98, 15, 225, 65, 32,
-			252, 92, 119, 21, 97, 60, 244, 121, 30, 205, 21, 143, 210, 158,
-			103, 115, 10, 2, 194, 207, 39, 166, 216, 93, 34, 156, 201, 37,
-			2, 32, 124, 253, 213, 115, 61, 129, 98, 6, 40, 6, 250, 243,
-			105, 137, 53, 147, 66, 68, 106, 170, 25, 160, 24, 100, 20, 197,
-			12, 80, 12, 128, 162, 208, 107, 54, 151, 56, 212, 167, 222, 80,
-			175, 242, 158, 67, 15, 229, 44, 80, 62, 212, 3, 69, 57, 155,
-			66, 132, 106, 174, 89, 160, 124, 152, 29, 85, 16, 80, 62, 28,
-			159, 148, 122, 101, 185, 196, 75, 160, 252, 26, 189, 158, 68, 146,
-			1, 201, 151, 250, 161, 114, 29, 44, 133, 152, 212, 100, 25, 144,
-			124, 153, 81, 36, 25, 144, 124, 9, 36, 33, 152, 72, 15, 228,
-			82, 223, 96, 177, 204, 176, 184, 170, 85, 70, 142, 158, 46, 183,
-			66, 206, 89, 2, 218, 241, 69, 21, 173, 34, 90, 130, 116, 33,
-			103, 215, 196, 129, 120, 77, 129, 169, 17, 224, 239, 233, 97, 31,
-			172, 230, 222, 23, 143, 105, 116, 238, 223, 100, 70, 152, 197, 146,
-			105, 44, 46, 37, 127, 71, 211, 87, 140, 39, 221, 74, 22, 55,
-			241, 138, 7, 221, 55, 150, 172, 192, 250, 135, 93, 24, 92, 235,
-			161, 213, 155, 76, 157, 84, 78, 97, 16, 35, 248, 230, 1, 196,
-			90, 190, 92, 228, 105, 42, 27, 1, 157, 244, 57, 5, 234, 8,
-			142, 188, 175, 192, 4, 130, 139, 69, 182, 65, 60, 65, 223, 159,
-			104, 250, 35, 99, 185, 63, 191, 181, 201, 213, 217, 45, 240, 77,
-			94, 40, 178, 185, 216, 123, 79, 95, 118, 230, 120, 163, 72, 156,
-			57, 41, 242, 26, 161, 76, 143, 43, 80, 71, 112, 226, 186, 2,
-			19, 8, 126, 248, 5, 91, 33, 242, 208, 248, 83, 77, 47, 26,
-			31, 198, 211, 146, 55, 207, 36, 5, 78, 156, 196, 79, 181, 244,
-			136, 2, 9, 233, 104, 94, 129, 9, 4, 231, 191, 207, 118, 136,
-			34, 64, 127, 160, 233, 235, 70, 227, 88, 64, 120, 108, 198, 209,
-			220, 232, 92, 146, 158, 71, 198, 162, 151, 216, 227, 69, 38, 214,
-			100, 196, 16, 108, 49, 64, 36, 61, 161, 64, 29, 193, 201, 130,
-			2, 137, 133, 187, 107, 236, 47, 53, 226, 8, 204, 249, 91, 77,
-			255, 220, 248, 133, 214, 235, 196, 95, 195, 144, 111, 53, 193, 80,
-			123, 36, 50, 27, 68, 123, 83, 143, 101, 200, 203, 224, 116, 55,
-			103, 231, 40, 22, 241, 224, 221, 35, 101, 88, 157, 54, 214, 121,
-			58, 244, 70, 117, 183, 163, 94, 88, 178, 8, 99, 52, 59, 172,
-			189, 125, 171, 165, 199, 20, 168, 35, 56, 62, 167, 192, 4, 130,
-			183, 87, 163, 195, 204, 255, 60, 203, 134, 66, 51, 248, 58, 232,
-			59, 202, 124, 205, 233, 101, 254, 111, 53, 54, 36, 229, 209, 128,
-			225, 111, 113, 78, 51, 194, 18, 120, 16, 37, 142, 137, 240, 103,
-			238, 83, 118, 6, 194, 192, 208, 177, 182, 33, 51, 49, 197, 25,
-			213, 43, 79, 182, 134, 68, 247, 18, 246, 206, 45, 179, 115, 77,
-			15, 143, 168, 67, 107, 27, 52, 96, 123, 226, 209, 247, 43, 17,
-			12, 171, 17, 27, 52, 224, 243, 127, 30, 18, 239, 194, 175, 254,
-			230, 23, 235, 103, 89, 75, 148, 229, 207, 192, 108, 140, 31, 240,
-			152, 146, 132, 35, 69, 109, 115, 136, 193, 49, 116, 193, 159, 42,
-			3, 239, 190, 227, 142, 37, 151, 226, 66, 45, 248, 78, 7, 56,
-			57, 86, 38, 66, 7, 122, 6, 182, 233, 232, 201, 248, 48, 132,
-			220, 119, 143, 21, 189, 165, 37, 168, 27, 133, 81, 105, 16, 249,
-			217, 161, 217, 133, 94, 79, 205, 125, 88, 63, 51, 17, 171, 185,
-			15, 247, 212, 220, 135, 101, 56, 46, 106, 238, 195, 16, 142, 127,
-			164, 106, 238, 35, 16, 142, 139, 45, 107, 223, 12, 246, 79, 137,
-			195, 61, 181, 147, 246, 84, 219, 71, 244, 225, 137, 88, 181, 125,
-			164, 167, 218, 62, 34, 3, 112, 81, 109, 31, 129, 0, 156, 146,
-			123, 42, 183, 143, 3, 205, 255, 243, 228, 190, 255, 5, 255, 119,
-			78, 238, 69, 5, 31, 120, 28, 137, 85, 240, 199, 123, 30, 94,
-			143, 103, 134, 98, 21, 252, 113, 152, 220, 31, 234, 234, 229, 245,
-			140, 94, 48, 254, 91, 227, 37, 15, 89, 133, 248, 26, 93, 44,
-			62, 174, 17, 51, 85, 15, 215, 99, 220, 118, 220, 208, 118, 240,
-			134, 136, 77, 175, 104, 28, 11, 198, 136, 73, 123, 78, 43, 138,
-			197, 109, 241, 40, 196, 49, 197, 142, 218, 246, 97, 59, 69, 7,
-			10, 171, 194, 57, 154, 55, 247, 246, 124, 220, 176, 15, 187, 18,
-			13, 196, 37, 101, 228, 128, 136, 2, 15, 32, 40, 255, 8, 0,
-			18, 13, 88, 174, 105, 59, 4, 237, 88, 205, 142, 25, 28, 47,
-			228, 209, 157, 93, 245, 62, 93, 4, 247, 77, 15, 146, 78, 241,
-			28, 6, 130, 7, 79, 68, 16, 7, 86, 203, 22, 143, 247, 61,
-			31, 95, 188, 199, 159, 148, 207, 232, 227, 74, 142, 137, 52, 74,
-			199, 80, 16, 158, 56, 204, 204, 42, 8, 37, 119, 253, 6, 251,
-			181, 174, 222, 148, 95, 209, 111, 26, 191, 18, 255, 148, 129, 121,
-			160, 158, 33, 9, 73, 238, 134, 242, 102, 39, 45, 70, 204, 97,
-			32, 207, 23, 175, 130, 112, 185, 196, 166, 160, 124, 150, 11, 194,
-			97, 61, 7, 86, 226, 125, 147, 220, 29, 96, 188, 77, 55, 76,
-			163, 199, 250, 136, 25, 55, 31, 241, 188, 7, 20, 65, 20, 77,
-			151, 20, 195, 68, 26, 83, 232, 65, 47, 176, 99, 134, 181, 131,
-			121, 54, 184, 133, 166, 37, 213, 215, 131, 46, 250, 135, 16, 156,
-			102, 71, 60, 101, 194, 127, 12, 65, 62, 51, 66, 17, 219, 248,
-			6, 202, 66, 125, 88, 47, 219, 182, 218, 30, 73, 125, 115, 244,
-			61, 122, 197, 241, 129, 80, 49, 14, 176, 92, 114, 79, 45, 234,
-			40, 158, 120, 204, 201, 111, 221, 238, 61, 239, 239, 175, 232, 51,
-			5, 41, 252, 100, 26, 197, 173, 20, 131, 89, 211, 149, 153, 235,
-			10, 2, 197, 92, 153, 95, 80, 155, 223, 255, 4, 0, 0, 255,
-			255, 202, 44, 248, 11, 210, 68, 0, 0},
+			8, 0, 0, 0, 0, 0, 2, 255, 236, 124,
+			207, 111, 28, 71, 118, 240, 116, 117, 207, 112,
+			88, 148, 40, 178, 72, 81, 100, 83, 63, 202,
+			35, 217, 162, 165, 33, 245, 195, 246, 106, 109,
+			89, 94, 12, 201, 145, 56, 54, 197, 161, 102,
+			134, 212, 26, 11, 155, 110, 78, 23, 135, 253,
+			185, 167, 123, 212, 221, 67, 137, 222, 207, 192,
+			126, 223, 6, 123, 88, 4, 240, 2, 57, 229,
+			176, 216, 36, 135, 32, 217, 67, 254, 131, 28,
+			114, 78, 128, 189, 229, 182, 167, 28, 114, 9,
+			16, 32, 65, 128, 32, 1, 130, 224, 189, 170,
+			234, 233, 225, 15, 89, 118, 114, 89, 172, 13,
+			8, 158, 215, 85, 245, 222, 171, 247, 94, 189,
+			170, 247, 234, 21, 233, 111, 40, 29, 139, 19,
+			39, 17, 75, 189, 40, 76, 66, 86, 240, 195,
+			142, 27, 118, 74, 127, 97, 208, 241, 245, 176,
+			211, 76, 34, 225, 116, 155, 208, 129, 93, 165,
+			103, 177, 207, 206, 129, 136, 98, 47, 12, 102,
+			13, 110, 44, 140, 54, 206, 224, 199, 109, 249,
+			141, 205, 208, 66, 44, 218, 145, 72, 102, 9,
+			55, 22, 206, 52, 20, 196, 94, 167, 227, 137,
+			136, 186, 94, 224, 248, 59, 94, 224, 138, 23,
+			179, 38, 55, 22, 204, 198, 89, 253, 181, 6,
+			31, 153, 77, 139, 78, 212, 222, 247, 14, 132,
+			59, 107, 113, 99, 161, 216, 72, 97, 64, 221,
+			235, 71, 29, 225, 206, 230, 177, 69, 65, 31,
+			254, 193, 40, 45, 48, 203, 202, 93, 50, 232,
+			175, 13, 106, 156, 97, 166, 149, 99, 119, 127,
+			101, 240, 149, 176, 119, 24, 121, 157, 253, 132,
+			223, 189, 125, 231, 123, 188, 181, 47, 248, 202,
+			126, 20, 118, 189, 126, 151, 87, 250, 201, 126,
+			24, 197, 75, 188, 226, 251, 28, 59, 197, 60,
+			18, 177, 136, 14, 132, 187, 68, 249, 86, 44,
+			120, 184, 199, 147, 125, 47, 230, 113, 216, 143,
+			218, 130, 183, 67, 87, 112, 47, 230, 157, 240,
+			64, 68, 129, 112, 249, 238, 33, 119, 248, 114,
+			115, 117, 49, 78, 14, 125, 193, 125, 175, 45,
+			130, 88, 240, 100, 223, 73, 120, 219, 9, 248,
+			174, 160, 124, 47, 236, 7, 46, 247, 2, 158,
+			236, 11, 190, 94, 91, 169, 110, 52, 171, 124,
+			207, 243, 197, 18, 165, 69, 106, 16, 102, 22,
+			138, 227, 180, 69, 137, 149, 99, 214, 104, 238,
+			146, 97, 175, 241, 97, 177, 3, 73, 24, 236,
+			135, 29, 30, 227, 103, 142, 250, 226, 7, 142,
+			223, 7, 174, 186, 221, 126, 224, 181, 157, 68,
+			184, 60, 9, 57, 204, 192, 107, 139, 120, 137,
+			82, 74, 77, 43, 103, 48, 115, 180, 56, 67,
+			87, 169, 101, 229, 72, 142, 153, 99, 100, 222,
+			190, 199, 55, 51, 42, 211, 4, 80, 141, 187,
+			253, 61, 174, 212, 203, 247, 194, 72, 9, 0,
+			201, 46, 81, 122, 150, 230, 1, 139, 197, 172,
+			49, 50, 58, 75, 207, 72, 48, 15, 88, 139,
+			26, 50, 152, 57, 54, 58, 163, 33, 147, 153,
+			99, 115, 54, 253, 37, 65, 250, 6, 51, 167,
+			200, 148, 253, 21, 225, 173, 161, 25, 93, 143,
+			185, 52, 147, 37, 74, 249, 70, 152, 40, 33,
+			2, 87, 242, 59, 240, 24, 137, 164, 15, 114,
+			127, 141, 183, 128, 41, 47, 230, 225, 231, 206,
+			97, 153, 199, 94, 208, 22, 146, 81, 17, 184,
+			189, 208, 11, 176, 123, 24, 248, 135, 148, 59,
+			237, 182, 136, 99, 111, 215, 23, 32, 156, 36,
+			234, 199, 32, 167, 84, 72, 188, 53, 32, 33,
+			85, 198, 219, 78, 123, 95, 42, 87, 247, 226,
+			73, 72, 65, 218, 158, 11, 98, 87, 58, 240,
+			130, 189, 48, 234, 58, 9, 8, 234, 185, 151,
+			236, 135, 253, 132, 7, 66, 184, 94, 208, 1,
+			74, 61, 252, 63, 24, 92, 24, 70, 174, 23,
+			56, 73, 24, 113, 47, 160, 124, 87, 36, 207,
+			133, 8, 184, 112, 218, 251, 188, 223, 3, 148,
+			169, 96, 13, 139, 89, 83, 100, 108, 94, 9,
+			207, 200, 131, 184, 70, 52, 4, 194, 43, 142,
+			107, 200, 100, 230, 212, 36, 163, 7, 40, 87,
+			194, 204, 11, 100, 222, 246, 228, 116, 52, 131,
+			174, 120, 33, 109, 248, 136, 168, 245, 138, 227,
+			93, 17, 199, 78, 71, 44, 241, 154, 236, 37,
+			45, 202, 139, 249, 226, 157, 50, 77, 199, 161,
+			1, 120, 190, 175, 16, 120, 65, 39, 101, 152,
+			88, 204, 186, 64, 166, 166, 20, 83, 36, 15,
+			124, 104, 134, 65, 219, 23, 138, 218, 18, 136,
+			201, 204, 11, 115, 54, 125, 11, 25, 54, 153,
+			105, 147, 105, 251, 13, 77, 121, 192, 31, 223,
+			119, 98, 190, 11, 18, 210, 235, 62, 165, 102,
+			90, 204, 178, 201, 5, 45, 30, 51, 15, 72,
+			10, 26, 50, 152, 105, 143, 156, 211, 16, 16,
+			96, 83, 244, 14, 82, 179, 152, 121, 145, 48,
+			251, 218, 203, 168, 73, 95, 146, 210, 178, 44,
+			102, 93, 36, 246, 180, 194, 103, 229, 1, 133,
+			166, 101, 25, 204, 188, 56, 114, 86, 67, 38,
+			51, 47, 78, 76, 238, 22, 112, 1, 189, 69,
+			255, 245, 53, 122, 185, 19, 134, 29, 95, 220,
+			210, 75, 234, 150, 219, 143, 208, 84, 148, 147,
+			61, 39, 219, 151, 116, 123, 233, 61, 90, 92,
+			85, 93, 216, 44, 29, 137, 69, 59, 12, 220,
+			24, 29, 172, 217, 208, 32, 155, 166, 249, 192,
+			9, 194, 24, 93, 107, 190, 33, 129, 229, 77,
+			58, 213, 14, 187, 75, 71, 80, 46, 159, 213,
+			8, 113, 173, 111, 26, 127, 98, 24, 127, 70,
+			204, 71, 155, 203, 191, 38, 151, 31, 201, 190,
+			155, 170, 239, 210, 83, 225, 251, 31, 5, 225,
+			243, 160, 117, 216, 19, 241, 135, 127, 201, 193,
+			161, 94, 206, 237, 24, 244, 239, 206, 160, 67,
+			189, 156, 99, 119, 255, 230, 140, 116, 27, 237,
+			208, 231, 203, 253, 189, 61, 17, 197, 124, 145,
+			75, 84, 215, 99, 238, 58, 137, 195, 189, 32,
+			17, 81, 123, 223, 9, 58, 130, 203, 245, 65,
+			135, 188, 240, 237, 239, 171, 1, 188, 22, 180,
+			151, 248, 41, 206, 119, 63, 73, 122, 241, 123,
+			183, 110, 185, 226, 64, 248, 97, 79, 68, 177,
+			158, 93, 59, 236, 74, 161, 182, 67, 127, 113,
+			87, 50, 113, 139, 82, 222, 16, 174, 23, 39,
+			145, 183, 219, 199, 5, 233, 4, 46, 239, 199,
+			130, 123, 129, 118, 222, 240, 101, 215, 11, 156,
+			232, 16, 249, 138, 203, 184, 104, 121, 24, 233,
+			197, 75, 121, 55, 116, 189, 61, 112, 164, 94,
+			24, 148, 185, 19, 9, 222, 131, 133, 146, 128,
+			195, 232, 69, 225, 129, 231, 10, 119, 224, 151,
+			246, 66, 223, 15, 159, 195, 42, 7, 221, 120,
+			48, 40, 134, 65, 148, 119, 69, 242, 30, 165,
+			28, 254, 187, 113, 132, 177, 24, 214, 99, 118,
+			59, 233, 246, 227, 4, 28, 155, 163, 118, 8,
+			103, 55, 60, 128, 38, 37, 49, 202, 131, 48,
+			241, 218, 162, 44, 221, 155, 239, 197, 9, 96,
+			200, 82, 12, 220, 35, 236, 184, 94, 220, 246,
+			29, 175, 43, 162, 165, 211, 152, 240, 130, 172,
+			44, 52, 19, 189, 40, 116, 251, 109, 49, 224,
+			131, 14, 24, 249, 31, 241, 65, 245, 254, 231,
+			134, 237, 126, 87, 4, 137, 163, 149, 116, 43,
+			140, 120, 152, 236, 139, 136, 119, 157, 68, 68,
+			158, 227, 199, 3, 81, 163, 130, 146, 125, 65,
+			121, 150, 251, 116, 82, 27, 194, 195, 145, 128,
+			56, 112, 186, 184, 93, 103, 109, 43, 8, 7,
+			109, 40, 119, 47, 137, 97, 70, 129, 68, 21,
+			70, 49, 239, 58, 135, 224, 241, 251, 177, 220,
+			58, 69, 224, 134, 17, 236, 251, 17, 48, 209,
+			133, 109, 72, 202, 36, 137, 185, 43, 34, 240,
+			69, 124, 47, 10, 187, 84, 31, 11, 246, 146,
+			231, 96, 38, 218, 253, 199, 61, 209, 6, 11,
+			226, 189, 200, 3, 195, 138, 192, 118, 2, 105,
+			69, 113, 140, 188, 83, 222, 90, 171, 53, 121,
+			179, 254, 176, 245, 180, 210, 168, 242, 90, 147,
+			111, 54, 234, 219, 181, 213, 234, 42, 95, 254,
+			152, 183, 214, 170, 124, 165, 190, 249, 113, 163,
+			246, 104, 173, 197, 215, 234, 235, 171, 213, 70,
+			147, 87, 54, 86, 249, 74, 125, 163, 213, 168,
+			45, 111, 181, 234, 141, 38, 229, 165, 74, 147,
+			215, 154, 37, 108, 169, 108, 124, 204, 171, 63,
+			220, 108, 84, 155, 77, 94, 111, 240, 218, 227,
+			205, 245, 90, 117, 149, 63, 173, 52, 26, 149,
+			141, 86, 173, 218, 44, 243, 218, 198, 202, 250,
+			214, 106, 109, 227, 81, 153, 47, 111, 181, 248,
+			70, 189, 69, 249, 122, 237, 113, 173, 85, 93,
+			229, 173, 122, 25, 201, 30, 31, 199, 235, 15,
+			249, 227, 106, 99, 101, 173, 178, 209, 170, 44,
+			215, 214, 107, 173, 143, 145, 224, 195, 90, 107,
+			3, 136, 61, 172, 55, 40, 175, 240, 205, 74,
+			163, 85, 91, 217, 90, 175, 52, 248, 230, 86,
+			99, 179, 222, 172, 114, 152, 217, 106, 173, 185,
+			178, 94, 169, 61, 174, 174, 46, 241, 218, 6,
+			223, 168, 243, 234, 118, 117, 163, 197, 155, 107,
+			149, 245, 245, 225, 137, 82, 94, 127, 186, 81,
+			109, 0, 247, 217, 105, 242, 229, 42, 95, 175,
+			85, 150, 215, 171, 64, 10, 231, 185, 90, 107,
+			84, 87, 90, 48, 161, 193, 175, 149, 218, 106,
+			117, 163, 85, 89, 47, 83, 222, 220, 172, 174,
+			212, 42, 235, 101, 94, 253, 97, 245, 241, 230,
+			122, 165, 241, 113, 89, 33, 109, 86, 159, 108,
+			85, 55, 90, 181, 202, 58, 95, 173, 60, 174,
+			60, 170, 54, 249, 194, 215, 73, 101, 179, 81,
+			95, 217, 106, 84, 31, 3, 215, 245, 135, 188,
+			185, 181, 220, 108, 213, 90, 91, 173, 42, 127,
+			84, 175, 175, 162, 176, 155, 213, 198, 118, 109,
+			165, 218, 188, 207, 215, 235, 77, 20, 216, 86,
+			179, 90, 166, 124, 181, 210, 170, 32, 233, 205,
+			70, 253, 97, 173, 213, 188, 15, 191, 151, 183,
+			154, 53, 20, 92, 109, 163, 85, 109, 52, 182,
+			54, 91, 181, 250, 198, 155, 124, 173, 254, 180,
+			186, 93, 109, 240, 149, 202, 86, 179, 186, 138,
+			18, 174, 111, 192, 108, 193, 86, 170, 245, 198,
+			199, 128, 118, 189, 166, 52, 80, 230, 79, 215,
+			170, 173, 181, 106, 3, 132, 138, 210, 170, 128,
+			24, 154, 173, 70, 109, 165, 149, 237, 86, 111,
+			240, 86, 189, 209, 162, 153, 121, 242, 141, 234,
+			163, 245, 218, 163, 234, 198, 74, 21, 154, 235,
+			128, 230, 105, 173, 89, 125, 147, 87, 26, 181,
+			38, 116, 168, 33, 97, 254, 180, 242, 49, 175,
+			111, 225, 172, 65, 81, 91, 205, 42, 149, 191,
+			51, 166, 91, 70, 125, 242, 218, 67, 94, 89,
+			221, 174, 1, 231, 170, 247, 102, 189, 217, 172,
+			41, 115, 65, 177, 173, 172, 41, 153, 167, 71,
+			94, 94, 188, 0, 191, 138, 204, 44, 229, 238,
+			211, 49, 106, 21, 255, 113, 36, 39, 129, 51,
+			52, 15, 0, 97, 102, 105, 228, 2, 61, 75,
+			11, 8, 229, 36, 56, 78, 71, 36, 104, 72,
+			88, 117, 30, 97, 102, 201, 126, 79, 97, 188,
+			154, 43, 43, 140, 134, 4, 100, 39, 32, 123,
+			117, 100, 74, 97, 52, 72, 78, 130, 18, 163,
+			129, 24, 1, 86, 157, 71, 152, 121, 117, 230,
+			166, 194, 120, 45, 183, 164, 48, 18, 9, 200,
+			78, 112, 232, 186, 54, 50, 175, 48, 18, 146,
+			147, 160, 196, 72, 16, 35, 192, 170, 243, 8,
+			51, 175, 93, 94, 84, 24, 95, 207, 149, 20,
+			70, 83, 2, 178, 147, 73, 152, 249, 250, 136,
+			173, 48, 154, 36, 39, 65, 137, 209, 68, 140,
+			0, 171, 206, 48, 244, 210, 107, 10, 227, 27,
+			233, 172, 45, 9, 200, 78, 22, 97, 230, 27,
+			35, 215, 20, 70, 139, 228, 36, 40, 49, 90,
+			136, 17, 96, 213, 217, 100, 230, 27, 215, 245,
+			172, 175, 231, 94, 83, 24, 243, 18, 144, 157,
+			242, 132, 153, 215, 71, 102, 21, 198, 60, 201,
+			73, 80, 98, 204, 35, 70, 128, 85, 231, 17,
+			102, 94, 159, 231, 244, 31, 168, 140, 111, 26,
+			185, 29, 195, 254, 123, 112, 26, 250, 108, 130,
+			219, 142, 136, 69, 144, 196, 220, 225, 177, 215,
+			9, 132, 91, 230, 123, 222, 11, 225, 46, 250,
+			34, 232, 36, 251, 60, 238, 57, 1, 30, 99,
+			189, 174, 24, 116, 23, 46, 229, 14, 140, 105,
+			135, 253, 0, 55, 35, 117, 72, 194, 157, 104,
+			47, 114, 218, 131, 253, 86, 55, 36, 28, 15,
+			76, 8, 82, 30, 137, 56, 244, 229, 150, 194,
+			107, 24, 49, 192, 137, 185, 39, 2, 87, 72,
+			132, 78, 112, 200, 219, 142, 47, 2, 215, 137,
+			16, 107, 59, 12, 218, 162, 151, 192, 254, 247,
+			185, 224, 37, 215, 57, 44, 81, 30, 70, 188,
+			212, 13, 131, 100, 191, 164, 209, 68, 194, 215,
+			193, 88, 203, 235, 138, 56, 113, 186, 61, 185,
+			3, 170, 163, 131, 235, 193, 185, 69, 64, 212,
+			162, 162, 1, 202, 147, 231, 217, 222, 120, 16,
+			199, 16, 199, 25, 136, 10, 88, 240, 210, 48,
+			197, 113, 97, 143, 12, 35, 30, 247, 119, 19,
+			152, 46, 72, 4, 118, 39, 238, 12, 16, 45,
+			241, 6, 30, 197, 0, 81, 175, 23, 133, 47,
+			60, 216, 103, 253, 67, 126, 115, 241, 206, 237,
+			242, 237, 219, 183, 249, 161, 112, 34, 136, 21,
+			121, 245, 133, 211, 237, 249, 130, 223, 121, 143,
+			175, 132, 221, 94, 63, 17, 3, 202, 136, 118,
+			136, 67, 16, 22, 239, 197, 162, 239, 134, 120,
+			144, 89, 82, 7, 158, 193, 20, 226, 196, 137,
+			18, 254, 128, 47, 45, 45, 221, 63, 218, 38,
+			2, 119, 168, 37, 37, 164, 143, 201, 186, 85,
+			54, 235, 175, 75, 90, 147, 15, 0, 67, 10,
+			45, 74, 90, 26, 190, 127, 100, 16, 234, 92,
+			13, 145, 191, 245, 0, 132, 52, 17, 111, 143,
+			47, 28, 35, 244, 62, 191, 205, 223, 120, 227,
+			40, 174, 15, 248, 237, 55, 249, 143, 229, 176,
+			19, 184, 187, 249, 128, 223, 185, 127, 172, 85,
+			145, 126, 192, 239, 220, 214, 255, 169, 78, 95,
+			114, 225, 199, 98, 136, 129, 56, 69, 246, 193,
+			137, 28, 188, 255, 114, 14, 22, 95, 194, 193,
+			205, 147, 56, 200, 232, 255, 238, 64, 255, 3,
+			133, 161, 1, 12, 192, 155, 3, 141, 125, 115,
+			51, 56, 85, 217, 167, 27, 137, 108, 202, 234,
+			252, 193, 176, 206, 249, 205, 99, 66, 184, 63,
+			24, 164, 45, 32, 163, 245, 236, 128, 99, 102,
+			48, 24, 51, 44, 231, 33, 163, 203, 138, 120,
+			48, 224, 230, 203, 245, 59, 232, 248, 65, 182,
+			227, 41, 52, 110, 158, 76, 99, 241, 100, 13,
+			166, 233, 158, 70, 113, 130, 246, 116, 186, 103,
+			155, 76, 219, 109, 222, 68, 199, 154, 122, 66,
+			149, 20, 200, 122, 214, 37, 254, 24, 162, 128,
+			93, 33, 117, 189, 248, 214, 157, 119, 202, 239,
+			220, 251, 30, 248, 8, 248, 71, 121, 18, 242,
+			155, 71, 62, 114, 47, 104, 251, 253, 216, 59,
+			16, 67, 169, 161, 109, 210, 96, 153, 212, 208,
+			118, 154, 16, 0, 230, 182, 139, 231, 50, 169,
+			161, 109, 54, 69, 127, 106, 234, 212, 208, 167,
+			132, 217, 255, 70, 52, 179, 67, 62, 220, 81,
+			188, 15, 59, 241, 140, 15, 207, 206, 137, 14,
+			38, 165, 141, 45, 230, 190, 136, 33, 205, 5,
+			51, 14, 68, 138, 45, 26, 218, 82, 100, 200,
+			225, 240, 219, 148, 127, 166, 100, 245, 25, 223,
+			243, 132, 239, 162, 243, 117, 120, 47, 140, 189,
+			196, 59, 192, 16, 33, 16, 29, 7, 127, 127,
+			134, 12, 169, 142, 75, 252, 97, 24, 165, 182,
+			21, 35, 43, 25, 130, 97, 196, 187, 97, 36,
+			202, 220, 225, 65, 24, 44, 126, 33, 162, 80,
+			101, 93, 246, 84, 184, 50, 132, 77, 134, 102,
+			187, 66, 206, 8, 166, 7, 129, 14, 108, 147,
+			220, 145, 73, 187, 35, 124, 30, 85, 227, 187,
+			239, 190, 91, 86, 255, 164, 10, 51, 31, 78,
+			80, 31, 36, 160, 62, 37, 219, 211, 153, 4,
+			212, 167, 67, 9, 168, 79, 139, 103, 51, 9,
+			168, 79, 51, 89, 143, 191, 62, 79, 231, 143,
+			102, 61, 68, 183, 151, 28, 158, 150, 242, 24,
+			161, 249, 42, 180, 159, 150, 191, 160, 216, 58,
+			72, 94, 252, 251, 55, 200, 95, 76, 203, 252,
+			197, 91, 19, 223, 229, 47, 190, 203, 95, 124,
+			151, 191, 248, 46, 127, 241, 93, 254, 226, 187,
+			252, 197, 239, 111, 254, 226, 102, 54, 127, 113,
+			243, 219, 231, 47, 110, 252, 158, 230, 47, 36,
+			198, 133, 220, 21, 133, 177, 32, 1, 217, 169,
+			64, 152, 185, 144, 234, 186, 64, 114, 18, 148,
+			24, 11, 136, 113, 33, 213, 117, 193, 100, 230,
+			130, 125, 153, 254, 39, 193, 140, 136, 249, 86,
+			110, 194, 254, 103, 194, 43, 188, 35, 2, 17,
+			121, 109, 142, 71, 38, 125, 49, 39, 119, 235,
+			195, 176, 143, 65, 127, 36, 22, 225, 76, 144,
+			132, 220, 57, 8, 61, 151, 187, 98, 207, 11,
+			112, 167, 234, 247, 124, 121, 1, 76, 135, 199,
+			227, 78, 121, 24, 246, 35, 94, 217, 172, 193,
+			85, 55, 79, 14, 123, 94, 219, 241, 185, 80,
+			209, 158, 23, 3, 62, 64, 235, 37, 250, 72,
+			25, 137, 103, 125, 17, 39, 152, 218, 144, 112,
+			220, 11, 241, 98, 251, 176, 39, 100, 98, 4,
+			240, 193, 57, 97, 63, 84, 7, 94, 47, 136,
+			19, 39, 104, 11, 125, 112, 80, 87, 167, 252,
+			97, 24, 14, 98, 155, 168, 215, 230, 203, 78,
+			180, 112, 228, 164, 183, 132, 7, 189, 55, 213,
+			253, 110, 204, 79, 105, 207, 196, 170, 112, 203,
+			249, 97, 179, 190, 49, 56, 187, 171, 60, 69,
+			24, 241, 207, 176, 247, 103, 48, 51, 41, 11,
+			236, 24, 238, 254, 31, 209, 78, 248, 103, 63,
+			254, 242, 179, 204, 197, 248, 91, 197, 179, 233,
+			249, 245, 231, 239, 209, 179, 138, 235, 225, 74,
+			8, 59, 91, 30, 97, 127, 205, 205, 158, 253,
+			178, 51, 112, 233, 23, 6, 157, 124, 36, 146,
+			149, 48, 216, 243, 58, 13, 37, 86, 86, 166,
+			172, 141, 95, 118, 20, 253, 157, 126, 228, 171,
+			218, 138, 9, 217, 210, 148, 13, 91, 145, 207,
+			46, 81, 154, 246, 150, 53, 22, 163, 141, 81,
+			221, 43, 97, 75, 116, 74, 99, 81, 221, 122,
+			78, 178, 143, 181, 22, 163, 141, 73, 213, 36,
+			25, 216, 116, 146, 253, 210, 255, 55, 232, 249,
+			134, 232, 120, 113, 34, 34, 89, 116, 208, 144,
+			234, 135, 107, 200, 94, 20, 130, 216, 20, 47,
+			26, 60, 181, 196, 227, 88, 125, 136, 121, 66,
+			125, 8, 163, 150, 43, 226, 54, 22, 119, 156,
+			105, 224, 239, 210, 54, 157, 57, 202, 131, 146,
+			205, 56, 37, 158, 171, 232, 19, 207, 101, 101,
+			154, 71, 109, 32, 229, 177, 187, 51, 75, 82,
+			71, 75, 195, 37, 19, 13, 217, 169, 244, 132,
+			78, 174, 135, 142, 251, 170, 243, 146, 196, 72,
+			74, 76, 179, 106, 98, 181, 137, 100, 245, 207,
+			13, 202, 178, 56, 83, 29, 42, 190, 140, 87,
+			224, 43, 69, 76, 6, 50, 96, 55, 169, 233,
+			116, 4, 210, 26, 187, 59, 119, 52, 26, 90,
+			210, 129, 108, 3, 122, 177, 215, 232, 25, 121,
+			59, 238, 248, 59, 159, 139, 67, 37, 204, 49,
+			253, 237, 35, 113, 88, 250, 127, 6, 157, 105,
+			201, 139, 254, 68, 124, 91, 9, 12, 52, 109,
+			126, 77, 49, 143, 117, 66, 49, 79, 233, 143,
+			77, 58, 93, 65, 158, 190, 53, 7, 111, 208,
+			115, 126, 216, 217, 17, 65, 18, 29, 238, 96,
+			174, 87, 215, 13, 249, 97, 167, 10, 95, 87,
+			224, 227, 43, 114, 4, 55, 232, 34, 138, 194,
+			8, 43, 136, 70, 27, 18, 128, 53, 37, 203,
+			1, 112, 229, 81, 185, 166, 228, 23, 88, 114,
+			87, 232, 152, 4, 118, 98, 239, 11, 49, 59,
+			134, 136, 213, 136, 166, 247, 133, 96, 243, 116,
+			20, 105, 226, 240, 105, 28, 94, 196, 15, 106,
+			193, 202, 70, 28, 124, 30, 7, 203, 238, 56,
+			118, 142, 22, 33, 242, 196, 161, 151, 165, 44,
+			0, 134, 145, 243, 116, 20, 155, 112, 224, 21,
+			28, 136, 125, 113, 28, 240, 44, 64, 225, 56,
+			114, 65, 241, 140, 95, 52, 207, 178, 25, 71,
+			191, 169, 120, 198, 79, 48, 254, 238, 191, 16,
+			90, 84, 110, 37, 102, 31, 208, 209, 212, 47,
+			177, 153, 165, 19, 29, 176, 61, 167, 141, 250,
+			184, 11, 171, 211, 241, 225, 5, 204, 46, 233,
+			206, 39, 58, 23, 251, 242, 105, 205, 10, 225,
+			10, 165, 131, 85, 198, 230, 6, 203, 233, 200,
+			106, 182, 237, 147, 154, 20, 146, 26, 61, 119,
+			100, 5, 176, 148, 238, 201, 75, 195, 62, 101,
+			238, 172, 74, 207, 14, 25, 50, 187, 168, 17,
+			157, 100, 223, 167, 161, 249, 240, 151, 139, 116,
+			132, 229, 173, 220, 47, 140, 223, 217, 82, 181,
+			81, 74, 204, 28, 51, 139, 35, 211, 248, 19,
+			202, 203, 70, 174, 227, 79, 194, 76, 58, 114,
+			141, 126, 34, 239, 122, 198, 115, 23, 12, 251,
+			9, 63, 102, 43, 186, 218, 44, 61, 85, 196,
+			73, 212, 111, 39, 253, 104, 144, 2, 235, 199,
+			34, 162, 188, 148, 14, 45, 165, 117, 93, 153,
+			189, 123, 188, 56, 71, 3, 157, 229, 156, 36,
+			220, 118, 80, 104, 112, 48, 217, 106, 172, 235,
+			124, 217, 174, 19, 11, 94, 242, 251, 109, 111,
+			177, 173, 112, 233, 77, 30, 138, 129, 112, 115,
+			46, 99, 79, 87, 236, 57, 125, 63, 161, 186,
+			29, 177, 60, 135, 178, 39, 21, 97, 15, 229,
+			56, 39, 201, 248, 124, 38, 199, 57, 57, 84,
+			254, 54, 57, 58, 159, 201, 113, 78, 94, 190,
+			66, 63, 208, 41, 206, 105, 50, 107, 223, 225,
+			45, 21, 214, 107, 46, 37, 107, 58, 29, 30,
+			139, 132, 39, 33, 247, 67, 71, 134, 235, 67,
+			217, 185, 105, 50, 201, 51, 217, 185, 105, 82,
+			204, 100, 231, 166, 71, 167, 50, 217, 185, 233,
+			153, 11, 116, 67, 151, 135, 205, 144, 215, 236,
+			10, 18, 134, 243, 128, 38, 156, 136, 23, 201,
+			98, 140, 137, 11, 239, 139, 65, 93, 220, 17,
+			134, 82, 35, 206, 150, 125, 205, 144, 233, 217,
+			76, 217, 215, 76, 202, 8, 76, 115, 102, 244,
+			98, 166, 236, 107, 230, 10, 167, 255, 151, 66,
+			213, 148, 53, 159, 123, 221, 176, 123, 252, 68,
+			167, 160, 45, 35, 22, 50, 57, 227, 248, 190,
+			136, 22, 227, 126, 175, 231, 123, 194, 149, 249,
+			57, 101, 34, 244, 8, 134, 161, 42, 59, 61,
+			137, 35, 70, 3, 2, 154, 47, 94, 162, 11,
+			212, 178, 48, 126, 186, 68, 206, 219, 243, 199,
+			11, 17, 213, 78, 164, 38, 107, 160, 186, 47,
+			145, 121, 60, 238, 3, 152, 135, 145, 69, 13,
+			25, 204, 188, 52, 58, 161, 33, 147, 153, 151,
+			166, 166, 233, 117, 36, 97, 48, 243, 10, 153,
+			178, 237, 151, 212, 58, 74, 10, 160, 215, 43,
+			228, 210, 121, 133, 5, 244, 122, 69, 101, 93,
+			13, 212, 235, 21, 85, 246, 103, 160, 94, 175,
+			76, 50, 122, 31, 41, 64, 148, 73, 230, 109,
+			89, 197, 120, 172, 124, 19, 114, 78, 65, 231,
+			148, 42, 78, 3, 149, 88, 34, 87, 166, 20,
+			102, 80, 98, 41, 157, 23, 48, 95, 82, 85,
+			156, 6, 42, 177, 52, 103, 211, 143, 144, 170,
+			201, 204, 107, 100, 210, 254, 64, 213, 78, 166,
+			198, 147, 158, 117, 86, 69, 220, 142, 188, 94,
+			18, 70, 3, 166, 78, 227, 2, 106, 250, 174,
+			145, 210, 188, 162, 4, 53, 125, 215, 210, 185,
+			131, 119, 185, 86, 60, 163, 33, 32, 124, 110,
+			130, 126, 64, 137, 69, 152, 181, 144, 91, 52,
+			236, 187, 188, 149, 245, 37, 58, 110, 210, 158,
+			228, 136, 149, 52, 54, 87, 148, 49, 192, 252,
+			22, 138, 151, 233, 219, 212, 178, 48, 244, 189,
+			65, 38, 236, 235, 210, 237, 102, 76, 169, 182,
+			122, 188, 128, 82, 177, 78, 208, 48, 110, 144,
+			5, 185, 28, 9, 26, 198, 13, 37, 64, 25,
+			62, 223, 24, 29, 211, 144, 201, 204, 27, 227,
+			231, 232, 59, 72, 206, 96, 102, 153, 204, 219,
+			11, 82, 128, 88, 203, 27, 238, 101, 67, 47,
+			225, 158, 68, 15, 204, 164, 76, 110, 76, 40,
+			156, 70, 1, 208, 164, 16, 32, 157, 156, 209,
+			144, 201, 204, 242, 156, 77, 215, 40, 84, 39,
+			90, 183, 115, 223, 55, 236, 247, 249, 177, 221,
+			19, 125, 140, 92, 116, 237, 126, 20, 137, 32,
+			25, 176, 227, 12, 179, 0, 66, 3, 117, 220,
+			46, 206, 225, 10, 194, 232, 254, 238, 171, 172,
+			32, 19, 5, 117, 151, 220, 150, 58, 54, 81,
+			80, 119, 149, 160, 100, 86, 224, 174, 90, 65,
+			38, 10, 234, 238, 212, 52, 234, 197, 4, 65,
+			189, 157, 234, 101, 152, 4, 56, 177, 97, 77,
+			165, 228, 64, 78, 111, 147, 187, 231, 21, 74,
+			88, 78, 111, 167, 228, 64, 78, 111, 43, 189,
+			152, 40, 167, 183, 199, 207, 209, 247, 144, 28,
+			97, 230, 61, 50, 97, 47, 98, 153, 104, 212,
+			23, 101, 121, 67, 226, 138, 35, 22, 192, 221,
+			212, 192, 83, 162, 176, 154, 238, 145, 183, 245,
+			60, 96, 53, 221, 83, 245, 162, 38, 106, 252,
+			222, 136, 38, 10, 11, 232, 30, 18, 197, 42,
+			211, 251, 185, 154, 97, 47, 189, 220, 142, 7,
+			154, 203, 216, 48, 84, 161, 222, 47, 218, 104,
+			84, 152, 26, 121, 240, 141, 141, 202, 66, 221,
+			60, 32, 247, 165, 187, 182, 72, 174, 0, 104,
+			38, 52, 100, 48, 243, 129, 50, 42, 11, 117,
+			243, 0, 106, 185, 13, 36, 104, 48, 179, 66,
+			38, 237, 175, 12, 16, 87, 74, 163, 204, 147,
+			97, 175, 112, 178, 216, 78, 244, 88, 52, 61,
+			198, 12, 122, 202, 45, 184, 235, 36, 109, 204,
+			165, 243, 210, 80, 132, 89, 82, 215, 100, 94,
+			192, 75, 50, 228, 75, 39, 6, 86, 80, 33,
+			15, 230, 21, 243, 96, 5, 21, 229, 88, 44,
+			180, 130, 138, 114, 44, 22, 90, 65, 229, 220,
+			4, 125, 19, 231, 69, 152, 185, 74, 174, 218,
+			23, 145, 69, 80, 194, 105, 30, 192, 66, 165,
+			175, 146, 202, 164, 194, 67, 10, 48, 212, 214,
+			144, 193, 204, 213, 249, 203, 26, 50, 153, 185,
+			250, 90, 137, 254, 169, 20, 158, 201, 204, 53,
+			50, 103, 255, 145, 33, 169, 168, 184, 141, 127,
+			46, 14, 79, 32, 39, 203, 150, 189, 24, 155,
+			221, 80, 196, 193, 245, 36, 35, 18, 248, 234,
+			5, 178, 94, 59, 197, 164, 20, 82, 206, 90,
+			0, 86, 114, 247, 123, 34, 218, 243, 251, 97,
+			95, 222, 121, 196, 251, 97, 223, 119, 225, 140,
+			227, 10, 95, 36, 233, 49, 199, 66, 207, 188,
+			70, 86, 175, 170, 9, 128, 103, 94, 75, 5,
+			8, 174, 96, 173, 56, 173, 33, 152, 206, 133,
+			89, 26, 81, 40, 148, 182, 30, 231, 158, 26,
+			246, 30, 63, 249, 136, 253, 141, 118, 249, 35,
+			40, 78, 219, 218, 243, 6, 51, 31, 23, 47,
+			163, 99, 194, 148, 94, 253, 85, 28, 83, 30,
+			141, 191, 78, 30, 75, 15, 158, 71, 199, 84,
+			87, 158, 66, 166, 2, 235, 202, 49, 229, 209,
+			248, 235, 202, 49, 229, 65, 181, 79, 190, 169,
+			99, 202, 163, 73, 62, 33, 245, 243, 10, 37,
+			152, 228, 147, 148, 28, 152, 228, 19, 229, 152,
+			242, 104, 146, 79, 198, 207, 225, 73, 34, 15,
+			38, 217, 124, 133, 147, 68, 30, 13, 178, 73,
+			158, 104, 166, 193, 11, 53, 149, 206, 242, 104,
+			144, 77, 117, 146, 200, 163, 65, 54, 39, 25,
+			189, 141, 20, 224, 46, 158, 204, 219, 87, 145,
+			66, 250, 62, 96, 232, 9, 193, 144, 237, 231,
+			209, 60, 182, 73, 115, 74, 161, 51, 51, 55,
+			253, 121, 52, 143, 109, 85, 250, 159, 71, 243,
+			216, 158, 179, 105, 64, 137, 85, 96, 214, 143,
+			114, 135, 134, 189, 203, 79, 10, 156, 190, 129,
+			113, 12, 143, 167, 167, 153, 70, 193, 96, 230,
+			143, 138, 23, 209, 52, 48, 55, 251, 201, 171,
+			152, 70, 1, 77, 227, 19, 242, 35, 185, 124,
+			11, 104, 26, 159, 40, 93, 201, 156, 238, 39,
+			202, 52, 10, 104, 26, 159, 76, 77, 211, 135,
+			72, 194, 96, 230, 14, 153, 176, 223, 125, 165,
+			179, 132, 12, 192, 158, 59, 241, 209, 199, 14,
+			5, 52, 150, 29, 242, 201, 121, 69, 4, 140,
+			101, 39, 101, 0, 140, 101, 71, 25, 75, 1,
+			141, 101, 103, 252, 28, 125, 31, 25, 32, 204,
+			116, 200, 69, 251, 150, 140, 50, 250, 221, 93,
+			17, 1, 97, 32, 10, 89, 20, 79, 196, 138,
+			170, 136, 196, 113, 178, 96, 65, 14, 217, 209,
+			115, 3, 11, 114, 148, 90, 11, 104, 65, 78,
+			241, 130, 134, 76, 102, 58, 246, 60, 6, 55,
+			5, 0, 218, 100, 94, 5, 55, 251, 94, 103,
+			95, 158, 47, 58, 195, 207, 81, 78, 159, 47,
+			216, 83, 155, 56, 23, 21, 114, 176, 167, 118,
+			74, 24, 236, 169, 173, 236, 169, 128, 246, 212,
+			158, 179, 233, 111, 13, 164, 108, 49, 211, 35,
+			83, 246, 111, 112, 35, 10, 194, 36, 19, 213,
+			69, 66, 18, 11, 6, 142, 17, 51, 62, 120,
+			145, 9, 30, 85, 110, 31, 24, 74, 99, 38,
+			220, 11, 92, 204, 164, 75, 70, 157, 64, 118,
+			231, 97, 187, 221, 143, 132, 203, 23, 118, 5,
+			28, 169, 161, 116, 67, 200, 164, 181, 19, 184,
+			88, 88, 129, 54, 122, 8, 35, 100, 57, 135,
+			156, 40, 190, 154, 217, 15, 159, 243, 126, 79,
+			7, 214, 89, 147, 56, 98, 194, 218, 116, 65,
+			108, 113, 42, 24, 56, 35, 120, 164, 61, 175,
+			38, 15, 47, 81, 188, 212, 16, 224, 12, 224,
+			141, 142, 107, 200, 100, 166, 55, 201, 232, 15,
+			80, 46, 121, 102, 250, 100, 78, 29, 145, 149,
+			188, 179, 177, 241, 176, 35, 81, 91, 180, 147,
+			56, 41, 229, 188, 197, 44, 159, 120, 83, 10,
+			123, 30, 17, 106, 202, 224, 115, 253, 209, 180,
+			205, 100, 166, 63, 51, 75, 191, 143, 148, 11,
+			204, 12, 200, 156, 125, 19, 41, 67, 254, 233,
+			149, 73, 22, 44, 102, 5, 196, 159, 83, 104,
+			11, 121, 192, 164, 173, 0, 214, 114, 80, 212,
+			36, 225, 42, 37, 152, 153, 85, 230, 55, 194,
+			204, 103, 105, 108, 253, 117, 147, 149, 182, 56,
+			68, 120, 196, 98, 214, 51, 18, 104, 194, 112,
+			105, 244, 44, 157, 43, 92, 197, 61, 27, 101,
+			26, 50, 153, 249, 236, 252, 5, 122, 15, 9,
+			23, 153, 25, 147, 89, 251, 198, 203, 231, 122,
+			2, 197, 162, 197, 172, 152, 60, 155, 85, 88,
+			139, 121, 64, 164, 167, 90, 52, 152, 25, 23,
+			53, 197, 162, 201, 204, 248, 252, 5, 165, 215,
+			81, 102, 30, 144, 11, 175, 168, 87, 85, 39,
+			49, 68, 121, 212, 98, 214, 1, 137, 53, 229,
+			209, 60, 32, 212, 115, 29, 53, 152, 121, 48,
+			58, 169, 33, 147, 153, 7, 211, 51, 74, 175,
+			148, 153, 47, 200, 133, 175, 211, 235, 73, 36,
+			169, 197, 172, 23, 228, 64, 187, 14, 154, 7,
+			76, 122, 178, 212, 96, 230, 139, 162, 38, 73,
+			77, 102, 190, 152, 158, 129, 195, 68, 33, 199,
+			242, 95, 66, 178, 204, 22, 92, 231, 42, 83,
+			71, 143, 197, 177, 235, 97, 103, 53, 236, 12,
+			45, 170, 116, 21, 225, 18, 196, 130, 158, 61,
+			7, 6, 66, 153, 3, 213, 35, 122, 81, 136,
+			15, 3, 131, 206, 145, 23, 147, 5, 112, 238,
+			95, 22, 39, 168, 160, 86, 1, 146, 75, 214,
+			79, 12, 178, 98, 63, 29, 100, 178, 184, 3,
+			37, 34, 49, 119, 244, 72, 158, 132, 60, 18,
+			224, 90, 15, 196, 112, 48, 117, 82, 58, 133,
+			242, 158, 19, 57, 93, 145, 136, 72, 45, 242,
+			2, 166, 141, 172, 159, 24, 133, 115, 26, 36,
+			0, 78, 188, 174, 65, 19, 192, 219, 21, 186,
+			137, 60, 25, 204, 250, 169, 65, 30, 219, 203,
+			71, 227, 91, 15, 93, 157, 231, 138, 110, 47,
+			76, 100, 52, 151, 121, 47, 26, 169, 206, 28,
+			42, 146, 228, 157, 149, 38, 111, 32, 202, 194,
+			180, 6, 9, 128, 231, 111, 104, 208, 4, 240,
+			157, 143, 232, 10, 146, 39, 204, 250, 153, 65,
+			42, 246, 59, 217, 176, 228, 213, 35, 73, 137,
+			19, 38, 241, 51, 163, 48, 161, 65, 68, 58,
+			89, 210, 160, 9, 224, 226, 15, 232, 46, 82,
+			52, 153, 245, 115, 131, 108, 216, 173, 99, 7,
+			194, 99, 51, 78, 231, 134, 247, 154, 248, 188,
+			50, 115, 122, 201, 60, 126, 164, 114, 77, 166,
+			12, 153, 6, 16, 41, 156, 215, 32, 1, 112,
+			166, 172, 65, 100, 225, 222, 58, 253, 43, 3,
+			57, 178, 152, 245, 149, 65, 62, 180, 127, 101,
+			12, 59, 241, 175, 97, 40, 18, 237, 48, 114,
+			135, 36, 114, 61, 78, 247, 166, 33, 203, 80,
+			197, 228, 88, 219, 179, 123, 152, 57, 241, 120,
+			241, 192, 176, 250, 189, 48, 224, 113, 31, 223,
+			184, 238, 245, 245, 11, 77, 154, 98, 76, 103,
+			7, 185, 183, 175, 140, 194, 148, 6, 9, 128,
+			211, 11, 26, 52, 1, 124, 107, 45, 189, 12,
+			253, 143, 179, 116, 44, 113, 226, 207, 227, 35,
+			87, 161, 95, 115, 251, 89, 250, 91, 131, 142,
+			41, 121, 180, 156, 248, 243, 111, 112, 207, 51,
+			65, 77, 184, 200, 146, 215, 76, 240, 147, 189,
+			79, 207, 196, 34, 73, 124, 177, 227, 10, 223,
+			145, 119, 92, 47, 189, 25, 27, 147, 221, 87,
+			161, 55, 91, 166, 231, 218, 33, 92, 113, 39,
+			98, 167, 39, 34, 47, 148, 143, 198, 95, 138,
+			96, 92, 143, 216, 196, 1, 31, 254, 211, 152,
+			124, 87, 126, 237, 119, 63, 89, 127, 157, 186,
+			50, 45, 127, 38, 119, 205, 176, 127, 200, 51,
+			74, 146, 142, 20, 180, 205, 159, 245, 69, 95,
+			200, 159, 58, 2, 31, 188, 3, 207, 4, 151,
+			178, 32, 215, 11, 58, 190, 160, 199, 211, 68,
+			224, 64, 207, 20, 167, 6, 79, 206, 199, 201,
+			121, 251, 222, 177, 164, 183, 178, 4, 93, 145,
+			152, 166, 6, 129, 159, 93, 156, 93, 18, 14,
+			229, 220, 199, 201, 153, 243, 153, 156, 251, 248,
+			80, 206, 125, 92, 29, 199, 101, 206, 125, 124,
+			106, 26, 183, 44, 204, 185, 79, 144, 9, 181,
+			101, 237, 59, 241, 254, 41, 231, 240, 80, 239,
+			164, 67, 217, 246, 9, 50, 126, 62, 147, 109,
+			159, 24, 202, 182, 79, 168, 3, 184, 204, 182,
+			79, 140, 159, 147, 193, 61, 166, 219, 167, 201,
+			196, 255, 126, 112, 127, 244, 47, 0, 124, 235,
+			224, 94, 102, 240, 167, 201, 196, 68, 38, 131,
+			63, 61, 244, 112, 123, 186, 56, 150, 201, 224,
+			79, 143, 159, 163, 127, 72, 244, 203, 237, 121,
+			82, 182, 255, 203, 224, 171, 33, 176, 250, 220,
+			137, 19, 33, 31, 231, 200, 153, 234, 135, 239,
+			25, 110, 251, 65, 226, 249, 220, 67, 30, 157,
+			132, 251, 194, 137, 149, 202, 67, 223, 77, 207,
+			226, 158, 124, 84, 226, 59, 114, 71, 237, 69,
+			226, 0, 29, 232, 129, 136, 252, 195, 69, 167,
+			211, 137, 96, 195, 62, 24, 72, 52, 150, 69,
+			206, 192, 1, 18, 245, 186, 130, 242, 36, 58,
+			244, 130, 14, 138, 38, 224, 123, 142, 231, 35,
+			180, 43, 218, 125, 39, 62, 158, 200, 195, 154,
+			95, 253, 190, 93, 30, 238, 219, 161, 239, 11,
+			249, 156, 198, 11, 48, 36, 128, 45, 67, 184,
+			158, 124, 252, 31, 70, 240, 98, 62, 251, 36,
+			125, 158, 76, 107, 57, 154, 5, 144, 142, 157,
+			121, 146, 62, 63, 127, 61, 243, 36, 125, 254,
+			198, 77, 250, 91, 162, 223, 164, 95, 37, 183,
+			236, 223, 200, 63, 133, 224, 116, 245, 51, 38,
+			41, 201, 189, 68, 85, 134, 226, 98, 132, 24,
+			166, 29, 9, 249, 170, 8, 150, 75, 102, 10,
+			218, 103, 5, 34, 142, 233, 208, 133, 149, 124,
+			31, 165, 118, 7, 222, 142, 60, 172, 80, 77,
+			31, 251, 3, 102, 216, 124, 228, 243, 160, 208,
+			119, 145, 162, 19, 160, 98, 168, 12, 99, 202,
+			67, 232, 37, 118, 136, 176, 118, 5, 23, 240,
+			247, 15, 218, 66, 169, 111, 8, 93, 250, 135,
+			20, 252, 118, 95, 62, 133, 218, 61, 76, 159,
+			41, 129, 136, 189, 36, 70, 35, 16, 92, 188,
+			232, 121, 122, 123, 68, 245, 45, 224, 247, 244,
+			21, 200, 155, 82, 197, 48, 64, 4, 232, 158,
+			92, 158, 232, 70, 190, 160, 190, 13, 186, 15,
+			189, 223, 191, 74, 230, 203, 74, 248, 86, 1,
+			196, 109, 103, 222, 239, 95, 157, 191, 145, 121,
+			191, 127, 117, 113, 73, 111, 126, 255, 61, 0,
+			124, 117, 95, 139, 18, 69, 0, 0},
 	)
 }
@@ -198,6 +198,11 @@ type ArchiveStreamRequest struct {
 	DataUrl string `protobuf:"bytes,30,opt,name=data_url,json=dataUrl" json:"data_url,omitempty"`
 	// The size of the log stream's binary data.
 	DataSize int64 `protobuf:"varint,31,opt,name=data_size,json=dataSize" json:"data_size,omitempty"`
+	// The archive URL of the log stream's full-text search index. It is empty
+	// if no search index was archived.
+	SearchUrl string `protobuf:"bytes,40,opt,name=search_url,json=searchUrl" json:"search_url,omitempty"`
+	// The size of the log stream's full-text search index.
+	SearchSize int64 `protobuf:"varint,41,opt,name=search_size,json=searchSize" json:"search_size,omitempty"`
 }
 
 func (m *ArchiveStreamRequest) Reset()                    { *m = ArchiveStreamRequest{} }
@@ -489,46 +494,47 @@ var _Services_serviceDesc = grpc.ServiceDesc{
 }
 
 var fileDescriptor0 = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x54, 0xe1, 0x4e, 0x13, 0x41,
-	0x10, 0xce, 0xb5, 0x80, 0xed, 0x94, 0x82, 0xac, 0xd0, 0x5c, 0x0f, 0x01, 0xad, 0xd1, 0x98, 0x68,
-	0x8e, 0x04, 0xff, 0x9b, 0x18, 0x24, 0x86, 0x48, 0xa2, 0x5e, 0x95, 0xbf, 0xcd, 0xd1, 0x1b, 0x8e,
-	0xd3, 0xe3, 0xb6, 0xee, 0x6e, 0x89, 0xf5, 0x9f, 0x3e, 0x81, 0x4f, 0xe1, 0x3b, 0xf9, 0x1a, 0x3e,
-	0x81, 0xbb, 0xb3, 0x7b, 0x57, 0x29, 0x25, 0x21, 0xfc, 0xbb, 0x9d, 0x6f, 0xbe, 0xf9, 0xbe, 0x9b,
-	0x9d, 0x59, 0x68, 0x4b, 0x14, 0x17, 0xd9, 0x10, 0xc3, 0x91, 0xe0, 0x8a, 0xb3, 0xa5, 0x9c, 0xa7,
-	0x09, 0x4f, 0x83, 0x96, 0x54, 0xb1, 0x72, 0xc1, 0x60, 0x3b, 0xe5, 0x3c, 0xcd, 0x71, 0x97, 0x4e,
-	0x27, 0xe3, 0xd3, 0xdd, 0x64, 0x2c, 0x62, 0x95, 0xf1, 0xc2, 0xe1, 0x9b, 0xb3, 0x38, 0x9e, 0x8f,
-	0xd4, 0xc4, 0x82, 0xbd, 0x5f, 0x1e, 0xac, 0xbd, 0x41, 0xb5, 0xcf, 0x8b, 0xd3, 0x2c, 0x8d, 0x50,
-	0x8e, 0x78, 0x21, 0x91, 0x3d, 0x07, 0x36, 0xa4, 0xc8, 0xc0, 0xe9, 0x0f, 0xc6, 0x22, 0xf7, 0xbd,
-	0x07, 0xde, 0xd3, 0x66, 0x74, 0xd7, 0x22, 0x7d, 0x0b, 0x7c, 0x12, 0x39, 0xdb, 0x02, 0xa8, 0xb2,
-	0x95, 0x5f, 0xa3, 0xac, 0x66, 0x99, 0xa5, 0x58, 0x08, 0xf7, 0xca, 0x2a, 0x2e, 0x6d, 0x14, 0xab,
-	0x33, 0xbf, 0x4e, 0x79, 0x6b, 0x0e, 0xb2, 0x06, 0xde, 0x6b, 0xa0, 0xf7, 0xd3, 0x83, 0x8d, 0x08,
-	0xd3, 0x4c, 0x2a, 0x14, 0x7d, 0x25, 0x30, 0x3e, 0x8f, 0xf0, 0xeb, 0x18, 0xa5, 0x62, 0x3e, 0xdc,
-	0xd1, 0xae, 0x3f, 0xe3, 0x50, 0x39, 0x2f, 0xe5, 0x91, 0x75, 0x60, 0x49, 0xe2, 0x50, 0x38, 0xf9,
-	0xe5, 0xc8, 0x9d, 0xd8, 0x23, 0x68, 0xd3, 0x7f, 0x0e, 0x2e, 0x50, 0x48, 0xdd, 0x12, 0xa7, 0xba,
-	0x4c, 0xc1, 0x63, 0x1b, 0x63, 0x0c, 0x16, 0x12, 0x94, 0x43, 0x7f, 0x81, 0xa8, 0xf4, 0xdd, 0x3b,
-	0x86, 0xce, 0xac, 0x07, 0xd7, 0x9b, 0x15, 0xa8, 0x65, 0x89, 0xd3, 0xd7, 0x5f, 0xba, 0x57, 0x8b,
-	0x74, 0x1b, 0xa4, 0xdc, 0xda, 0xeb, 0x84, 0xf6, 0x8e, 0xc2, 0x23, 0x9e, 0x5a, 0x66, 0xdf, 0xa0,
-	0x91, 0x4d, 0xea, 0x7d, 0x80, 0xb5, 0x23, 0x1e, 0x27, 0x37, 0xfd, 0x2f, 0x2b, 0x56, 0xab, 0xc4,
-	0x4a, 0xab, 0xe6, 0x37, 0x1a, 0xce, 0xea, 0x6f, 0x0f, 0xd8, 0xff, 0x35, 0xab, 0x3b, 0x74, 0xbe,
-	0xbc, 0x1b, 0xf8, 0xaa, 0x0a, 0xd7, 0xa6, 0x3d, 0x60, 0xcf, 0xa0, 0x1e, 0xa7, 0x48, 0x5a, 0xad,
-	0xbd, 0x6e, 0x68, 0xc7, 0x28, 0x2c, 0xc7, 0x28, 0x7c, 0xed, 0xc6, 0x2c, 0x32, 0x59, 0xec, 0x21,
-	0x2c, 0xc7, 0x62, 0x78, 0x96, 0x5d, 0xc4, 0xf9, 0xe0, 0x0b, 0x4e, 0x5c, 0x33, 0x5b, 0x65, 0xec,
-	0x2d, 0x4e, 0x7a, 0x3f, 0x3c, 0xe8, 0x7c, 0x44, 0x71, 0x9e, 0x15, 0x5a, 0xf1, 0xb6, 0x1d, 0x98,
-	0xde, 0x74, 0xfd, 0xd2, 0x4d, 0x3f, 0x86, 0x15, 0x65, 0x6b, 0xe7, 0x83, 0xac, 0x48, 0xf0, 0x1b,
-	0x39, 0xa8, 0x47, 0xed, 0x32, 0x7a, 0x68, 0x82, 0xbd, 0x3f, 0x35, 0x58, 0x7f, 0x45, 0x9e, 0x6e,
-	0xed, 0xe0, 0x09, 0xac, 0xea, 0x56, 0x0e, 0xb0, 0x50, 0x62, 0xa2, 0x27, 0x7a, 0x5c, 0x58, 0x2b,
-	0x5a, 0x4a, 0x87, 0x0f, 0x4c, 0x74, 0xdf, 0x04, 0x6f, 0xe8, 0x88, 0xad, 0xc3, 0x22, 0x0a, 0xc1,
-	0x85, 0xbf, 0x48, 0x0a, 0xf6, 0x60, 0x76, 0x4a, 0x92, 0x3f, 0xda, 0x3c, 0xb0, 0x3b, 0x65, 0x23,
-	0x66, 0xe5, 0x76, 0xa0, 0xe5, 0x60, 0x99, 0x7d, 0x47, 0xbf, 0x45, 0x85, 0x1d, 0xa3, 0xaf, 0x23,
-	0x6c, 0x13, 0x9a, 0xa4, 0x49, 0xf4, 0x75, 0xa2, 0x37, 0x28, 0xe0, 0x16, 0xd6, 0x82, 0x44, 0xde,
-	0x20, 0xb2, 0x4d, 0x27, 0x6e, 0x17, 0x1a, 0x49, 0xac, 0x62, 0xa2, 0x6e, 0xdb, 0x5e, 0x98, 0xb3,
-	0x61, 0xea, 0xb2, 0x04, 0x11, 0x71, 0x87, 0x88, 0x94, 0x6b, 0x78, 0x7b, 0x7f, 0x6b, 0xd0, 0x70,
-	0xcf, 0x82, 0x64, 0x2f, 0xa1, 0x59, 0xbd, 0x2b, 0xac, 0x73, 0x65, 0x78, 0x0e, 0xcc, 0x1b, 0x14,
-	0x74, 0xcb, 0xa1, 0xbc, 0xfa, 0x04, 0xbd, 0x83, 0x95, 0xcb, 0x0b, 0xc8, 0xb6, 0xca, 0xe4, 0xb9,
-	0x8f, 0x43, 0xb0, 0x7d, 0x1d, 0xec, 0x0a, 0xee, 0x03, 0x4c, 0xb7, 0x84, 0x75, 0xa7, 0xeb, 0x30,
-	0xb3, 0x8d, 0x41, 0x30, 0x0f, 0x72, 0x45, 0x0e, 0x61, 0x75, 0x66, 0x82, 0x59, 0xa5, 0x3b, 0x7f,
-	0xb4, 0x83, 0x6b, 0xfe, 0x9d, 0x1d, 0x40, 0xfb, 0xd2, 0x20, 0xb2, 0xfb, 0x65, 0xa1, 0x79, 0xf3,
-	0x79, 0x5d, 0x99, 0x93, 0x25, 0x3a, 0xbf, 0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0x97, 0x7b, 0x4f,
-	0x01, 0x2a, 0x06, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x51, 0x4f, 0x13, 0x41,
+	0x10, 0xce, 0xb5, 0x80, 0xed, 0x94, 0x82, 0xac, 0xd0, 0x5c, 0x0f, 0x01, 0xad, 0xd1, 0x60, 0x24,
+	0x47, 0x82, 0xef, 0x26, 0x06, 0x89, 0x21, 0x92, 0xa8, 0x57, 0xe5, 0xf5, 0x72, 0xdc, 0x0d, 0xc7,
+	0xea, 0x71, 0x5b, 0x77, 0xb7, 0xc4, 0xf2, 0xa6, 0xbf, 0xc0, 0x57, 0xff, 0x80, 0x3f, 0xd0, 0x5f,
+	0x60, 0x76, 0x76, 0xaf, 0x95, 0x52, 0x12, 0xc2, 0xdb, 0xed, 0xf7, 0xcd, 0x37, 0xf3, 0xdd, 0xce,
+	0xcc, 0x42, 0x5b, 0xa1, 0xbc, 0xe0, 0x29, 0x86, 0x03, 0x29, 0xb4, 0x60, 0x0b, 0x85, 0xc8, 0x33,
+	0x91, 0x07, 0x2d, 0xa5, 0x13, 0xed, 0xc0, 0x60, 0x33, 0x17, 0x22, 0x2f, 0x70, 0x97, 0x4e, 0x27,
+	0xc3, 0xd3, 0xdd, 0x6c, 0x28, 0x13, 0xcd, 0x45, 0xe9, 0xf8, 0xf5, 0x69, 0x1e, 0xcf, 0x07, 0x7a,
+	0x64, 0xc9, 0xde, 0x2f, 0x0f, 0x56, 0xde, 0xa2, 0xde, 0x17, 0xe5, 0x29, 0xcf, 0x23, 0x54, 0x03,
+	0x51, 0x2a, 0x64, 0x3b, 0xc0, 0x52, 0x42, 0x62, 0x57, 0x3f, 0x1e, 0xca, 0xc2, 0xf7, 0x1e, 0x79,
+	0xdb, 0xcd, 0xe8, 0xbe, 0x65, 0xfa, 0x96, 0xf8, 0x2c, 0x0b, 0xb6, 0x01, 0x30, 0x8e, 0xd6, 0x7e,
+	0x8d, 0xa2, 0x9a, 0x55, 0x94, 0x66, 0x21, 0x3c, 0xa8, 0xb2, 0xb8, 0xb0, 0x41, 0xa2, 0xcf, 0xfc,
+	0x3a, 0xc5, 0xad, 0x38, 0xca, 0x1a, 0xf8, 0x90, 0xe8, 0xb3, 0xde, 0x4f, 0x0f, 0xd6, 0x22, 0xcc,
+	0xb9, 0xd2, 0x28, 0xfb, 0x5a, 0x62, 0x72, 0x1e, 0xe1, 0xb7, 0x21, 0x2a, 0xcd, 0x7c, 0xb8, 0x37,
+	0x90, 0xe2, 0x0b, 0xa6, 0xda, 0x79, 0xa9, 0x8e, 0xac, 0x03, 0x0b, 0x0a, 0x53, 0xe9, 0xca, 0x2f,
+	0x46, 0xee, 0xc4, 0x9e, 0x40, 0x9b, 0xfe, 0x33, 0xbe, 0x40, 0xa9, 0xb8, 0x28, 0x5d, 0xd5, 0x45,
+	0x02, 0x8f, 0x2d, 0xc6, 0x18, 0xcc, 0x65, 0xa8, 0x52, 0x7f, 0x8e, 0xa4, 0xf4, 0xdd, 0x3b, 0x86,
+	0xce, 0xb4, 0x07, 0x77, 0x37, 0x4b, 0x50, 0xe3, 0x99, 0xab, 0x5f, 0xe3, 0x19, 0xdb, 0x81, 0x79,
+	0xea, 0x06, 0x55, 0x6e, 0xed, 0x75, 0x42, 0xdb, 0xa3, 0xf0, 0x48, 0xe4, 0x56, 0xd9, 0x37, 0x6c,
+	0x64, 0x83, 0x7a, 0x1f, 0x61, 0xe5, 0x48, 0x24, 0xd9, 0x6d, 0xff, 0xcb, 0x16, 0xab, 0x8d, 0x8b,
+	0x55, 0x56, 0xcd, 0x6f, 0x34, 0x9c, 0xd5, 0x3f, 0x1e, 0xb0, 0xff, 0x73, 0x8e, 0x7b, 0xe8, 0x7c,
+	0x79, 0xb7, 0xf0, 0x35, 0x4e, 0x5c, 0x9b, 0xdc, 0x01, 0x7b, 0x01, 0xf5, 0x24, 0x47, 0xaa, 0xd5,
+	0xda, 0xeb, 0x86, 0x76, 0x8c, 0xc2, 0x6a, 0x8c, 0xc2, 0x37, 0x6e, 0xcc, 0x22, 0x13, 0xc5, 0x1e,
+	0xc3, 0x62, 0x22, 0xd3, 0x33, 0x7e, 0x91, 0x14, 0xf1, 0x57, 0x1c, 0xb9, 0xcb, 0x6c, 0x55, 0xd8,
+	0x3b, 0x1c, 0xf5, 0x7e, 0x78, 0xd0, 0xf9, 0x84, 0xf2, 0x9c, 0x97, 0x89, 0xc6, 0xbb, 0xde, 0xc0,
+	0xa4, 0xd3, 0xf5, 0x2b, 0x9d, 0x7e, 0x0a, 0x4b, 0xda, 0xe6, 0x2e, 0x62, 0x5e, 0x66, 0xf8, 0x9d,
+	0x1c, 0xd4, 0xa3, 0x76, 0x85, 0x1e, 0x1a, 0xb0, 0xf7, 0xbb, 0x0e, 0xab, 0xaf, 0xc9, 0xd3, 0x9d,
+	0x1d, 0x3c, 0x83, 0xe5, 0x42, 0xe4, 0x31, 0x96, 0x5a, 0x8e, 0xe2, 0x54, 0x0c, 0x4b, 0x6b, 0xa5,
+	0x1e, 0xb5, 0x0b, 0x91, 0x1f, 0x18, 0x74, 0xdf, 0x80, 0xb7, 0x74, 0xc4, 0x56, 0x61, 0x1e, 0xa5,
+	0x14, 0xd2, 0x9f, 0xa7, 0x0a, 0xf6, 0x60, 0x76, 0x4a, 0x91, 0x3f, 0xda, 0x3c, 0xb0, 0x3b, 0x65,
+	0x11, 0xb3, 0x72, 0x5b, 0xd0, 0x72, 0xb4, 0xe2, 0x97, 0xe8, 0xb7, 0x28, 0xb1, 0x53, 0xf4, 0xf9,
+	0x25, 0xb2, 0x75, 0x68, 0x52, 0x4d, 0x92, 0xaf, 0x92, 0xbc, 0x41, 0x80, 0x5b, 0x58, 0x4b, 0x92,
+	0x78, 0x8d, 0xc4, 0x36, 0x9c, 0xb4, 0x5d, 0x68, 0x64, 0x89, 0x4e, 0x48, 0xba, 0x69, 0xef, 0xc2,
+	0x9c, 0x8d, 0x72, 0x1d, 0x9a, 0x44, 0x91, 0x70, 0x8b, 0x84, 0x14, 0x4b, 0x3a, 0xe3, 0x19, 0x4d,
+	0xc3, 0x49, 0xb9, 0xed, 0x3c, 0x13, 0x52, 0x79, 0xb6, 0x34, 0xa9, 0x9f, 0x3b, 0xcf, 0x04, 0x19,
+	0xfd, 0xde, 0xdf, 0x1a, 0x34, 0xdc, 0xb3, 0xa2, 0xd8, 0x2b, 0x68, 0x8e, 0xdf, 0x25, 0xd6, 0xb9,
+	0x36, 0x7c, 0x07, 0xe6, 0x0d, 0x0b, 0xba, 0xd5, 0x50, 0x5f, 0x7f, 0xc2, 0xde, 0xc3, 0xd2, 0xd5,
+	0x05, 0x66, 0x1b, 0x55, 0xf0, 0xcc, 0xc7, 0x25, 0xd8, 0xbc, 0x89, 0x76, 0x09, 0xf7, 0x01, 0x26,
+	0x5b, 0xc6, 0xba, 0x93, 0x75, 0x9a, 0xda, 0xe6, 0x20, 0x98, 0x45, 0xb9, 0x24, 0x87, 0xb0, 0x3c,
+	0xb5, 0x01, 0x6c, 0x5c, 0x77, 0xf6, 0x6a, 0x04, 0x37, 0xfc, 0x3b, 0x3b, 0x80, 0xf6, 0x95, 0x41,
+	0x66, 0x0f, 0xab, 0x44, 0xb3, 0xe6, 0xfb, 0xa6, 0x34, 0x27, 0x0b, 0x74, 0x7e, 0xf9, 0x6f, 0x00,
+	0x50, 0xbb, 0xfc, 0xe2, 0x6a, 0x06, 0x00, 0x00,
 }
@@ -6,12 +6,17 @@ package cache
 
 import (
 	"bytes"
+	"crypto"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"hash"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -45,6 +50,11 @@ type Cache interface {
 
 	// Hardlink ensures file at |dest| has the same content as cached |digest|.
 	//
+	// |perm| is the mode of |dest|. Since the mode is shared by all the
+	// hardlinks to a file, the item is copied instead of hardlinked when |perm|
+	// is writeable, so that |dest| can't be used to modify the cached item, or
+	// when the item is already hardlinked elsewhere with another mode.
+	//
 	// Note that the behavior when dest already exists is undefined. It will work
 	// on all POSIX and may or may not fail on Windows depending on the
 	// implementation used. Do not rely on this behavior.
@@ -64,42 +74,68 @@ type Policies struct {
 	// MinFreeSpace trims if disk free space becomes lower than this value. If 0,
 	// it unconditionally fills the disk. Only makes sense when using disk based
 	// cache.
-	//
-	// BUG: Implement Policies.MinFreeSpace.
 	MinFreeSpace units.Size
 }
 
+// exceeded returns true if the items in 'l' are above the MaxSize and MaxItems
+// limits.
+func (p *Policies) exceeded(l *lruDict) bool {
+	return (p.MaxItems != 0 && l.length() > p.MaxItems) || (p.MaxSize != 0 && l.sum > p.MaxSize)
+}
+
 // NewMemory creates a purely in-memory cache.
-func NewMemory(policies Policies) Cache {
+//
+// 'h' is the hashing algorithm used to verify the items' digest.
+func NewMemory(policies Policies, h crypto.Hash) Cache {
 	return &memory{
 		policies: policies,
+		h:        h,
 		data:     map[isolated.HexDigest][]byte{},
-		lru:      makeLRUDict(),
+		lru:      makeLRUDict(h),
 	}
 }
 
 // NewDisk creates a disk based cache.
 //
+// 'h' is the hashing algorithm used to verify the items' digest.
+//
+// Multiple processes can share the same cache directory. The directory is
+// locked while the state file is read or written and while items are added or
+// removed, and the LRU state of each process is merged into the state file on
+// Close(). If another process holds the lock, the cache waits for it to be
+// released for up to a minute and then fails. Items are verified when added,
+// when read and when their size or modification time changed, and evicted if
+// corrupted.
+//
 // It may return both a valid Cache and an error if it failed to load the
-// previous cache metadata. It is safe to ignore this error.
-func NewDisk(policies Policies, path string) (Cache, error) {
+// previous cache metadata. In this case, the metadata is rebuilt from the
+// directory content. It is safe to ignore this error.
+func NewDisk(policies Policies, path string, h crypto.Hash) (Cache, error) {
 	if !filepath.IsAbs(path) {
 		return nil, errors.New("must use absolute path")
 	}
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(filepath.Join(path, lockFileName), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
 	d := &disk{
 		policies: policies,
 		path:     path,
-		lru:      makeLRUDict(),
+		h:        h,
+		lockFile: f,
+		lru:      makeLRUDict(h),
+		used:     makeOrderedDict(),
 	}
-	p := d.statePath()
-	f, err := os.Open(p)
-	if err == nil {
-		defer f.Close()
-		err = json.NewDecoder(f).Decode(&d.lru)
-	} else if os.IsNotExist(err) {
-		// The fact that the cache is new is not an error.
-		err = nil
+	if err := d.lockDir(); err != nil {
+		f.Close()
+		return nil, err
 	}
+	defer d.unlockDir()
+	err = d.load()
+	d.respectPolicies()
 	return d, err
 }
 
@@ -108,6 +144,7 @@ func NewDisk(policies Policies, path string) (Cache, error) {
 type memory struct {
 	// Immutable.
 	policies Policies
+	h        crypto.Hash
 
 	// Lock protected.
 	lock sync.Mutex
@@ -128,7 +165,7 @@ func (m *memory) Keys() isolated.HexDigests {
 }
 
 func (m *memory) Touch(digest isolated.HexDigest) bool {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return false
 	}
 	m.lock.Lock()
@@ -141,7 +178,7 @@ func (m *memory) Touch(digest isolated.HexDigest) bool {
 }
 
 func (m *memory) Evict(digest isolated.HexDigest) {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return
 	}
 	m.lock.Lock()
@@ -151,7 +188,7 @@ func (m *memory) Evict(digest isolated.HexDigest) {
 }
 
 func (m *memory) Read(digest isolated.HexDigest) (io.ReadCloser, error) {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return nil, os.ErrInvalid
 	}
 	m.lock.Lock()
@@ -164,7 +201,7 @@ func (m *memory) Read(digest isolated.HexDigest) (io.ReadCloser, error) {
 }
 
 func (m *memory) Add(digest isolated.HexDigest, src io.Reader) error {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return os.ErrInvalid
 	}
 	// TODO(maruel): Use a LimitedReader flavor that fails when reaching limit.
@@ -172,10 +209,10 @@ func (m *memory) Add(digest isolated.HexDigest, src io.Reader) error {
 	if err != nil {
 		return err
 	}
-	if isolated.HashBytes(content) != digest {
+	if isolated.HashBytes(m.h, content) != digest {
 		return errors.New("invalid hash")
 	}
-	if units.Size(len(content)) > m.policies.MaxSize {
+	if m.policies.MaxSize != 0 && units.Size(len(content)) > m.policies.MaxSize {
 		return errors.New("item too large")
 	}
 	m.lock.Lock()
@@ -187,7 +224,7 @@ func (m *memory) Add(digest isolated.HexDigest, src io.Reader) error {
 }
 
 func (m *memory) Hardlink(digest isolated.HexDigest, dest string, perm os.FileMode) error {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return os.ErrInvalid
 	}
 	m.lock.Lock()
@@ -200,35 +237,65 @@ func (m *memory) Hardlink(digest isolated.HexDigest, dest string, perm os.FileMo
 }
 
 func (m *memory) respectPolicies() {
-	for m.lru.length() > m.policies.MaxItems || m.lru.sum > m.policies.MaxSize {
+	for m.policies.exceeded(&m.lru) {
 		k, _ := m.lru.popOldest()
 		delete(m.data, k)
 	}
 }
 
+const (
+	stateFileName = "state.json"
+	lockFileName  = "state.lock"
+	// tmpPrefix is the prefix of the files being added to the cache.
+	tmpPrefix = "tmp"
+	// tmpMaxAge is the age after which a temporary file is considered a
+	// leftover from an interrupted Add(). Younger ones may still be written by
+	// another process sharing the directory.
+	tmpMaxAge = time.Hour
+	// itemMode is the mode of the cached items. They are read-only so they can
+	// be hardlinked in read-only trees.
+	itemMode = os.FileMode(0444)
+	// lockPollInterval is how often the lock of a cache directory in use is
+	// tried again.
+	lockPollInterval = 100 * time.Millisecond
+)
+
+// lockTimeout is how long the lock of a cache directory in use by another
+// process is waited for.
+var lockTimeout = time.Minute
+
 type disk struct {
 	// Immutable.
 	policies Policies
 	path     string
+	h        crypto.Hash
 
 	// Lock protected.
-	lock sync.Mutex
-	lru  lruDict // Implements LRU based eviction.
+	lock     sync.Mutex
+	lockFile *os.File                         // Cross-process lock on the directory, nil once closed.
+	lru      lruDict                          // Implements LRU based eviction.
+	used     orderedDict                      // Items used by this process since the state was loaded.
+	mtimes   map[isolated.HexDigest]time.Time // Modification time of the items known to be valid.
 	// TODO(maruel): Add stats about: # added, # removed.
-	// TODO(maruel): stateFile
 }
 
 func (d *disk) Close() error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
-	if !d.lru.IsDirty() {
+	if d.lockFile == nil {
 		return nil
 	}
-	f, err := os.Create(d.statePath())
-	if err == nil {
-		defer f.Close()
-		err = json.NewEncoder(f).Encode(&d.lru)
+	var err error
+	if d.lru.IsDirty() {
+		if err = d.lockDir(); err == nil {
+			err = d.merge()
+			d.unlockDir()
+		}
 	}
+	if err2 := d.lockFile.Close(); err == nil {
+		err = err2
+	}
+	d.lockFile = nil
 	return err
 }
 
@@ -239,7 +306,7 @@ func (d *disk) Keys() isolated.HexDigests {
 }
 
 func (d *disk) Touch(digest isolated.HexDigest) bool {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return false
 	}
 	d.lock.Lock()
@@ -248,71 +315,83 @@ func (d *disk) Touch(digest isolated.HexDigest) bool {
 	if err := os.Chtimes(d.itemPath(digest), mtime, mtime); err != nil {
 		return false
 	}
-	d.lru.touch(digest)
+	info, err := os.Stat(d.itemPath(digest))
+	if err != nil {
+		return false
+	}
+	d.markUsed(digest, info)
 	return true
 }
 
 func (d *disk) Evict(digest isolated.HexDigest) {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return
 	}
 	d.lock.Lock()
 	defer d.lock.Unlock()
 	d.lru.pop(digest)
-	_ = os.Remove(d.itemPath(digest))
+	d.used.pop(digest)
+	delete(d.mtimes, digest)
+	if d.lockDir() == nil {
+		_ = removeItem(d.itemPath(digest))
+		d.unlockDir()
+	}
 }
 
 func (d *disk) Read(digest isolated.HexDigest) (io.ReadCloser, error) {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return nil, os.ErrInvalid
 	}
 	f, err := os.Open(d.itemPath(digest))
 	if err != nil {
 		return nil, err
 	}
-	return f, nil
+	return &verifiedReader{f: f, h: d.h.New(), digest: digest, d: d}, nil
 }
 
 func (d *disk) Add(digest isolated.HexDigest, src io.Reader) error {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return os.ErrInvalid
 	}
-	p := d.itemPath(digest)
-	dst, err := os.Create(p)
+	// Write to a temporary file first so an interrupted Add() never leaves a
+	// partial item behind.
+	dst, err := ioutil.TempFile(d.path, tmpPrefix)
 	if err != nil {
 		return err
 	}
-	h := isolated.GetHash()
+	tmp := dst.Name()
+	h := d.h.New()
 	// TODO(maruel): Use a LimitedReader flavor that fails when reaching limit.
 	size, err := io.Copy(dst, io.TeeReader(src, h))
 	if err2 := dst.Close(); err == nil {
 		err = err2
 	}
-	if err != nil {
-		_ = os.Remove(p)
-		return err
+	if err == nil && isolated.Sum(h) != digest {
+		err = errors.New("invalid hash")
 	}
-	if isolated.Sum(h) != digest {
-		_ = os.Remove(p)
-		return errors.New("invalid hash")
+	if err == nil && d.policies.MaxSize != 0 && units.Size(size) > d.policies.MaxSize {
+		err = errors.New("item too large")
 	}
-	if units.Size(size) > d.policies.MaxSize {
-		_ = os.Remove(p)
-		return errors.New("item too large")
+	if err == nil {
+		err = os.Chmod(tmp, itemMode)
 	}
-
-	d.lock.Lock()
-	defer d.lock.Unlock()
-	d.lru.pushFront(digest, units.Size(size))
-	d.respectPolicies()
-	return nil
+	if err == nil {
+		err = d.commit(digest, tmp)
+	}
+	if err != nil {
+		_ = removeItem(tmp)
+	}
+	return err
 }
 
 func (d *disk) Hardlink(digest isolated.HexDigest, dest string, perm os.FileMode) error {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return os.ErrInvalid
 	}
 	src := d.itemPath(digest)
+	if err := d.check(digest); err != nil {
+		return err
+	}
 	// - Windows, if dest exists, the call fails. In particular, trying to
 	//   os.Remove() will fail if the file's ReadOnly bit is set. What's worse is
 	//   that the ReadOnly bit is set on the file inode, shared on all hardlinks
@@ -328,7 +407,93 @@ func (d *disk) Hardlink(digest isolated.HexDigest, dest string, perm os.FileMode
 	//  In short, nobody ain't got time for that.
 	//
 	// - On any other (sane) OS, if dest exists, it is silently overwritten.
-	return os.Link(src, dest)
+	if perm&0222 != 0 {
+		// Writing to a hardlink would modify the cached item.
+		return copyFile(src, dest, perm)
+	}
+	if linked, err := d.link(src, dest, perm); linked || err != nil {
+		return err
+	}
+	return copyFile(src, dest, perm)
+}
+
+// commit moves the new item 'tmp' in place.
+func (d *disk) commit(digest isolated.HexDigest, tmp string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if err := d.lockDir(); err != nil {
+		return err
+	}
+	defer d.unlockDir()
+	if err := os.Rename(tmp, d.itemPath(digest)); err != nil {
+		return err
+	}
+	info, err := os.Stat(d.itemPath(digest))
+	if err != nil {
+		return err
+	}
+	d.markUsed(digest, info)
+	d.respectPolicies()
+	return nil
+}
+
+// link hardlinks the item 'src' to 'dest' if the item has the mode 'perm' or
+// can be changed to it. It returns false if the item must be copied instead.
+func (d *disk) link(src, dest string, perm os.FileMode) (bool, error) {
+	// The mode is shared by all the hardlinks to this inode, including the
+	// cached item itself, so it can only be changed while no other hardlink
+	// exists. The lock ensures no hardlink is added in the meantime.
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if err := d.lockDir(); err != nil {
+		return false, err
+	}
+	defer d.unlockDir()
+	info, err := os.Stat(src)
+	if err != nil {
+		return false, err
+	}
+	if info.Mode()&os.ModePerm != perm {
+		if linkCount(info) != 1 {
+			return false, nil
+		}
+		if err := os.Chmod(src, perm); err != nil {
+			return false, err
+		}
+	}
+	return true, os.Link(src, dest)
+}
+
+// markUsed moves the item to the front of the LRU and records its
+// modification time.
+func (d *disk) markUsed(digest isolated.HexDigest, info os.FileInfo) {
+	d.lru.pop(digest)
+	d.lru.pushFront(digest, units.Size(info.Size()))
+	d.used.pushFront(digest, 0)
+	d.mtimes[digest] = info.ModTime()
+}
+
+// lockDir waits up to lockTimeout for the cross-process lock on the cache
+// directory. It must be released with unlockDir().
+func (d *disk) lockDir() error {
+	if d.lockFile == nil {
+		return errors.New("cache is closed")
+	}
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		locked, err := tryLock(d.lockFile)
+		if err != nil || locked {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("cache directory %s is in use by another process", d.path)
+		}
+		time.Sleep(lockPollInterval)
+	}
+}
+
+func (d *disk) unlockDir() {
+	_ = unlock(d.lockFile)
 }
 
 func (d *disk) itemPath(digest isolated.HexDigest) string {
@@ -336,12 +501,247 @@ func (d *disk) itemPath(digest isolated.HexDigest) string {
 }
 
 func (d *disk) statePath() string {
-	return filepath.Join(d.path, "state.json")
+	return filepath.Join(d.path, stateFileName)
+}
+
+// removeItem deletes a cached item. On Windows, read-only files can't be
+// deleted, so it is made writeable first if needed.
+func removeItem(path string) error {
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) && os.Chmod(path, 0600) == nil {
+		err = os.Remove(path)
+	}
+	return err
+}
+
+// copyFile copies the file 'src' to a new file 'dest' with mode 'perm'.
+func copyFile(src, dest string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if err2 := out.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		// Do not depend on the umask.
+		err = os.Chmod(dest, perm)
+	}
+	if err != nil {
+		_ = os.Remove(dest)
+	}
+	return err
 }
 
+// check verifies the cached item if its size or modification time changed
+// since it was last known to be valid.
+func (d *disk) check(digest isolated.HexDigest) error {
+	info, err := os.Stat(d.itemPath(digest))
+	if err != nil {
+		return err
+	}
+	d.lock.Lock()
+	mtime, ok := d.mtimes[digest]
+	size := d.lru.items.get(digest)
+	d.lock.Unlock()
+	if ok && mtime.Equal(info.ModTime()) && size == units.Size(info.Size()) {
+		return nil
+	}
+	if err := d.verify(digest); err != nil {
+		return err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.mtimes[digest] = info.ModTime()
+	return nil
+}
+
+// verify hashes the cached item and evicts it if it is corrupted.
+func (d *disk) verify(digest isolated.HexDigest) error {
+	f, err := os.Open(d.itemPath(digest))
+	if err != nil {
+		return err
+	}
+	h := d.h.New()
+	_, err = io.Copy(h, f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	if isolated.Sum(h) != digest {
+		d.Evict(digest)
+		return fmt.Errorf("cached item %s is corrupted", digest)
+	}
+	return nil
+}
+
+// load loads the state file and reconciles it with the directory content.
+//
+// Items missing from the directory are dropped and items found in the
+// directory but not in the state file are added as the most recently used
+// ones. When the state file cannot be loaded, the state is rebuilt from the
+// directory content ordered by modification time and the loading error is
+// returned.
+//
+// The directory must be locked.
+func (d *disk) load() error {
+	d.mtimes = map[isolated.HexDigest]time.Time{}
+	var err error
+	if f, err2 := os.Open(d.statePath()); err2 == nil {
+		err = json.NewDecoder(f).Decode(&d.lru)
+		f.Close()
+		if err != nil {
+			d.lru = makeLRUDict(d.h)
+		}
+	} else if !os.IsNotExist(err2) {
+		// The fact that the cache is new is not an error.
+		err = err2
+	}
+
+	infos, err2 := ioutil.ReadDir(d.path)
+	if err2 != nil {
+		return err2
+	}
+	found := map[isolated.HexDigest]os.FileInfo{}
+	for _, info := range infos {
+		name := info.Name()
+		if strings.HasPrefix(name, tmpPrefix) {
+			if time.Since(info.ModTime()) > tmpMaxAge {
+				_ = removeItem(filepath.Join(d.path, name))
+			}
+			continue
+		}
+		if digest := isolated.HexDigest(name); !info.IsDir() && digest.Validate(d.h) {
+			found[digest] = info
+		}
+	}
+	for _, digest := range d.lru.keys() {
+		info, ok := found[digest]
+		delete(found, digest)
+		if !ok {
+			d.lru.pop(digest)
+		} else if units.Size(info.Size()) != d.lru.items.get(digest) {
+			d.lru.pop(digest)
+			_ = removeItem(d.itemPath(digest))
+		} else {
+			d.mtimes[digest] = info.ModTime()
+		}
+	}
+	orphans := make(byModTime, 0, len(found))
+	for _, info := range found {
+		orphans = append(orphans, info)
+	}
+	sort.Sort(orphans)
+	for _, info := range orphans {
+		digest := isolated.HexDigest(info.Name())
+		d.lru.pushFront(digest, units.Size(info.Size()))
+		d.mtimes[digest] = info.ModTime()
+	}
+	return err
+}
+
+// merge reloads the state saved by the other processes sharing the directory,
+// moves the items used by this process to the front and saves the result.
+//
+// The items added or evicted by this process are found or missing in the
+// directory, so they are accounted for by load().
+//
+// The directory must be locked.
+func (d *disk) merge() error {
+	used := d.used.keys()
+	d.lru = makeLRUDict(d.h)
+	d.used = makeOrderedDict()
+	// On error, the state is rebuilt from the directory content.
+	_ = d.load()
+	for i := len(used) - 1; i >= 0; i-- {
+		if _, ok := d.lru.items.entries[used[i]]; ok {
+			d.lru.touch(used[i])
+		}
+	}
+	d.respectPolicies()
+	return d.saveState()
+}
+
+// saveState atomically writes the state file.
+//
+// The directory must be locked.
+func (d *disk) saveState() error {
+	f, err := ioutil.TempFile(d.path, tmpPrefix)
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(f).Encode(&d.lru)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), d.statePath())
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
+
+// respectPolicies evicts the oldest items until the policies are met.
+//
+// The directory must be locked.
 func (d *disk) respectPolicies() {
-	for d.lru.length() > d.policies.MaxItems || d.lru.sum > d.policies.MaxSize {
-		k, _ := d.lru.popOldest()
-		_ = os.Remove(d.itemPath(k))
+	// The free space is only queried once; assume each evicted item frees its
+	// size.
+	checkFree := false
+	var free units.Size
+	if d.policies.MinFreeSpace != 0 {
+		var err error
+		free, err = getFreeSpace(d.path)
+		checkFree = err == nil
+	}
+	for d.lru.length() != 0 && (d.policies.exceeded(&d.lru) || (checkFree && free < d.policies.MinFreeSpace)) {
+		k, size := d.lru.popOldest()
+		d.used.pop(k)
+		delete(d.mtimes, k)
+		_ = removeItem(d.itemPath(k))
+		free += size
 	}
 }
+
+// verifiedReader hashes an item as it is read and returns an error at the end
+// if the item is corrupted. Corrupted items are evicted on Close().
+type verifiedReader struct {
+	f         *os.File
+	h         hash.Hash
+	digest    isolated.HexDigest
+	d         *disk
+	corrupted bool
+}
+
+func (v *verifiedReader) Read(p []byte) (int, error) {
+	n, err := v.f.Read(p)
+	v.h.Write(p[:n])
+	if err == io.EOF && isolated.Sum(v.h) != v.digest {
+		v.corrupted = true
+		return n, fmt.Errorf("cached item %s is corrupted", v.digest)
+	}
+	return n, err
+}
+
+func (v *verifiedReader) Close() error {
+	err := v.f.Close()
+	if v.corrupted {
+		v.d.Evict(v.digest)
+	}
+	return err
+}
+
+// byModTime sorts os.FileInfo from the oldest to the newest.
+type byModTime []os.FileInfo
+
+func (b byModTime) Len() int           { return len(b) }
+func (b byModTime) Less(i, j int) bool { return b[i].ModTime().Before(b[j].ModTime()) }
+func (b byModTime) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
@@ -6,16 +6,21 @@ package cache
 
 import (
 	"bytes"
+	"crypto"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/units"
 	"github.com/maruel/ut"
 )
 
-func testCache(t *testing.T, c Cache) isolated.HexDigests {
+func testCache(t *testing.T, c Cache, h crypto.Hash) isolated.HexDigests {
 	// c's policies must have MaxItems == 2 and MaxSize == 1024.
 	td, err := ioutil.TempDir("", "cache")
 	ut.AssertEqual(t, nil, err)
@@ -25,18 +30,18 @@ func testCache(t *testing.T, c Cache) isolated.HexDigests {
 		}
 	}()
 
-	fakeDigest := isolated.HexDigest("0123456789012345678901234567890123456789")
-	badDigest := isolated.HexDigest("012345678901234567890123456789012345678")
+	fakeDigest := isolated.HexDigest(strings.Repeat("0", h.Size()*2))
+	badDigest := isolated.HexDigest(strings.Repeat("0", h.Size()*2-1))
 	emptyContent := []byte{}
-	emptyDigest := isolated.HashBytes(emptyContent)
+	emptyDigest := isolated.HashBytes(h, emptyContent)
 	file1Content := []byte("foo")
-	file1Digest := isolated.HashBytes(file1Content)
+	file1Digest := isolated.HashBytes(h, file1Content)
 	file2Content := []byte("foo bar")
-	file2Digest := isolated.HashBytes(file2Content)
+	file2Digest := isolated.HashBytes(h, file2Content)
 	largeContent := bytes.Repeat([]byte("A"), 1023)
-	largeDigest := isolated.HashBytes(largeContent)
+	largeDigest := isolated.HashBytes(h, largeContent)
 	tooLargeContent := bytes.Repeat([]byte("A"), 1025)
-	tooLargeDigest := isolated.HashBytes(tooLargeContent)
+	tooLargeDigest := isolated.HashBytes(h, tooLargeContent)
 
 	ut.AssertEqual(t, isolated.HexDigests{}, c.Keys())
 
@@ -97,7 +102,11 @@ func testCache(t *testing.T, c Cache) isolated.HexDigests {
 }
 
 func TestNewMemory(t *testing.T) {
-	testCache(t, NewMemory(Policies{MaxSize: 1024, MaxItems: 2}))
+	testCache(t, NewMemory(Policies{MaxSize: 1024, MaxItems: 2}, crypto.SHA1), crypto.SHA1)
+}
+
+func TestNewMemorySHA256(t *testing.T) {
+	testCache(t, NewMemory(Policies{MaxSize: 1024, MaxItems: 2}, crypto.SHA256), crypto.SHA256)
 }
 
 func TestNewDisk(t *testing.T) {
@@ -109,16 +118,284 @@ func TestNewDisk(t *testing.T) {
 		}
 	}()
 	pol := Policies{MaxSize: 1024, MaxItems: 2}
-	c, err := NewDisk(pol, td)
+	c, err := NewDisk(pol, td, crypto.SHA1)
 	ut.AssertEqual(t, nil, err)
-	expected := testCache(t, c)
+	expected := testCache(t, c, crypto.SHA1)
 
-	c, err = NewDisk(pol, td)
+	c, err = NewDisk(pol, td, crypto.SHA1)
 	ut.AssertEqual(t, nil, err)
 	ut.AssertEqual(t, expected, c.Keys())
 	ut.AssertEqual(t, nil, c.Close())
 
-	c, err = NewDisk(pol, "non absolute path")
+	// The state can't be reused with another hashing algorithm.
+	c, err = NewDisk(pol, td, crypto.SHA256)
+	ut.AssertEqual(t, true, err != nil)
+	ut.AssertEqual(t, isolated.HexDigests{}, c.Keys())
+	ut.AssertEqual(t, nil, c.Close())
+
+	c, err = NewDisk(pol, "non absolute path", crypto.SHA1)
 	ut.AssertEqual(t, nil, c)
 	ut.AssertEqual(t, true, nil != err)
 }
+
+func TestDiskRecovery(t *testing.T) {
+	td, err := ioutil.TempDir("", "cache")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	pol := Policies{MaxSize: 1024, MaxItems: 10}
+	c, err := NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	file1Content := []byte("foo")
+	file1Digest := isolated.HashBytes(crypto.SHA1, file1Content)
+	file2Content := []byte("foo bar")
+	file2Digest := isolated.HashBytes(crypto.SHA1, file2Content)
+	ut.AssertEqual(t, nil, c.Add(file1Digest, bytes.NewBuffer(file1Content)))
+	ut.AssertEqual(t, nil, c.Add(file2Digest, bytes.NewBuffer(file2Content)))
+	ut.AssertEqual(t, nil, c.Close())
+	// Make the order deterministic.
+	old := time.Now().Add(-time.Hour)
+	ut.AssertEqual(t, nil, os.Chtimes(filepath.Join(td, string(file1Digest)), old, old))
+
+	// The state is rebuilt from the directory content; leftovers from an
+	// interrupted Add() are deleted and unrelated files are kept. Recent
+	// temporary files may still be written by another process.
+	ut.AssertEqual(t, nil, os.Remove(filepath.Join(td, stateFileName)))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, tmpPrefix+"123"), []byte("partial"), 0600))
+	stale := time.Now().Add(-2 * tmpMaxAge)
+	ut.AssertEqual(t, nil, os.Chtimes(filepath.Join(td, tmpPrefix+"123"), stale, stale))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, tmpPrefix+"456"), []byte("partial"), 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "unrelated"), []byte("foo"), 0600))
+	c, err = NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, isolated.HexDigests{file2Digest, file1Digest}, c.Keys())
+	ut.AssertEqual(t, nil, c.Close())
+	_, err = os.Stat(filepath.Join(td, tmpPrefix+"123"))
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(td, tmpPrefix+"456"))
+	ut.AssertEqual(t, nil, err)
+	_, err = os.Stat(filepath.Join(td, "unrelated"))
+	ut.AssertEqual(t, nil, err)
+
+	// A corrupted state file is reported and the state is rebuilt anyway.
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, stateFileName), []byte("}"), 0600))
+	c, err = NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, true, err != nil)
+	ut.AssertEqual(t, isolated.HexDigests{file2Digest, file1Digest}, c.Keys())
+	ut.AssertEqual(t, nil, c.Close())
+
+	// Items missing from the directory are dropped.
+	ut.AssertEqual(t, nil, os.Remove(filepath.Join(td, string(file2Digest))))
+	c, err = NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, isolated.HexDigests{file1Digest}, c.Keys())
+	ut.AssertEqual(t, nil, c.Close())
+}
+
+func TestDiskCorrupted(t *testing.T) {
+	td, err := ioutil.TempDir("", "cache")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	c, err := NewDisk(Policies{MaxSize: 1024, MaxItems: 10}, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	file1Content := []byte("foo")
+	file1Digest := isolated.HashBytes(crypto.SHA1, file1Content)
+	file2Content := []byte("foo bar")
+	file2Digest := isolated.HashBytes(crypto.SHA1, file2Content)
+	ut.AssertEqual(t, nil, c.Add(file1Digest, bytes.NewBuffer(file1Content)))
+	ut.AssertEqual(t, nil, c.Add(file2Digest, bytes.NewBuffer(file2Content)))
+	for _, digest := range []isolated.HexDigest{file1Digest, file2Digest} {
+		ut.AssertEqual(t, nil, os.Chmod(filepath.Join(td, string(digest)), 0600))
+	}
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, string(file1Digest)), []byte("bar"), 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, string(file2Digest)), []byte("bar bar"), 0600))
+	// The size is the same, only the modification time tells the item changed.
+	later := time.Now().Add(time.Hour)
+	ut.AssertEqual(t, nil, os.Chtimes(filepath.Join(td, string(file2Digest)), later, later))
+
+	r, err := c.Read(file1Digest)
+	ut.AssertEqual(t, nil, err)
+	_, err = ioutil.ReadAll(r)
+	ut.AssertEqual(t, true, err != nil)
+	ut.AssertEqual(t, nil, r.Close())
+	ut.AssertEqual(t, true, nil != c.Hardlink(file2Digest, filepath.Join(td, "foo"), os.FileMode(0600)))
+	ut.AssertEqual(t, isolated.HexDigests{}, c.Keys())
+
+	// An item is not hashed again when materialized unless it looks modified.
+	ut.AssertEqual(t, nil, c.Add(file1Digest, bytes.NewBuffer(file1Content)))
+	p := filepath.Join(td, string(file1Digest))
+	info, err := os.Stat(p)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, os.Chmod(p, 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("bar"), 0600))
+	ut.AssertEqual(t, nil, os.Chtimes(p, info.ModTime(), info.ModTime()))
+	ut.AssertEqual(t, nil, c.Hardlink(file1Digest, filepath.Join(td, "bar"), os.FileMode(0600)))
+	ut.AssertEqual(t, nil, c.Close())
+}
+
+func TestDiskHardlinkMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not fully supported on Windows")
+	}
+	td, err := ioutil.TempDir("", "cache")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	cacheDir := filepath.Join(td, "cache")
+	c, err := NewDisk(Policies{MaxSize: 1024, MaxItems: 10}, cacheDir, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	content := []byte("foo")
+	digest := isolated.HashBytes(crypto.SHA1, content)
+	ut.AssertEqual(t, nil, c.Add(digest, bytes.NewBuffer(content)))
+	itemPath := filepath.Join(cacheDir, string(digest))
+	item, err := os.Stat(itemPath)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, itemMode, item.Mode())
+
+	// A writeable file is copied.
+	copied := filepath.Join(td, "copied")
+	ut.AssertEqual(t, nil, c.Hardlink(digest, copied, os.FileMode(0644)))
+	info, err := os.Stat(copied)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, false, os.SameFile(item, info))
+	ut.AssertEqual(t, os.FileMode(0644), info.Mode())
+	actual, err := ioutil.ReadFile(copied)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, content, actual)
+
+	// A read-only file is hardlinked. Since the item isn't hardlinked anywhere
+	// yet, its mode is changed as needed.
+	linked := filepath.Join(td, "linked")
+	ut.AssertEqual(t, nil, c.Hardlink(digest, linked, os.FileMode(0555)))
+	info, err = os.Stat(linked)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, true, os.SameFile(item, info))
+	ut.AssertEqual(t, os.FileMode(0555), info.Mode())
+	linked2 := filepath.Join(td, "linked2")
+	ut.AssertEqual(t, nil, c.Hardlink(digest, linked2, os.FileMode(0555)))
+	info, err = os.Stat(linked2)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, true, os.SameFile(item, info))
+
+	// Once hardlinked, the item's mode can't change anymore so it is copied.
+	copied2 := filepath.Join(td, "copied2")
+	ut.AssertEqual(t, nil, c.Hardlink(digest, copied2, os.FileMode(0444)))
+	info, err = os.Stat(copied2)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, false, os.SameFile(item, info))
+	ut.AssertEqual(t, os.FileMode(0444), info.Mode())
+	info, err = os.Stat(itemPath)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, os.FileMode(0555), info.Mode())
+	ut.AssertEqual(t, nil, c.Close())
+}
+
+func TestDiskPolicies(t *testing.T) {
+	td, err := ioutil.TempDir("", "cache")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	content := []byte("foo")
+	digest := isolated.HashBytes(crypto.SHA1, content)
+
+	// 0 means no limit.
+	c, err := NewDisk(Policies{}, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, c.Add(digest, bytes.NewBuffer(content)))
+	ut.AssertEqual(t, isolated.HexDigests{digest}, c.Keys())
+	ut.AssertEqual(t, nil, c.Close())
+
+	// No partition has that much free space.
+	c, err = NewDisk(Policies{MinFreeSpace: units.Size(1) << 62}, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, isolated.HexDigests{}, c.Keys())
+	ut.AssertEqual(t, nil, c.Add(digest, bytes.NewBuffer(content)))
+	ut.AssertEqual(t, isolated.HexDigests{}, c.Keys())
+	ut.AssertEqual(t, nil, c.Close())
+	_, err = os.Stat(filepath.Join(td, string(digest)))
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+}
+
+func TestDiskShared(t *testing.T) {
+	td, err := ioutil.TempDir("", "cache")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	pol := Policies{MaxSize: 1024, MaxItems: 10}
+	file1Content := []byte("foo")
+	file1Digest := isolated.HashBytes(crypto.SHA1, file1Content)
+	file2Content := []byte("foo bar")
+	file2Digest := isolated.HashBytes(crypto.SHA1, file2Content)
+	file3Content := []byte("foo bar baz")
+	file3Digest := isolated.HashBytes(crypto.SHA1, file3Content)
+
+	c1, err := NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, c1.Add(file1Digest, bytes.NewBuffer(file1Content)))
+	ut.AssertEqual(t, nil, c1.Close())
+
+	// Both caches are used at the same time; the state saved last contains the
+	// items of both.
+	c1, err = NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	c2, err := NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, c1.Add(file2Digest, bytes.NewBuffer(file2Content)))
+	ut.AssertEqual(t, nil, c2.Add(file3Digest, bytes.NewBuffer(file3Content)))
+	ut.AssertEqual(t, true, c2.Touch(file1Digest))
+	ut.AssertEqual(t, nil, c2.Close())
+	ut.AssertEqual(t, nil, c1.Close())
+
+	c, err := NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 3, len(c.Keys()))
+	ut.AssertEqual(t, file2Digest, c.Keys()[0])
+	ut.AssertEqual(t, nil, c.Close())
+}
+
+func TestDiskLockTimeout(t *testing.T) {
+	td, err := ioutil.TempDir("", "cache")
+	ut.AssertEqual(t, nil, err)
+	defer func() {
+		if err := os.RemoveAll(td); err != nil {
+			t.Error(err)
+		}
+	}()
+	oldTimeout := lockTimeout
+	lockTimeout = 10 * time.Millisecond
+	defer func() { lockTimeout = oldTimeout }()
+
+	// Simulate another process holding the lock.
+	f, err := os.OpenFile(filepath.Join(td, lockFileName), os.O_RDWR|os.O_CREATE, 0600)
+	ut.AssertEqual(t, nil, err)
+	locked, err := tryLock(f)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, true, locked)
+
+	pol := Policies{MaxSize: 1024, MaxItems: 10}
+	c, err := NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, c)
+	ut.AssertEqual(t, true, err != nil && strings.Contains(err.Error(), "in use by another process"))
+
+	ut.AssertEqual(t, nil, unlock(f))
+	c, err = NewDisk(pol, td, crypto.SHA1)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, c.Close())
+	ut.AssertEqual(t, nil, f.Close())
+}
@@ -0,0 +1,47 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// +build !windows
+
+package cache
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/luci/luci-go/common/units"
+)
+
+// tryLock takes an exclusive lock on f without blocking. It returns false if
+// the lock is held by another process.
+func tryLock(f *os.File) (bool, error) {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err == syscall.EWOULDBLOCK {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// unlock releases the lock taken by tryLock.
+func unlock(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
+
+// getFreeSpace returns the free space available to the current user on the
+// partition containing path.
+func getFreeSpace(path string) (units.Size, error) {
+	s := syscall.Statfs_t{}
+	if err := syscall.Statfs(path, &s); err != nil {
+		return 0, err
+	}
+	return units.Size(uint64(s.Bavail) * uint64(s.Bsize)), nil
+}
+
+// linkCount returns the number of hardlinks to the file described by info.
+func linkCount(info os.FileInfo) uint64 {
+	if s, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(s.Nlink)
+	}
+	return 0
+}
@@ -0,0 +1,88 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// +build windows
+
+package cache
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+
+	"github.com/luci/luci-go/common/units"
+)
+
+// See https://msdn.microsoft.com/en-us/library/windows/desktop/aa365203(v=vs.85).aspx,
+// https://msdn.microsoft.com/en-us/library/windows/desktop/aa365716(v=vs.85).aspx
+// and https://msdn.microsoft.com/en-us/library/windows/desktop/aa364937(v=vs.85).aspx
+
+var (
+	kernel32                = syscall.NewLazyDLL("kernel32.dll")
+	procLockFileEx          = kernel32.NewProc("LockFileEx")
+	procUnlockFileEx        = kernel32.NewProc("UnlockFileEx")
+	procGetDiskFreeSpaceExW = kernel32.NewProc("GetDiskFreeSpaceExW")
+)
+
+const (
+	lockfileFailImmediately = 1
+	lockfileExclusiveLock   = 2
+	errorLockViolation      = syscall.Errno(33)
+)
+
+// tryLock takes an exclusive lock on f without blocking. It returns false if
+// the lock is held by another process.
+func tryLock(f *os.File) (bool, error) {
+	ol := syscall.Overlapped{}
+	ret, _, err := procLockFileEx.Call(f.Fd(), lockfileExclusiveLock|lockfileFailImmediately, 0, 1, 0, uintptr(unsafe.Pointer(&ol)))
+	if ret == 0 {
+		if err == errorLockViolation {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return false, syscall.EINVAL
+	}
+	return true, nil
+}
+
+// unlock releases the lock taken by tryLock.
+func unlock(f *os.File) error {
+	ol := syscall.Overlapped{}
+	ret, _, err := procUnlockFileEx.Call(f.Fd(), 0, 1, 0, uintptr(unsafe.Pointer(&ol)))
+	if ret == 0 {
+		if err != nil {
+			return err
+		}
+		return syscall.EINVAL
+	}
+	return nil
+}
+
+// getFreeSpace returns the free space available to the current user on the
+// partition containing path.
+func getFreeSpace(path string) (units.Size, error) {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	var available, total, free uint64
+	ret, _, err := procGetDiskFreeSpaceExW.Call(uintptr(unsafe.Pointer(p)), uintptr(unsafe.Pointer(&available)), uintptr(unsafe.Pointer(&total)), uintptr(unsafe.Pointer(&free)))
+	if ret == 0 {
+		if err != nil {
+			return 0, err
+		}
+		return 0, syscall.EINVAL
+	}
+	return units.Size(available), nil
+}
+
+// linkCount returns the number of hardlinks to the file described by info.
+//
+// The number is not reported by os.Stat() on Windows, so it returns 0 and the
+// mode of cached items is never changed.
+func linkCount(info os.FileInfo) uint64 {
+	return 0
+}
@@ -6,6 +6,7 @@ package cache
 
 import (
 	"container/list"
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -76,6 +77,13 @@ func (o *orderedDict) pop(key isolated.HexDigest) units.Size {
 	return 0
 }
 
+func (o *orderedDict) get(key isolated.HexDigest) units.Size {
+	if e, hit := o.entries[key]; hit {
+		return e.Value.(*entry).value
+	}
+	return 0
+}
+
 func (o *orderedDict) popOldest() (isolated.HexDigest, units.Size) {
 	if e := o.ll.Back(); e != nil {
 		entry := o.removeElement(e)
@@ -127,13 +135,15 @@ func (o *orderedDict) serialized() []entry {
 //
 // Designed to be serialized as JSON on disk.
 type lruDict struct {
+	h     crypto.Hash // hashing algorithm of the keys.
 	items orderedDict // ordered key -> value mapping, newest items at the bottom.
 	dirty bool        // true if was modified after loading until it is marshaled.
 	sum   units.Size  // sum of all the values.
 }
 
-func makeLRUDict() lruDict {
+func makeLRUDict(h crypto.Hash) lruDict {
 	return lruDict{
+		h:     h,
 		items: makeOrderedDict(),
 	}
 }
@@ -179,14 +189,14 @@ func (l *lruDict) touch(key isolated.HexDigest) {
 
 type serializedLRUDict struct {
 	Version int     // 1.
-	Algo    string  // "sha-1".
+	Algo    string  // "sha-1", "sha-256" or "sha-512".
 	Items   []entry // ordered key -> value mapping in order.
 }
 
 func (l *lruDict) MarshalJSON() ([]byte, error) {
 	s := &serializedLRUDict{
 		Version: 1,
-		Algo:    "sha-1",
+		Algo:    isolated.GetAlgo(l.h),
 		Items:   l.items.serialized(),
 	}
 	// Not strictly true but #closeneough.
@@ -205,12 +215,12 @@ func (l *lruDict) UnmarshalJSON(data []byte) error {
 	if s.Version != 1 {
 		return errors.New("invalid lru dict version")
 	}
-	if s.Algo != "sha-1" {
+	if s.Algo != isolated.GetAlgo(l.h) {
 		return errors.New("invalid lru dict algo")
 	}
 	l.sum = 0
 	for _, e := range s.Items {
-		if !e.key.Validate() {
+		if !e.key.Validate(l.h) {
 			return fmt.Errorf("invalid entry: %s", e.key)
 		}
 		l.items.pushBack(e.key, e.value)
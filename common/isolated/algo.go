@@ -8,44 +8,91 @@ package isolated
 
 import (
 	"compress/zlib"
-	"crypto/sha1"
-	"hash"
+	"crypto"
+	// Register the supported hashing algorithms.
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"fmt"
 	"io"
-	"log"
+	"io/ioutil"
+	"strings"
 	// https://crbug.com/552697
 	//"github.com/klauspost/compress/zlib"
 )
 
-var hashLength = sha1.New().Size()
+// algos maps the supported hashing algorithms to their name in the .isolated
+// file format.
+var algos = map[crypto.Hash]string{
+	crypto.SHA1:   "sha-1",
+	crypto.SHA256: "sha-256",
+	crypto.SHA512: "sha-512",
+}
 
-// GetHash returns a fresh instance of the hashing algorithm to be used to
-// calculate the HexDigest.
+// GetHash returns the hashing algorithm used in the isolate server namespace
+// 'namespace'.
 //
-// It is currently hardcoded to sha-1.
-func GetHash() hash.Hash {
-	return sha1.New()
+// Namespaces prefixed with "sha256-" or "sha512-" use SHA-256 or SHA-512
+// respectively. All other namespaces, like "default-gzip", use SHA-1.
+func GetHash(namespace string) crypto.Hash {
+	switch {
+	case strings.HasPrefix(namespace, "sha256-"):
+		return crypto.SHA256
+	case strings.HasPrefix(namespace, "sha512-"):
+		return crypto.SHA512
+	default:
+		return crypto.SHA1
+	}
 }
 
-// GetDecompressor returns a fresh instance of the decompression algorithm.
+// GetAlgo returns the name of the hashing algorithm 'h' as stored in the
+// "algo" field of .isolated files.
+//
+// It returns an empty string if 'h' is not supported.
+func GetAlgo(h crypto.Hash) string {
+	return algos[h]
+}
+
+// GetHashFromAlgo returns the hashing algorithm named 'algo' in the "algo"
+// field of .isolated files.
+func GetHashFromAlgo(algo string) (crypto.Hash, error) {
+	for h, name := range algos {
+		if name == algo {
+			return h, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported algo %q", algo)
+}
+
+// IsCompressed returns true if the content stored in the isolate server
+// namespace 'namespace' is compressed on the wire.
+func IsCompressed(namespace string) bool {
+	return strings.HasSuffix(namespace, "-gzip") || strings.HasSuffix(namespace, "-deflate")
+}
+
+// GetDecompressor returns a fresh instance of the decompression algorithm used
+// in 'namespace'.
 //
 // It must be closed after use.
 //
-// It is currently hardcoded to RFC 1950 (zlib).
-func GetDecompressor(in io.Reader) io.ReadCloser {
-	d, err := zlib.NewReader(in)
-	if err != nil {
-		// The data is corrupted.
-		log.Printf("%s", err)
+// Compressed namespaces use RFC 1950 (zlib), despite the "-gzip" suffix.
+func GetDecompressor(namespace string, in io.Reader) (io.ReadCloser, error) {
+	if !IsCompressed(namespace) {
+		return ioutil.NopCloser(in), nil
 	}
-	return d
+	return zlib.NewReader(in)
 }
 
-// GetCompressor returns a fresh instance of the compression algorithm.
+// GetCompressor returns a fresh instance of the compression algorithm used in
+// 'namespace'.
 //
 // It must be closed after use.
 //
-// It is currently hardcoded to RFC 1950 (zlib).
-func GetCompressor(out io.Writer) io.WriteCloser {
+// Compressed namespaces use RFC 1950 (zlib), despite the "-gzip" suffix.
+func GetCompressor(namespace string, out io.Writer) io.WriteCloser {
+	if !IsCompressed(namespace) {
+		return nopWriteCloser{out}
+	}
 	c, _ := zlib.NewWriterLevel(out, 7)
 	return c
 }
@@ -54,9 +101,9 @@ func GetCompressor(out io.Writer) io.WriteCloser {
 // are accepted.
 type HexDigest string
 
-// Validate returns true if the hash is valid.
-func (d HexDigest) Validate() bool {
-	if len(d) != hashLength*2 {
+// Validate returns true if the hash is valid for the hashing algorithm 'h'.
+func (d HexDigest) Validate(h crypto.Hash) bool {
+	if len(d) != h.Size()*2 {
 		return false
 	}
 	for _, c := range d {
@@ -74,3 +121,9 @@ type HexDigests []HexDigest
 func (h HexDigests) Len() int           { return len(h) }
 func (h HexDigests) Less(i, j int) bool { return h[i] < h[j] }
 func (h HexDigests) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -5,6 +5,9 @@
 package isolated
 
 import (
+	"bytes"
+	"crypto"
+	"io/ioutil"
 	"testing"
 
 	"github.com/maruel/ut"
@@ -17,8 +20,10 @@ func TestHexDigestValid(t *testing.T) {
 		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 	}
 	for i, in := range valid {
-		ut.AssertEqualIndex(t, i, true, HexDigest(in).Validate())
+		ut.AssertEqualIndex(t, i, true, HexDigest(in).Validate(crypto.SHA1))
 	}
+	ut.AssertEqual(t, true, HashBytes(crypto.SHA256, nil).Validate(crypto.SHA256))
+	ut.AssertEqual(t, true, HashBytes(crypto.SHA512, nil).Validate(crypto.SHA512))
 }
 
 func TestHexDigestInvalid(t *testing.T) {
@@ -30,6 +35,51 @@ func TestHexDigestInvalid(t *testing.T) {
 		"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
 	}
 	for i, in := range invalid {
-		ut.AssertEqualIndex(t, i, false, HexDigest(in).Validate())
+		ut.AssertEqualIndex(t, i, false, HexDigest(in).Validate(crypto.SHA1))
+	}
+	ut.AssertEqual(t, false, HashBytes(crypto.SHA1, nil).Validate(crypto.SHA256))
+	ut.AssertEqual(t, false, HashBytes(crypto.SHA256, nil).Validate(crypto.SHA1))
+}
+
+func TestGetHash(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		namespace string
+		h         crypto.Hash
+		algo      string
+	}{
+		{"default-gzip", crypto.SHA1, "sha-1"},
+		{"default", crypto.SHA1, "sha-1"},
+		{"sha256-deflate", crypto.SHA256, "sha-256"},
+		{"sha512-gzip", crypto.SHA512, "sha-512"},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.h, GetHash(line.namespace))
+		ut.AssertEqualIndex(t, i, line.algo, GetAlgo(line.h))
+		h, err := GetHashFromAlgo(line.algo)
+		ut.AssertEqualIndex(t, i, nil, err)
+		ut.AssertEqualIndex(t, i, line.h, h)
+	}
+	_, err := GetHashFromAlgo("md5")
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestCompression(t *testing.T) {
+	t.Parallel()
+	content := bytes.Repeat([]byte("foo"), 100)
+	for _, namespace := range []string{"default-gzip", "sha256-deflate", "default"} {
+		buf := bytes.Buffer{}
+		c := GetCompressor(namespace, &buf)
+		_, err := c.Write(content)
+		ut.AssertEqual(t, nil, err)
+		ut.AssertEqual(t, nil, c.Close())
+		ut.AssertEqual(t, IsCompressed(namespace), !bytes.Equal(content, buf.Bytes()))
+
+		d, err := GetDecompressor(namespace, &buf)
+		ut.AssertEqual(t, nil, err)
+		actual, err := ioutil.ReadAll(d)
+		ut.AssertEqual(t, nil, err)
+		ut.AssertEqual(t, nil, d.Close())
+		ut.AssertEqual(t, content, actual)
 	}
 }
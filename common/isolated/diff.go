@@ -0,0 +1,171 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package isolated
+
+import "sort"
+
+// FileChange describes a file present in both trees of a Diff whose entry
+// differs.
+type FileChange struct {
+	Old File `json:"old"`
+	New File `json:"new"`
+	// Content is true if the content of the file changed, either its digest or
+	// its size.
+	Content bool `json:"content,omitempty"`
+	// Mode is true if the file mode changed.
+	Mode bool `json:"mode,omitempty"`
+	// Link is true if the symlink target changed, or if the entry changed
+	// between a symlink and a regular file.
+	Link bool `json:"link,omitempty"`
+}
+
+// Diff is the difference between two Isolated.
+//
+// Files are keyed by their path relative to the root of the tree.
+type Diff struct {
+	Added           map[string]File       `json:"added,omitempty"`
+	Removed         map[string]File       `json:"removed,omitempty"`
+	Changed         map[string]FileChange `json:"changed,omitempty"`
+	IncludesAdded   HexDigests            `json:"includes_added,omitempty"`
+	IncludesRemoved HexDigests            `json:"includes_removed,omitempty"`
+	// OldCommand and NewCommand are only set if the command changed.
+	OldCommand []string `json:"old_command,omitempty"`
+	NewCommand []string `json:"new_command,omitempty"`
+	// OldRelativeCwd and NewRelativeCwd are only set if the relative working
+	// directory changed.
+	OldRelativeCwd *string `json:"old_relative_cwd,omitempty"`
+	NewRelativeCwd *string `json:"new_relative_cwd,omitempty"`
+	// OldReadOnly and NewReadOnly are only set if the read only value changed.
+	OldReadOnly *ReadOnlyValue `json:"old_read_only,omitempty"`
+	NewReadOnly *ReadOnlyValue `json:"new_read_only,omitempty"`
+}
+
+// NewDiff returns the difference between before and after.
+//
+// Includes are compared as sets; only the includes listed in one Isolated but
+// not the other are reported.
+func NewDiff(before, after *Isolated) *Diff {
+	d := &Diff{
+		Added:   map[string]File{},
+		Removed: map[string]File{},
+		Changed: map[string]FileChange{},
+	}
+	for p, o := range before.Files {
+		n, ok := after.Files[p]
+		if !ok {
+			d.Removed[p] = o
+			continue
+		}
+		c := FileChange{
+			Old:     o,
+			New:     n,
+			Content: o.Digest != n.Digest || !equalInt64(o.Size, n.Size),
+			Mode:    !equalInt(o.Mode, n.Mode),
+			Link:    !equalString(o.Link, n.Link),
+		}
+		if c.Content || c.Mode || c.Link {
+			d.Changed[p] = c
+		}
+	}
+	for p, n := range after.Files {
+		if _, ok := before.Files[p]; !ok {
+			d.Added[p] = n
+		}
+	}
+	d.IncludesAdded = subDigests(after.Includes, before.Includes)
+	d.IncludesRemoved = subDigests(before.Includes, after.Includes)
+	if !equalStrings(before.Command, after.Command) {
+		d.OldCommand = before.Command
+		d.NewCommand = after.Command
+	}
+	if before.RelativeCwd != after.RelativeCwd {
+		d.OldRelativeCwd = newString(before.RelativeCwd)
+		d.NewRelativeCwd = newString(after.RelativeCwd)
+	}
+	if !equalReadOnly(before.ReadOnly, after.ReadOnly) {
+		d.OldReadOnly = before.ReadOnly
+		d.NewReadOnly = after.ReadOnly
+	}
+	return d
+}
+
+// Empty returns true if both trees are equivalent.
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 &&
+		len(d.IncludesAdded) == 0 && len(d.IncludesRemoved) == 0 &&
+		d.OldCommand == nil && d.NewCommand == nil &&
+		d.OldRelativeCwd == nil && d.NewRelativeCwd == nil &&
+		d.OldReadOnly == nil && d.NewReadOnly == nil
+}
+
+// Paths returns the sorted list of all the paths that differ.
+func (d *Diff) Paths() []string {
+	out := make([]string, 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	for p := range d.Added {
+		out = append(out, p)
+	}
+	for p := range d.Removed {
+		out = append(out, p)
+	}
+	for p := range d.Changed {
+		out = append(out, p)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Private details.
+
+// subDigests returns the digests in a that are not in b, in the order of a.
+func subDigests(a, b HexDigests) HexDigests {
+	in := make(map[HexDigest]bool, len(b))
+	for _, h := range b {
+		in[h] = true
+	}
+	var out HexDigests
+	for _, h := range a {
+		if !in[h] {
+			out = append(out, h)
+			in[h] = true
+		}
+	}
+	return out
+}
+
+func newString(v string) *string {
+	o := new(string)
+	*o = v
+	return o
+}
+
+// equalStrings returns true if a and b have the same items. A nil slice is
+// equal to an empty one.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalInt(a, b *int) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
+
+func equalInt64(a, b *int64) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
+
+func equalString(a, b *string) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
+
+func equalReadOnly(a, b *ReadOnlyValue) bool {
+	return a == b || (a != nil && b != nil && *a == *b)
+}
@@ -0,0 +1,90 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package isolated
+
+import (
+	"testing"
+
+	"github.com/maruel/ut"
+)
+
+func TestDiffEmpty(t *testing.T) {
+	t.Parallel()
+	ro := FilesReadOnly
+	i := &Isolated{
+		Command:  []string{"foo"},
+		Files:    map[string]File{"a": {Digest: "0123", Mode: newInt(0600), Size: newInt64(4)}},
+		Includes: HexDigests{"abcd"},
+		ReadOnly: &ro,
+	}
+	d := NewDiff(i, i)
+	ut.AssertEqual(t, true, d.Empty())
+	ut.AssertEqual(t, []string{}, d.Paths())
+	ut.AssertEqual(t, true, NewDiff(&Isolated{}, &Isolated{Command: []string{}}).Empty())
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	ro := FilesReadOnly
+	old := &Isolated{
+		Command: []string{"foo"},
+		Files: map[string]File{
+			"content": {Digest: "0123", Mode: newInt(0600), Size: newInt64(4)},
+			"link":    {Link: newString("content")},
+			"mode":    {Digest: "4567", Mode: newInt(0600), Size: newInt64(4)},
+			"removed": {Digest: "89ab", Mode: newInt(0600), Size: newInt64(4)},
+			"same":    {Digest: "cdef", Mode: newInt(0600), Size: newInt64(4)},
+			"to_link": {Digest: "cdef", Mode: newInt(0600), Size: newInt64(4)},
+		},
+		Includes: HexDigests{"aaaa", "bbbb"},
+	}
+	new := &Isolated{
+		Command: []string{"foo"},
+		Files: map[string]File{
+			"added":   {Digest: "0000", Mode: newInt(0600), Size: newInt64(4)},
+			"content": {Digest: "1111", Mode: newInt(0600), Size: newInt64(5)},
+			"link":    {Link: newString("same")},
+			"mode":    {Digest: "4567", Mode: newInt(0700), Size: newInt64(4)},
+			"same":    {Digest: "cdef", Mode: newInt(0600), Size: newInt64(4)},
+			"to_link": {Link: newString("same")},
+		},
+		Includes:    HexDigests{"bbbb", "cccc"},
+		ReadOnly:    &ro,
+		RelativeCwd: "sub",
+	}
+	d := NewDiff(old, new)
+	ut.AssertEqual(t, false, d.Empty())
+	ut.AssertEqual(t, map[string]File{"added": new.Files["added"]}, d.Added)
+	ut.AssertEqual(t, map[string]File{"removed": old.Files["removed"]}, d.Removed)
+	expected := map[string]FileChange{
+		"content": {Old: old.Files["content"], New: new.Files["content"], Content: true},
+		"link":    {Old: old.Files["link"], New: new.Files["link"], Link: true},
+		"mode":    {Old: old.Files["mode"], New: new.Files["mode"], Mode: true},
+		"to_link": {Old: old.Files["to_link"], New: new.Files["to_link"], Content: true, Mode: true, Link: true},
+	}
+	ut.AssertEqual(t, expected, d.Changed)
+	ut.AssertEqual(t, HexDigests{"cccc"}, d.IncludesAdded)
+	ut.AssertEqual(t, HexDigests{"aaaa"}, d.IncludesRemoved)
+	ut.AssertEqual(t, []string(nil), d.OldCommand)
+	ut.AssertEqual(t, "", *d.OldRelativeCwd)
+	ut.AssertEqual(t, "sub", *d.NewRelativeCwd)
+	ut.AssertEqual(t, (*ReadOnlyValue)(nil), d.OldReadOnly)
+	ut.AssertEqual(t, FilesReadOnly, *d.NewReadOnly)
+	ut.AssertEqual(t, []string{"added", "content", "link", "mode", "removed", "to_link"}, d.Paths())
+}
+
+// Private details.
+
+func newInt(v int) *int {
+	o := new(int)
+	*o = v
+	return o
+}
+
+func newInt64(v int64) *int64 {
+	o := new(int64)
+	*o = v
+	return o
+}
@@ -37,7 +37,7 @@ type File struct {
 
 // Isolated is the data from a JSON serialized .isolated file.
 type Isolated struct {
-	Algo        string          `json:"algo"` // Must be "sha-1"
+	Algo        string          `json:"algo"` // One of "sha-1", "sha-256" or "sha-512"
 	Command     []string        `json:"command,omitempty"`
 	Files       map[string]File `json:"files,omitempty"`
 	Includes    HexDigests      `json:"includes,omitempty"`
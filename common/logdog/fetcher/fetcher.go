@@ -14,6 +14,7 @@ import (
 	"github.com/luci/luci-go/common/logdog/types"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/common/retry"
 	"golang.org/x/net/context"
 )
 
@@ -85,6 +86,15 @@ type Options struct {
 	// Delay is the amount of time to wait in between unsuccessful log requests.
 	Delay time.Duration
 
+	// Backoff, if not nil, generates the delays to wait in between unsuccessful
+	// log requests instead of Delay. A new Iterator is generated for each round
+	// of log requests, so the delay is reset once logs are returned.
+	//
+	// If the Iterator returns retry.Stop, the Fetcher stops requesting logs and
+	// returns io.EOF once the buffered logs are consumed, as if the stream had
+	// terminated.
+	Backoff retry.Factory
+
 	// sizeFunc is a function that calculates the byte size of a LogEntry
 	// protobuf.
 	//
@@ -171,6 +181,9 @@ type fetchResponse struct {
 	logs []*logpb.LogEntry
 	tidx types.MessageIndex
 	err  error
+
+	// stopped is true if no logs were returned and the Backoff Iterator stopped.
+	stopped bool
 }
 
 // fetch is run in a separate goroutine. It handles buffering goroutine and
@@ -201,6 +214,7 @@ func (f *Fetcher) fetch(c context.Context) {
 	}
 
 	count := int64(0)
+	stopped := false
 	for tidx < 0 || lastSentIndex < tidx {
 		// If we're configured with an upper delivery bound and we've delivered
 		// that many entries, we're done.
@@ -225,11 +239,17 @@ func (f *Fetcher) fetch(c context.Context) {
 				index: types.MessageIndex(le.StreamIndex),
 			}
 			sendC = f.logC
+		} else if stopped {
+			// Our Backoff has stopped and we've sent all buffered logs.
+			log.Fields{
+				"lastSentIndex": lastSentIndex,
+			}.Debugf(c, "Stopped fetching logs.")
+			break
 		}
 
 		// If we're not currently fetching logs, and we are below our thresholds,
 		// request a new batch of logs.
-		if logFetchC == nil && (tidx < 0 || nextFetchIndex <= tidx) {
+		if logFetchC == nil && !stopped && (tidx < 0 || nextFetchIndex <= tidx) {
 
 			// We always have a byte constraint. Are we below it?
 			fetchCount := f.applyConstraint(int64(f.o.BufferCount), int64(lb.size()))
@@ -294,6 +314,9 @@ func (f *Fetcher) fetch(c context.Context) {
 			if resp.tidx >= 0 {
 				tidx = resp.tidx
 			}
+			if resp.stopped {
+				stopped = true
+			}
 
 		case sendC <- lr:
 			bytes -= lr.size
@@ -330,6 +353,11 @@ func (f *Fetcher) fetchLogs(c context.Context, req *fetchRequest) {
 		lreq.Bytes = 0
 	}
 
+	var it retry.Iterator
+	if f.o.Backoff != nil {
+		it = f.o.Backoff()
+	}
+
 	for {
 		log.Fields{
 			"index": req.index,
@@ -360,11 +388,22 @@ func (f *Fetcher) fetchLogs(c context.Context, req *fetchRequest) {
 
 		default:
 			// No logs this round. Sleep for more.
+			delay := f.o.Delay
+			if it != nil {
+				if delay = it.Next(c, nil); delay == retry.Stop {
+					log.Fields{
+						"index": req.index,
+					}.Debugf(c, "No logs returned and backoff has stopped.")
+					resp.stopped = true
+					return
+				}
+			}
+
 			log.Fields{
 				"index": req.index,
-				"delay": f.o.Delay,
+				"delay": delay,
 			}.Infof(c, "No logs returned. Sleeping...")
-			if tr := clock.Sleep(c, f.o.Delay); tr.Incomplete() {
+			if tr := clock.Sleep(c, delay); tr.Incomplete() {
 				log.WithError(tr.Err).Warningf(c, "Context was canceled.")
 				resp.err = tr.Err
 				return
@@ -16,6 +16,7 @@ import (
 	"github.com/luci/luci-go/common/clock/testclock"
 	"github.com/luci/luci-go/common/logdog/types"
 	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/common/retry"
 	"github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
@@ -246,6 +247,33 @@ func TestFetcher(t *testing.T) {
 				So(delayed, ShouldBeTrue)
 			})
 
+			Convey(`Will stop when no log records are available and the backoff stops.`, func() {
+				var delays []time.Duration
+				tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+					delays = append(delays, d)
+					tc.Add(d)
+				})
+				o.Backoff = func() retry.Iterator {
+					return &retry.ExponentialBackoff{
+						Limited: retry.Limited{
+							Delay:   time.Second,
+							Retries: 2,
+						},
+					}
+				}
+
+				var cmd testSourceCommand
+				ts.send(cmd.logs(0, 1))
+
+				f := newFetcher()
+				defer reap(f)
+
+				logs, err := loadLogs(f, 0)
+				So(err, ShouldEqual, io.EOF)
+				So(logs, ShouldResemble, []types.MessageIndex{0, 1})
+				So(delays, ShouldResemble, []time.Duration{time.Second, 2 * time.Second})
+			})
+
 			Convey(`When an error is countered getting the terminal index, returns the error.`, func() {
 				var cmd testSourceCommand
 				ts.send(cmd.error(errors.New("test error"), false))
@@ -19,6 +19,7 @@ type Transport struct {
 	//
 	// Types that are valid to be assigned to Type:
 	//	*Transport_Pubsub
+	//	*Transport_Direct_
 	Type isTransport_Type `protobuf_oneof:"Type"`
 }
 
@@ -35,7 +36,12 @@ type Transport_Pubsub struct {
 	Pubsub *Transport_PubSub `protobuf:"bytes,1,opt,name=pubsub,oneof"`
 }
 
-func (*Transport_Pubsub) isTransport_Type() {}
+type Transport_Direct_ struct {
+	Direct *Transport_Direct `protobuf:"bytes,2,opt,name=direct,oneof"`
+}
+
+func (*Transport_Pubsub) isTransport_Type()  {}
+func (*Transport_Direct_) isTransport_Type() {}
 
 func (m *Transport) GetType() isTransport_Type {
 	if m != nil {
@@ -51,10 +57,18 @@ func (m *Transport) GetPubsub() *Transport_PubSub {
 	return nil
 }
 
+func (m *Transport) GetDirect() *Transport_Direct {
+	if x, ok := m.GetType().(*Transport_Direct_); ok {
+		return x.Direct
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Transport) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Transport_OneofMarshaler, _Transport_OneofUnmarshaler, _Transport_OneofSizer, []interface{}{
 		(*Transport_Pubsub)(nil),
+		(*Transport_Direct_)(nil),
 	}
 }
 
@@ -67,6 +81,11 @@ func _Transport_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Pubsub); err != nil {
 			return err
 		}
+	case *Transport_Direct_:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Direct); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Transport.Type has unexpected type %T", x)
@@ -85,6 +104,14 @@ func _Transport_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buff
 		err := b.DecodeMessage(msg)
 		m.Type = &Transport_Pubsub{msg}
 		return true, err
+	case 2: // Type.direct
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Transport_Direct)
+		err := b.DecodeMessage(msg)
+		m.Type = &Transport_Direct_{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -99,6 +126,11 @@ func _Transport_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(1<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Transport_Direct_:
+		s := proto.Size(x.Direct)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -121,22 +153,42 @@ func (m *Transport_PubSub) String() string            { return proto.CompactText
 func (*Transport_PubSub) ProtoMessage()               {}
 func (*Transport_PubSub) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 0} }
 
+// Direct is a transport configuration for sending Butler log bundles
+// directly to Collector instances over HTTP.
+type Transport_Direct struct {
+	// The URL that Butler instances POST log bundles to. This must route to a
+	// Collector instance's listen address.
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	// The local address that Collector instances listen on for log bundles
+	// (e.g., ":8080").
+	Listen string `protobuf:"bytes,2,opt,name=listen" json:"listen,omitempty"`
+}
+
+func (m *Transport_Direct) Reset()                    { *m = Transport_Direct{} }
+func (m *Transport_Direct) String() string            { return proto.CompactTextString(m) }
+func (*Transport_Direct) ProtoMessage()               {}
+func (*Transport_Direct) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 1} }
+
 func init() {
 	proto.RegisterType((*Transport)(nil), "svcconfig.Transport")
 	proto.RegisterType((*Transport_PubSub)(nil), "svcconfig.Transport.PubSub")
+	proto.RegisterType((*Transport_Direct)(nil), "svcconfig.Transport.Direct")
 }
 
 var fileDescriptor4 = []byte{
-	// 167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x2f, 0x29, 0x4a, 0xcc,
-	0x2b, 0x2e, 0xc8, 0x2f, 0x2a, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2c, 0x2e, 0x4b,
-	0x4e, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0x57, 0x5a, 0xc1, 0xc8, 0xc5, 0x19, 0x02, 0x93, 0x16, 0x32,
-	0xe5, 0x62, 0x2b, 0x28, 0x4d, 0x2a, 0x2e, 0x4d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92,
-	0xd6, 0x83, 0xab, 0xd4, 0x83, 0xab, 0xd2, 0x0b, 0x28, 0x4d, 0x0a, 0x2e, 0x4d, 0xf2, 0x60, 0x08,
-	0x82, 0x2a, 0x96, 0x8a, 0xe1, 0x62, 0x83, 0x88, 0x09, 0x49, 0x70, 0xb1, 0x03, 0xad, 0xc8, 0x4a,
-	0x4d, 0x2e, 0x01, 0x9b, 0xc0, 0x19, 0x04, 0xe3, 0x0a, 0x89, 0x70, 0xb1, 0x96, 0xe4, 0x17, 0x64,
-	0x26, 0x4b, 0x30, 0x81, 0xc5, 0x21, 0x1c, 0x21, 0x25, 0x2e, 0x1e, 0xa0, 0x01, 0xc5, 0xc9, 0x45,
-	0x99, 0x05, 0x25, 0x99, 0xf9, 0x79, 0x12, 0xcc, 0x60, 0x49, 0x14, 0x31, 0x27, 0x36, 0x2e, 0x96,
-	0x90, 0xca, 0x82, 0xd4, 0x24, 0x36, 0xb0, 0xe3, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x39,
-	0x0e, 0x08, 0x8a, 0xcf, 0x00, 0x00, 0x00,
+	// 215 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcb, 0x4a, 0xc4, 0x30,
+	0x14, 0x86, 0x6d, 0xab, 0x91, 0x1c, 0x05, 0x25, 0x88, 0x84, 0xba, 0x91, 0xae, 0x5c, 0x65, 0x51,
+	0xf1, 0x05, 0xc4, 0x85, 0x4b, 0xa9, 0x5d, 0xba, 0x31, 0xb1, 0x0e, 0x19, 0x4a, 0x12, 0x72, 0x19,
+	0x98, 0xe7, 0x98, 0x17, 0x1e, 0x72, 0x69, 0x61, 0x36, 0xb3, 0xcb, 0x9f, 0xf3, 0xfd, 0xdf, 0x81,
+	0x03, 0x77, 0xde, 0xfe, 0x2a, 0x67, 0xb4, 0xf5, 0xcc, 0x58, 0xed, 0x35, 0xc1, 0x6e, 0x27, 0x84,
+	0x56, 0xff, 0x72, 0xd3, 0x1d, 0x6a, 0xc0, 0xe3, 0x32, 0x26, 0x6f, 0x80, 0x4c, 0xe0, 0x2e, 0x70,
+	0x5a, 0x3d, 0x57, 0x2f, 0x37, 0xfd, 0x13, 0x5b, 0x49, 0xb6, 0x52, 0xec, 0x2b, 0xf0, 0xef, 0xc0,
+	0x3f, 0x2f, 0x86, 0x02, 0xc7, 0xda, 0x9f, 0xb4, 0x93, 0xf0, 0xb4, 0x3e, 0x53, 0xfb, 0x48, 0x48,
+	0xac, 0x65, 0xb8, 0xfd, 0x01, 0x94, 0x55, 0x84, 0xc2, 0xb5, 0xb1, 0x7a, 0x1b, 0x0d, 0x71, 0x31,
+	0x1e, 0x96, 0x48, 0x1e, 0xe0, 0xca, 0x6b, 0x23, 0x45, 0x32, 0xe3, 0x21, 0x07, 0xd2, 0xc1, 0xad,
+	0x0b, 0xdc, 0x09, 0x2b, 0x8d, 0x97, 0x5a, 0xd1, 0x26, 0x0d, 0x4f, 0xfe, 0xda, 0x1e, 0x50, 0xde,
+	0x48, 0xee, 0xa1, 0x09, 0x76, 0x2e, 0xe6, 0xf8, 0x24, 0x8f, 0x80, 0x66, 0xe9, 0xfc, 0xa4, 0x8a,
+	0xb6, 0xa4, 0x77, 0x04, 0x97, 0xe3, 0xde, 0x4c, 0x1c, 0xa5, 0x3b, 0xbd, 0x1e, 0x07, 0x00, 0x9c,
+	0x58, 0x86, 0xbf, 0x3a, 0x01, 0x00, 0x00,
 }
@@ -6,6 +6,9 @@ package main
 
 import (
 	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/luci/luci-go/common/auth"
@@ -14,6 +17,7 @@ import (
 	gcps "github.com/luci/luci-go/common/gcloud/pubsub"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/parallel"
+	"github.com/luci/luci-go/common/proto/logdog/svcconfig"
 	"github.com/luci/luci-go/common/tsmon/distribution"
 	"github.com/luci/luci-go/common/tsmon/field"
 	"github.com/luci/luci-go/common/tsmon/metric"
@@ -49,6 +53,21 @@ var (
 	tsTaskProcessingTime = metric.NewCumulativeDistribution("logdog/collector/subscription/processing_time_ms",
 		"Amount of time in milliseconds that a single Pub/Sub message takes to process.",
 		distribution.DefaultBucketer)
+
+	// tsDirectCount counts the number of log bundles processed by the direct
+	// transport.
+	//
+	// Result tracks the outcome of each bundle, either "success", "failure", or
+	// "transient_failure".
+	tsDirectCount = metric.NewCounter("logdog/collector/direct/count",
+		"The number of log bundles received by the direct transport.",
+		field.String("result"))
+
+	// tsDirectProcessingTime tracks the amount of time a single direct transport
+	// log bundle takes to process, in milliseconds.
+	tsDirectProcessingTime = metric.NewCumulativeDistribution("logdog/collector/direct/processing_time_ms",
+		"Amount of time in milliseconds that a single direct transport log bundle takes to process.",
+		distribution.DefaultBucketer)
 )
 
 // application is the Collector application state.
@@ -64,11 +83,46 @@ func (a *application) runCollector(c context.Context) error {
 		return errors.New("no collector configuration")
 	}
 
-	pscfg := cfg.GetTransport().GetPubsub()
-	if pscfg == nil {
-		return errors.New("missing Pub/Sub configuration")
+	tcfg := cfg.GetTransport()
+	if tcfg.GetPubsub() == nil && tcfg.GetDirect() == nil {
+		return errors.New("missing Pub/Sub or direct transport configuration")
+	}
+
+	st, err := a.IntermediateStorage(c)
+	if err != nil {
+		return err
 	}
+	defer st.Close()
+
+	// Initialize our Collector service object using a caching Coordinator
+	// interface.
+	coord := coordinator.NewCoordinator(a.Coordinator())
+	coord = coordinator.NewCache(coord, int(ccfg.StateCacheSize), ccfg.StateCacheExpiration.Duration())
 
+	coll := collector.Collector{
+		Coordinator:       coord,
+		Storage:           st,
+		MaxMessageWorkers: int(ccfg.MaxMessageWorkers),
+	}
+	defer coll.Close()
+
+	if dcfg := tcfg.GetDirect(); dcfg != nil {
+		err = a.runDirect(c, &coll, dcfg)
+	} else {
+		err = a.runPubSub(c, &coll, ccfg, tcfg.GetPubsub())
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Debugf(c, "Collector finished.")
+	return nil
+}
+
+// runPubSub pulls log bundles from the configured Pub/Sub subscription until
+// the application is shut down.
+func (a *application) runPubSub(c context.Context, coll *collector.Collector, ccfg *svcconfig.Collector,
+	pscfg *svcconfig.Transport_PubSub) error {
 	// Our Subscription must be a valid one.
 	sub := gcps.NewSubscription(pscfg.Project, pscfg.Subscription)
 	if err := sub.Validate(); err != nil {
@@ -112,24 +166,6 @@ func (a *application) runCollector(c context.Context) error {
 		"subscription": sub,
 	}.Infof(c, "Successfully validated Pub/Sub subscription.")
 
-	st, err := a.IntermediateStorage(c)
-	if err != nil {
-		return err
-	}
-	defer st.Close()
-
-	// Initialize our Collector service object using a caching Coordinator
-	// interface.
-	coord := coordinator.NewCoordinator(a.Coordinator())
-	coord = coordinator.NewCache(coord, int(ccfg.StateCacheSize), ccfg.StateCacheExpiration.Duration())
-
-	coll := collector.Collector{
-		Coordinator:       coord,
-		Storage:           st,
-		MaxMessageWorkers: int(ccfg.MaxMessageWorkers),
-	}
-	defer coll.Close()
-
 	// Execute our main subscription pull loop. It will run until the supplied
 	// Context is cancelled.
 	psIterator, err := psSub.Pull(c)
@@ -151,7 +187,7 @@ func (a *application) runCollector(c context.Context) error {
 			case nil:
 				taskC <- func() error {
 					c := log.SetField(c, "messageID", msg.ID)
-					msg.Done(a.processMessage(c, &coll, msg))
+					msg.Done(a.processMessage(c, coll, msg))
 					return nil
 				}
 
@@ -167,8 +203,69 @@ func (a *application) runCollector(c context.Context) error {
 			}
 		}
 	}))
+	return nil
+}
 
-	log.Debugf(c, "Collector finished.")
+// runDirect serves log bundles POSTed directly by Butler instances until the
+// application is shut down.
+func (a *application) runDirect(c context.Context, coll *collector.Collector, dcfg *svcconfig.Transport_Direct) error {
+	if dcfg.Listen == "" {
+		return errors.New("missing direct transport listen address")
+	}
+
+	l, err := net.Listen("tcp", dcfg.Listen)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"address":    dcfg.Listen,
+		}.Errorf(c, "Failed to listen for direct transport.")
+		return err
+	}
+
+	h := collector.Handler{
+		Context:   c,
+		Collector: coll,
+		Processed: func(c context.Context, err error, d time.Duration) {
+			// We track processing time in milliseconds.
+			tsDirectProcessingTime.Add(c, d.Seconds()*1000)
+
+			switch {
+			case errors.IsTransient(err):
+				tsDirectCount.Add(c, 1, "transient_failure")
+			case err == nil:
+				tsDirectCount.Add(c, 1, "success")
+			default:
+				tsDirectCount.Add(c, 1, "failure")
+			}
+		},
+	}
+	srv := http.Server{
+		Handler: &h,
+	}
+
+	// Application shutdown will now operate by closing our listener, which
+	// will cause Serve to return.
+	var shutdown int32
+	a.SetShutdownFunc(func() {
+		atomic.StoreInt32(&shutdown, 1)
+		srv.SetKeepAlivesEnabled(false)
+		l.Close()
+	})
+
+	log.Fields{
+		"address": l.Addr(),
+	}.Infof(c, "Listening for direct transport log bundles.")
+
+	err = srv.Serve(l)
+
+	// Serve returns once our listener is closed, but log bundles may still be
+	// in flight. Wait for them before our Collector and storage are closed.
+	h.Close()
+
+	if err != nil && atomic.LoadInt32(&shutdown) == 0 {
+		log.WithError(err).Errorf(c, "Failed to serve direct transport.")
+		return err
+	}
 	return nil
 }
 
@@ -223,6 +320,8 @@ func main() {
 	a := application{
 		Service: service.Service{
 			Name: "collector",
+			// The Collector is the only writer of intermediate storage.
+			ExpireIntermediateStorage: true,
 		},
 	}
 	a.Run(context.Background(), a.runCollector)
@@ -32,6 +32,7 @@ const (
 	tsEntriesField = "entries"
 	tsIndexField   = "index"
 	tsDataField    = "data"
+	tsSearchField  = "search"
 )
 
 var (
@@ -355,6 +356,7 @@ func (a *Archivist) archiveTaskImpl(c context.Context, task Task) error {
 		staged.stream.addMetrics(c, tsEntriesField, streamType)
 		staged.index.addMetrics(c, tsIndexField, streamType)
 		staged.data.addMetrics(c, tsDataField, streamType)
+		staged.search.addMetrics(c, tsSearchField, streamType)
 
 		tsLogEntries.Add(c, float64(staged.logEntryCount), streamType)
 		tsTotalLogEntries.Add(c, staged.logEntryCount, streamType)
@@ -364,6 +366,7 @@ func (a *Archivist) archiveTaskImpl(c context.Context, task Task) error {
 		"streamURL":     ar.StreamUrl,
 		"indexURL":      ar.IndexUrl,
 		"dataURL":       ar.DataUrl,
+		"searchURL":     ar.SearchUrl,
 		"terminalIndex": ar.TerminalIndex,
 		"logEntryCount": ar.LogEntryCount,
 		"hadError":      ar.Error,
@@ -447,6 +450,11 @@ func (a *Archivist) makeStagedArchival(c context.Context, project config.Project
 	sa.stream = sa.makeStagingPaths("logstream.entries", uid)
 	sa.index = sa.makeStagingPaths("logstream.index", uid)
 	sa.data = sa.makeStagingPaths(fmt.Sprintf("data.%s", bext), uid)
+
+	// Only text log streams have a search index.
+	if sa.desc.StreamType == logpb.StreamType_TEXT {
+		sa.search = sa.makeStagingPaths("logstream.search", uid)
+	}
 	return &sa, nil
 }
 
@@ -461,6 +469,7 @@ type stagedArchival struct {
 	stream stagingPaths
 	index  stagingPaths
 	data   stagingPaths
+	search stagingPaths
 
 	finalized     bool
 	terminalIndex types.MessageIndex
@@ -534,6 +543,7 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		"streamURL": sa.stream.staged,
 		"indexURL":  sa.index.staged,
 		"dataURL":   sa.data.staged,
+		"searchURL": sa.search.staged,
 	}.Debugf(c, "Staging log stream...")
 
 	// Group any transient errors that occur during cleanup. If we aren't
@@ -581,7 +591,7 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		return w, nil
 	}
 
-	var streamWriter, indexWriter, dataWriter gs.Writer
+	var streamWriter, indexWriter, dataWriter, searchWriter gs.Writer
 	if streamWriter, err = createWriter(sa.stream.staged); err != nil {
 		return
 	}
@@ -597,6 +607,13 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 	}
 	defer closeWriter(dataWriter, sa.data.staged)
 
+	if sa.search.staged != "" {
+		if searchWriter, err = createWriter(sa.search.staged); err != nil {
+			return err
+		}
+		defer closeWriter(searchWriter, sa.search.staged)
+	}
+
 	// Read our log entries from intermediate storage.
 	ss := storageSource{
 		Context:       c,
@@ -619,6 +636,9 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 
 		Logger: log.Get(c),
 	}
+	if searchWriter != nil {
+		m.SearchIndexWriter = searchWriter
+	}
 	if err = archive.Archive(m); err != nil {
 		log.WithError(err).Errorf(c, "Failed to archive log stream.")
 		return
@@ -643,6 +663,9 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 	sa.stream.bytesWritten = streamWriter.Count()
 	sa.index.bytesWritten = indexWriter.Count()
 	sa.data.bytesWritten = dataWriter.Count()
+	if searchWriter != nil {
+		sa.search.bytesWritten = searchWriter.Count()
+	}
 	return
 }
 
@@ -699,6 +722,12 @@ func (sa *stagedArchival) finalize(c context.Context, client gs.Client, ar *logd
 	ar.IndexSize = sa.index.bytesWritten
 	ar.DataUrl = string(sa.data.final)
 	ar.DataSize = sa.data.bytesWritten
+
+	// Only text streams with text in them have a search index.
+	if sa.search.bytesWritten > 0 {
+		ar.SearchUrl = string(sa.search.final)
+		ar.SearchSize = sa.search.bytesWritten
+	}
 	return nil
 }
 
@@ -724,6 +753,7 @@ func (sa *stagedArchival) getStagingPaths() []*stagingPaths {
 		&sa.stream,
 		&sa.index,
 		&sa.data,
+		&sa.search,
 	}
 }
 
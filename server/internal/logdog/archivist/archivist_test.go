@@ -329,17 +329,19 @@ func TestHandleArchive(t *testing.T) {
 		//
 		// After checking, the values are set to zero. This allows us to use
 		// ShouldEqual without hardcoding specific archival sizes into the results.
-		hasStreams := func(log, index, data bool) bool {
+		hasStreams := func(log, index, data, search bool) bool {
 			So(archiveRequest, ShouldNotBeNil)
 			if (log && archiveRequest.StreamSize <= 0) ||
 				(index && archiveRequest.IndexSize <= 0) ||
-				(data && archiveRequest.DataSize <= 0) {
+				(data && archiveRequest.DataSize <= 0) ||
+				(search && archiveRequest.SearchSize <= 0) {
 				return false
 			}
 
 			archiveRequest.StreamSize = 0
 			archiveRequest.IndexSize = 0
 			archiveRequest.DataSize = 0
+			archiveRequest.SearchSize = 0
 			return true
 		}
 
@@ -425,7 +427,7 @@ func TestHandleArchive(t *testing.T) {
 				So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 				So(task.consumed, ShouldBeTrue)
 
-				So(hasStreams(true, true, true), ShouldBeTrue)
+				So(hasStreams(true, true, true, true), ShouldBeTrue)
 				So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 					Project:       project,
 					Id:            archiveTask.Id,
@@ -435,6 +437,7 @@ func TestHandleArchive(t *testing.T) {
 					StreamUrl: gsURL(project, "logstream.entries"),
 					IndexUrl:  gsURL(project, "logstream.index"),
 					DataUrl:   gsURL(project, "data.bin"),
+					SearchUrl: gsURL(project, "logstream.search"),
 				})
 			})
 
@@ -496,7 +499,7 @@ func TestHandleArchive(t *testing.T) {
 					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 					So(task.consumed, ShouldBeTrue)
 
-					So(hasStreams(true, true, false), ShouldBeTrue)
+					So(hasStreams(true, true, false, false), ShouldBeTrue)
 					So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 						Project:       project,
 						Id:            archiveTask.Id,
@@ -509,13 +512,35 @@ func TestHandleArchive(t *testing.T) {
 					})
 				})
 
+				Convey(`Will not stage a search index for a binary stream.`, func() {
+					desc.StreamType = logpb.StreamType_BINARY
+					stream.Desc, err = proto.Marshal(&desc)
+					So(err, ShouldBeNil)
+					addTestEntry(project, 0, 1, 2, 4)
+
+					var paths []string
+					gsc.newWriterErr = func(w *testGSWriter) error {
+						paths = append(paths, string(w.path))
+						return nil
+					}
+
+					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
+					So(task.consumed, ShouldBeTrue)
+					So(paths, ShouldHaveLength, 3)
+					for _, p := range paths {
+						So(p, ShouldNotEndWith, "/logstream.search")
+					}
+					So(hasStreams(true, true, false, false), ShouldBeTrue)
+					So(archiveRequest.SearchUrl, ShouldEqual, "")
+				})
+
 				Convey(`With {0, 1, 2, 4} (incomplete) will archive the stream and update its terminal index.`, func() {
 					addTestEntry(project, 0, 1, 2, 4)
 
 					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 					So(task.consumed, ShouldBeTrue)
 
-					So(hasStreams(true, true, true), ShouldBeTrue)
+					So(hasStreams(true, true, true, true), ShouldBeTrue)
 					So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 						Project:       project,
 						Id:            archiveTask.Id,
@@ -525,6 +550,7 @@ func TestHandleArchive(t *testing.T) {
 						StreamUrl: gsURL(project, "logstream.entries"),
 						IndexUrl:  gsURL(project, "logstream.index"),
 						DataUrl:   gsURL(project, "data.bin"),
+						SearchUrl: gsURL(project, "logstream.search"),
 					})
 				})
 			})
@@ -536,7 +562,7 @@ func TestHandleArchive(t *testing.T) {
 					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 					So(task.consumed, ShouldBeTrue)
 
-					So(hasStreams(true, true, false), ShouldBeTrue)
+					So(hasStreams(true, true, false, false), ShouldBeTrue)
 					So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 						Project:       project,
 						Id:            archiveTask.Id,
@@ -555,7 +581,7 @@ func TestHandleArchive(t *testing.T) {
 					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 					So(task.consumed, ShouldBeTrue)
 
-					So(hasStreams(true, true, true), ShouldBeTrue)
+					So(hasStreams(true, true, true, true), ShouldBeTrue)
 					So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 						Project:       project,
 						Id:            archiveTask.Id,
@@ -565,6 +591,7 @@ func TestHandleArchive(t *testing.T) {
 						StreamUrl: gsURL(project, "logstream.entries"),
 						IndexUrl:  gsURL(project, "logstream.index"),
 						DataUrl:   gsURL(project, "data.bin"),
+						SearchUrl: gsURL(project, "logstream.search"),
 					})
 				})
 			})
@@ -580,7 +607,7 @@ func TestHandleArchive(t *testing.T) {
 				So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 				So(task.consumed, ShouldBeTrue)
 
-				So(hasStreams(true, true, true), ShouldBeTrue)
+				So(hasStreams(true, true, true, true), ShouldBeTrue)
 				So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 					Project:       "",
 					Id:            archiveTask.Id,
@@ -590,6 +617,7 @@ func TestHandleArchive(t *testing.T) {
 					StreamUrl: gsURL("_", "logstream.entries"),
 					IndexUrl:  gsURL("_", "logstream.index"),
 					DataUrl:   gsURL("_", "data.bin"),
+					SearchUrl: gsURL("_", "logstream.search"),
 				})
 			})
 		})
@@ -599,7 +627,7 @@ func TestHandleArchive(t *testing.T) {
 			stream.State.TerminalIndex = 3
 			addTestEntry(project, 0, 1, 2, 3)
 
-			for _, failName := range []string{"/logstream.entries", "/logstream.index", "/data.bin"} {
+			for _, failName := range []string{"/logstream.entries", "/logstream.index", "/data.bin", "/logstream.search"} {
 				for _, testCase := range []struct {
 					name  string
 					setup func()
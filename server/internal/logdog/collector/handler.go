@@ -0,0 +1,133 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package collector
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"golang.org/x/net/context"
+)
+
+const (
+	// DefaultMaxBundleSize is the default maximum size, in bytes, of a log
+	// bundle that a Handler will accept.
+	DefaultMaxBundleSize = 16 * 1024 * 1024
+)
+
+// Handler is an http.Handler that ingests log bundles that are POSTed to it
+// directly by Butler instances. It is used in place of a Pub/Sub subscription
+// when the service is configured with a direct transport.
+//
+// The request body is the same butlerproto-encoded data that would be
+// published to Pub/Sub, and is passed to the Collector's Process method. The
+// response status reflects the outcome:
+//	- 200 (OK) if the bundle was ingested, or was discarded due to bad data.
+//	- 400 (Bad Request) if the bundle could not be ingested and should not be
+//	  resent.
+//	- 503 (Service Unavailable) if a transient error occurred, or the Handler
+//	  has been closed, and the bundle should be resent.
+type Handler struct {
+	// Context is the base Context for bundle processing.
+	Context context.Context
+
+	// Collector is the Collector to ingest bundles with.
+	Collector *Collector
+
+	// MaxBundleSize is the maximum size of a log bundle request body. If <= 0,
+	// DefaultMaxBundleSize will be used.
+	MaxBundleSize int64
+
+	// Processed, if not nil, is called after each log bundle has been passed to
+	// the Collector with the resulting error, if any, and the time that it took
+	// to process.
+	Processed func(c context.Context, err error, d time.Duration)
+
+	mu       sync.Mutex
+	closed   bool
+	inFlight sync.WaitGroup
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// Close stops the Handler from accepting new log bundles and blocks until all
+// in-flight log bundles have been processed. Log bundles received afterwards
+// are rejected as a transient error.
+func (h *Handler) Close() {
+	h.mu.Lock()
+	h.closed = true
+	h.mu.Unlock()
+
+	h.inFlight.Wait()
+}
+
+func (h *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	c := h.Context
+	if req.Method != "POST" {
+		http.Error(rw, "log bundles must be POSTed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		http.Error(rw, "collector is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	h.inFlight.Add(1)
+	h.mu.Unlock()
+	defer h.inFlight.Done()
+
+	maxSize := h.MaxBundleSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxBundleSize
+	}
+
+	// Read one byte beyond our maximum so we can identify oversized bundles.
+	data, err := ioutil.ReadAll(io.LimitReader(req.Body, maxSize+1))
+	if err != nil {
+		log.WithError(err).Warningf(c, "Failed to read log bundle request body.")
+		http.Error(rw, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+	if int64(len(data)) > maxSize {
+		log.Fields{
+			"remoteAddr": req.RemoteAddr,
+			"maxSize":    maxSize,
+		}.Errorf(c, "Log bundle exceeds maximum size.")
+		http.Error(rw, "log bundle is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	c = log.SetField(c, "remoteAddr", req.RemoteAddr)
+	log.Fields{
+		"size": len(data),
+	}.Infof(c, "Received log bundle.")
+
+	startTime := clock.Now(c)
+	err = h.Collector.Process(c, data)
+	if h.Processed != nil {
+		h.Processed(c, err, clock.Now(c).Sub(startTime))
+	}
+
+	switch {
+	case err == nil:
+		rw.WriteHeader(http.StatusOK)
+
+	case errors.IsTransient(err):
+		log.WithError(err).Warningf(c, "TRANSIENT error ingesting log bundle.")
+		http.Error(rw, "transient error ingesting log bundle", http.StatusServiceUnavailable)
+
+	default:
+		log.WithError(err).Errorf(c, "Non-transient error ingesting log bundle.")
+		http.Error(rw, "failed to ingest log bundle", http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package collector
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/server/logdog/storage/memory"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Collector HTTP Handler`, t, func() {
+		c, _ := testclock.UseTime(context.Background(), testclock.TestTimeLocal)
+
+		tcc := &testCoordinator{}
+		st := &testStorage{Storage: &memory.Storage{}}
+
+		coll := &Collector{
+			Coordinator: tcc,
+			Storage:     st,
+		}
+		defer coll.Close()
+
+		h := Handler{
+			Context:   c,
+			Collector: coll,
+		}
+
+		bb := bundleBuilder{
+			Context: c,
+		}
+
+		post := func(data []byte) *httptest.ResponseRecorder {
+			req, err := http.NewRequest("POST", "/", bytes.NewReader(data))
+			if err != nil {
+				panic(err)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			return rec
+		}
+
+		Convey(`Will ingest a POSTed log bundle.`, func() {
+			bb.addFullStream("foo/+/bar", 16)
+
+			So(post(bb.bundle()).Code, ShouldEqual, http.StatusOK)
+			So(tcc, shouldHaveRegisteredStream, "test-project", "foo/+/bar", 15)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", indexRange{0, 15})
+		})
+
+		Convey(`Will accept, and discard, a corrupt log bundle.`, func() {
+			So(post([]byte{0x00}).Code, ShouldEqual, http.StatusOK)
+			So(tcc, shouldNotHaveRegisteredStream, "test-project", "foo/+/bar")
+		})
+
+		Convey(`Will return StatusServiceUnavailable on transient error.`, func() {
+			tcc.errC = make(chan error, 1)
+			tcc.errC <- errors.WrapTransient(errors.New("test error"))
+
+			bb.addFullStream("foo/+/bar", 16)
+			So(post(bb.bundle()).Code, ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		Convey(`Will return StatusBadRequest on non-transient error.`, func() {
+			b := bb.genBase()
+			b.Secret = nil
+			bb.addFullStream("foo/+/bar", 16)
+
+			So(post(bb.bundle()).Code, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey(`Will reject a log bundle that exceeds the maximum size.`, func() {
+			h.MaxBundleSize = 4
+
+			So(post([]byte("12345")).Code, ShouldEqual, http.StatusRequestEntityTooLarge)
+		})
+
+		Convey(`Will report the outcome of each processed log bundle.`, func() {
+			var errs []error
+			h.Processed = func(c context.Context, err error, d time.Duration) {
+				errs = append(errs, err)
+			}
+			tcc.errC = make(chan error, 1)
+			tcc.errC <- errors.WrapTransient(errors.New("test error"))
+
+			bb.addFullStream("foo/+/bar", 16)
+			So(post(bb.bundle()).Code, ShouldEqual, http.StatusServiceUnavailable)
+			So(post([]byte("12345")).Code, ShouldEqual, http.StatusOK)
+			So(errs, ShouldHaveLength, 2)
+			So(errors.IsTransient(errs[0]), ShouldBeTrue)
+			So(errs[1], ShouldBeNil)
+		})
+
+		Convey(`Will wait for in-flight log bundles when closed, then reject new ones.`, func() {
+			tcc.errC = make(chan error)
+			bb.addFullStream("foo/+/bar", 16)
+			data := bb.bundle()
+
+			doneC := make(chan int)
+			go func() {
+				doneC <- post(data).Code
+			}()
+
+			// Once the Coordinator has been entered, the log bundle is in flight.
+			tcc.errC <- nil
+
+			closedC := make(chan struct{})
+			go func() {
+				defer close(closedC)
+				h.Close()
+			}()
+
+			stopC := make(chan struct{})
+			defer close(stopC)
+			select {
+			case <-closedC:
+				panic("Handler closed with a log bundle in flight")
+			default:
+			}
+			go func() {
+				for {
+					select {
+					case tcc.errC <- nil:
+					case <-stopC:
+						return
+					}
+				}
+			}()
+
+			So(<-doneC, ShouldEqual, http.StatusOK)
+			<-closedC
+			So(post(data).Code, ShouldEqual, http.StatusServiceUnavailable)
+		})
+
+		Convey(`Will reject a non-POST request.`, func() {
+			req, err := http.NewRequest("GET", "/", nil)
+			So(err, ShouldBeNil)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			So(rec.Code, ShouldEqual, http.StatusMethodNotAllowed)
+		})
+	})
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/luci/luci-go/server/internal/logdog/service/config"
 	"github.com/luci/luci-go/server/logdog/storage"
 	"github.com/luci/luci-go/server/logdog/storage/bigtable"
+	"github.com/luci/luci-go/server/logdog/storage/filesystem"
 	"golang.org/x/net/context"
 	"google.golang.org/cloud"
 	"google.golang.org/cloud/compute/metadata"
@@ -65,6 +66,10 @@ type Service struct {
 	Name string
 	// Flags is the set of flags that will be used by the Service.
 	Flags flag.FlagSet
+	// ExpireIntermediateStorage, if true, has this service remove expired log
+	// data from local filesystem intermediate storage. Only the service that
+	// writes to that storage should set this.
+	ExpireIntermediateStorage bool
 
 	shutdownFunc atomic.Value
 
@@ -77,6 +82,7 @@ type Service struct {
 	coordinatorInsecure       bool
 	serviceID                 string
 	storageCredentialJSONPath string
+	storageLocalDir           string
 	cpuProfilePath            string
 	heapProfilePath           string
 
@@ -252,6 +258,8 @@ func (s *Service) addFlags(c context.Context, fs *flag.FlagSet) {
 		"Connect to Coordinator over HTTP (instead of HTTPS).")
 	fs.StringVar(&s.storageCredentialJSONPath, "storage-credential-json-path", "",
 		"If supplied, the path of a JSON credential file to load and use for storage operations.")
+	fs.StringVar(&s.storageLocalDir, "storage-local-dir", "",
+		"If supplied, store intermediate log data in this local directory instead of BigTable.")
 	fs.StringVar(&s.cpuProfilePath, "cpu-profile-path", "",
 		"If supplied, enable CPU profiling and write the profile here.")
 	fs.StringVar(&s.heapProfilePath, "heap-profile-path", "",
@@ -381,6 +389,30 @@ func (s *Service) IntermediateStorage(c context.Context) (storage.Storage, error
 		return nil, ErrInvalidConfig
 	}
 
+	if s.storageLocalDir != "" {
+		log.Fields{
+			"dir": s.storageLocalDir,
+		}.Infof(c, "Using local filesystem intermediate storage.")
+		st, err := filesystem.New(c, filesystem.Options{
+			Root:   s.storageLocalDir,
+			Expire: s.ExpireIntermediateStorage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// BigTable expires log data with a garbage collection policy; local storage
+		// must be told its maximum log age. Every service hides expired log data,
+		// but only one removes it.
+		if mla := cfg.GetStorage().GetMaxLogAge(); mla != nil {
+			if err := st.Config(storage.Config{MaxLogAge: mla.Duration()}); err != nil {
+				st.Close()
+				return nil, err
+			}
+		}
+		return st, nil
+	}
+
 	btcfg := cfg.GetStorage().GetBigtable()
 	if btcfg == nil {
 		log.Errorf(c, "Missing BigTable storage configuration")
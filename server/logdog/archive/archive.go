@@ -33,6 +33,10 @@ type Manifest struct {
 	// DataWriter, if not nil, is the Writer to which reconstructed LogEntry data
 	// will be written.
 	DataWriter io.Writer
+	// SearchIndexWriter, if not nil, is the Writer to which the full-text search
+	// index of a text log stream will be written. It is ignored for other stream
+	// types.
+	SearchIndexWriter io.Writer
 
 	// StreamIndexRange, if >0, is the maximum number of log entry stream indices
 	// in between successive index entries.
@@ -114,6 +118,15 @@ func Archive(m Manifest) error {
 			}
 		}
 
+		var searchC chan *logpb.LogEntry
+		if m.SearchIndexWriter != nil && m.Desc != nil && m.Desc.StreamType == logpb.StreamType_TEXT {
+			searchC = make(chan *logpb.LogEntry)
+
+			taskC <- func() error {
+				return archiveSearchIndex(m.SearchIndexWriter, searchC)
+			}
+		}
+
 		// Iterate through all of our Source's logs and process them.
 		taskC <- func() error {
 			if logC != nil {
@@ -122,6 +135,9 @@ func Archive(m Manifest) error {
 			if dataC != nil {
 				defer close(dataC)
 			}
+			if searchC != nil {
+				defer close(searchC)
+			}
 
 			sendLog := func(le *logpb.LogEntry) {
 				if logC != nil {
@@ -130,6 +146,9 @@ func Archive(m Manifest) error {
 				if dataC != nil {
 					dataC <- le
 				}
+				if searchC != nil {
+					searchC <- le
+				}
 			}
 
 			for {
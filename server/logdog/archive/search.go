@@ -0,0 +1,285 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+	"github.com/luci/luci-go/common/recordio"
+)
+
+// A search index is a RecordIO stream of line blocks. Each block is written as
+// two frames:
+//	- The sorted, concatenated set of distinct 3-byte trigrams that occur in
+//	  the lower-cased text of the block's lines.
+//	- The block's lines. Each line is encoded as its uvarint line index, the
+//	  uvarint stream index of the LogEntry in which it begins, the uvarint
+//	  length of its text, and its text.
+//
+// A block's trigram set is used to skip blocks that cannot contain a match
+// without decoding their lines.
+
+const (
+	// searchBlockSize is the number of text bytes after which a search index
+	// block is emitted.
+	searchBlockSize = 64 * 1024
+
+	// maxSearchFrameSize is the maximum size of a search index frame.
+	maxSearchFrameSize = 16 * 1024 * 1024
+
+	trigramSize = 3
+)
+
+// SearchMatch is a log stream line that matched a search.
+type SearchMatch struct {
+	// Line is the line index of the matching line within its log stream.
+	Line int64
+	// StreamIndex is the stream index of the LogEntry that contains the start
+	// of the matching line.
+	StreamIndex int64
+}
+
+// searchIndexBuilder is a stateful engine that constructs a search index.
+type searchIndexBuilder struct {
+	w io.Writer
+
+	// lines is the encoded lines of the current block.
+	lines bytes.Buffer
+	// trigrams is the set of trigrams of the current block.
+	trigrams map[string]struct{}
+
+	// partial is the text of the current line, if it spans multiple LogEntry.
+	partial       []byte
+	partialLine   uint64
+	partialStream uint64
+	hasPartial    bool
+}
+
+func archiveSearchIndex(w io.Writer, searchC <-chan *logpb.LogEntry) error {
+	sb := searchIndexBuilder{
+		w:        w,
+		trigrams: make(map[string]struct{}),
+	}
+
+	// Defer error handling until later, as we are still responsible for
+	// draining "searchC".
+	var err error
+	for le := range searchC {
+		if err == nil {
+			err = sb.addLogEntry(le)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return sb.finish()
+}
+
+func (sb *searchIndexBuilder) addLogEntry(le *logpb.LogEntry) error {
+	text := le.GetText()
+	if text == nil {
+		return nil
+	}
+
+	lineIndex := le.Sequence
+	for _, l := range text.Lines {
+		if !sb.hasPartial {
+			sb.partial, sb.partialLine, sb.partialStream = sb.partial[:0], lineIndex, le.StreamIndex
+			sb.hasPartial = true
+		}
+		sb.partial = append(sb.partial, l.Value...)
+
+		// A line without a delimiter is continued in the next line.
+		if l.Delimiter == "" {
+			continue
+		}
+		if err := sb.addLine(); err != nil {
+			return err
+		}
+		lineIndex++
+	}
+	return nil
+}
+
+func (sb *searchIndexBuilder) addLine() error {
+	sb.hasPartial = false
+
+	var hdr [3 * binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(hdr[:], sb.partialLine)
+	n += binary.PutUvarint(hdr[n:], sb.partialStream)
+	n += binary.PutUvarint(hdr[n:], uint64(len(sb.partial)))
+	sb.lines.Write(hdr[:n])
+	sb.lines.Write(sb.partial)
+
+	lower := strings.ToLower(string(sb.partial))
+	for i := 0; i+trigramSize <= len(lower); i++ {
+		sb.trigrams[lower[i:i+trigramSize]] = struct{}{}
+	}
+
+	if sb.lines.Len() >= searchBlockSize {
+		return sb.emitBlock()
+	}
+	return nil
+}
+
+func (sb *searchIndexBuilder) emitBlock() error {
+	if sb.lines.Len() == 0 {
+		return nil
+	}
+
+	trigrams := make([]string, 0, len(sb.trigrams))
+	for t := range sb.trigrams {
+		trigrams = append(trigrams, t)
+	}
+	sort.Strings(trigrams)
+
+	if _, err := recordio.WriteFrame(sb.w, []byte(strings.Join(trigrams, ""))); err != nil {
+		return err
+	}
+	if _, err := recordio.WriteFrame(sb.w, sb.lines.Bytes()); err != nil {
+		return err
+	}
+
+	sb.lines.Reset()
+	sb.trigrams = make(map[string]struct{}, len(sb.trigrams))
+	return nil
+}
+
+// finish emits any buffered lines, including a trailing line that was never
+// delimited.
+func (sb *searchIndexBuilder) finish() error {
+	if sb.hasPartial {
+		if err := sb.addLine(); err != nil {
+			return err
+		}
+	}
+	return sb.emitBlock()
+}
+
+// Search scans the search index read from r for lines that contain text. If
+// ignoreCase is true, lines are matched case-insensitively.
+//
+// The callback is invoked for each matching line, in index order. If it returns
+// false, the search will stop.
+func Search(r io.Reader, text string, ignoreCase bool, cb func(*SearchMatch) bool) error {
+	if text == "" {
+		return errors.New("empty search text")
+	}
+
+	lowerText := strings.ToLower(text)
+	if ignoreCase {
+		text = lowerText
+	}
+
+	// Every trigram of the lower-cased text must be in a block's trigram set for
+	// the block to contain a match.
+	var trigrams []string
+	for i := 0; i+trigramSize <= len(lowerText); i++ {
+		trigrams = append(trigrams, lowerText[i:i+trigramSize])
+	}
+
+	rio := recordio.NewReader(r, maxSearchFrameSize)
+	for {
+		set, err := readSearchFrame(rio)
+		switch err {
+		case nil:
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+
+		if !hasTrigrams(set, trigrams) {
+			// Skip the block's lines.
+			if _, fr, err := rio.ReadFrame(); err != nil {
+				return eofIsCorrupt(err)
+			} else if _, err := io.Copy(ioutil.Discard, fr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		lines, err := readSearchFrame(rio)
+		if err != nil {
+			return eofIsCorrupt(err)
+		}
+		for len(lines) > 0 {
+			var m SearchMatch
+			var line []byte
+			if m, line, lines, err = decodeSearchLine(lines); err != nil {
+				return err
+			}
+
+			l := string(line)
+			if ignoreCase {
+				l = strings.ToLower(l)
+			}
+			if strings.Contains(l, text) && !cb(&m) {
+				return nil
+			}
+		}
+	}
+}
+
+func readSearchFrame(rio recordio.Reader) ([]byte, error) {
+	size, fr, err := rio.ReadFrame()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(fr, buf); err != nil {
+		return nil, eofIsCorrupt(err)
+	}
+	return buf, nil
+}
+
+func decodeSearchLine(d []byte) (m SearchMatch, line, rest []byte, err error) {
+	var v [3]uint64
+	for i := range v {
+		n := 0
+		if v[i], n = binary.Uvarint(d); n <= 0 {
+			err = errors.New("corrupt search index line")
+			return
+		}
+		d = d[n:]
+	}
+	if v[2] > uint64(len(d)) {
+		err = errors.New("corrupt search index line")
+		return
+	}
+
+	m.Line, m.StreamIndex = int64(v[0]), int64(v[1])
+	line, rest = d[:v[2]], d[v[2]:]
+	return
+}
+
+// hasTrigrams returns true if every trigram in trigrams is in set, a sorted
+// concatenation of trigrams.
+func hasTrigrams(set []byte, trigrams []string) bool {
+	count := len(set) / trigramSize
+	for _, t := range trigrams {
+		i := sort.Search(count, func(i int) bool {
+			return string(set[i*trigramSize:(i+1)*trigramSize]) >= t
+		})
+		if i == count || string(set[i*trigramSize:(i+1)*trigramSize]) != t {
+			return false
+		}
+	}
+	return true
+}
+
+func eofIsCorrupt(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errors.New("truncated search index")
+	}
+	return err
+}
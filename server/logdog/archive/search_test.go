@@ -0,0 +1,132 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/luci/luci-go/common/proto/logdog/logpb"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func textEntry(streamIndex, sequence uint64, lines ...string) *logpb.LogEntry {
+	le := logpb.LogEntry{
+		StreamIndex: streamIndex,
+		PrefixIndex: streamIndex,
+		Sequence:    sequence,
+	}
+	text := logpb.Text{}
+	for _, l := range lines {
+		// A trailing "\n" marks a delimited line.
+		line := logpb.Text_Line{Value: strings.TrimSuffix(l, "\n")}
+		if line.Value != l {
+			line.Delimiter = "\n"
+		}
+		text.Lines = append(text.Lines, &line)
+	}
+	le.Content = &logpb.LogEntry_Text{Text: &text}
+	return &le
+}
+
+func TestSearchIndex(t *testing.T) {
+	Convey(`A Manifest with a search index Writer`, t, func() {
+		var searchB bytes.Buffer
+		desc := &logpb.LogStreamDescriptor{
+			Prefix:     "test",
+			Name:       "foo",
+			StreamType: logpb.StreamType_TEXT,
+		}
+		ts := testSource{}
+		m := Manifest{
+			Desc:              desc,
+			Source:            &ts,
+			SearchIndexWriter: &searchB,
+		}
+
+		search := func(text string, ignoreCase bool) ([]SearchMatch, error) {
+			var matches []SearchMatch
+			err := Search(bytes.NewReader(searchB.Bytes()), text, ignoreCase, func(m *SearchMatch) bool {
+				matches = append(matches, *m)
+				return true
+			})
+			return matches, err
+		}
+
+		Convey(`Can search lines, including lines that span log entries.`, func() {
+			ts.addEntries(
+				textEntry(0, 0, "Starting build\n", "compile: "),
+				textEntry(1, 1, "ERROR: no such file\n", "link ok\n"),
+				textEntry(2, 3, "error: retrying\n", "tail without delimiter"),
+			)
+			So(Archive(m), ShouldBeNil)
+
+			matches, err := search("ERROR", false)
+			So(err, ShouldBeNil)
+			So(matches, ShouldResemble, []SearchMatch{{Line: 1, StreamIndex: 0}})
+
+			matches, err = search("error", true)
+			So(err, ShouldBeNil)
+			So(matches, ShouldResemble, []SearchMatch{{Line: 1, StreamIndex: 0}, {Line: 3, StreamIndex: 2}})
+
+			matches, err = search("without", false)
+			So(err, ShouldBeNil)
+			So(matches, ShouldResemble, []SearchMatch{{Line: 4, StreamIndex: 2}})
+
+			matches, err = search("ok", false)
+			So(err, ShouldBeNil)
+			So(matches, ShouldResemble, []SearchMatch{{Line: 2, StreamIndex: 1}})
+
+			matches, err = search("missing", true)
+			So(err, ShouldBeNil)
+			So(matches, ShouldBeNil)
+		})
+
+		Convey(`Can search across multiple index blocks.`, func() {
+			line := strings.Repeat("x", 100)
+			count := 2 * searchBlockSize / len(line)
+			for i := 0; i < count; i++ {
+				ts.addEntries(textEntry(uint64(i), uint64(i), fmt.Sprintf("%s %d\n", line, i)))
+			}
+			So(Archive(m), ShouldBeNil)
+
+			matches, err := search(fmt.Sprintf("x %d", count-1), false)
+			So(err, ShouldBeNil)
+			So(matches, ShouldResemble, []SearchMatch{{Line: int64(count - 1), StreamIndex: int64(count - 1)}})
+		})
+
+		Convey(`Will stop searching when the callback returns false.`, func() {
+			ts.addEntries(textEntry(0, 0, "a match\n", "another match\n"))
+			So(Archive(m), ShouldBeNil)
+
+			count := 0
+			So(Search(&searchB, "match", false, func(*SearchMatch) bool {
+				count++
+				return false
+			}), ShouldBeNil)
+			So(count, ShouldEqual, 1)
+		})
+
+		Convey(`Will not build a search index for a binary stream.`, func() {
+			desc.StreamType = logpb.StreamType_BINARY
+			ts.addEntries(textEntry(0, 0, "text\n"))
+			So(Archive(m), ShouldBeNil)
+			So(searchB.Len(), ShouldEqual, 0)
+		})
+
+		Convey(`Will fail on a truncated search index.`, func() {
+			ts.addEntries(textEntry(0, 0, "some text\n"))
+			So(Archive(m), ShouldBeNil)
+
+			searchB.Truncate(searchB.Len() - 1)
+			_, err := search("text", false)
+			So(err, ShouldErrLike, "truncated search index")
+		})
+	})
+}
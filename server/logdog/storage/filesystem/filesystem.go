@@ -0,0 +1,657 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package filesystem implements a storage.Storage instance that stores log
+// stream data in a local directory.
+//
+// This is intended for running a LogDog service stack on a single machine. It
+// is durable, but is not designed to scale.
+//
+// Each log stream is stored in its own directory, named for a hash of its
+// project and path. The directory contains:
+//	- Append-only segment files, named "<number>.seg", which hold the raw
+//	  concatenated log entry data.
+//	- An append-only "index" file, which holds a fixed-size record for each
+//	  log entry identifying its stream index, segment, offset, size, and the
+//	  time at which it was written.
+//
+// Log entry data is synced to its segment before its index record is written,
+// so an interrupted Put can, at worst, leave unreferenced data in a segment
+// or a partial trailing index record, both of which are ignored.
+//
+// Multiple Storage instances may read the same directory, but only one may
+// write to it. That one should also be the only one to remove expired data
+// (see Options.Expire).
+package filesystem
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/logdog/types"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/server/logdog/storage"
+	"golang.org/x/net/context"
+)
+
+const (
+	// defaultMaxSegmentSize is the default size after which a log stream's
+	// segment will no longer be written to.
+	defaultMaxSegmentSize = 16 * 1024 * 1024
+
+	// indexFileName is the name of a log stream's index file.
+	indexFileName = "index"
+	// segmentExt is the file extension of a log stream's segment files.
+	segmentExt = ".seg"
+
+	// indexEntrySize is the size of an encoded indexEntry.
+	indexEntrySize = 32
+)
+
+var errClosed = errors.New("filesystem: storage is closed")
+
+// Options is the set of configuration options for filesystem Storage.
+type Options struct {
+	// Root is the directory in which log stream data is stored. It will be
+	// created if it does not exist.
+	Root string
+
+	// MaxSegmentSize, if >0, is the size in bytes after which a log stream's
+	// segment file is retired and a new one started. Expiration removes whole
+	// segments, so smaller segments expire data more promptly.
+	//
+	// If <= 0, a default size will be used.
+	MaxSegmentSize int64
+
+	// Expire, if true, removes log stream data that has outlived the configured
+	// MaxLogAge from Root. Otherwise, expired log entries are hidden from reads
+	// but left on disk.
+	//
+	// Only the one process that writes to Root should enable this.
+	Expire bool
+}
+
+// indexEntry is the index record for a single log entry.
+//
+// It is encoded big-endian as:
+//	- index (8 bytes)
+//	- segment (4 bytes)
+//	- size (4 bytes)
+//	- offset (8 bytes)
+//	- written, as Unix nanoseconds (8 bytes)
+type indexEntry struct {
+	index   types.MessageIndex
+	segment uint32
+	size    uint32
+	offset  int64
+	written time.Time
+}
+
+func (e *indexEntry) encode(d []byte) {
+	binary.BigEndian.PutUint64(d[0:], uint64(e.index))
+	binary.BigEndian.PutUint32(d[8:], e.segment)
+	binary.BigEndian.PutUint32(d[12:], e.size)
+	binary.BigEndian.PutUint64(d[16:], uint64(e.offset))
+	binary.BigEndian.PutUint64(d[24:], uint64(e.written.UnixNano()))
+}
+
+func (e *indexEntry) decode(d []byte) {
+	e.index = types.MessageIndex(binary.BigEndian.Uint64(d[0:]))
+	e.segment = binary.BigEndian.Uint32(d[8:])
+	e.size = binary.BigEndian.Uint32(d[12:])
+	e.offset = int64(binary.BigEndian.Uint64(d[16:]))
+	e.written = time.Unix(0, int64(binary.BigEndian.Uint64(d[24:]))).UTC()
+}
+
+// segment is the state of a single log stream segment file.
+type segment struct {
+	// size is the size of the segment's referenced data.
+	size int64
+	// newest is the write time of the segment's newest log entry.
+	newest time.Time
+	// removed is true if the segment file no longer exists.
+	removed bool
+}
+
+// logStream is the loaded state of a single log stream's directory.
+type logStream struct {
+	dir string
+
+	entries map[types.MessageIndex]*indexEntry
+	// indices is the sorted set of keys in entries.
+	indices []types.MessageIndex
+
+	segments map[uint32]*segment
+	// current is the highest-numbered segment.
+	current uint32
+
+	// indexSize is the number of bytes of the index file that have been loaded.
+	indexSize int64
+}
+
+func newLogStream(dir string) *logStream {
+	return &logStream{
+		dir:      dir,
+		entries:  map[types.MessageIndex]*indexEntry{},
+		segments: map[uint32]*segment{},
+	}
+}
+
+func (ls *logStream) indexPath() string { return filepath.Join(ls.dir, indexFileName) }
+
+func (ls *logStream) segmentPath(seg uint32) string {
+	return filepath.Join(ls.dir, fmt.Sprintf("%08d%s", seg, segmentExt))
+}
+
+// refresh loads any index records that have been appended to the log stream's
+// index file since it was last loaded.
+//
+// If the log stream's directory no longer exists, refresh returns
+// storage.ErrDoesNotExist.
+func (ls *logStream) refresh() error {
+	fd, err := os.Open(ls.indexPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			*ls = *newLogStream(ls.dir)
+			if _, err := os.Stat(ls.dir); os.IsNotExist(err) {
+				return storage.ErrDoesNotExist
+			}
+			return nil
+		}
+		return err
+	}
+	defer fd.Close()
+
+	st, err := fd.Stat()
+	if err != nil {
+		return err
+	}
+	size := st.Size()
+	if size < ls.indexSize {
+		// The index has been replaced. Reload it from the beginning.
+		*ls = *newLogStream(ls.dir)
+	}
+
+	// Only load complete records. A partial trailing record is the result of
+	// an interrupted write, and will be replaced by the next Put.
+	size -= size % indexEntrySize
+	if size == ls.indexSize {
+		return nil
+	}
+
+	d := make([]byte, size-ls.indexSize)
+	if _, err := fd.ReadAt(d, ls.indexSize); err != nil {
+		return err
+	}
+	for ; len(d) > 0; d = d[indexEntrySize:] {
+		e := indexEntry{}
+		e.decode(d)
+		if err := ls.addEntry(&e); err != nil {
+			return err
+		}
+	}
+	ls.indexSize = size
+	return nil
+}
+
+// addEntry adds an index entry to the log stream's state. Entries whose
+// segment has been removed are discarded.
+func (ls *logStream) addEntry(e *indexEntry) error {
+	seg := ls.segments[e.segment]
+	if seg == nil {
+		seg = &segment{}
+		if _, err := os.Stat(ls.segmentPath(e.segment)); err != nil {
+			if !os.IsNotExist(err) {
+				return err
+			}
+			seg.removed = true
+		}
+		ls.segments[e.segment] = seg
+		if e.segment > ls.current {
+			ls.current = e.segment
+		}
+	}
+	if seg.removed {
+		return nil
+	}
+
+	if end := e.offset + int64(e.size); end > seg.size {
+		seg.size = end
+	}
+	if e.written.After(seg.newest) {
+		seg.newest = e.written
+	}
+
+	if _, ok := ls.entries[e.index]; !ok {
+		i := sort.Search(len(ls.indices), func(i int) bool { return ls.indices[i] >= e.index })
+		ls.indices = append(ls.indices, 0)
+		copy(ls.indices[i+1:], ls.indices[i:])
+		ls.indices[i] = e.index
+	}
+	ls.entries[e.index] = e
+	return nil
+}
+
+// removeSegment deletes a segment file and discards its index entries.
+func (ls *logStream) removeSegment(seg uint32) error {
+	if err := os.Remove(ls.segmentPath(seg)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	ls.segments[seg].removed = true
+
+	indices := ls.indices[:0]
+	for _, idx := range ls.indices {
+		if ls.entries[idx].segment == seg {
+			delete(ls.entries, idx)
+			continue
+		}
+		indices = append(indices, idx)
+	}
+	ls.indices = indices
+	return nil
+}
+
+// fsStorage is a storage.Storage implementation that stores log stream data
+// in a local directory.
+type fsStorage struct {
+	*Options
+
+	// Context is the bound supplied with New. It is used for logging and to
+	// determine the current time.
+	context.Context
+
+	mu        sync.Mutex
+	maxLogAge time.Duration
+	streams   map[string]*logStream
+	closed    bool
+}
+
+var _ storage.Storage = (*fsStorage)(nil)
+
+// New instantiates a new Storage instance that stores log stream data under
+// the configured Options' Root directory.
+func New(ctx context.Context, o Options) (storage.Storage, error) {
+	if o.Root == "" {
+		return nil, errors.New("filesystem: a root directory is required")
+	}
+	if o.MaxSegmentSize <= 0 {
+		o.MaxSegmentSize = defaultMaxSegmentSize
+	}
+	if err := os.MkdirAll(o.Root, 0755); err != nil {
+		return nil, err
+	}
+
+	return &fsStorage{
+		Options: &o,
+		Context: ctx,
+		streams: map[string]*logStream{},
+	}, nil
+}
+
+// Close implements storage.Storage.
+func (s *fsStorage) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	s.streams = nil
+}
+
+// Config implements storage.Storage.
+//
+// If Options.Expire is set, log stream segments whose entries have all
+// outlived the configured MaxLogAge are removed.
+func (s *fsStorage) Config(cfg storage.Config) error {
+	return s.run(func() error {
+		s.maxLogAge = cfg.MaxLogAge
+		if s.maxLogAge <= 0 || !s.Expire {
+			return nil
+		}
+
+		dirs, err := ioutil.ReadDir(s.Root)
+		if err != nil {
+			return err
+		}
+		for _, d := range dirs {
+			if !d.IsDir() {
+				continue
+			}
+
+			ls, err := s.loadLogStreamLocked(d.Name(), false)
+			switch {
+			case err == storage.ErrDoesNotExist:
+				continue
+			case err != nil:
+				return err
+			}
+			if err := s.expireLocked(d.Name(), ls); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Put implements storage.Storage.
+func (s *fsStorage) Put(req storage.PutRequest) error {
+	return s.run(func() error {
+		key := streamKey(req.Project, req.Path)
+		ls, err := s.loadLogStreamLocked(key, true)
+		if err != nil {
+			return err
+		}
+		if err := s.expireLocked(key, ls); err != nil {
+			return err
+		}
+		if ls, err = s.loadLogStreamLocked(key, true); err != nil {
+			return err
+		}
+
+		// Make sure that none of our records exist before writing any of them.
+		for i := range req.Values {
+			if e := ls.entries[req.Index+types.MessageIndex(i)]; e != nil && !s.isExpired(e) {
+				return storage.ErrExists
+			}
+		}
+		if len(req.Values) == 0 {
+			return nil
+		}
+
+		// Retire the current segment if it is full or has been expired.
+		seg := ls.current
+		if cur := ls.segments[seg]; cur != nil && (cur.removed || cur.size >= s.MaxSegmentSize) {
+			seg++
+		}
+
+		now := clock.Now(s).UTC()
+		entries := make([]indexEntry, len(req.Values))
+		if err := appendSync(ls.segmentPath(seg), -1, func(offset int64) []byte {
+			var d []byte
+			for i, v := range req.Values {
+				entries[i] = indexEntry{
+					index:   req.Index + types.MessageIndex(i),
+					segment: seg,
+					size:    uint32(len(v)),
+					offset:  offset + int64(len(d)),
+					written: now,
+				}
+				d = append(d, v...)
+			}
+			return d
+		}); err != nil {
+			return err
+		}
+
+		// Discard any partial trailing index record before appending ours.
+		if err := appendSync(ls.indexPath(), ls.indexSize, func(int64) []byte {
+			d := make([]byte, len(entries)*indexEntrySize)
+			for i := range entries {
+				entries[i].encode(d[i*indexEntrySize:])
+			}
+			return d
+		}); err != nil {
+			return err
+		}
+
+		for i := range entries {
+			if err := ls.addEntry(&entries[i]); err != nil {
+				return err
+			}
+		}
+		ls.indexSize += int64(len(entries) * indexEntrySize)
+		return nil
+	})
+}
+
+// Get implements storage.Storage.
+func (s *fsStorage) Get(req storage.GetRequest, cb storage.GetCallback) error {
+	var (
+		indices []types.MessageIndex
+		data    [][]byte
+	)
+	err := s.run(func() error {
+		ls, err := s.getLiveLogStreamLocked(req.Project, req.Path)
+		if err != nil {
+			return err
+		}
+
+		start := sort.Search(len(ls.indices), func(i int) bool { return ls.indices[i] >= req.Index })
+		var entries []*indexEntry
+		for _, idx := range ls.indices[start:] {
+			if req.Limit > 0 && len(entries) >= req.Limit {
+				break
+			}
+			if e := ls.entries[idx]; !s.isExpired(e) {
+				entries = append(entries, e)
+			}
+		}
+
+		indices = make([]types.MessageIndex, len(entries))
+		for i, e := range entries {
+			indices[i] = e.index
+		}
+		if req.KeysOnly {
+			return nil
+		}
+		data, err = ls.readEntries(entries)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, idx := range indices {
+		var d []byte
+		if data != nil {
+			d = data[i]
+		}
+		if !cb(idx, d) {
+			break
+		}
+	}
+	return nil
+}
+
+// Tail implements storage.Storage.
+func (s *fsStorage) Tail(project config.ProjectName, path types.StreamPath) (d []byte, idx types.MessageIndex, err error) {
+	err = s.run(func() error {
+		ls, err := s.getLiveLogStreamLocked(project, path)
+		if err != nil {
+			return err
+		}
+
+		for i := len(ls.indices) - 1; i >= 0; i-- {
+			e := ls.entries[ls.indices[i]]
+			if s.isExpired(e) {
+				continue
+			}
+
+			data, err := ls.readEntries([]*indexEntry{e})
+			if err != nil {
+				return err
+			}
+			d, idx = data[0], e.index
+			return nil
+		}
+		return storage.ErrDoesNotExist
+	})
+	return
+}
+
+func (s *fsStorage) run(f func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errClosed
+	}
+	return f()
+}
+
+func (s *fsStorage) isExpired(e *indexEntry) bool {
+	return s.maxLogAge > 0 && clock.Now(s).Sub(e.written) > s.maxLogAge
+}
+
+// getLiveLogStreamLocked loads a log stream for reading. If the log stream
+// has no entries, storage.ErrDoesNotExist will be returned.
+func (s *fsStorage) getLiveLogStreamLocked(project config.ProjectName, path types.StreamPath) (*logStream, error) {
+	ls, err := s.loadLogStreamLocked(streamKey(project, path), false)
+	if err != nil {
+		return nil, err
+	}
+	if len(ls.indices) == 0 {
+		return nil, storage.ErrDoesNotExist
+	}
+	return ls, nil
+}
+
+// loadLogStreamLocked returns the up-to-date state of the log stream stored in
+// the directory named key.
+//
+// If the log stream's directory does not exist, it will be created if create
+// is true. Otherwise, storage.ErrDoesNotExist will be returned.
+func (s *fsStorage) loadLogStreamLocked(key string, create bool) (*logStream, error) {
+	ls := s.streams[key]
+	if ls == nil {
+		ls = newLogStream(filepath.Join(s.Root, key))
+	}
+
+	err := ls.refresh()
+	if err == storage.ErrDoesNotExist && create {
+		if err = os.MkdirAll(ls.dir, 0755); err == nil {
+			err = ls.refresh()
+		}
+	}
+	if err != nil {
+		delete(s.streams, key)
+		return nil, err
+	}
+
+	s.streams[key] = ls
+	return ls, nil
+}
+
+// expireLocked removes the segments of a log stream whose entries have all
+// expired. If no segments remain, the log stream's directory is removed.
+//
+// It does nothing unless Options.Expire is set.
+func (s *fsStorage) expireLocked(key string, ls *logStream) error {
+	if s.maxLogAge <= 0 || !s.Expire {
+		return nil
+	}
+
+	now := clock.Now(s)
+	live := 0
+	for num, seg := range ls.segments {
+		switch {
+		case seg.removed:
+			continue
+		case now.Sub(seg.newest) <= s.maxLogAge:
+			live++
+			continue
+		}
+
+		log.Fields{
+			"dir":     ls.dir,
+			"segment": num,
+			"newest":  seg.newest,
+		}.Debugf(s, "Removing expired log stream segment.")
+		if err := ls.removeSegment(num); err != nil {
+			return err
+		}
+	}
+
+	if live == 0 && len(ls.segments) > 0 {
+		log.Fields{
+			"dir": ls.dir,
+		}.Debugf(s, "Removing expired log stream.")
+		if err := os.RemoveAll(ls.dir); err != nil {
+			return err
+		}
+		delete(s.streams, key)
+	}
+	return nil
+}
+
+// readEntries reads the data for the supplied index entries.
+func (ls *logStream) readEntries(entries []*indexEntry) ([][]byte, error) {
+	files := map[uint32]*os.File{}
+	defer func() {
+		for _, fd := range files {
+			fd.Close()
+		}
+	}()
+
+	data := make([][]byte, len(entries))
+	for i, e := range entries {
+		fd := files[e.segment]
+		if fd == nil {
+			var err error
+			if fd, err = os.Open(ls.segmentPath(e.segment)); err != nil {
+				return nil, err
+			}
+			files[e.segment] = fd
+		}
+
+		d := make([]byte, e.size)
+		if _, err := fd.ReadAt(d, e.offset); err != nil {
+			if err == io.EOF {
+				return nil, storage.ErrBadData
+			}
+			return nil, err
+		}
+		data[i] = d
+	}
+	return data, nil
+}
+
+// streamKey returns the name of the directory that stores the specified log
+// stream.
+func streamKey(project config.ProjectName, path types.StreamPath) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s", project, path)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// appendSync appends data to the file at path and syncs it to disk.
+//
+// If size is >= 0, the file will be truncated to size before appending. The
+// data function is supplied the offset at which its data will be written.
+func appendSync(path string, size int64, data func(int64) []byte) error {
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	if size >= 0 {
+		if err := fd.Truncate(size); err != nil {
+			return err
+		}
+	}
+	offset, err := fd.Seek(0, os.SEEK_END)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fd.Write(data(offset)); err != nil {
+		return err
+	}
+	if err := fd.Sync(); err != nil {
+		return err
+	}
+	return fd.Close()
+}
@@ -0,0 +1,223 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package filesystem
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/logdog/types"
+	"github.com/luci/luci-go/server/logdog/storage"
+	"github.com/luci/luci-go/server/logdog/storage/storagetest"
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	tdir, err := ioutil.TempDir("", "logdog_filesystem_storage")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tdir)
+
+	count := 0
+	storagetest.Test(t, func() storage.Storage {
+		count++
+		st, err := New(context.Background(), Options{
+			Root: filepath.Join(tdir, fmt.Sprintf("%d", count)),
+		})
+		if err != nil {
+			panic(err)
+		}
+		return st
+	})
+}
+
+func TestFilesystemStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A filesystem Storage instance`, t, func() {
+		tdir, err := ioutil.TempDir("", "logdog_filesystem_storage")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tdir)
+
+		// Write times are stored as Unix nanoseconds, which can't represent
+		// testclock.TestTimeUTC.
+		c, tc := testclock.UseTime(context.Background(), time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))
+		o := Options{
+			Root:           tdir,
+			MaxSegmentSize: 16,
+			Expire:         true,
+		}
+		newStorage := func() storage.Storage {
+			st, err := New(c, o)
+			if err != nil {
+				panic(err)
+			}
+			return st
+		}
+		st := newStorage()
+		defer st.Close()
+
+		project := config.ProjectName("test-project")
+		path := types.StreamPath("testing/+/foo/bar")
+
+		getAll := func(st storage.Storage) ([]types.MessageIndex, error) {
+			var indices []types.MessageIndex
+			err := st.Get(storage.GetRequest{Project: project, Path: path}, func(idx types.MessageIndex, d []byte) bool {
+				if !bytes.Equal(storagetest.NumRec(idx).Data, d) {
+					panic(fmt.Errorf("bad data for %d: %v", idx, d))
+				}
+				indices = append(indices, idx)
+				return true
+			})
+			return indices, err
+		}
+
+		Convey(`Requires a root directory.`, func() {
+			_, err := New(c, Options{})
+			So(err, ShouldErrLike, "a root directory is required")
+		})
+
+		Convey(`Will fail after it has been closed.`, func() {
+			st.Close()
+			So(storagetest.PutRange(st, project, path, 0, 1), ShouldErrLike, "storage is closed")
+		})
+
+		Convey(`With records written across several segments`, func() {
+			// Each record is 8 bytes, so each segment holds two Put calls.
+			for i := 0; i < 6; i++ {
+				So(storagetest.PutRange(st, project, path, types.MessageIndex(i), 1), ShouldBeNil)
+				tc.Add(time.Minute)
+			}
+
+			segs, err := filepath.Glob(filepath.Join(tdir, "*", "*"+segmentExt))
+			So(err, ShouldBeNil)
+			So(len(segs), ShouldEqual, 3)
+
+			Convey(`Can read the records from a new Storage instance.`, func() {
+				st := newStorage()
+				defer st.Close()
+
+				indices, err := getAll(st)
+				So(err, ShouldBeNil)
+				So(indices, ShouldResemble, []types.MessageIndex{0, 1, 2, 3, 4, 5})
+			})
+
+			Convey(`A reading Storage instance sees records as they are written.`, func() {
+				rst := newStorage()
+				defer rst.Close()
+
+				_, idx, err := rst.Tail(project, path)
+				So(err, ShouldBeNil)
+				So(idx, ShouldEqual, 5)
+
+				So(storagetest.PutRange(st, project, path, 6, 1), ShouldBeNil)
+				_, idx, err = rst.Tail(project, path)
+				So(err, ShouldBeNil)
+				So(idx, ShouldEqual, 6)
+			})
+
+			Convey(`Will ignore a partial trailing index record.`, func() {
+				ip := filepath.Join(tdir, streamKey(project, path), indexFileName)
+				fd, err := os.OpenFile(ip, os.O_WRONLY|os.O_APPEND, 0644)
+				So(err, ShouldBeNil)
+				_, err = fd.Write([]byte{0xFF, 0xFF, 0xFF})
+				So(err, ShouldBeNil)
+				So(fd.Close(), ShouldBeNil)
+
+				st := newStorage()
+				defer st.Close()
+
+				indices, err := getAll(st)
+				So(err, ShouldBeNil)
+				So(indices, ShouldResemble, []types.MessageIndex{0, 1, 2, 3, 4, 5})
+
+				Convey(`And will replace it on the next Put.`, func() {
+					So(storagetest.PutRange(st, project, path, 6, 1), ShouldBeNil)
+
+					indices, err := getAll(newStorage())
+					So(err, ShouldBeNil)
+					So(indices, ShouldResemble, []types.MessageIndex{0, 1, 2, 3, 4, 5, 6})
+				})
+			})
+
+			Convey(`Will only hide expired records if it doesn't expire data.`, func() {
+				o.Expire = false
+				ro := newStorage()
+				defer ro.Close()
+				So(ro.Config(storage.Config{MaxLogAge: 3*time.Minute + time.Second}), ShouldBeNil)
+
+				indices, err := getAll(ro)
+				So(err, ShouldBeNil)
+				So(indices, ShouldResemble, []types.MessageIndex{3, 4, 5})
+
+				segs, err := filepath.Glob(filepath.Join(tdir, "*", "*"+segmentExt))
+				So(err, ShouldBeNil)
+				So(len(segs), ShouldEqual, 3)
+			})
+
+			Convey(`When configured with a MaxLogAge`, func() {
+				So(st.Config(storage.Config{MaxLogAge: 3*time.Minute + time.Second}), ShouldBeNil)
+
+				Convey(`Will not return expired records.`, func() {
+					indices, err := getAll(st)
+					So(err, ShouldBeNil)
+					So(indices, ShouldResemble, []types.MessageIndex{3, 4, 5})
+				})
+
+				Convey(`Will remove segments whose records have all expired.`, func() {
+					segs, err := filepath.Glob(filepath.Join(tdir, "*", "*"+segmentExt))
+					So(err, ShouldBeNil)
+					So(len(segs), ShouldEqual, 2)
+
+					// Records removed from disk are not visible to new instances.
+					indices, err := getAll(newStorage())
+					So(err, ShouldBeNil)
+					So(indices, ShouldResemble, []types.MessageIndex{2, 3, 4, 5})
+				})
+
+				Convey(`Can put a record over an expired record.`, func() {
+					So(storagetest.PutRange(st, project, path, 0, 1), ShouldBeNil)
+
+					indices, err := getAll(st)
+					So(err, ShouldBeNil)
+					So(indices, ShouldResemble, []types.MessageIndex{0, 3, 4, 5})
+				})
+
+				Convey(`Will remove the log stream once all of its records have expired.`, func() {
+					tc.Add(time.Hour)
+					So(st.Config(storage.Config{MaxLogAge: time.Minute}), ShouldBeNil)
+
+					_, _, err := st.Tail(project, path)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+					dirs, err := ioutil.ReadDir(tdir)
+					So(err, ShouldBeNil)
+					So(dirs, ShouldHaveLength, 0)
+
+					Convey(`And can recreate it.`, func() {
+						So(storagetest.PutRange(st, project, path, 0, 1), ShouldBeNil)
+
+						indices, err := getAll(st)
+						So(err, ShouldBeNil)
+						So(indices, ShouldResemble, []types.MessageIndex{0})
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright 2016 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package storagetest implements a behavioral test suite for storage.Storage
+// implementations.
+package storagetest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/config"
+	"github.com/luci/luci-go/common/logdog/types"
+	"github.com/luci/luci-go/server/logdog/storage"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// Rec is a single log stream record.
+type Rec struct {
+	Index types.MessageIndex
+	Data  []byte
+}
+
+// NumRec returns a Rec whose data is the big-endian encoding of its index.
+func NumRec(v types.MessageIndex) *Rec {
+	buf := bytes.Buffer{}
+	binary.Write(&buf, binary.BigEndian, v)
+	return &Rec{
+		Index: v,
+		Data:  buf.Bytes(),
+	}
+}
+
+// PutRange writes count NumRec records to a log stream, starting at index
+// start.
+func PutRange(st storage.Storage, project config.ProjectName, path types.StreamPath,
+	start types.MessageIndex, count int) error {
+	req := storage.PutRequest{
+		Project: project,
+		Path:    path,
+		Index:   start,
+	}
+	for i := 0; i < count; i++ {
+		req.Values = append(req.Values, NumRec(start+types.MessageIndex(i)).Data)
+	}
+	return st.Put(req)
+}
+
+// Test runs the behavioral test suite against Storage instances created by
+// newStorage. A new Storage instance is created, and closed, for each test
+// case.
+func Test(t *testing.T, newStorage func() storage.Storage) {
+	Convey(`A Storage instance`, t, func() {
+		st := newStorage()
+		defer st.Close()
+
+		project := config.ProjectName("test-project")
+		path := types.StreamPath("testing/+/foo/bar")
+
+		Convey(`Will return ErrDoesNotExist for a log stream with no records.`, func() {
+			err := st.Get(storage.GetRequest{Project: project, Path: path}, func(types.MessageIndex, []byte) bool {
+				panic("unexpected record")
+			})
+			So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+			_, _, err = st.Tail(project, path)
+			So(err, ShouldEqual, storage.ErrDoesNotExist)
+		})
+
+		Convey(`Can Put() log stream records {0..5, 7, 8, 10}.`, func() {
+			So(PutRange(st, project, path, 0, 6), ShouldBeNil)
+			So(PutRange(st, project, path, 7, 2), ShouldBeNil)
+			So(PutRange(st, project, path, 10, 1), ShouldBeNil)
+
+			var recs []*Rec
+			for _, idx := range []types.MessageIndex{0, 1, 2, 3, 4, 5, 7, 8, 10} {
+				recs = append(recs, NumRec(idx))
+			}
+
+			var getRecs []*Rec
+			getAllCB := func(idx types.MessageIndex, data []byte) bool {
+				getRecs = append(getRecs, &Rec{
+					Index: idx,
+					Data:  data,
+				})
+				return true
+			}
+
+			Convey(`Put()`, func() {
+				req := storage.PutRequest{
+					Project: project,
+					Path:    path,
+				}
+
+				Convey(`Will return ErrExists when putting an existing entry.`, func() {
+					req.Values = [][]byte{[]byte("ohai")}
+
+					So(st.Put(req), ShouldEqual, storage.ErrExists)
+				})
+
+				Convey(`Can put records to a different log stream.`, func() {
+					So(PutRange(st, project, "testing/+/other", 0, 1), ShouldBeNil)
+
+					d, idx, err := st.Tail(project, "testing/+/other")
+					So(err, ShouldBeNil)
+					So(d, ShouldResemble, NumRec(0).Data)
+					So(idx, ShouldEqual, 0)
+				})
+			})
+
+			Convey(`Get()`, func() {
+				req := storage.GetRequest{
+					Project: project,
+					Path:    path,
+				}
+
+				Convey(`Can retrieve all of the records correctly.`, func() {
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recs)
+				})
+
+				Convey(`Can retrieve records starting from a missing index.`, func() {
+					req.Index = 6
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recs[6:])
+				})
+
+				Convey(`Will adhere to GetRequest limit.`, func() {
+					req.Limit = 4
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recs[:4])
+				})
+
+				Convey(`Will return no data for a KeysOnly request.`, func() {
+					req.KeysOnly = true
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(len(getRecs), ShouldEqual, len(recs))
+					for i, r := range getRecs {
+						So(r.Index, ShouldEqual, recs[i].Index)
+						So(r.Data, ShouldBeNil)
+					}
+				})
+
+				Convey(`Will stop iterating if callback returns false.`, func() {
+					count := 0
+					err := st.Get(req, func(types.MessageIndex, []byte) bool {
+						count++
+						return false
+					})
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+
+				Convey(`Will fail to retrieve records if the project doesn't exist.`, func() {
+					req.Project = "project-does-not-exist"
+
+					So(st.Get(req, getAllCB), ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Will fail to retrieve records if the path doesn't exist.`, func() {
+					req.Path = "testing/+/does/not/exist"
+
+					So(st.Get(req, getAllCB), ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey(`Tail()`, func() {
+				Convey(`Can retrieve the tail record, 10.`, func() {
+					d, idx, err := st.Tail(project, path)
+					So(err, ShouldBeNil)
+					So(d, ShouldResemble, NumRec(10).Data)
+					So(idx, ShouldEqual, 10)
+				})
+
+				Convey(`Will fail to retrieve records if the project doesn't exist.`, func() {
+					_, _, err := st.Tail("project-does-not-exist", path)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Will fail to retrieve records if the path doesn't exist.`, func() {
+					_, _, err := st.Tail(project, "testing/+/does/not/exist")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey(`Config()`, func() {
+				Convey(`Can update the configuration.`, func() {
+					So(st.Config(storage.Config{MaxLogAge: time.Hour}), ShouldBeNil)
+				})
+			})
+		})
+	})
+}